@@ -26,4 +26,8 @@ pushd ${source_dir}/arrow
 go get -d -t -v ./...
 go install -v ./...
 
+# the pure Go fallbacks of the platforms without assembly kernels.
+GOARCH=arm64 go vet ./bitutil ./internal/cpu ./math ./memory
+GOARCH=386 go vet ./bitutil ./internal/cpu ./math ./memory
+
 popd
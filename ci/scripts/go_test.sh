@@ -27,4 +27,9 @@ for d in $(go list ./... | grep -v vendor); do
     go test $d
 done
 
+# the pure Go fallbacks of the assembly kernels.
+for d in ./bitutil ./math ./memory; do
+    go test -tags noasm $d
+done
+
 popd
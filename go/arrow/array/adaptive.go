@@ -0,0 +1,322 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Promotion is a set of type promotions an AdaptiveBuilder may apply when
+// the values appended to it have different types.
+type Promotion int
+
+const (
+	// PromoteIntToFloat stores integers as FLOAT64 values when they are
+	// mixed with floating-point numbers.
+	PromoteIntToFloat Promotion = 1 << iota
+	// PromoteToString stores all the values as strings when they are of
+	// incompatible types, e.g. booleans mixed with numbers.
+	PromoteToString
+
+	// PromoteAll allows all the promotions. It is the default.
+	PromoteAll = PromoteIntToFloat | PromoteToString
+)
+
+type adaptiveConfig struct {
+	sample  int
+	promote Promotion
+}
+
+// AdaptiveOption is a functional option type used to configure an
+// AdaptiveBuilder.
+type AdaptiveOption func(*adaptiveConfig)
+
+// WithSampleSize fixes the type of an AdaptiveBuilder once n valid values
+// have been appended, rather than when its first array is created.
+// Values appended afterwards are converted to the fixed type.
+func WithSampleSize(n int) AdaptiveOption {
+	return func(cfg *adaptiveConfig) {
+		cfg.sample = n
+	}
+}
+
+// WithPromotions sets the type promotions an AdaptiveBuilder may apply.
+// The default is PromoteAll.
+func WithPromotions(p Promotion) AdaptiveOption {
+	return func(cfg *adaptiveConfig) {
+		cfg.promote = p
+	}
+}
+
+// valueKind is the kind of the Go values appended to an AdaptiveBuilder,
+// from which its data type is inferred.
+type valueKind int
+
+const (
+	kindNull valueKind = iota
+	kindBool
+	kindInt
+	kindFloat
+	kindString
+)
+
+func kindOf(v interface{}) (valueKind, bool) {
+	switch v.(type) {
+	case nil:
+		return kindNull, true
+	case bool:
+		return kindBool, true
+	case int64:
+		return kindInt, true
+	case float64:
+		return kindFloat, true
+	case string:
+		return kindString, true
+	default:
+		return 0, false
+	}
+}
+
+func (k valueKind) dataType() arrow.DataType {
+	switch k {
+	case kindBool:
+		return arrow.FixedWidthTypes.Boolean
+	case kindInt:
+		return arrow.PrimitiveTypes.Int64
+	case kindFloat:
+		return arrow.PrimitiveTypes.Float64
+	case kindString:
+		return arrow.BinaryTypes.String
+	default:
+		return arrow.Null
+	}
+}
+
+// AdaptiveBuilder builds arrays from Go values whose type is not known in
+// advance, such as the values of a schema-less JSON document.
+//
+// The values appended to an AdaptiveBuilder must be nil (a null), bool,
+// int64, float64 or string values. They are buffered until the data type of
+// the array is fixed, on the first call to NewArray or, with
+// WithSampleSize, once enough valid values have been appended. The type is
+// the narrowest one holding all the buffered values: BOOL, INT64, FLOAT64
+// for a mix of integers and floating-point numbers, and STRING for a mix of
+// incompatible values, as allowed by WithPromotions. Builders holding only
+// nulls create arrays of the NULL type.
+//
+// Once fixed from valid values, the type is kept for the next arrays, so
+// that they may be the chunks of a single column.
+type AdaptiveBuilder struct {
+	refCount int64
+	mem      memory.Allocator
+	cfg      adaptiveConfig
+
+	kind    valueKind     // kind of the values appended so far.
+	pending []interface{} // values appended before the type is fixed.
+	valid   int           // number of valid values in pending.
+	bldr    Builder       // builder of the fixed type, or nil.
+}
+
+// NewAdaptiveBuilder returns a new adaptive builder, using the provided
+// memory allocator.
+func NewAdaptiveBuilder(mem memory.Allocator, opts ...AdaptiveOption) *AdaptiveBuilder {
+	cfg := adaptiveConfig{promote: PromoteAll}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &AdaptiveBuilder{refCount: 1, mem: mem, cfg: cfg}
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (b *AdaptiveBuilder) Retain() {
+	atomic.AddInt64(&b.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (b *AdaptiveBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.bldr != nil {
+			b.bldr.Release()
+			b.bldr = nil
+		}
+		b.pending = nil
+	}
+}
+
+// Type returns the data type of the arrays created by the builder, or nil
+// if it is not fixed yet.
+func (b *AdaptiveBuilder) Type() arrow.DataType {
+	if b.bldr == nil {
+		return nil
+	}
+	return b.bldr.Type()
+}
+
+// Len returns the number of values appended to the builder.
+func (b *AdaptiveBuilder) Len() int {
+	if b.bldr == nil {
+		return len(b.pending)
+	}
+	return b.bldr.Len()
+}
+
+// NullN returns the number of nulls appended to the builder.
+func (b *AdaptiveBuilder) NullN() int {
+	if b.bldr == nil {
+		return len(b.pending) - b.valid
+	}
+	return b.bldr.NullN()
+}
+
+// AppendNull appends a null.
+func (b *AdaptiveBuilder) AppendNull() {
+	if b.bldr == nil {
+		b.pending = append(b.pending, nil)
+		return
+	}
+	b.bldr.AppendNull()
+}
+
+// Append appends v, which must be nil, a bool, an int64, a float64 or a
+// string.
+//
+// Append returns an error, and appends nothing, if v has another Go type,
+// or if the type of v cannot be reconciled with the values appended before
+// with the promotions allowed to the builder.
+func (b *AdaptiveBuilder) Append(v interface{}) error {
+	k, ok := kindOf(v)
+	if !ok {
+		return xerrors.Errorf("arrow/array: unsupported value type %T for adaptive builder", v)
+	}
+	if k == kindNull {
+		b.AppendNull()
+		return nil
+	}
+
+	kind, err := b.merge(b.kind, k)
+	if err != nil {
+		return err
+	}
+
+	if b.bldr != nil {
+		if kind != b.kind {
+			return xerrors.Errorf("arrow/array: cannot append %T value to %v array", v, b.bldr.Type())
+		}
+		b.append(v)
+		return nil
+	}
+
+	b.kind = kind
+	b.pending = append(b.pending, v)
+	b.valid++
+	if b.cfg.sample > 0 && b.valid >= b.cfg.sample {
+		b.fix()
+	}
+	return nil
+}
+
+// merge returns the kind of the values holding values of kinds x and y.
+func (b *AdaptiveBuilder) merge(x, y valueKind) (valueKind, error) {
+	switch {
+	case x == y || y == kindNull:
+		return x, nil
+	case x == kindNull:
+		return y, nil
+	case (x == kindInt && y == kindFloat) || (x == kindFloat && y == kindInt):
+		if b.cfg.promote&PromoteIntToFloat != 0 {
+			return kindFloat, nil
+		}
+	}
+	if b.cfg.promote&PromoteToString != 0 {
+		return kindString, nil
+	}
+	return 0, xerrors.Errorf("arrow/array: cannot mix %v and %v values without promotion", x.dataType(), y.dataType())
+}
+
+// fix creates the builder of the inferred type, and appends the pending
+// values to it.
+func (b *AdaptiveBuilder) fix() {
+	b.bldr = NewBuilder(b.mem, b.kind.dataType())
+	b.bldr.Reserve(len(b.pending))
+	for _, v := range b.pending {
+		if v == nil {
+			b.bldr.AppendNull()
+			continue
+		}
+		b.append(v)
+	}
+	b.pending = nil
+	b.valid = 0
+}
+
+// append appends the valid value v to the builder of the fixed type,
+// converting it to that type.
+func (b *AdaptiveBuilder) append(v interface{}) {
+	switch bldr := b.bldr.(type) {
+	case *BooleanBuilder:
+		bldr.Append(v.(bool))
+	case *Int64Builder:
+		bldr.Append(v.(int64))
+	case *Float64Builder:
+		switch v := v.(type) {
+		case int64:
+			bldr.Append(float64(v))
+		default:
+			bldr.Append(v.(float64))
+		}
+	case *StringBuilder:
+		switch v := v.(type) {
+		case bool:
+			bldr.Append(strconv.FormatBool(v))
+		case int64:
+			bldr.Append(strconv.FormatInt(v, 10))
+		case float64:
+			bldr.Append(strconv.FormatFloat(v, 'g', -1, 64))
+		default:
+			bldr.Append(v.(string))
+		}
+	}
+}
+
+// NewArray creates a new array from the appended values, fixing the data
+// type of the builder if needed, and resets the builder so it can be used
+// to build a new array.
+//
+// The type is kept for the next arrays unless the builder only held nulls,
+// in which case it is inferred again from the next values.
+func (b *AdaptiveBuilder) NewArray() Interface {
+	if b.bldr == nil {
+		b.fix()
+	}
+	arr := b.bldr.NewArray()
+	if b.kind == kindNull {
+		b.bldr.Release()
+		b.bldr = nil
+	}
+	return arr
+}
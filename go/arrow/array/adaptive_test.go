@@ -0,0 +1,177 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestAdaptiveBuilder(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		values  []interface{}
+		promote array.Promotion
+		want    arrow.DataType
+		str     string
+	}{
+		{"bools", []interface{}{nil, true, false}, array.PromoteAll, arrow.FixedWidthTypes.Boolean, "[(null) true false]"},
+		{"ints", []interface{}{int64(1), nil, int64(-2)}, array.PromoteAll, arrow.PrimitiveTypes.Int64, "[1 (null) -2]"},
+		{"floats", []interface{}{1.5, math.Inf(1)}, array.PromoteAll, arrow.PrimitiveTypes.Float64, "[1.5 +Inf]"},
+		{"strings", []interface{}{nil, nil, "a", ""}, array.PromoteAll, arrow.BinaryTypes.String, `[(null) (null) "a" ""]`},
+		{"int-to-float", []interface{}{nil, int64(1), 2.5, int64(3)}, array.PromoteAll, arrow.PrimitiveTypes.Float64, "[(null) 1 2.5 3]"},
+		{"int-to-float-only", []interface{}{int64(1), 2.5}, array.PromoteIntToFloat, arrow.PrimitiveTypes.Float64, "[1 2.5]"},
+		{"to-string", []interface{}{int64(1), 2.5, true, nil, "x"}, array.PromoteAll, arrow.BinaryTypes.String, `["1" "2.5" "true" (null) "x"]`},
+		{"int-to-string", []interface{}{int64(1), 2.5}, array.PromoteToString, arrow.BinaryTypes.String, `["1" "2.5"]`},
+		{"all-nulls", []interface{}{nil, nil}, array.PromoteAll, arrow.Null, "[(null) (null)]"},
+		{"empty", nil, array.PromoteAll, arrow.Null, "[]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewAdaptiveBuilder(mem, array.WithPromotions(tc.promote))
+			defer b.Release()
+
+			for _, v := range tc.values {
+				if err := b.Append(v); err != nil {
+					t.Fatalf("could not append %v: %v", v, err)
+				}
+			}
+			if b.Type() != nil {
+				t.Fatalf("type should not be fixed before NewArray: got=%v", b.Type())
+			}
+			if got, want := b.Len(), len(tc.values); got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+
+			arr := b.NewArray()
+			defer arr.Release()
+
+			if !arrow.TypeEqual(arr.DataType(), tc.want) {
+				t.Fatalf("invalid type: got=%v, want=%v", arr.DataType(), tc.want)
+			}
+			if got := fmt.Sprint(arr); got != tc.str {
+				t.Fatalf("invalid array: got=%s, want=%s", got, tc.str)
+			}
+		})
+	}
+}
+
+func TestAdaptiveBuilderErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewAdaptiveBuilder(mem, array.WithPromotions(0))
+	defer b.Release()
+
+	if err := b.Append(int32(1)); err == nil {
+		t.Fatalf("expected an error appending an int32")
+	}
+	if err := b.Append(int64(1)); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []interface{}{1.5, "x", true} {
+		if err := b.Append(v); err == nil {
+			t.Fatalf("expected an error appending %v without promotions", v)
+		}
+	}
+	if got, want := b.Len(), 1; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+}
+
+func TestAdaptiveBuilderSampleSize(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewAdaptiveBuilder(mem, array.WithSampleSize(2))
+	defer b.Release()
+
+	// nulls before the first typed value do not count in the sample.
+	for _, v := range []interface{}{nil, nil, int64(1), nil} {
+		if err := b.Append(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b.Type() != nil {
+		t.Fatalf("type should not be fixed yet: got=%v", b.Type())
+	}
+	if err := b.Append(2.5); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.Type(), arrow.PrimitiveTypes.Float64; got != want {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+	if got, want := b.NullN(), 3; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+
+	// once fixed, values are converted to the type, or rejected.
+	if err := b.Append(int64(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Append("x"); err == nil {
+		t.Fatalf("expected an error appending a string to a float64 array")
+	}
+
+	arr := b.NewArray()
+	defer arr.Release()
+	if got, want := fmt.Sprint(arr), "[(null) (null) 1 (null) 2.5 3]"; got != want {
+		t.Fatalf("invalid array: got=%s, want=%s", got, want)
+	}
+
+	// the type is kept for the next arrays.
+	if err := b.Append(int64(4)); err != nil {
+		t.Fatal(err)
+	}
+	next := b.NewArray()
+	defer next.Release()
+	if !arrow.TypeEqual(next.DataType(), arr.DataType()) {
+		t.Fatalf("invalid type: got=%v, want=%v", next.DataType(), arr.DataType())
+	}
+}
+
+func TestAdaptiveBuilderNullsReset(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewAdaptiveBuilder(mem)
+	defer b.Release()
+
+	b.AppendNull()
+	nulls := b.NewArray()
+	defer nulls.Release()
+	if got, want := nulls.DataType(), arrow.Null; got != want {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+
+	// a type inferred from nulls only is not kept.
+	if err := b.Append("a"); err != nil {
+		t.Fatal(err)
+	}
+	arr := b.NewArray()
+	defer arr.Release()
+	if got, want := arr.DataType(), arrow.BinaryTypes.String; got != want {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+}
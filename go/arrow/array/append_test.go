@@ -0,0 +1,478 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilderAppendArray(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i := 0; i < int(recs[0].NumCols()); i++ {
+				b := array.NewBuilder(mem, recs[0].Column(i).DataType())
+				defer b.Release()
+
+				// append ranges of each column, and of slices of it, so that
+				// neither the source nor the destination validity bits are
+				// byte-aligned.
+				var want []array.Interface
+				appendRange := func(col array.Interface, beg, end int) {
+					b.AppendArray(col, beg, end)
+					want = append(want, array.NewSlice(col, int64(beg), int64(end)))
+				}
+				for _, rec := range recs {
+					col := rec.Column(i)
+					n := col.Len()
+					appendRange(col, 0, n)
+					if n > 2 {
+						appendRange(col, 1, n-1)
+						appendRange(col, n-1, n)
+						appendRange(col, 0, 0)
+
+						sub := array.NewSlice(col, 1, int64(n))
+						appendRange(sub, 1, sub.Len())
+						sub.Release()
+					}
+				}
+
+				got := b.NewArray()
+				pos := int64(0)
+				for j, part := range want {
+					n := int64(part.Len())
+					if !array.ArraySliceEqual(got, pos, pos+n, part, 0, n) {
+						t.Fatalf("invalid part %d of column %d:\ngot= %v\nwant=%v", j, i, array.NewSlice(got, pos, pos+n), part)
+					}
+					pos += n
+					part.Release()
+				}
+				if int64(got.Len()) != pos {
+					t.Fatalf("invalid length for column %d: got=%d, want=%d", i, got.Len(), pos)
+				}
+				checkValidArray(t, mem, got)
+				got.Release()
+			}
+		})
+	}
+}
+
+func TestBuilderAppendNulls(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i := 0; i < int(recs[0].NumCols()); i++ {
+				var (
+					dtype = recs[0].Column(i).DataType()
+					bulk  = array.NewBuilder(mem, dtype)
+					one   = array.NewBuilder(mem, dtype)
+				)
+				defer bulk.Release()
+				defer one.Release()
+
+				// interleave nulls and values, so that the nulls are not
+				// byte-aligned, and some span several bytes.
+				appendNulls := func(n int) {
+					bulk.AppendNulls(n)
+					for j := 0; j < n; j++ {
+						one.AppendNull()
+					}
+					switch dt := dtype.(type) {
+					case *arrow.FixedSizeListType:
+						// the values of fixed-size lists are appended by the caller.
+						size := n * int(dt.Len())
+						bulk.(*array.FixedSizeListBuilder).ValueBuilder().AppendNulls(size)
+						one.(*array.FixedSizeListBuilder).ValueBuilder().AppendNulls(size)
+					case *arrow.StructType:
+						// so are the fields of null structs, unlike with AppendNull.
+						sb := bulk.(*array.StructBuilder)
+						for k := 0; k < sb.NumField(); k++ {
+							sb.FieldBuilder(k).AppendNulls(n)
+						}
+					}
+				}
+				appendNulls(3)
+				for _, rec := range recs {
+					col := rec.Column(i)
+					bulk.AppendArray(col, 0, col.Len())
+					one.AppendArray(col, 0, col.Len())
+					appendNulls(13)
+					appendNulls(0)
+				}
+				appendNulls(70)
+
+				if got, want := bulk.Len(), one.Len(); got != want {
+					t.Fatalf("invalid length for column %d: got=%d, want=%d", i, got, want)
+				}
+				if got, want := bulk.NullN(), one.NullN(); got != want {
+					t.Fatalf("invalid number of nulls for column %d: got=%d, want=%d", i, got, want)
+				}
+
+				got := bulk.NewArray()
+				defer got.Release()
+				want := one.NewArray()
+				defer want.Release()
+
+				if !array.ArrayEqual(got, want) {
+					t.Fatalf("invalid column %d:\ngot= %v\nwant=%v", i, got, want)
+				}
+				if dtype.ID() != arrow.NULL {
+					gotBits, wantBits := got.NullBitmapBytes(), want.NullBitmapBytes()
+					for j := 0; j < want.Len(); j++ {
+						if bitutil.BitIsSet(gotBits, j) != bitutil.BitIsSet(wantBits, j) {
+							t.Fatalf("invalid validity bit %d of column %d", j, i)
+						}
+					}
+				}
+				checkValidArray(t, mem, got)
+			}
+		})
+	}
+}
+
+func TestBuilderAppendNullsChildren(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	t.Run("binary", func(t *testing.T) {
+		b := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+		defer b.Release()
+
+		b.AppendString("ab")
+		b.AppendNulls(3)
+		b.AppendString("c")
+		b.AppendNulls(2)
+		assert.Equal(t, 3, b.DataLen())
+
+		arr := b.NewBinaryArray()
+		defer arr.Release()
+		assert.Equal(t, []int32{0, 2, 2, 2, 2, 3, 3, 3}, arr.ValueOffsets())
+		assert.Equal(t, []byte{0x11}, arr.NullBitmapBytes()[:1])
+		assert.Equal(t, "c", arr.ValueString(4))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		b := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+		defer b.Release()
+		vb := b.ValueBuilder().(*array.Int32Builder)
+
+		b.Append(true)
+		vb.AppendValues([]int32{1, 2}, nil)
+		b.AppendNulls(9)
+		assert.Equal(t, 2, vb.Len(), "value builder must not grow")
+		b.Append(true)
+		vb.Append(3)
+
+		arr := b.NewListArray()
+		defer arr.Release()
+		assert.Equal(t, []int32{0, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3}, arr.Offsets())
+		assert.Equal(t, []byte{0x01, 0x04}, arr.NullBitmapBytes()[:2])
+		assert.Equal(t, 3, arr.ListValues().Len())
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		dtype := arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true})
+		b := array.NewStructBuilder(mem, dtype)
+		defer b.Release()
+		fb := b.FieldBuilder(0).(*array.Int64Builder)
+
+		b.Append(true)
+		fb.Append(1)
+		b.AppendNulls(4)
+		assert.Equal(t, 1, fb.Len(), "field builder must not grow")
+		fb.AppendValues([]int64{0, 0, 0, 0}, nil)
+		b.Append(true)
+		fb.Append(2)
+
+		arr := b.NewStructArray()
+		defer arr.Release()
+		assert.Equal(t, 4, arr.NullN())
+		assert.Equal(t, []byte{0x21}, arr.NullBitmapBytes()[:1])
+		assert.Equal(t, "[1 0 0 0 0 2]", fmt.Sprint(arr.Field(0)))
+		checkValidArray(t, mem, arr)
+	})
+
+	t.Run("no-nulls", func(t *testing.T) {
+		b := array.NewInt64Builder(mem)
+		defer b.Release()
+		array.DisableNulls(b)
+
+		b.AppendNulls(0)
+		assert.Panics(t, func() { b.AppendNulls(1) })
+	})
+}
+
+func TestBuilderAppendArrayNoNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	src := array.NewInt32Builder(mem)
+	defer src.Release()
+	src.AppendValues([]int32{1, 2, 3, 4}, []bool{true, true, true, false})
+	arr := src.NewArray()
+	defer arr.Release()
+
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+	array.DisableNulls(b)
+
+	b.AppendArray(arr, 1, 3)
+	assert.Panics(t, func() { b.AppendArray(arr, 2, 4) })
+
+	got := b.NewInt32Array()
+	defer got.Release()
+	assert.Equal(t, []int32{2, 3}, got.Int32Values())
+	assert.Zero(t, got.NullN())
+	assert.Nil(t, got.Data().Buffers()[0])
+}
+
+func TestBuilderAppendArrayInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	src := array.NewInt32Builder(mem)
+	defer src.Release()
+	src.AppendValues([]int32{1, 2, 3}, nil)
+	arr := src.NewArray()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		name       string
+		dtype      arrow.DataType
+		start, end int
+	}{
+		{"type", arrow.PrimitiveTypes.Int64, 0, 1},
+		{"negative-start", arrow.PrimitiveTypes.Int32, -1, 1},
+		{"start-after-end", arrow.PrimitiveTypes.Int32, 2, 1},
+		{"end-after-len", arrow.PrimitiveTypes.Int32, 0, 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+			assert.Panics(t, func() { b.AppendArray(arr, tc.start, tc.end) })
+			assert.Zero(t, b.Len())
+		})
+	}
+}
+
+func TestNewArraySlice(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i := 0; i < int(recs[0].NumCols()); i++ {
+				src := array.NewBuilder(mem, recs[0].Column(i).DataType())
+				for _, rec := range recs {
+					src.AppendArray(rec.Column(i), 0, rec.Column(i).Len())
+				}
+				all := src.NewArray()
+				src.Release()
+
+				n := all.Len()
+				for _, cut := range []int{0, 1, 3, 7, 8, 9, 13, n / 2, n - 1, n} {
+					if cut < 0 || cut > n {
+						continue
+					}
+					b := array.NewBuilder(mem, all.DataType())
+					b.AppendArray(all, 0, n)
+
+					head := array.NewArraySlice(b, cut)
+					if got := b.Len(); got != n-cut {
+						t.Fatalf("column %d, cut %d: invalid builder length: got=%d, want=%d", i, cut, got, n-cut)
+					}
+					rest := b.NewArray()
+					b.Release()
+
+					if head.Len() != cut || !array.ArraySliceEqual(head, 0, int64(cut), all, 0, int64(cut)) {
+						t.Fatalf("column %d, cut %d: invalid head:\ngot= %v\nwant=%v", i, cut, head, array.NewSlice(all, 0, int64(cut)))
+					}
+					if rest.Len() != n-cut || !array.ArraySliceEqual(rest, 0, int64(n-cut), all, int64(cut), int64(n)) {
+						t.Fatalf("column %d, cut %d: invalid rest:\ngot= %v\nwant=%v", i, cut, rest, array.NewSlice(all, int64(cut), int64(n)))
+					}
+					checkValidArray(t, mem, head)
+					checkValidArray(t, mem, rest)
+					head.Release()
+					rest.Release()
+				}
+				all.Release()
+			}
+		})
+	}
+}
+
+func TestNewArraySliceValidity(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	var (
+		values []string
+		valid  []bool
+	)
+	for i := 0; i < 21; i++ {
+		values = append(values, strings.Repeat("x", i))
+		valid = append(valid, i%3 != 0)
+	}
+	b.AppendValues(values, valid)
+
+	// flush windows of 5 elements, appending 2 elements in between: the
+	// boundaries are never byte-aligned.
+	var got []array.Interface
+	for b.Len() >= 5 {
+		got = append(got, array.NewArraySlice(b, 5))
+		b.AppendNull()
+		b.Append("tail")
+	}
+	got = append(got, b.NewArray())
+
+	var (
+		wantValues = append([]string(nil), values...)
+		wantValid  = append([]bool(nil), valid...)
+	)
+	for range got[:len(got)-1] {
+		wantValues = append(wantValues, "", "tail")
+		wantValid = append(wantValid, false, true)
+	}
+
+	pos := 0
+	for j, arr := range got {
+		str := arr.(*array.String)
+		for k := 0; k < str.Len(); k++ {
+			if got, want := str.IsValid(k), wantValid[pos]; got != want {
+				t.Fatalf("window %d, element %d: invalid validity: got=%v, want=%v", j, k, got, want)
+			}
+			if str.IsValid(k) && str.Value(k) != wantValues[pos] {
+				t.Fatalf("window %d, element %d: invalid value: got=%q, want=%q", j, k, str.Value(k), wantValues[pos])
+			}
+			pos++
+		}
+		checkValidArray(t, mem, arr)
+		arr.Release()
+	}
+	if pos != len(wantValues) {
+		t.Fatalf("invalid number of elements: got=%d, want=%d", pos, len(wantValues))
+	}
+}
+
+func TestNewArraySliceInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+	b.AppendValues([]int32{1, 2, 3}, nil)
+
+	assert.Panics(t, func() { array.NewArraySlice(b, -1) })
+	assert.Panics(t, func() { array.NewArraySlice(b, 4) })
+	assert.Equal(t, 3, b.Len())
+}
+
+func benchmarkAppendArray(b *testing.B, arr array.Interface, roundTrip func(bldr array.Builder, beg, end int)) {
+	const batch = 1000
+
+	mem := memory.NewGoAllocator()
+	n := arr.Len()
+	for _, tc := range []struct {
+		name   string
+		append func(bldr array.Builder, beg, end int)
+	}{
+		{"AppendArray", func(bldr array.Builder, beg, end int) { bldr.AppendArray(arr, beg, end) }},
+		{"RoundTrip", roundTrip},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bldr := array.NewBuilder(mem, arr.DataType())
+				for beg := 3; beg < n; beg += batch {
+					end := beg + batch
+					if end > n {
+						end = n
+					}
+					tc.append(bldr, beg, end)
+				}
+				bldr.NewArray().Release()
+				bldr.Release()
+			}
+		})
+	}
+}
+
+func validity(arr array.Interface, beg, end int) []bool {
+	valid := make([]bool, end-beg)
+	for i := range valid {
+		valid[i] = arr.IsValid(beg + i)
+	}
+	return valid
+}
+
+func BenchmarkAppendArrayInt64(b *testing.B) {
+	mem := memory.NewGoAllocator()
+	src := array.NewInt64Builder(mem)
+	defer src.Release()
+	for i := 0; i < 100000; i++ {
+		if i%7 == 0 {
+			src.AppendNull()
+			continue
+		}
+		src.Append(int64(i))
+	}
+	arr := src.NewInt64Array()
+	defer arr.Release()
+
+	benchmarkAppendArray(b, arr, func(bldr array.Builder, beg, end int) {
+		bldr.(*array.Int64Builder).AppendValues(arr.Int64Values()[beg:end], validity(arr, beg, end))
+	})
+}
+
+func BenchmarkAppendArrayString(b *testing.B) {
+	mem := memory.NewGoAllocator()
+	src := array.NewStringBuilder(mem)
+	defer src.Release()
+	for i := 0; i < 100000; i++ {
+		if i%7 == 0 {
+			src.AppendNull()
+			continue
+		}
+		src.Append(fmt.Sprintf("value-%d", i))
+	}
+	arr := src.NewStringArray()
+	defer arr.Release()
+
+	benchmarkAppendArray(b, arr, func(bldr array.Builder, beg, end int) {
+		values := make([]string, end-beg)
+		for i := range values {
+			values[i] = arr.Value(beg + i)
+		}
+		bldr.(*array.StringBuilder).AppendValues(values, validity(arr, beg, end))
+	})
+}
@@ -92,7 +92,12 @@ func (a *array) DataType() arrow.DataType { return a.data.dtype }
 
 // NullN returns the number of null values in the array.
 func (a *array) NullN() int {
-	if a.data.nulls < 0 {
+	switch {
+	case a.data.nulls >= 0:
+	case len(a.nullBitmapBytes) == 0:
+		// no validity bitmap: all the elements are valid.
+		a.data.nulls = 0
+	default:
 		a.data.nulls = a.data.length - bitutil.CountSetBits(a.nullBitmapBytes, a.data.offset, a.data.length)
 	}
 	return a.data.nulls
@@ -119,6 +124,10 @@ func (a *array) IsValid(i int) bool {
 }
 
 func (a *array) setData(data *Data) {
+	if debugChecks {
+		debugValidateValues(data)
+	}
+
 	// Retain before releasing in case a.data is the same as data.
 	data.Retain()
 
@@ -139,20 +148,37 @@ func (a *array) Offset() int {
 type arrayConstructorFn func(*Data) Interface
 
 var (
-	makeArrayFn [32]arrayConstructorFn
+	makeArrayFn [64]arrayConstructorFn
 )
 
 func unsupportedArrayType(data *Data) Interface {
 	panic("unsupported data type: " + data.dtype.ID().String())
 }
 
+func makeCustom(data *Data) Interface {
+	if _, ok := data.dtype.(arrow.StorageProvider); !ok {
+		return unsupportedArrayType(data)
+	}
+	return NewCustomData(data)
+}
+
 func invalidDataType(data *Data) Interface {
 	panic("invalid data type: " + data.dtype.ID().String())
 }
 
 // MakeFromData constructs a strongly-typed array instance from generic Data.
 func MakeFromData(data *Data) Interface {
-	return makeArrayFn[byte(data.dtype.ID()&0x1f)](data)
+	return makeArrayFn[byte(data.dtype.ID()&0x3f)](data)
+}
+
+// MakeFromDataWithMetadata constructs a strongly-typed array holding the
+// values of data, without copying them, with the metadata md attached.
+// data is left untouched.
+func MakeFromDataWithMetadata(data *Data, md *arrow.Metadata) Interface {
+	view := NewData(data.dtype, data.length, data.buffers, data.childData, data.nulls, data.offset)
+	defer view.Release()
+	view.meta = md
+	return MakeFromData(view)
 }
 
 // NewSlice constructs a zero-copy slice of the array with the indicated
@@ -194,15 +220,23 @@ func init() {
 		arrow.INTERVAL:          func(data *Data) Interface { return NewIntervalData(data) },
 		arrow.DECIMAL:           func(data *Data) Interface { return NewDecimal128Data(data) },
 		arrow.LIST:              func(data *Data) Interface { return NewListData(data) },
+		arrow.MAP:               func(data *Data) Interface { return NewMapData(data) },
 		arrow.STRUCT:            func(data *Data) Interface { return NewStructData(data) },
 		arrow.UNION:             unsupportedArrayType,
-		arrow.DICTIONARY:        unsupportedArrayType,
-		arrow.MAP:               unsupportedArrayType,
-		arrow.EXTENSION:         unsupportedArrayType,
+		arrow.DICTIONARY:        func(data *Data) Interface { return NewDictionaryData(data) },
+		arrow.EXTENSION:         makeCustom,
 		arrow.FIXED_SIZE_LIST:   func(data *Data) Interface { return NewFixedSizeListData(data) },
 		arrow.DURATION:          func(data *Data) Interface { return NewDurationData(data) },
+		arrow.DECIMAL256:        func(data *Data) Interface { return NewDecimal256Data(data) },
+		arrow.LARGE_LIST:        func(data *Data) Interface { return NewLargeListData(data) },
+
+		// invalid data types to fill out array size 2⁶-1
+		63: invalidDataType,
+	}
 
-		// invalid data types to fill out array size 2⁵-1
-		31: invalidDataType,
+	for i, fn := range makeArrayFn {
+		if fn == nil {
+			makeArrayFn[i] = invalidDataType
+		}
 	}
 }
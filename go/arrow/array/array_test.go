@@ -83,17 +83,30 @@ func TestMakeFromData(t *testing.T) {
 			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
 			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
 		}},
+		{name: "dictionary", d: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String}, child: []*array.Data{
+			array.NewData(arrow.BinaryTypes.String, 0, make([]*memory.Buffer, 3), nil, 0, 0),
+		}},
+		{name: "map", d: arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32), child: []*array.Data{
+			array.NewData(arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32).Elem(), 0, make([]*memory.Buffer, 1), []*array.Data{
+				array.NewData(arrow.BinaryTypes.String, 0, make([]*memory.Buffer, 3), nil, 0, 0),
+				array.NewData(arrow.PrimitiveTypes.Int32, 0, make([]*memory.Buffer, 2), nil, 0, 0),
+			}, 0, 0),
+		}},
 		{name: "duration", d: &testDataType{arrow.DURATION}},
+		{name: "decimal256", d: &testDataType{arrow.DECIMAL256}},
+		{name: "large_list", d: &testDataType{arrow.LARGE_LIST}, child: []*array.Data{
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+		}},
 
 		// unsupported types
 		{name: "union", d: &testDataType{arrow.UNION}, expPanic: true, expError: "unsupported data type: UNION"},
-		{name: "dictionary", d: &testDataType{arrow.DICTIONARY}, expPanic: true, expError: "unsupported data type: DICTIONARY"},
-		{name: "map", d: &testDataType{arrow.Type(27)}, expPanic: true, expError: "unsupported data type: MAP"},
 		{name: "extension", d: &testDataType{arrow.Type(28)}, expPanic: true, expError: "unsupported data type: EXTENSION"},
 
 		// invalid types
 		{name: "invalid(-1)", d: &testDataType{arrow.Type(-1)}, expPanic: true, expError: "invalid data type: Type(-1)"},
-		{name: "invalid(31)", d: &testDataType{arrow.Type(31)}, expPanic: true, expError: "invalid data type: Type(31)"},
+		{name: "invalid(33)", d: &testDataType{arrow.Type(33)}, expPanic: true, expError: "invalid data type: Type(33)"},
+		{name: "invalid(63)", d: &testDataType{arrow.Type(63)}, expPanic: true, expError: "invalid data type: Type(63)"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -131,6 +144,7 @@ func TestArray_NullN(t *testing.T) {
 		{name: "unknown,l12,ignores last nibble", l: 12, bm: bbits(0x11001010, 0x00111111), n: array.UnknownNullCount, exp: 6},
 		{name: "unknown,l12,12 nulls", l: 12, bm: bbits(0x00000000, 0x00000000), n: array.UnknownNullCount, exp: 12},
 		{name: "unknown,l12,00 nulls", l: 12, bm: bbits(0x11111111, 0x11111111), n: array.UnknownNullCount, exp: 0},
+		{name: "unknown,l12,no bitmap", l: 12, bm: nil, n: array.UnknownNullCount, exp: 0},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -0,0 +1,485 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
+	"golang.org/x/xerrors"
+)
+
+// Clock is the source of time driving the interval-based flushes of a
+// BatchingWriter.
+type Clock interface {
+	// AfterFunc waits for d to elapse and then calls f in its own goroutine.
+	// It returns a function stopping the timer, which reports whether the
+	// timer was stopped before f was called.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+type systemClock struct{}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+type batchingConfig struct {
+	maxRows  int
+	maxBytes int
+	interval time.Duration
+	clock    Clock
+}
+
+// BatchingOption is a functional option type used to configure a
+// BatchingWriter.
+type BatchingOption func(*batchingConfig)
+
+// WithMaxRows flushes the pending record once it holds n rows.
+func WithMaxRows(n int) BatchingOption {
+	return func(cfg *batchingConfig) {
+		cfg.maxRows = n
+	}
+}
+
+// WithMaxBytes flushes the pending record once the size of its buffers
+// reaches n bytes.
+func WithMaxBytes(n int) BatchingOption {
+	return func(cfg *batchingConfig) {
+		cfg.maxBytes = n
+	}
+}
+
+// WithFlushInterval flushes the pending record d after its first row was
+// appended.
+func WithFlushInterval(d time.Duration) BatchingOption {
+	return func(cfg *batchingConfig) {
+		cfg.interval = d
+	}
+}
+
+// WithClock sets the clock driving the interval-based flushes.
+// The default is the system clock.
+func WithClock(c Clock) BatchingOption {
+	return func(cfg *batchingConfig) {
+		cfg.clock = c
+	}
+}
+
+// BatchingWriter accumulates rows, one at a time, in a RecordBuilder, and
+// hands them over to a sink as records of bounded size.
+//
+// A record is emitted when it reaches the number of rows or the size set
+// with WithMaxRows or WithMaxBytes, when the interval set with
+// WithFlushInterval has elapsed since its first row, and on Flush and Close.
+// Without any of these options, records are only emitted on Flush and Close.
+//
+// BatchingWriter is safe for concurrent use by multiple goroutines.
+type BatchingWriter struct {
+	mu   sync.Mutex
+	rb   *RecordBuilder
+	sink func(Record) error
+	cfg  batchingConfig
+
+	rows   int         // number of rows of the pending record.
+	batch  int64       // sequence number of the pending record.
+	stop   func() bool // stops the flush timer of the pending record.
+	err    error
+	closed bool
+}
+
+// NewBatchingWriter returns a writer appending rows to rb and passing the
+// resulting records to sink.
+//
+// Records are released once sink returns: sink must retain them to use them
+// afterwards. sink is called with the writer locked, and thus must not call
+// the writer back. The first error returned by sink is returned by all
+// subsequent calls to the writer.
+//
+// NewBatchingWriter retains rb, until the writer is closed.
+func NewBatchingWriter(rb *RecordBuilder, sink func(Record) error, opts ...BatchingOption) *BatchingWriter {
+	cfg := batchingConfig{clock: systemClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	rb.Retain()
+	return &BatchingWriter{rb: rb, sink: sink, cfg: cfg}
+}
+
+// AppendRow appends a row holding one value per field of the schema of the
+// record builder. A nil value appends a null.
+//
+// Values must be of the Go type appended by the builder of their field,
+// eg: int32 for an Int32Builder or arrow.Timestamp for a TimestampBuilder.
+// Strings are also accepted for binary fields. Lists and structs are given
+// as []interface{} holding their elements or fields.
+//
+// Nothing is appended if one of the values is invalid.
+func (w *BatchingWriter) AppendRow(values ...interface{}) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch {
+	case w.closed:
+		return xerrors.New("arrow/array: append to closed batching writer")
+	case w.err != nil:
+		return w.err
+	}
+
+	fields := w.rb.Schema().Fields()
+	if len(values) != len(fields) {
+		return xerrors.Errorf("arrow/array: invalid number of values in row: got=%d, want=%d", len(values), len(fields))
+	}
+
+	appends := make([]func(), len(values))
+	for i, v := range values {
+		app, err := valueAppender(w.rb.Field(i), v)
+		if err != nil {
+			return xerrors.Errorf("arrow/array: invalid value for field %q: %w", fields[i].Name, err)
+		}
+		appends[i] = app
+	}
+	for _, app := range appends {
+		app()
+	}
+	w.rows++
+
+	if w.rows == 1 && w.cfg.interval > 0 {
+		batch := w.batch
+		w.stop = w.cfg.clock.AfterFunc(w.cfg.interval, func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			if w.batch != batch || w.closed || w.err != nil {
+				return // the record was already flushed.
+			}
+			w.flush()
+		})
+	}
+
+	switch {
+	case w.cfg.maxRows > 0 && w.rows >= w.cfg.maxRows,
+		w.cfg.maxBytes > 0 && w.size() >= w.cfg.maxBytes:
+		w.flush()
+	}
+	return w.err
+}
+
+// Flush emits the pending record, if it holds any row.
+func (w *BatchingWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch {
+	case w.closed:
+		return xerrors.New("arrow/array: flush of closed batching writer")
+	case w.err != nil:
+		return w.err
+	}
+	w.flush()
+	return w.err
+}
+
+// Close emits the pending record, if it holds any row, and releases the
+// record builder. Close is idempotent.
+func (w *BatchingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return w.err
+	}
+	if w.err == nil {
+		w.flush()
+	}
+	if w.stop != nil {
+		w.stop()
+		w.stop = nil
+	}
+	w.closed = true
+	w.rb.Release()
+	return w.err
+}
+
+func (w *BatchingWriter) flush() {
+	if w.rows == 0 {
+		return
+	}
+	if w.stop != nil {
+		w.stop()
+		w.stop = nil
+	}
+
+	rec := w.rb.NewRecord()
+	defer rec.Release()
+	w.rows = 0
+	w.batch++
+
+	if err := w.sink(rec); err != nil {
+		w.err = xerrors.Errorf("arrow/array: could not flush record: %w", err)
+	}
+}
+
+// size returns the size of the buffers of the pending record.
+func (w *BatchingWriter) size() int {
+	n := 0
+	for _, b := range w.rb.Fields() {
+		n += builderSize(b)
+	}
+	return n
+}
+
+// builderSize returns the size of the buffers of the array being built by b.
+func builderSize(b Builder) int {
+	var (
+		n    = b.Len()
+		size = int(bitutil.BytesForBits(int64(n)))
+	)
+	switch b := b.(type) {
+	case *NullBuilder:
+		return 0
+	case *BinaryBuilder:
+		size += arrow.Int32Traits.BytesRequired(n+1) + b.DataLen()
+	case *StringBuilder:
+		size += arrow.Int32Traits.BytesRequired(n+1) + b.DataLen()
+	case *ListBuilder:
+		size += arrow.Int32Traits.BytesRequired(n+1) + builderSize(b.ValueBuilder())
+	case *MapBuilder:
+		size += arrow.Int32Traits.BytesRequired(n+1) + builderSize(b.ValueBuilder())
+	case *LargeListBuilder:
+		size += arrow.Int64Traits.BytesRequired(n+1) + builderSize(b.ValueBuilder())
+	case *FixedSizeListBuilder:
+		size += builderSize(b.ValueBuilder())
+	case *StructBuilder:
+		for i := 0; i < b.NumField(); i++ {
+			size += builderSize(b.FieldBuilder(i))
+		}
+	default:
+		if dt, ok := b.Type().(arrow.FixedWidthDataType); ok {
+			size += int(bitutil.BytesForBits(int64(n * dt.BitWidth())))
+		}
+	}
+	return size
+}
+
+// valueAppender returns a function appending v to b, or an error if v cannot
+// be appended to b. Checking and appending are split, so that a row is
+// appended either in full or not at all.
+func valueAppender(b Builder, v interface{}) (func(), error) {
+	if v == nil {
+		if b, ok := b.(interface{ nullsDisabled() bool }); ok && b.nullsDisabled() {
+			return nil, xerrors.New(errNoNulls)
+		}
+		return b.AppendNull, nil
+	}
+
+	invalid := xerrors.Errorf("cannot append %v (%T) to %v builder", v, v, b.Type())
+	switch b := b.(type) {
+	case *BooleanBuilder:
+		if x, ok := v.(bool); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Int8Builder:
+		if x, ok := v.(int8); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Int16Builder:
+		if x, ok := v.(int16); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Int32Builder:
+		if x, ok := v.(int32); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Int64Builder:
+		if x, ok := v.(int64); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Uint8Builder:
+		if x, ok := v.(uint8); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Uint16Builder:
+		if x, ok := v.(uint16); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Uint32Builder:
+		if x, ok := v.(uint32); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Uint64Builder:
+		if x, ok := v.(uint64); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Float16Builder:
+		if x, ok := v.(float16.Num); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Float32Builder:
+		if x, ok := v.(float32); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Float64Builder:
+		if x, ok := v.(float64); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Decimal128Builder:
+		if x, ok := v.(decimal128.Num); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Decimal256Builder:
+		if x, ok := v.(decimal256.Num); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Date32Builder:
+		if x, ok := v.(arrow.Date32); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Date64Builder:
+		if x, ok := v.(arrow.Date64); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Time32Builder:
+		if x, ok := v.(arrow.Time32); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *Time64Builder:
+		if x, ok := v.(arrow.Time64); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *TimestampBuilder:
+		if x, ok := v.(arrow.Timestamp); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *DurationBuilder:
+		if x, ok := v.(arrow.Duration); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *MonthIntervalBuilder:
+		if x, ok := v.(arrow.MonthInterval); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *DayTimeIntervalBuilder:
+		if x, ok := v.(arrow.DayTimeInterval); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *StringBuilder:
+		if x, ok := v.(string); ok {
+			return func() { b.Append(x) }, nil
+		}
+	case *BinaryBuilder:
+		switch x := v.(type) {
+		case []byte:
+			return func() { b.Append(x) }, nil
+		case string:
+			return func() { b.AppendString(x) }, nil
+		}
+	case *FixedSizeBinaryBuilder:
+		width := b.Type().(*arrow.FixedSizeBinaryType).ByteWidth
+		switch x := v.(type) {
+		case []byte:
+			if len(x) != width {
+				return nil, xerrors.Errorf("invalid length %d for %v value", len(x), b.Type())
+			}
+			return func() { b.Append(x) }, nil
+		case string:
+			if len(x) != width {
+				return nil, xerrors.Errorf("invalid length %d for %v value", len(x), b.Type())
+			}
+			return func() { b.Append([]byte(x)) }, nil
+		}
+	case *ListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			elems, err := valueAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *LargeListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			elems, err := valueAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *FixedSizeListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			if n := b.Type().(*arrow.FixedSizeListType).Len(); len(x) != int(n) {
+				return nil, xerrors.Errorf("invalid length %d for %v value", len(x), b.Type())
+			}
+			elems, err := valueAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *StructBuilder:
+		if x, ok := v.([]interface{}); ok {
+			if len(x) != b.NumField() {
+				return nil, xerrors.Errorf("invalid number of fields %d for %v value", len(x), b.Type())
+			}
+			fields := make([]func(), len(x))
+			for i, v := range x {
+				app, err := valueAppender(b.FieldBuilder(i), v)
+				if err != nil {
+					return nil, err
+				}
+				fields[i] = app
+			}
+			return func() {
+				b.Append(true)
+				for _, app := range fields {
+					app()
+				}
+			}, nil
+		}
+	}
+	return nil, invalid
+}
+
+// valueAppenders returns a function appending all of values to b.
+func valueAppenders(b Builder, values []interface{}) (func(), error) {
+	apps := make([]func(), len(values))
+	for i, v := range values {
+		app, err := valueAppender(b, v)
+		if err != nil {
+			return nil, err
+		}
+		apps[i] = app
+	}
+	return func() {
+		for _, app := range apps {
+			app()
+		}
+	}, nil
+}
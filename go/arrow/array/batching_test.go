@@ -0,0 +1,343 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Duration
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	at      time.Duration
+	f       func()
+	stopped bool
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{at: c.now + d, f: f}
+	c.timers = append(c.timers, t)
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if t.stopped {
+			return false
+		}
+		t.stopped = true
+		return true
+	}
+}
+
+// Advance moves the clock forward by d, and calls the functions of the
+// timers that fired.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now += d
+	var fired []*fakeTimer
+	for _, t := range c.timers {
+		if !t.stopped && t.at <= c.now {
+			t.stopped = true
+			fired = append(fired, t)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, t := range fired {
+		t.f()
+	}
+}
+
+type recordSink struct {
+	mu   sync.Mutex
+	rows []int64
+	ids  []int64
+}
+
+func (s *recordSink) write(rec array.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows = append(s.rows, rec.NumRows())
+	s.ids = append(s.ids, rec.Column(0).(*array.Int64).Int64Values()...)
+	return nil
+}
+
+func TestBatchingWriter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		{Name: "pos", Type: arrow.StructOf(
+			arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Float64},
+			arrow.Field{Name: "y", Type: arrow.PrimitiveTypes.Float64},
+		), Nullable: true},
+	}, nil)
+
+	for _, tc := range []struct {
+		name string
+		opts []array.BatchingOption
+		rows int
+		want []int64
+	}{
+		{name: "no-limit", rows: 5, want: []int64{5}},
+		{name: "max-rows", opts: []array.BatchingOption{array.WithMaxRows(2)}, rows: 5, want: []int64{2, 2, 1}},
+		{name: "max-bytes", opts: []array.BatchingOption{array.WithMaxBytes(150)}, rows: 5, want: []int64{3, 2}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rb := array.NewRecordBuilder(mem, schema)
+			defer rb.Release()
+
+			var sink recordSink
+			w := array.NewBatchingWriter(rb, sink.write, tc.opts...)
+			for i := 0; i < tc.rows; i++ {
+				err := w.AppendRow(int64(i), "name", []interface{}{"a", nil}, []interface{}{1.0, 2.0})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := sink.rows, tc.want; !equalInt64s(got, want) {
+				t.Fatalf("invalid record sizes: got=%v, want=%v", got, want)
+			}
+		})
+	}
+}
+
+func TestBatchingWriterValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+		{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int8), Nullable: true},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s, Nullable: true},
+	}, nil)
+
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+	array.DisableNulls(rb.Field(0))
+
+	var got array.Record
+	w := array.NewBatchingWriter(rb, func(rec array.Record) error {
+		rec.Retain()
+		got = rec
+		return nil
+	})
+
+	if err := w.AppendRow(int32(1), "a", []interface{}{int8(1), int8(2)}, arrow.Timestamp(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AppendRow(int32(2), nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, row := range [][]interface{}{
+		{int32(3), nil, nil},
+		{int64(3), nil, nil, nil},
+		{nil, nil, nil, nil},
+		{int32(3), 1, nil, nil},
+		{int32(3), nil, []interface{}{int8(1)}, nil},
+		{int32(3), nil, []interface{}{int8(1), "a"}, nil},
+	} {
+		if err := w.AppendRow(row...); err == nil {
+			t.Fatalf("expected an error appending %v", row)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	if got.NumRows() != 2 {
+		t.Fatalf("invalid number of rows: got=%d, want=2", got.NumRows())
+	}
+	for i, col := range got.Columns() {
+		if col.Len() != 2 {
+			t.Fatalf("invalid length of column %d: got=%d, want=2", i, col.Len())
+		}
+	}
+	if got, want := array.ValueToString(got.Column(2), 0), "[1 2]"; got != want {
+		t.Fatalf("invalid value: got=%q, want=%q", got, want)
+	}
+	if !got.Column(3).IsNull(1) {
+		t.Fatalf("expected a null value")
+	}
+}
+
+func TestBatchingWriterSinkError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	errSink := xerrors.New("sink error")
+	w := array.NewBatchingWriter(rb, func(array.Record) error { return errSink }, array.WithMaxRows(1))
+
+	if err := w.AppendRow(int64(1)); !xerrors.Is(err, errSink) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if err := w.AppendRow(int64(2)); !xerrors.Is(err, errSink) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if err := w.Close(); !xerrors.Is(err, errSink) {
+		t.Fatalf("invalid error: %v", err)
+	}
+}
+
+func TestBatchingWriterInterval(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	var (
+		clock = new(fakeClock)
+		sink  recordSink
+		w     = array.NewBatchingWriter(rb, sink.write, array.WithFlushInterval(time.Second), array.WithMaxRows(3), array.WithClock(clock))
+	)
+
+	clock.Advance(2 * time.Second) // nothing to flush.
+	w.AppendRow(int64(0))
+	clock.Advance(500 * time.Millisecond)
+	w.AppendRow(int64(1))
+	clock.Advance(500 * time.Millisecond) // flushes [0, 1].
+	w.AppendRow(int64(2))
+	w.AppendRow(int64(3))
+	w.AppendRow(int64(4)) // flushes [2, 3, 4] on size.
+	clock.Advance(time.Second)
+	w.AppendRow(int64(5))
+	if err := w.Flush(); err != nil { // flushes [5].
+		t.Fatal(err)
+	}
+	clock.Advance(time.Second)
+	w.AppendRow(int64(6))
+	if err := w.Close(); err != nil { // flushes [6].
+		t.Fatal(err)
+	}
+	clock.Advance(time.Second)
+
+	if got, want := sink.rows, []int64{2, 3, 1, 1}; !equalInt64s(got, want) {
+		t.Fatalf("invalid record sizes: got=%v, want=%v", got, want)
+	}
+	if err := w.AppendRow(int64(7)); err == nil {
+		t.Fatalf("expected an error appending to a closed writer")
+	}
+}
+
+func TestBatchingWriterConcurrent(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	const (
+		producers = 8
+		rows      = 1000
+	)
+
+	var (
+		clock = new(fakeClock)
+		sink  recordSink
+		w     = array.NewBatchingWriter(rb, sink.write, array.WithFlushInterval(time.Millisecond), array.WithMaxRows(100), array.WithClock(clock))
+		wg    sync.WaitGroup
+		done  = make(chan struct{})
+	)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				clock.Advance(time.Millisecond)
+			}
+		}
+	}()
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < rows; i++ {
+				if err := w.AppendRow(int64(p*rows + i)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(p)
+	}
+	wg.Wait()
+	close(done)
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Slice(sink.ids, func(i, j int) bool { return sink.ids[i] < sink.ids[j] })
+	if len(sink.ids) != producers*rows {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", len(sink.ids), producers*rows)
+	}
+	for i, id := range sink.ids {
+		if id != int64(i) {
+			t.Fatalf("invalid id at %d: got=%d", i, id)
+		}
+	}
+	for _, n := range sink.rows {
+		if n > 100 {
+			t.Fatalf("invalid record size %d", n)
+		}
+	}
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
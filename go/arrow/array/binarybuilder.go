@@ -23,6 +23,7 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 const (
@@ -70,6 +71,9 @@ func (b *BinaryBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *BinaryBuilder) Type() arrow.DataType { return b.dtype }
+
 func (b *BinaryBuilder) Append(v []byte) {
 	b.Reserve(1)
 	b.appendNextOffset()
@@ -77,6 +81,16 @@ func (b *BinaryBuilder) Append(v []byte) {
 	b.UnsafeAppendBoolToBitmap(true)
 }
 
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve and ReserveData
+// beforehand to make room for the new element and its bytes.
+func (b *BinaryBuilder) UnsafeAppend(v []byte) {
+	arrow.Int32Traits.PutValue(b.offsets.bytes[b.offsets.length:], int32(b.values.length))
+	b.offsets.length += arrow.Int32SizeBytes
+	b.values.unsafeAppend(v)
+	b.UnsafeAppendBoolToBitmap(true)
+}
+
 func (b *BinaryBuilder) AppendString(v string) {
 	b.Append([]byte(v))
 }
@@ -87,9 +101,30 @@ func (b *BinaryBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+// AppendNulls appends n null values to the builder, in bulk.
+// The nulls hold no bytes: their offsets are the current data length.
+func (b *BinaryBuilder) AppendNulls(n int) {
+	if n == 0 {
+		return
+	}
+	b.Reserve(n)
+	var (
+		out = arrow.Int32Traits.CastFromBytes(b.offsets.bytes[b.offsets.length:])[:n]
+		off = int32(b.values.Len())
+	)
+	for i := range out {
+		out[i] = off
+	}
+	b.offsets.length += n * arrow.Int32SizeBytes
+	b.unsafeAppendNulls(n)
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
+//
+// The elements for which valid is false are nulls, even if their value is empty. Their values are
+// still copied to the data buffer, and the offsets and data buffers are reserved once for all the values.
 func (b *BinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
 		panic("len(v) != len(valid) && len(valid) != 0")
@@ -99,11 +134,19 @@ func (b *BinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 		return
 	}
 
-	b.Reserve(len(v))
+	size := 0
 	for _, vv := range v {
-		b.appendNextOffset()
-		b.values.Append(vv)
+		size += len(vv)
+	}
+	b.Reserve(len(v))
+	b.ReserveData(size)
+
+	offsets := arrow.Int32Traits.CastFromBytes(b.offsets.bytes[b.offsets.length:])[:len(v)]
+	for i, vv := range v {
+		offsets[i] = int32(b.values.length)
+		b.values.unsafeAppend(vv)
 	}
+	b.offsets.length += len(v) * arrow.Int32SizeBytes
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
@@ -111,6 +154,8 @@ func (b *BinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 // AppendStringValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
+//
+// As with AppendValues, the elements for which valid is false are nulls, whose values are still copied.
 func (b *BinaryBuilder) AppendStringValues(v []string, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
 		panic("len(v) != len(valid) && len(valid) != 0")
@@ -120,16 +165,51 @@ func (b *BinaryBuilder) AppendStringValues(v []string, valid []bool) {
 		return
 	}
 
-	b.Reserve(len(v))
+	size := 0
 	for _, vv := range v {
-		b.appendNextOffset()
-		b.values.Append([]byte(vv))
+		size += len(vv)
 	}
+	b.Reserve(len(v))
+	b.ReserveData(size)
+
+	offsets := arrow.Int32Traits.CastFromBytes(b.offsets.bytes[b.offsets.length:])[:len(v)]
+	for i, vv := range v {
+		offsets[i] = int32(b.values.length)
+		b.values.unsafeAppendString(vv)
+	}
+	b.offsets.length += len(v) * arrow.Int32SizeBytes
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *BinaryBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.dtype, arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	var (
+		data    = arr.Data()
+		offsets = arrow.Int32Traits.CastFromBytes(data.buffers[1].Bytes())[data.offset+start : data.offset+end+1]
+		shift   = int32(b.values.Len()) - offsets[0]
+	)
+	b.Reserve(n)
+	out := arrow.Int32Traits.CastFromBytes(b.offsets.bytes[b.offsets.length:])
+	for i, v := range offsets[:n] {
+		out[i] = v + shift
+	}
+	b.offsets.length += n * arrow.Int32SizeBytes
+	b.values.Append(bufferBytes(data.buffers[2])[offsets[0]:offsets[n]])
+	b.builder.unsafeAppendValidity(data, start, n)
+}
+
+// Value returns the bytes of the i-th value appended to the builder, which
+// are empty if the element is null. The returned slice aliases the buffer of
+// the builder, and is only valid until the next append.
+// Value panics if i is not in the range [0, Len()).
 func (b *BinaryBuilder) Value(i int) []byte {
+	b.checkIndex(i)
 	offsets := b.offsets.Values()
 	start := int(offsets[i])
 	var end int
@@ -141,6 +221,22 @@ func (b *BinaryBuilder) Value(i int) []byte {
 	return b.values.Bytes()[start:end]
 }
 
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid. As the values are stored contiguously, only a value of
+// the same length can be overwritten in place: SetValid returns an error,
+// and leaves the builder unchanged, if v does not have the length of the
+// current value, which is 0 for nulls.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *BinaryBuilder) SetValid(i int, v []byte) error {
+	cur := b.Value(i)
+	if len(v) != len(cur) {
+		return xerrors.Errorf("arrow/array: cannot overwrite a value of length %d with a value of length %d", len(cur), len(v))
+	}
+	b.setValid(i)
+	copy(cur, v)
+	return nil
+}
+
 func (b *BinaryBuilder) init(capacity int) {
 	b.builder.init(capacity)
 	b.offsets.resize((capacity + 1) * arrow.Int32SizeBytes)
@@ -170,6 +266,10 @@ func (b *BinaryBuilder) ReserveData(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may be reduced.
 func (b *BinaryBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	b.offsets.resize((n + 1) * arrow.Int32SizeBytes)
 	b.builder.resize(n, b.init)
 }
@@ -190,7 +290,10 @@ func (b *BinaryBuilder) NewBinaryArray() (a *Binary) {
 }
 
 func (b *BinaryBuilder) newData() (data *Data) {
-	b.appendNextOffset()
+	if b.offsets.Cap() > 0 {
+		// an empty builder, never reserved, leaves the offsets to NewData.
+		b.appendNextOffset()
+	}
 	offsets, values := b.offsets.Finish(), b.values.Finish()
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, offsets, values}, nil, b.nulls, 0)
 	if offsets != nil {
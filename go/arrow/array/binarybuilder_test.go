@@ -61,6 +61,56 @@ func TestBinaryBuilder(t *testing.T) {
 	assert.Zero(t, ab.NullN(), "unexpected ArrayBuilder.NullN(), NewBinaryArray did not reset state")
 }
 
+func TestBinaryBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	defer ab.Release()
+
+	want := make([][]byte, 100)
+	for i := range want {
+		if i%10 == 1 {
+			ab.AppendNull()
+			continue
+		}
+		want[i] = bytes.Repeat([]byte{byte(i)}, i%5)
+		ab.Append(want[i])
+	}
+	for i, v := range want {
+		assert.Equal(t, i%10 == 1, ab.IsNull(i))
+		assert.True(t, bytes.Equal(v, ab.Value(i)), "value %d: got=%v, want=%v", i, ab.Value(i), v)
+	}
+	assert.Panics(t, func() { ab.Value(len(want)) })
+	assert.Panics(t, func() { ab.IsNull(len(want)) })
+}
+
+func TestBinaryBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewStringBuilder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]string{"abc", "", "de"}, []bool{true, false, true})
+	ab.SetNull(0)
+	assert.Equal(t, 2, ab.NullN())
+	assert.NoError(t, ab.SetValid(0, "xyz"))
+	assert.NoError(t, ab.SetValid(1, ""))
+	assert.Error(t, ab.SetValid(2, "long"))
+	assert.Equal(t, 0, ab.NullN())
+	assert.Equal(t, "de", ab.Value(2))
+	ab.SetNull(2)
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewStringArray()
+	defer a.Release()
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, "xyz", a.Value(0))
+	assert.True(t, a.IsValid(1))
+	assert.True(t, a.IsNull(2))
+}
+
 func TestBinaryBuilder_ReserveData(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -85,3 +135,32 @@ func TestBinaryBuilder_ReserveData(t *testing.T) {
 	assert.Zero(t, ab.Cap(), "unexpected ArrayBuilder.Cap(), NewBinaryArray did not reset state")
 	assert.Zero(t, ab.NullN(), "unexpected ArrayBuilder.NullN(), NewBinaryArray did not reset state")
 }
+
+func TestBinaryBuilder_AppendValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	defer ab.Release()
+
+	ab.Append([]byte("a"))
+	ab.AppendValues([][]byte{[]byte("bc"), nil, []byte("kept"), []byte(""), []byte("def")}, []bool{true, false, false, true, true})
+	ab.AppendValues([][]byte{[]byte("gh")}, nil)
+	ab.AppendValues(nil, nil)
+
+	// nulls keep the bytes of their value.
+	assert.Equal(t, len("abckeptdefgh"), ab.DataLen())
+
+	arr := ab.NewBinaryArray()
+	defer arr.Release()
+
+	assert.Equal(t, 7, arr.Len())
+	assert.Equal(t, 2, arr.NullN())
+	assert.Equal(t, []int32{0, 1, 3, 3, 7, 7, 10, 12}, arr.ValueOffsets())
+	for i, want := range []string{"a", "bc", "", "kept", "", "def", "gh"} {
+		assert.Equal(t, want, string(arr.Value(i)), "value %d", i)
+	}
+	for i, valid := range []bool{true, true, false, false, true, true, true} {
+		assert.Equal(t, valid, arr.IsValid(i), "validity of value %d", i)
+	}
+}
@@ -55,6 +55,9 @@ func (b *BooleanBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *BooleanBuilder) Type() arrow.DataType { return arrow.FixedWidthTypes.Boolean }
+
 func (b *BooleanBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -70,8 +73,19 @@ func (b *BooleanBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *BooleanBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
 func (b *BooleanBuilder) UnsafeAppend(v bool) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	if !b.noNulls {
+		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	}
 	if v {
 		bitutil.SetBit(b.rawData, b.length)
 	} else {
@@ -96,6 +110,35 @@ func (b *BooleanBuilder) AppendValues(v []bool, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *BooleanBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	data := arr.Data()
+	b.Reserve(n)
+	bitutil.CopyBitmap(data.buffers[1].Bytes(), data.offset+start, n, b.rawData, b.length)
+	b.builder.unsafeAppendValidity(data, start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is false if
+// the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *BooleanBuilder) Value(i int) bool {
+	b.checkIndex(i)
+	return bitutil.BitIsSet(b.rawData, i)
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *BooleanBuilder) SetValid(i int, v bool) {
+	b.setValid(i)
+	bitutil.SetBitTo(b.rawData, i, v)
+}
+
 func (b *BooleanBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -114,6 +157,10 @@ func (b *BooleanBuilder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *BooleanBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
 	}
@@ -145,8 +192,8 @@ func (b *BooleanBuilder) NewBooleanArray() (a *Boolean) {
 func (b *BooleanBuilder) newData() *Data {
 	bytesRequired := arrow.BooleanTraits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	res := NewData(arrow.FixedWidthTypes.Boolean, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
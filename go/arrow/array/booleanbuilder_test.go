@@ -17,6 +17,7 @@
 package array_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow/array"
@@ -44,6 +45,93 @@ func TestBooleanBuilder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestBooleanBuilder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewBooleanBuilder(mem)
+	defer b.Release()
+
+	b.Reserve(4)
+	b.UnsafeAppend(true)
+	b.UnsafeAppendBoolToBitmap(false)
+	b.UnsafeAppend(false)
+	b.UnsafeAppend(true)
+	assert.Equal(t, 4, b.Len())
+	assert.Equal(t, 1, b.NullN())
+
+	a := b.NewBooleanArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.True(t, a.Value(0))
+	assert.False(t, a.Value(2))
+	assert.True(t, a.Value(3))
+}
+
+func TestBooleanBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewBooleanBuilder(mem)
+	defer ab.Release()
+
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%7 == 0 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(i%3 == 0)
+	}
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%7 == 0, ab.IsNull(i))
+		assert.Equal(t, i%7 != 0 && i%3 == 0, ab.Value(i))
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsValid(n) })
+
+	nb := array.NewBooleanBuilder(mem)
+	defer nb.Release()
+	array.DisableNulls(nb)
+	nb.AppendValues([]bool{true, false}, nil)
+	assert.True(t, nb.IsValid(1))
+	assert.False(t, nb.Value(1))
+}
+
+func TestBooleanBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewBooleanBuilder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]bool{true, false, true}, []bool{true, false, true})
+	ab.SetNull(0)
+	ab.SetValid(1, true)
+	ab.SetValid(2, false)
+	ab.SetNull(2)
+	ab.SetValid(2, false)
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewBooleanArray()
+	defer a.Release()
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(0))
+	assert.True(t, a.Value(1))
+	assert.True(t, a.IsValid(2))
+	assert.False(t, a.Value(2))
+
+	nb := array.NewBooleanBuilder(mem)
+	defer nb.Release()
+	array.DisableNulls(nb)
+	nb.Append(true)
+	nb.SetValid(0, false)
+	assert.False(t, nb.Value(0))
+	assert.Panics(t, func() { nb.SetNull(0) })
+}
+
 func TestBooleanBuilder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -88,3 +176,38 @@ func TestBooleanBuilder_Empty(t *testing.T) {
 	assert.Equal(t, want, boolValues(a))
 	a.Release()
 }
+
+// BenchmarkBooleanBuilder_UnsafeAppend compares Append with UnsafeAppend,
+// after a single Reserve call.
+func BenchmarkBooleanBuilder_UnsafeAppend(b *testing.B) {
+	const N = 1 << 20
+
+	for _, unsafe := range []bool{false, true} {
+		b.Run(fmt.Sprintf("unsafe=%v", unsafe), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewBooleanBuilder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(N / 8)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(N)
+				if unsafe {
+					for j := 0; j < N; j++ {
+						bldr.UnsafeAppend(j%3 == 0)
+					}
+				} else {
+					for j := 0; j < N; j++ {
+						bldr.Append(j%3 == 0)
+					}
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
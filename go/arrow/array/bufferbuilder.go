@@ -125,3 +125,8 @@ func (b *bufferBuilder) unsafeAppend(data []byte) {
 	copy(b.bytes[b.length:], data)
 	b.length += len(data)
 }
+
+func (b *bufferBuilder) unsafeAppendString(data string) {
+	copy(b.bytes[b.length:], data)
+	b.length += len(data)
+}
@@ -48,24 +48,64 @@ type Builder interface {
 	// NullN returns the number of null values in the array builder.
 	NullN() int
 
+	// IsNull returns whether the i-th element appended to the builder is
+	// null, reading the validity bitmap being built.
+	// IsNull panics if i is not in the range [0, Len()).
+	IsNull(i int) bool
+
+	// IsValid returns whether the i-th element appended to the builder is
+	// valid. IsValid panics if i is not in the range [0, Len()).
+	IsValid(i int) bool
+
 	// AppendNull adds a new null value to the array being built.
 	AppendNull()
 
+	// SetNull retroactively sets the i-th element appended to the builder
+	// to null. Its value, if any, is kept but becomes meaningless.
+	// SetNull panics if i is not in the range [0, Len()), or if the builder
+	// is in no-nulls mode.
+	SetNull(i int)
+
+	// AppendNulls adds n null values to the array being built, in bulk.
+	// The child builders of list and struct builders do not grow: the
+	// fields of null structs must be appended to by the caller, as with
+	// StructBuilder.AppendValues.
+	AppendNulls(n int)
+
+	// AppendValueFromString parses s as a value of the data type of the
+	// builder, and appends it. Nothing is appended if s cannot be parsed.
+	// See ValueToString for the supported formats.
+	AppendValueFromString(s string) error
+
+	// AppendArray appends the elements of arr in the range [start, end)
+	// to the builder, copying their values and validity in bulk.
+	// AppendArray panics if the data type of arr is not the one of the
+	// builder, or if the range is out of bounds.
+	AppendArray(arr Interface, start, end int)
+
 	// Reserve ensures there is enough space for appending n elements
 	// by checking the capacity and calling Resize if necessary.
 	Reserve(n int)
 
 	// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 	// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+	// The appended values are kept: the capacity is never reduced below Len.
 	Resize(n int)
 
 	// NewArray creates a new array from the memory buffers used
 	// by the builder and resets the Builder so it can be used to build
 	// a new array.
+	// The buffers are moved to the array, without copy, and the builder
+	// only allocates new ones when values are next appended or reserved:
+	// NewArray on an empty builder allocates nothing.
 	NewArray() Interface
 
+	// Type returns the data type of the arrays created by the builder.
+	Type() arrow.DataType
+
 	init(capacity int)
 	resize(newBits int, init func(int))
+	disableNulls()
 }
 
 // builder provides common functionality for managing the validity bitmap (nulls) when building arrays.
@@ -76,6 +116,8 @@ type builder struct {
 	nulls      int
 	length     int
 	capacity   int
+
+	noNulls bool // no validity bitmap is maintained, appending nulls is an error.
 }
 
 // Retain increases the reference count by 1.
@@ -93,7 +135,83 @@ func (b *builder) Cap() int { return b.capacity }
 // NullN returns the number of null values in the array builder.
 func (b *builder) NullN() int { return b.nulls }
 
+// IsNull returns whether the i-th element appended to the builder is null.
+func (b *builder) IsNull(i int) bool { return !b.IsValid(i) }
+
+// IsValid returns whether the i-th element appended to the builder is valid.
+func (b *builder) IsValid(i int) bool {
+	b.checkIndex(i)
+	return b.noNulls || bitutil.BitIsSet(b.nullBitmap.Bytes(), i)
+}
+
+// SetNull sets the i-th element appended to the builder to null.
+func (b *builder) SetNull(i int) {
+	b.checkIndex(i)
+	if b.noNulls {
+		panic(errNoNulls)
+	}
+	if bitutil.BitIsSet(b.nullBitmap.Bytes(), i) {
+		bitutil.ClearBit(b.nullBitmap.Bytes(), i)
+		b.nulls++
+	}
+}
+
+// setValid marks the i-th element appended to the builder as valid.
+func (b *builder) setValid(i int) {
+	b.checkIndex(i)
+	if b.noNulls {
+		return
+	}
+	if !bitutil.BitIsSet(b.nullBitmap.Bytes(), i) {
+		bitutil.SetBit(b.nullBitmap.Bytes(), i)
+		b.nulls--
+	}
+}
+
+// checkIndex panics if i is not the index of an appended element.
+func (b *builder) checkIndex(i int) {
+	if i < 0 || i >= b.length {
+		panic("arrow/array: index out of range")
+	}
+}
+
+// DisableNulls switches b to the no-nulls mode, suited to the builders of
+// non-nullable fields. NewRecordBuilder uses it for the fields declared
+// non-nullable.
+//
+// In no-nulls mode, b does not allocate nor maintain a validity bitmap:
+// arrays created by b have a nil validity buffer and no nulls.
+// Appending a null value, or passing a non-empty valid slice to
+// AppendValues, panics.
+//
+// DisableNulls panics if b is not empty or if b is a NullBuilder.
+// Child builders of nested builders are not affected.
+func DisableNulls(b Builder) {
+	if _, ok := b.(*NullBuilder); ok {
+		panic("arrow/array: cannot disable nulls of a NullBuilder")
+	}
+	if b.Len() != 0 {
+		panic("arrow/array: cannot disable nulls of a non-empty builder")
+	}
+	b.disableNulls()
+}
+
+func (b *builder) disableNulls() {
+	if b.nullBitmap != nil {
+		b.nullBitmap.Release()
+		b.nullBitmap = nil
+	}
+	b.noNulls = true
+}
+
+func (b *builder) nullsDisabled() bool { return b.noNulls }
+
 func (b *builder) init(capacity int) {
+	if b.noNulls {
+		b.capacity = capacity
+		return
+	}
+
 	toAlloc := bitutil.CeilByte(capacity) / 8
 	b.nullBitmap = memory.NewResizableBuffer(b.mem)
 	b.nullBitmap.Resize(toAlloc)
@@ -113,6 +231,15 @@ func (b *builder) reset() {
 }
 
 func (b *builder) resize(newBits int, init func(int)) {
+	if newBits < b.length {
+		// never drop the appended values.
+		newBits = b.length
+	}
+	if b.noNulls {
+		b.capacity = newBits
+		return
+	}
+
 	if b.nullBitmap == nil {
 		init(newBits)
 		return
@@ -126,10 +253,6 @@ func (b *builder) resize(newBits int, init func(int)) {
 		// TODO(sgc): necessary?
 		memory.Set(b.nullBitmap.Buf()[oldBytesN:], 0)
 	}
-	if newBits < b.length {
-		b.length = newBits
-		b.nulls = newBits - bitutil.CountSetBits(b.nullBitmap.Buf(), 0, newBits)
-	}
 }
 
 func (b *builder) reserve(elements int, resize func(int)) {
@@ -142,6 +265,10 @@ func (b *builder) reserve(elements int, resize func(int)) {
 // unsafeAppendBoolsToBitmap appends the contents of valid to the validity bitmap.
 // As an optimization, if the valid slice is empty, the next length bits will be set to valid (not null).
 func (b *builder) unsafeAppendBoolsToBitmap(valid []bool, length int) {
+	if b.noNulls && len(valid) != 0 {
+		panic(errNoNulls)
+	}
+
 	if len(valid) == 0 {
 		b.unsafeSetValid(length)
 		return
@@ -177,6 +304,14 @@ func (b *builder) unsafeAppendBoolsToBitmap(valid []bool, length int) {
 
 // unsafeSetValid sets the next length bits to valid in the validity bitmap.
 func (b *builder) unsafeSetValid(length int) {
+	if length == 0 {
+		return
+	}
+	if b.noNulls {
+		b.length += length
+		return
+	}
+
 	padToByte := min(8-(b.length%8), length)
 	if padToByte == 8 {
 		padToByte = 0
@@ -199,16 +334,139 @@ func (b *builder) unsafeSetValid(length int) {
 	b.length = newLength
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or a null element to the validity
+// bitmap, without checking for capacity and without writing a value.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
 func (b *builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+	switch {
+	case b.noNulls:
+		if !isValid {
+			panic(errNoNulls)
+		}
+	case isValid:
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
+	default:
 		b.nulls++
 	}
 	b.length++
 }
 
+// unsafeAppendNulls appends n nulls to the validity bitmap.
+func (b *builder) unsafeAppendNulls(n int) {
+	if n == 0 {
+		return
+	}
+	if b.noNulls {
+		panic(errNoNulls)
+	}
+
+	var (
+		bits = b.nullBitmap.Bytes()
+		end  = b.length + n
+		i    = b.length
+	)
+	for ; i < end && i%8 != 0; i++ {
+		bitutil.ClearBit(bits, i)
+	}
+	full := (end - i) / 8
+	memory.Set(bits[i/8:i/8+full], 0)
+	for i += full * 8; i < end; i++ {
+		bitutil.ClearBit(bits, i)
+	}
+
+	b.nulls += n
+	b.length = end
+}
+
+// unsafeAppendValidity appends the validity of the n elements of data
+// starting at its element i.
+func (b *builder) unsafeAppendValidity(data *Data, i, n int) {
+	var (
+		bitmap = bufferBytes(data.buffers[0])
+		pos    = data.offset + i
+	)
+	if data.nulls == 0 || len(bitmap) == 0 {
+		b.unsafeSetValid(n)
+		return
+	}
+
+	valid := bitutil.CountSetBits(bitmap, pos, n)
+	if b.noNulls {
+		if valid != n {
+			panic(errNoNulls)
+		}
+		b.length += n
+		return
+	}
+	bitutil.CopyBitmap(bitmap, pos, n, b.nullBitmap.Bytes(), b.length)
+	b.nulls += n - valid
+	b.length += n
+}
+
+// appendArrayLen panics if the elements of arr in the range [start, end)
+// cannot be appended to a builder of arrays of type dtype, and returns their
+// number otherwise.
+func appendArrayLen(dtype arrow.DataType, arr Interface, start, end int) int {
+	if !arrow.TypeEqual(arr.DataType(), dtype) {
+		panic(fmt.Errorf("arrow/array: cannot append %v array to %v builder", arr.DataType(), dtype))
+	}
+	if start < 0 || start > end || end > arr.Len() {
+		panic(fmt.Errorf("arrow/array: index out of range [%d:%d] with length %d", start, end, arr.Len()))
+	}
+	return end - start
+}
+
+// NewArraySlice creates a new array from the first n elements appended to b,
+// and leaves b holding the remaining b.Len()-n elements, as if only those
+// had been appended.
+//
+// As with NewArray, the buffers of b are moved to the returned array without
+// copy: the remaining elements are appended again to b, which only copies
+// them. Releasing b does not affect the returned array.
+//
+// NewArraySlice panics if n is not in the range [0, b.Len()].
+func NewArraySlice(b Builder, n int) Interface {
+	if n < 0 || n > b.Len() {
+		panic(fmt.Errorf("arrow/array: index out of range [:%d] with length %d", n, b.Len()))
+	}
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	if n == arr.Len() {
+		arr.Retain()
+		return arr
+	}
+	b.AppendArray(arr, n, arr.Len())
+	return NewSlice(arr, 0, int64(n))
+}
+
+const errNoNulls = "arrow/array: cannot append null values to a builder in no-nulls mode"
+
+// NewBuilder returns a builder for arrays of the given data type.
+//
+// Custom data types, implementing arrow.StorageProvider, are built with a
+// CustomBuilder.
+//
+// Dictionary builders created by NewBuilder have a fixed index type, as the
+// builders of nested types and records must create arrays of the data type
+// of their children and fields: see WithFixedIndexType.
+//
+// NewBuilder panics if the data type is not supported, or with a
+// *arrow.NestingError if it is nested deeper than arrow.DefaultMaxNestingDepth.
 func NewBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
+	switch dtype.ID() {
+	case arrow.LIST, arrow.LARGE_LIST, arrow.FIXED_SIZE_LIST, arrow.STRUCT, arrow.MAP:
+		if err := arrow.ValidateNestingDepth(arrow.Field{Type: dtype}, arrow.DefaultMaxNestingDepth); err != nil {
+			panic(err)
+		}
+	}
+
+	if dtype, ok := dtype.(arrow.StorageProvider); ok {
+		return NewCustomBuilder(mem, dtype)
+	}
+
 	// FIXME(sbinet): use a type switch on dtype instead?
 	switch dtype.ID() {
 	case arrow.NULL:
@@ -270,13 +528,20 @@ func NewBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 		}
 	case arrow.LIST:
 		typ := dtype.(*arrow.ListType)
-		return NewListBuilder(mem, typ.Elem())
+		return NewListBuilderWithField(mem, typ.ElemField())
+	case arrow.LARGE_LIST:
+		typ := dtype.(*arrow.LargeListType)
+		return NewLargeListBuilder(mem, typ.Elem())
 	case arrow.STRUCT:
 		typ := dtype.(*arrow.StructType)
 		return NewStructBuilder(mem, typ)
 	case arrow.UNION:
 	case arrow.DICTIONARY:
+		typ := dtype.(*arrow.DictionaryType)
+		return NewDictionaryBuilder(mem, typ, WithFixedIndexType(true))
 	case arrow.MAP:
+		typ := dtype.(*arrow.MapType)
+		return NewMapBuilder(mem, typ.KeyType(), typ.ItemType(), typ.KeysSorted)
 	case arrow.EXTENSION:
 	case arrow.FIXED_SIZE_LIST:
 		typ := dtype.(*arrow.FixedSizeListType)
@@ -284,6 +549,9 @@ func NewBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 	case arrow.DURATION:
 		typ := dtype.(*arrow.DurationType)
 		return NewDurationBuilder(mem, typ)
+	case arrow.DECIMAL256:
+		typ := dtype.(*arrow.Decimal256Type)
+		return NewDecimal256Builder(mem, typ)
 	}
 	panic(fmt.Errorf("arrow/array: unsupported builder for %T", dtype))
 }
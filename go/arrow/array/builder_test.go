@@ -19,6 +19,10 @@ package array
 import (
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/internal/testing/tools"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/stretchr/testify/assert"
@@ -72,12 +76,278 @@ func TestBuilder_resize(t *testing.T) {
 	assert.Equal(t, n, b.Len())
 	assert.Equal(t, n-1, b.NullN())
 
-	n = 5
-	b.resize(n, b.init)
+	// shrinking keeps the appended values.
+	b.resize(5, b.init)
+	assert.Equal(t, n, b.Cap())
 	assert.Equal(t, n, b.Len())
 	assert.Equal(t, n-1, b.NullN())
 
-	b.resize(32, b.init)
+	b.resize(128, b.init)
+	assert.Equal(t, 128, b.Cap())
 	assert.Equal(t, n, b.Len())
 	assert.Equal(t, n-1, b.NullN())
 }
+
+// nestedBuilders returns, for each nested builder, a function creating the
+// builder, filling it with a few values and returning one of its child
+// builders.
+func nestedBuilders() map[string]func(mem memory.Allocator) (parent, child Builder) {
+	return map[string]func(mem memory.Allocator) (Builder, Builder){
+		"list": func(mem memory.Allocator) (Builder, Builder) {
+			b := NewListBuilder(mem, arrow.PrimitiveTypes.Int64)
+			vb := b.ValueBuilder().(*Int64Builder)
+			b.Append(true)
+			vb.AppendValues([]int64{1, 2, 3}, nil)
+			b.AppendNull()
+			return b, vb
+		},
+		"fixed-size-list": func(mem memory.Allocator) (Builder, Builder) {
+			b := NewFixedSizeListBuilder(mem, 2, arrow.PrimitiveTypes.Int64)
+			vb := b.ValueBuilder().(*Int64Builder)
+			b.Append(true)
+			vb.AppendValues([]int64{1, 2}, nil)
+			return b, vb
+		},
+		"struct": func(mem memory.Allocator) (Builder, Builder) {
+			b := NewStructBuilder(mem, arrow.StructOf(
+				arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+				arrow.Field{Name: "l", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+			))
+			b.Append(true)
+			b.FieldBuilder(0).(*Int64Builder).Append(1)
+			lb := b.FieldBuilder(1).(*ListBuilder)
+			lb.Append(true)
+			lb.ValueBuilder().(*StringBuilder).Append("a")
+			b.AppendNull()
+			return b, lb
+		},
+	}
+}
+
+func TestBuilder_NestedTeardown(t *testing.T) {
+	for name, newBuilder := range nestedBuilders() {
+		t.Run(name, func(t *testing.T) {
+			t.Run("parent-then-child", func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				b, child := newBuilder(mem)
+				child.Retain()
+				b.Release()
+				child.AppendNull()
+				child.Release()
+			})
+
+			t.Run("child-then-parent", func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				b, child := newBuilder(mem)
+				child.Retain()
+				child.Release()
+				b.Release()
+			})
+
+			t.Run("retained-parent", func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				b, _ := newBuilder(mem)
+				b.Retain()
+				b.Release()
+				arr := b.NewArray()
+				arr.Release()
+				b.Release()
+			})
+
+			t.Run("double-build", func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				b, _ := newBuilder(mem)
+				defer b.Release()
+
+				a1 := b.NewArray()
+				defer a1.Release()
+
+				b.AppendNull()
+				a2 := b.NewArray()
+				defer a2.Release()
+
+				assert.Equal(t, 1, a2.Len())
+				assert.Equal(t, 1, a2.NullN())
+			})
+		})
+	}
+}
+
+func TestRecordBuilder_Teardown(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+	}, nil)
+
+	b := NewRecordBuilder(mem, schema)
+	b.Retain()
+	b.Field(0).(*ListBuilder).Append(true)
+	b.Release()
+
+	field := b.Field(0)
+	field.Retain()
+	b.Release()
+
+	field.(*ListBuilder).ValueBuilder().(*Int32Builder).Append(1)
+	arr := field.NewArray()
+	arr.Release()
+	field.Release()
+}
+
+func TestBuilder_DisableNulls(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		new    func(mem memory.Allocator) Builder
+		append func(b Builder)
+		values func(b Builder, valid []bool)
+	}{
+		{
+			name:   "int64",
+			new:    func(mem memory.Allocator) Builder { return NewInt64Builder(mem) },
+			append: func(b Builder) { b.(*Int64Builder).Append(1) },
+			values: func(b Builder, valid []bool) { b.(*Int64Builder).AppendValues([]int64{1, 2}, valid) },
+		},
+		{
+			name:   "boolean",
+			new:    func(mem memory.Allocator) Builder { return NewBooleanBuilder(mem) },
+			append: func(b Builder) { b.(*BooleanBuilder).Append(true) },
+			values: func(b Builder, valid []bool) { b.(*BooleanBuilder).AppendValues([]bool{true, false}, valid) },
+		},
+		{
+			name:   "string",
+			new:    func(mem memory.Allocator) Builder { return NewStringBuilder(mem) },
+			append: func(b Builder) { b.(*StringBuilder).Append("a") },
+			values: func(b Builder, valid []bool) { b.(*StringBuilder).AppendValues([]string{"a", "b"}, valid) },
+		},
+		{
+			name:   "fixed-size-binary",
+			new:    func(mem memory.Allocator) Builder { return NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: 2}) },
+			append: func(b Builder) { b.(*FixedSizeBinaryBuilder).Append([]byte("ab")) },
+			values: func(b Builder, valid []bool) {
+				b.(*FixedSizeBinaryBuilder).AppendValues([][]byte{[]byte("ab"), []byte("cd")}, valid)
+			},
+		},
+		{
+			name:   "float16",
+			new:    func(mem memory.Allocator) Builder { return NewFloat16Builder(mem) },
+			append: func(b Builder) { b.(*Float16Builder).Append(float16.New(1)) },
+			values: func(b Builder, valid []bool) {
+				b.(*Float16Builder).AppendValues([]float16.Num{float16.New(1), float16.New(2)}, valid)
+			},
+		},
+		{
+			name:   "decimal128",
+			new:    func(mem memory.Allocator) Builder { return NewDecimal128Builder(mem, &arrow.Decimal128Type{Precision: 10, Scale: 1}) },
+			append: func(b Builder) { b.(*Decimal128Builder).Append(decimal128.FromI64(1)) },
+			values: func(b Builder, valid []bool) {
+				b.(*Decimal128Builder).AppendValues([]decimal128.Num{decimal128.FromI64(1), decimal128.FromI64(2)}, valid)
+			},
+		},
+		{
+			name:   "decimal256",
+			new:    func(mem memory.Allocator) Builder { return NewDecimal256Builder(mem, &arrow.Decimal256Type{Precision: 50, Scale: 1}) },
+			append: func(b Builder) { b.(*Decimal256Builder).Append(decimal256.FromI64(1)) },
+			values: func(b Builder, valid []bool) {
+				b.(*Decimal256Builder).AppendValues([]decimal256.Num{decimal256.FromI64(1), decimal256.FromI64(2)}, valid)
+			},
+		},
+		{
+			name: "list",
+			new:  func(mem memory.Allocator) Builder { return NewListBuilder(mem, arrow.PrimitiveTypes.Int32) },
+			append: func(b Builder) {
+				b.(*ListBuilder).Append(true)
+				b.(*ListBuilder).ValueBuilder().(*Int32Builder).Append(1)
+			},
+			values: func(b Builder, valid []bool) { b.(*ListBuilder).AppendValues([]int32{0, 0}, valid) },
+		},
+		{
+			name: "struct",
+			new: func(mem memory.Allocator) Builder {
+				return NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32}))
+			},
+			append: func(b Builder) {
+				b.(*StructBuilder).Append(true)
+				b.(*StructBuilder).FieldBuilder(0).(*Int32Builder).Append(1)
+			},
+			values: func(b Builder, valid []bool) {
+				b.(*StructBuilder).AppendValues(valid)
+				b.(*StructBuilder).FieldBuilder(0).(*Int32Builder).AppendValues(make([]int32, len(valid)), nil)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			b := tc.new(mem)
+			defer b.Release()
+
+			DisableNulls(b)
+
+			for i := 0; i < 2; i++ {
+				for j := 0; j < 100; j++ {
+					tc.append(b)
+				}
+				tc.values(b, nil)
+				arr := b.NewArray()
+				assert.Nil(t, arr.Data().Buffers()[0], "validity bitmap should not be allocated")
+				assert.Equal(t, 0, arr.NullN())
+				assert.True(t, arr.Len() >= 100)
+				arr.Release()
+			}
+
+			assert.Panics(t, func() { b.AppendNull() })
+			assert.Panics(t, func() { tc.values(b, []bool{true, true}) })
+		})
+	}
+}
+
+func TestBuilder_DisableNullsInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	nb := NewNullBuilder(mem)
+	defer nb.Release()
+	assert.Panics(t, func() { DisableNulls(nb) })
+
+	ib := NewInt64Builder(mem)
+	defer ib.Release()
+	ib.Append(1)
+	assert.Panics(t, func() { DisableNulls(ib) })
+}
+
+func TestBuilder_NestingTooDeep(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	nested := func(n int) arrow.DataType {
+		var dt arrow.DataType = arrow.PrimitiveTypes.Int8
+		for i := 0; i < n; i++ {
+			dt = arrow.ListOf(dt)
+		}
+		return dt
+	}
+
+	b := NewBuilder(mem, nested(arrow.DefaultMaxNestingDepth))
+	b.Release()
+
+	defer func() {
+		e := recover()
+		err, ok := e.(*arrow.NestingError)
+		if !ok {
+			t.Fatalf("invalid panic value: %v", e)
+		}
+		assert.Equal(t, arrow.DefaultMaxNestingDepth+1, err.Depth)
+	}()
+	NewBuilder(mem, nested(arrow.DefaultMaxNestingDepth+1))
+}
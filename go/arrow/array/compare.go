@@ -25,7 +25,8 @@ import (
 )
 
 // RecordEqual reports whether the two provided records are equal.
-func RecordEqual(left, right Record) bool {
+// Nulls are handled as described for ArrayEqual.
+func RecordEqual(left, right Record, opts ...EqualOption) bool {
 	switch {
 	case left.NumCols() != right.NumCols():
 		return false
@@ -33,10 +34,12 @@ func RecordEqual(left, right Record) bool {
 		return false
 	}
 
+	opt := newEqualOption(opts...)
+
 	for i := range left.Columns() {
 		lc := left.Column(i)
 		rc := right.Column(i)
-		if !ArrayEqual(lc, rc) {
+		if !arrayEqual(lc, rc, opt) {
 			return false
 		}
 	}
@@ -66,10 +69,25 @@ func RecordApproxEqual(left, right Record, opts ...EqualOption) bool {
 }
 
 // ArrayEqual reports whether the two provided arrays are equal.
-func ArrayEqual(left, right Interface) bool {
+//
+// By default, nulls are equal to each other: arrays are equal when their
+// nulls are at the same positions and their valid values are equal.
+// With WithNullsEqual(false), comparing a null yields an unknown result, as
+// in SQL: arrays holding nulls, at any nesting level, are never equal, not
+// even to themselves.
+//
+// ArrayEqual only honours the WithNullsEqual option: floating point values
+// are compared exactly.
+func ArrayEqual(left, right Interface, opts ...EqualOption) bool {
+	return arrayEqual(left, right, newEqualOption(opts...))
+}
+
+func arrayEqual(left, right Interface, opt equalOption) bool {
 	switch {
 	case !baseArrayEqual(left, right):
 		return false
+	case !opt.nullsEq && left.NullN() != 0:
+		return false
 	case left.Len() == 0:
 		return true
 	case left.NullN() == left.Len():
@@ -131,6 +149,9 @@ func ArrayEqual(left, right Interface) bool {
 	case *Decimal128:
 		r := right.(*Decimal128)
 		return arrayEqualDecimal128(l, r)
+	case *Decimal256:
+		r := right.(*Decimal256)
+		return arrayEqualDecimal256(l, r)
 	case *Date32:
 		r := right.(*Date32)
 		return arrayEqualDate32(l, r)
@@ -148,13 +169,19 @@ func ArrayEqual(left, right Interface) bool {
 		return arrayEqualTimestamp(l, r)
 	case *List:
 		r := right.(*List)
-		return arrayEqualList(l, r)
+		return arrayEqualList(l, r, opt)
+	case *Map:
+		r := right.(*Map)
+		return arrayEqualList(l.List, r.List, opt)
+	case *LargeList:
+		r := right.(*LargeList)
+		return arrayEqualLargeList(l, r, opt)
 	case *FixedSizeList:
 		r := right.(*FixedSizeList)
-		return arrayEqualFixedSizeList(l, r)
+		return arrayEqualFixedSizeList(l, r, opt)
 	case *Struct:
 		r := right.(*Struct)
-		return arrayEqualStruct(l, r)
+		return arrayEqualStruct(l, r, opt)
 	case *MonthInterval:
 		r := right.(*MonthInterval)
 		return arrayEqualMonthInterval(l, r)
@@ -164,6 +191,12 @@ func ArrayEqual(left, right Interface) bool {
 	case *Duration:
 		r := right.(*Duration)
 		return arrayEqualDuration(l, r)
+	case *Custom:
+		r := right.(*Custom)
+		return arrayEqual(l.storage, r.storage, opt)
+	case *Dictionary:
+		r := right.(*Dictionary)
+		return arrayEqual(l.indices, r.indices, opt) && arrayEqual(l.dict, r.dict, opt)
 
 	default:
 		panic(xerrors.Errorf("arrow/array: unknown array type %T", l))
@@ -171,20 +204,22 @@ func ArrayEqual(left, right Interface) bool {
 }
 
 // ArraySliceEqual reports whether slices left[lbeg:lend] and right[rbeg:rend] are equal.
-func ArraySliceEqual(left Interface, lbeg, lend int64, right Interface, rbeg, rend int64) bool {
+// Nulls are handled as described for ArrayEqual.
+func ArraySliceEqual(left Interface, lbeg, lend int64, right Interface, rbeg, rend int64, opts ...EqualOption) bool {
 	l := NewSlice(left, lbeg, lend)
 	defer l.Release()
 	r := NewSlice(right, rbeg, rend)
 	defer r.Release()
 
-	return ArrayEqual(l, r)
+	return ArrayEqual(l, r, opts...)
 }
 
 const defaultAbsoluteTolerance = 1e-5
 
 type equalOption struct {
-	atol   float64 // absolute tolerance
-	nansEq bool    // whether NaNs are considered equal.
+	atol    float64 // absolute tolerance
+	nansEq  bool    // whether NaNs are considered equal.
+	nullsEq bool    // whether nulls are considered equal.
 }
 
 func (eq equalOption) f16(f1, f2 float16.Num) bool {
@@ -220,8 +255,9 @@ func (eq equalOption) f64(v1, v2 float64) bool {
 
 func newEqualOption(opts ...EqualOption) equalOption {
 	eq := equalOption{
-		atol:   defaultAbsoluteTolerance,
-		nansEq: false,
+		atol:    defaultAbsoluteTolerance,
+		nansEq:  false,
+		nullsEq: true,
 	}
 	for _, opt := range opts {
 		opt(&eq)
@@ -240,6 +276,15 @@ func WithNaNsEqual(v bool) EqualOption {
 	}
 }
 
+// WithNullsEqual configures the comparison functions so that nulls are
+// considered equal to each other. It is the default.
+// When v is false, arrays and records holding nulls are never equal.
+func WithNullsEqual(v bool) EqualOption {
+	return func(o *equalOption) {
+		o.nullsEq = v
+	}
+}
+
 // WithAbsTolerance configures the comparison functions so that 2 floating point values
 // v1 and v2 are considered equal if |v1-v2| <= atol.
 func WithAbsTolerance(atol float64) EqualOption {
@@ -259,6 +304,8 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	switch {
 	case !baseArrayEqual(left, right):
 		return false
+	case !opt.nullsEq && left.NullN() != 0:
+		return false
 	case left.Len() == 0:
 		return true
 	case left.NullN() == left.Len():
@@ -320,6 +367,9 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *Decimal128:
 		r := right.(*Decimal128)
 		return arrayEqualDecimal128(l, r)
+	case *Decimal256:
+		r := right.(*Decimal256)
+		return arrayEqualDecimal256(l, r)
 	case *Date32:
 		r := right.(*Date32)
 		return arrayEqualDate32(l, r)
@@ -338,6 +388,12 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *List:
 		r := right.(*List)
 		return arrayApproxEqualList(l, r, opt)
+	case *Map:
+		r := right.(*Map)
+		return arrayApproxEqualList(l.List, r.List, opt)
+	case *LargeList:
+		r := right.(*LargeList)
+		return arrayApproxEqualLargeList(l, r, opt)
 	case *FixedSizeList:
 		r := right.(*FixedSizeList)
 		return arrayApproxEqualFixedSizeList(l, r, opt)
@@ -353,6 +409,12 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *Duration:
 		r := right.(*Duration)
 		return arrayEqualDuration(l, r)
+	case *Custom:
+		r := right.(*Custom)
+		return arrayApproxEqual(l.storage, r.storage, opt)
+	case *Dictionary:
+		r := right.(*Dictionary)
+		return arrayApproxEqual(l.indices, r.indices, opt) && arrayApproxEqual(l.dict, r.dict, opt)
 
 	default:
 		panic(xerrors.Errorf("arrow/array: unknown array type %T", l))
@@ -444,6 +506,25 @@ func arrayApproxEqualList(left, right *List, opt equalOption) bool {
 	return true
 }
 
+func arrayApproxEqualLargeList(left, right *LargeList, opt equalOption) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		o := func() bool {
+			l := left.newListValue(i)
+			defer l.Release()
+			r := right.newListValue(i)
+			defer r.Release()
+			return arrayApproxEqual(l, r, opt)
+		}()
+		if !o {
+			return false
+		}
+	}
+	return true
+}
+
 func arrayApproxEqualFixedSizeList(left, right *FixedSizeList, opt equalOption) bool {
 	for i := 0; i < left.Len(); i++ {
 		if left.IsNull(i) {
@@ -21,6 +21,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
@@ -427,6 +428,80 @@ func TestArrayEqualNull(t *testing.T) {
 	}
 }
 
+func TestArrayEqualNullsEqual(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 0, 3, 0, 5}, []bool{true, false, true, false, true})
+	a1 := ib.NewArray()
+	defer a1.Release()
+	ib.AppendValues([]int32{1, 2, 3, 4, 5}, []bool{true, false, true, false, true})
+	a2 := ib.NewArray()
+	defer a2.Release()
+	ib.AppendValues([]int32{1, 2, 3}, nil)
+	valid := ib.NewArray()
+	defer valid.Release()
+
+	sb := array.NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int32, Nullable: true}))
+	defer sb.Release()
+	fb := sb.FieldBuilder(0).(*array.Int32Builder)
+	sb.Append(true)
+	fb.Append(1)
+	sb.Append(true)
+	fb.AppendNull()
+	nested := sb.NewArray()
+	defer nested.Release()
+
+	for _, tc := range []struct {
+		name        string
+		left, right array.Interface
+		nullsEq     bool
+		sqlEq       bool
+	}{
+		{"interleaved-nulls", a1, a2, true, false},
+		{"same-array", a1, a1, true, false},
+		{"no-nulls", valid, valid, true, true},
+		{"nested-nulls", nested, nested, true, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := array.ArrayEqual(tc.left, tc.right); got != tc.nullsEq {
+				t.Fatalf("invalid default equality: got=%v, want=%v", got, tc.nullsEq)
+			}
+			if got := array.ArrayEqual(tc.left, tc.right, array.WithNullsEqual(true)); got != tc.nullsEq {
+				t.Fatalf("invalid nulls-equal equality: got=%v, want=%v", got, tc.nullsEq)
+			}
+			if got := array.ArrayEqual(tc.left, tc.right, array.WithNullsEqual(false)); got != tc.sqlEq {
+				t.Fatalf("invalid SQL equality: got=%v, want=%v", got, tc.sqlEq)
+			}
+			if got := array.ArrayApproxEqual(tc.left, tc.right, array.WithNullsEqual(false)); got != tc.sqlEq {
+				t.Fatalf("invalid SQL approximate equality: got=%v, want=%v", got, tc.sqlEq)
+			}
+		})
+	}
+
+	// the valid prefix of a1 has no nulls.
+	if !array.ArraySliceEqual(a1, 0, 1, a2, 0, 1, array.WithNullsEqual(false)) {
+		t.Fatalf("slices without nulls should compare equal")
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true}}, nil)
+	r1 := array.NewRecord(schema, []array.Interface{a1}, -1)
+	defer r1.Release()
+	r2 := array.NewRecord(schema, []array.Interface{a2}, -1)
+	defer r2.Release()
+	if !array.RecordEqual(r1, r2) {
+		t.Fatalf("records should compare equal by default")
+	}
+	if array.RecordEqual(r1, r2, array.WithNullsEqual(false)) {
+		t.Fatalf("records with nulls should not compare equal with SQL semantics")
+	}
+	if array.RecordApproxEqual(r1, r1, array.WithNullsEqual(false)) {
+		t.Fatalf("records with nulls should not compare approximately equal with SQL semantics")
+	}
+}
+
 func TestArrayEqualMaskedArray(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -0,0 +1,308 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Concatenate returns a new array holding the elements of arrs, one array
+// after the other. The values of arrs are copied to buffers allocated with
+// mem.
+//
+// Concatenate returns an error if arrs is empty, if the arrays do not all
+// have the same data type or if that data type is not supported.
+func Concatenate(mem memory.Allocator, arrs []Interface) (Interface, error) {
+	if len(arrs) == 0 {
+		return nil, xerrors.New("arrow/array: no array to concatenate")
+	}
+
+	var (
+		dtype = arrs[0].DataType()
+		segs  = make([]segment, len(arrs))
+	)
+	for i, arr := range arrs {
+		if !arrow.TypeEqual(arr.DataType(), dtype) {
+			return nil, xerrors.Errorf("arrow/array: cannot concatenate arrays of types %v and %v", dtype, arr.DataType())
+		}
+		data := arr.Data()
+		segs[i] = segment{data: data, off: data.offset, n: data.length}
+	}
+
+	data, err := concatData(mem, dtype, segs)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+	return MakeFromData(data), nil
+}
+
+// segment is a run of n elements of data, starting at the absolute offset off.
+type segment struct {
+	data   *Data
+	off, n int
+}
+
+// concatData returns a copy of the elements of segs, with a zero offset.
+func concatData(dst memory.Allocator, dtype arrow.DataType, segs []segment) (*Data, error) {
+	n := 0
+	for _, s := range segs {
+		n += s.n
+	}
+
+	if dtype.ID() == arrow.NULL {
+		return NewData(dtype, n, []*memory.Buffer{nil}, nil, n, 0), nil
+	}
+	if dt, ok := dtype.(arrow.StorageProvider); ok {
+		storage := make([]segment, len(segs))
+		for i, s := range segs {
+			d := s.data
+			storage[i] = segment{data: NewData(dt.StorageType(), d.length, d.buffers, d.childData, d.nulls, d.offset), off: s.off, n: s.n}
+			defer storage[i].data.Release()
+		}
+		out, err := concatData(dst, dt.StorageType(), storage)
+		if err != nil {
+			return nil, err
+		}
+		out.dtype = dt
+		return out, nil
+	}
+
+	var (
+		bufs     []*memory.Buffer
+		children []*Data
+		nulls    = 0
+	)
+	release := func() {
+		for _, b := range bufs {
+			if b != nil {
+				b.Release()
+			}
+		}
+		for _, c := range children {
+			c.Release()
+		}
+	}
+
+	for _, s := range segs {
+		if s.data.nulls != 0 && s.data.buffers[0] != nil {
+			nulls += s.n - bitutil.CountSetBits(s.data.buffers[0].Bytes(), s.off, s.n)
+		}
+	}
+	if nulls > 0 {
+		bitmap := newZeroBuffer(dst, int(bitutil.BytesForBits(int64(n))))
+		pos := 0
+		for _, s := range segs {
+			var src []byte
+			if s.data.nulls != 0 {
+				src = bufferBytes(s.data.buffers[0])
+			}
+			appendBits(bitmap.Bytes(), pos, src, s.off, s.n)
+			pos += s.n
+		}
+		bufs = append(bufs, bitmap)
+	} else {
+		bufs = append(bufs, nil)
+	}
+
+	switch dt := dtype.(type) {
+	case arrow.FixedWidthDataType:
+		bw := dt.BitWidth()
+		switch {
+		case bw%8 == 0:
+			sz := bw / 8
+			values := newZeroBuffer(dst, n*sz)
+			out := values.Bytes()
+			for _, s := range segs {
+				out = out[copy(out, bufferBytes(s.data.buffers[1])[s.off*sz:(s.off+s.n)*sz]):]
+			}
+			bufs = append(bufs, values)
+		default:
+			values := newZeroBuffer(dst, int(bitutil.BytesForBits(int64(n*bw))))
+			pos := 0
+			for _, s := range segs {
+				appendBits(values.Bytes(), pos, bufferBytes(s.data.buffers[1]), s.off*bw, s.n*bw)
+				pos += s.n * bw
+			}
+			bufs = append(bufs, values)
+		}
+
+	case arrow.BinaryDataType:
+		offsets, ranges := concatOffsets(dst, segs)
+		size := 0
+		for _, r := range ranges {
+			size += r.n
+		}
+		values := newZeroBuffer(dst, size)
+		out := values.Bytes()
+		for i, r := range ranges {
+			out = out[copy(out, bufferBytes(segs[i].data.buffers[2])[r.off:r.off+r.n]):]
+		}
+		bufs = append(bufs, offsets, values)
+
+	case *arrow.ListType:
+		offsets, ranges := concatOffsets(dst, segs)
+		bufs = append(bufs, offsets)
+		sub := make([]segment, len(segs))
+		for i, r := range ranges {
+			child := segs[i].data.childData[0]
+			sub[i] = segment{data: child, off: child.offset + r.off, n: r.n}
+		}
+		child, err := concatData(dst, dt.Elem(), sub)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.MapType:
+		offsets, ranges := concatOffsets(dst, segs)
+		bufs = append(bufs, offsets)
+		sub := make([]segment, len(segs))
+		for i, r := range ranges {
+			child := segs[i].data.childData[0]
+			sub[i] = segment{data: child, off: child.offset + r.off, n: r.n}
+		}
+		child, err := concatData(dst, dt.Elem(), sub)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.LargeListType:
+		offsets, ranges := concatOffsets64(dst, segs)
+		bufs = append(bufs, offsets)
+		sub := make([]segment, len(segs))
+		for i, r := range ranges {
+			child := segs[i].data.childData[0]
+			sub[i] = segment{data: child, off: child.offset + r.off, n: r.n}
+		}
+		child, err := concatData(dst, dt.Elem(), sub)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.FixedSizeListType:
+		sz := int(dt.Len())
+		sub := make([]segment, len(segs))
+		for i, s := range segs {
+			child := s.data.childData[0]
+			sub[i] = segment{data: child, off: child.offset + s.off*sz, n: s.n * sz}
+		}
+		child, err := concatData(dst, dt.Elem(), sub)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.StructType:
+		for j, f := range dt.Fields() {
+			sub := make([]segment, len(segs))
+			for i, s := range segs {
+				child := s.data.childData[j]
+				sub[i] = segment{data: child, off: child.offset + s.off, n: s.n}
+			}
+			child, err := concatData(dst, f.Type, sub)
+			if err != nil {
+				release()
+				return nil, err
+			}
+			children = append(children, child)
+		}
+
+	default:
+		release()
+		return nil, xerrors.Errorf("arrow/array: concatenation of %v arrays not supported", dtype)
+	}
+
+	return newOwnedData(dtype, n, bufs, children, nulls, 0), nil
+}
+
+// concatOffsets returns the offsets of the concatenation of the
+// variable-width segs, along with the range of values, relative to the
+// values of their own array, of each segment.
+func concatOffsets(dst memory.Allocator, segs []segment) (*memory.Buffer, []segment) {
+	n := 0
+	for _, s := range segs {
+		n += s.n
+	}
+
+	var (
+		buf    = newZeroBuffer(dst, arrow.Int32Traits.BytesRequired(n+1))
+		out    = arrow.Int32Traits.CastFromBytes(buf.Bytes())
+		ranges = make([]segment, len(segs))
+		pos    = 0
+	)
+	for i, s := range segs {
+		if s.n == 0 {
+			continue
+		}
+		offsets := arrow.Int32Traits.CastFromBytes(s.data.buffers[1].Bytes())[s.off : s.off+s.n+1]
+		for j, v := range offsets[1:] {
+			out[pos+j+1] = out[pos] + v - offsets[0]
+		}
+		ranges[i] = segment{off: int(offsets[0]), n: int(offsets[s.n] - offsets[0])}
+		pos += s.n
+	}
+	return buf, ranges
+}
+
+// concatOffsets64 is like concatOffsets, for 64-bit offsets.
+func concatOffsets64(dst memory.Allocator, segs []segment) (*memory.Buffer, []segment) {
+	n := 0
+	for _, s := range segs {
+		n += s.n
+	}
+
+	var (
+		buf    = newZeroBuffer(dst, arrow.Int64Traits.BytesRequired(n+1))
+		out    = arrow.Int64Traits.CastFromBytes(buf.Bytes())
+		ranges = make([]segment, len(segs))
+		pos    = 0
+	)
+	for i, s := range segs {
+		if s.n == 0 {
+			continue
+		}
+		offsets := arrow.Int64Traits.CastFromBytes(s.data.buffers[1].Bytes())[s.off : s.off+s.n+1]
+		for j, v := range offsets[1:] {
+			out[pos+j+1] = out[pos] + v - offsets[0]
+		}
+		ranges[i] = segment{off: int(offsets[0]), n: int(offsets[s.n] - offsets[0])}
+		pos += s.n
+	}
+	return buf, ranges
+}
+
+// appendBits copies the n bits of src starting at bit off to dst, starting
+// at bit pos. All bits are set if src is nil.
+func appendBits(dst []byte, pos int, src []byte, off, n int) {
+	if src != nil {
+		bitutil.CopyBitmap(src, off, n, dst, pos)
+		return
+	}
+	for i := 0; i < n; i++ {
+		bitutil.SetBit(dst, pos+i)
+	}
+}
@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestConcatenate(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i := 0; i < int(recs[0].NumCols()); i++ {
+				// concatenate the column of each record, and slices of it.
+				var parts []array.Interface
+				for _, rec := range recs {
+					col := rec.Column(i)
+					col.Retain()
+					parts = append(parts, col)
+					if n := int64(col.Len()); n > 2 {
+						parts = append(parts, array.NewSlice(col, 1, n-1))
+					}
+				}
+
+				got, err := array.Concatenate(mem, parts)
+				if err != nil {
+					t.Fatalf("could not concatenate column %d: %+v", i, err)
+				}
+
+				pos := int64(0)
+				for j, part := range parts {
+					n := int64(part.Len())
+					if !array.ArraySliceEqual(got, pos, pos+n, part, 0, n) {
+						t.Fatalf("invalid part %d of column %d:\ngot= %v\nwant=%v", j, i, array.NewSlice(got, pos, pos+n), part)
+					}
+					pos += n
+					part.Release()
+				}
+				if int64(got.Len()) != pos {
+					t.Fatalf("invalid length for column %d: got=%d, want=%d", i, got.Len(), pos)
+				}
+				got.Release()
+			}
+		})
+	}
+}
+
+func TestConcatenateInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.Append(1)
+	i32 := ib.NewArray()
+	defer i32.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.Append(1)
+	f64 := fb.NewArray()
+	defer f64.Release()
+
+	for _, tc := range []struct {
+		name string
+		arrs []array.Interface
+		err  string
+	}{
+		{"empty", nil, "no array to concatenate"},
+		{"types", []array.Interface{i32, f64}, "cannot concatenate arrays of types int32 and float64"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := array.Concatenate(mem, tc.arrs)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestConcatenateNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewBooleanBuilder(mem)
+	defer bld.Release()
+
+	bld.AppendValues([]bool{true, false, true}, nil)
+	a := bld.NewArray()
+	defer a.Release()
+
+	bld.AppendValues([]bool{false, false, true, true}, []bool{true, false, true, false})
+	b := bld.NewArray()
+	defer b.Release()
+
+	got, err := array.Concatenate(mem, []array.Interface{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	bld.AppendValues([]bool{true, false, true, false, false, true, true}, []bool{true, true, true, true, false, true, false})
+	want := bld.NewArray()
+	defer want.Release()
+
+	if !array.ArrayEqual(got, want) || got.NullN() != 2 {
+		t.Fatalf("invalid array:\ngot= %v\nwant=%v", got, want)
+	}
+	if got.DataType().ID() != arrow.BOOL {
+		t.Fatalf("invalid data type: %v", got.DataType())
+	}
+}
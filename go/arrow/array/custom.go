@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Custom is an array of a custom data type, implementing
+// arrow.StorageProvider. Its values are held by an array of the storage type
+// of the custom type, sharing the same buffers and children.
+type Custom struct {
+	array
+	storage Interface
+}
+
+// NewCustomData returns a new Custom array value from data, whose data type
+// must implement arrow.StorageProvider.
+func NewCustomData(data *Data) *Custom {
+	a := &Custom{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// NewCustom returns a Custom array of data type dtype, holding the values of
+// storage, without copying them.
+//
+// NewCustom panics if the data type of storage is not the storage type of
+// dtype.
+func NewCustom(dtype arrow.StorageProvider, storage Interface) *Custom {
+	if !arrow.TypeEqual(storage.DataType(), dtype.StorageType()) {
+		panic(fmt.Errorf("arrow/array: invalid storage type %v for %v (want=%v)", storage.DataType(), dtype, dtype.StorageType()))
+	}
+	sd := storage.Data()
+	data := NewData(dtype, sd.length, sd.buffers, sd.childData, sd.nulls, sd.offset)
+	defer data.Release()
+	data.meta = sd.meta
+	return NewCustomData(data)
+}
+
+// Storage returns the array holding the values of a.
+func (a *Custom) Storage() Interface { return a.storage }
+
+func (a *Custom) String() string { return fmt.Sprintf("%v", a.storage) }
+
+func (a *Custom) setData(data *Data) {
+	a.array.setData(data)
+	if a.storage != nil {
+		a.storage.Release()
+	}
+	dtype := data.dtype.(arrow.StorageProvider).StorageType()
+	sd := NewData(dtype, data.length, data.buffers, data.childData, data.nulls, data.offset)
+	defer sd.Release()
+	sd.meta = data.meta
+	a.storage = MakeFromData(sd)
+}
+
+func (a *Custom) Retain() {
+	a.array.Retain()
+	a.storage.Retain()
+}
+
+func (a *Custom) Release() {
+	a.array.Release()
+	a.storage.Release()
+}
+
+// CustomBuilder is a builder for arrays of a custom data type.
+// Values are appended to the builder of its storage type, available with
+// StorageBuilder or through the embedded Builder.
+type CustomBuilder struct {
+	Builder
+	dtype arrow.StorageProvider
+}
+
+// NewCustomBuilder returns a builder for arrays of the custom data type dtype.
+func NewCustomBuilder(mem memory.Allocator, dtype arrow.StorageProvider) *CustomBuilder {
+	return &CustomBuilder{
+		Builder: NewBuilder(mem, dtype.StorageType()),
+		dtype:   dtype,
+	}
+}
+
+// Type returns the custom data type of the arrays created by the builder.
+func (b *CustomBuilder) Type() arrow.DataType { return b.dtype }
+
+// AppendArray appends the elements of arr, a Custom array of the data type
+// of b, in the range [start, end) to b.
+func (b *CustomBuilder) AppendArray(arr Interface, start, end int) {
+	appendArrayLen(b.dtype, arr, start, end)
+	b.Builder.AppendArray(arr.(*Custom).Storage(), start, end)
+}
+
+// StorageBuilder returns the builder of the storage values.
+func (b *CustomBuilder) StorageBuilder() Builder { return b.Builder }
+
+// NewArray creates a Custom array from the memory buffers used by the
+// builder and resets the CustomBuilder so it can be used to build a new
+// array.
+func (b *CustomBuilder) NewArray() Interface {
+	return b.NewCustomArray()
+}
+
+// NewCustomArray creates a Custom array from the memory buffers used by the
+// builder and resets the CustomBuilder so it can be used to build a new
+// array.
+func (b *CustomBuilder) NewCustomArray() *Custom {
+	storage := b.Builder.NewArray()
+	defer storage.Release()
+	return NewCustom(b.dtype, storage)
+}
+
+var (
+	_ Interface = (*Custom)(nil)
+	_ Builder   = (*CustomBuilder)(nil)
+)
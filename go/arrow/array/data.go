@@ -33,10 +33,15 @@ type Data struct {
 	length    int
 	buffers   []*memory.Buffer // TODO(sgc): should this be an interface?
 	childData []*Data          // TODO(sgc): managed by ListArray, StructArray and UnionArray types
+	meta      *arrow.Metadata  // custom metadata, not part of the Arrow format
 }
 
 // NewData creates a new Data.
+//
+// The offsets buffer of an empty Binary, String or List array may be nil or
+// empty: NewData replaces it with the single zero offset it should hold.
 func NewData(dtype arrow.DataType, length int, buffers []*memory.Buffer, childData []*Data, nulls, offset int) *Data {
+	buffers = emptyOffsets(dtype, length, offset, buffers)
 	for _, b := range buffers {
 		if b != nil {
 			b.Retain()
@@ -61,7 +66,10 @@ func NewData(dtype arrow.DataType, length int, buffers []*memory.Buffer, childDa
 }
 
 // Reset sets the Data for re-use.
+// The metadata of the Data is dropped.
 func (d *Data) Reset(dtype arrow.DataType, length int, buffers []*memory.Buffer, childData []*Data, nulls, offset int) {
+	buffers = emptyOffsets(dtype, length, offset, buffers)
+
 	// Retain new buffers before releasing existing buffers in-case they're the same ones to prevent accidental premature
 	// release.
 	for _, b := range buffers {
@@ -94,6 +102,7 @@ func (d *Data) Reset(dtype arrow.DataType, length int, buffers []*memory.Buffer,
 	d.length = length
 	d.nulls = nulls
 	d.offset = offset
+	d.meta = nil
 }
 
 // Retain increases the reference count by 1.
@@ -137,6 +146,54 @@ func (d *Data) Offset() int { return d.offset }
 // Buffers returns the buffers.
 func (d *Data) Buffers() []*memory.Buffer { return d.buffers }
 
+// Metadata returns the custom metadata attached to the data, or nil.
+//
+// The metadata describes the array rather than its values, e.g. where they
+// were read from. It is shared, as the same pointer, by the views of the
+// data: slices (NewSliceData, NewSlice, Record.NewSlice), the storage of a
+// Custom array, and the arrays differing only by their data type, such as
+// the result of compute.ConvertTimezone. Deep copies made by TransferArray
+// and TransferRecord keep it too.
+// Arrays holding new values, such as the results of Concatenate or of the
+// compute kernels (Filter, Cast, ...), have no metadata.
+//
+// The metadata is not serialized by the ipc package, unless the writer is
+// created with ipc.WithArrayMetadata.
+func (d *Data) Metadata() *arrow.Metadata { return d.meta }
+
+// SetMetadata attaches md to the data, replacing its metadata.
+// md may be nil to drop the metadata.
+//
+// SetMetadata is not safe for concurrent use: it should be called before the
+// data is shared, e.g. right after NewData. Use MakeFromDataWithMetadata to
+// attach metadata to data that may be shared.
+func (d *Data) SetMetadata(md *arrow.Metadata) { d.meta = md }
+
+// emptyOffsets returns buffers, where the offsets buffer of an empty
+// variable-width array is replaced with a zero-filled one if it is too short
+// to hold the offset of the first element.
+// The buffers slice of the caller is left untouched.
+func emptyOffsets(dtype arrow.DataType, length, offset int, buffers []*memory.Buffer) []*memory.Buffer {
+	if length != 0 || len(buffers) < 2 {
+		return buffers
+	}
+	need := (offset + 1) * arrow.Int32SizeBytes
+	switch dtype.(type) {
+	case arrow.BinaryDataType, *arrow.ListType, *arrow.MapType:
+	case *arrow.LargeListType:
+		need = (offset + 1) * arrow.Int64SizeBytes
+	default:
+		return buffers
+	}
+
+	if buffers[1] != nil && buffers[1].Len() >= need {
+		return buffers
+	}
+	buffers = append([]*memory.Buffer(nil), buffers...)
+	buffers[1] = memory.NewBufferBytes(make([]byte, need))
+	return buffers
+}
+
 // NewSliceData returns a new slice that shares backing data with the input.
 // The returned Data slice starts at i and extends j-i elements, such as:
 //    slice := data[i:j]
@@ -169,6 +226,7 @@ func NewSliceData(data *Data, i, j int64) *Data {
 		offset:    data.offset + int(i),
 		buffers:   data.buffers,
 		childData: data.childData,
+		meta:      data.meta,
 	}
 
 	if data.nulls == 0 {
@@ -49,3 +49,67 @@ func TestDataReset(t *testing.T) {
 		data.Reset(&arrow.Int64Type{}, 5, data.Buffers(), nil, 1, 2)
 	}
 }
+
+func TestDataMetadata(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	md := arrow.NewMetadata([]string{"source"}, []string{"part-0.csv:0-42"})
+
+	b := NewInt64Builder(mem)
+	defer b.Release()
+	b.AppendValues([]int64{1, 2, 3, 4}, nil)
+	plain := b.NewInt64Array()
+	defer plain.Release()
+	assert.Nil(t, plain.Data().Metadata())
+
+	arr := MakeFromDataWithMetadata(plain.Data(), &md)
+	defer arr.Release()
+	assert.True(t, arr.Data().Metadata() == &md, "metadata not kept")
+	assert.Nil(t, plain.Data().Metadata(), "input data modified")
+	assert.Equal(t, plain.Int64Values(), arr.(*Int64).Int64Values())
+
+	t.Run("slice", func(t *testing.T) {
+		slice := NewSlice(arr, 1, 3)
+		defer slice.Release()
+		assert.True(t, slice.Data().Metadata() == &md, "metadata not kept")
+
+		data := NewSliceData(arr.Data(), 0, 1)
+		defer data.Release()
+		assert.True(t, data.Metadata() == &md, "metadata not kept")
+
+		schema := arrow.NewSchema([]arrow.Field{{Name: "f", Type: arrow.PrimitiveTypes.Int64}}, nil)
+		rec := NewRecord(schema, []Interface{arr}, 4)
+		defer rec.Release()
+		sub := rec.NewSlice(2, 4)
+		defer sub.Release()
+		assert.True(t, sub.Column(0).Data().Metadata() == &md, "metadata not kept")
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		for _, compact := range []bool{false, true} {
+			out := TransferArray(mem, arr, WithCompaction(compact))
+			assert.True(t, out.Data().Metadata() == &md, "metadata not kept")
+			out.Release()
+		}
+	})
+
+	t.Run("concatenate", func(t *testing.T) {
+		out, err := Concatenate(mem, []Interface{arr, arr})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer out.Release()
+		assert.Nil(t, out.Data().Metadata())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		data := NewData(arrow.PrimitiveTypes.Int64, 4, arr.Data().Buffers(), nil, 0, 0)
+		defer data.Release()
+		data.SetMetadata(&md)
+		assert.True(t, data.Metadata() == &md, "metadata not set")
+
+		data.Reset(arrow.PrimitiveTypes.Int64, 2, arr.Data().Buffers(), nil, 0, 0)
+		assert.Nil(t, data.Metadata())
+	})
+}
@@ -14,10 +14,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// +build wasm
+// +build !debug
 
-package memory
+package array
 
-func init() {
-	memset = memory_memset_go
-}
+const debugChecks = false
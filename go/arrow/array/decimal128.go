@@ -26,6 +26,7 @@ import (
 	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 // A type which represents an immutable sequence of 128-bit decimal values.
@@ -46,6 +47,18 @@ func (a *Decimal128) Value(i int) decimal128.Num { return a.values[i] }
 
 func (a *Decimal128) Values() []decimal128.Num { return a.values }
 
+// ValidatePrecision returns an error if a valid element of the array holds
+// more decimal digits than the precision of its data type.
+func (a *Decimal128) ValidatePrecision() error {
+	prec := a.array.data.dtype.(*arrow.Decimal128Type).Precision
+	for i, v := range a.values {
+		if a.IsValid(i) && !v.FitsInPrecision(prec) {
+			return xerrors.Errorf("arrow/array: decimal128 value at index %d does not fit in precision %d", i, prec)
+		}
+	}
+	return nil
+}
+
 func (a *Decimal128) String() string {
 	o := new(strings.Builder)
 	o.WriteString("[")
@@ -120,13 +133,18 @@ func (b *Decimal128Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Decimal128Builder) Type() arrow.DataType { return b.dtype }
+
 func (b *Decimal128Builder) Append(v decimal128.Num) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
 }
 
 func (b *Decimal128Builder) UnsafeAppend(v decimal128.Num) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	if !b.noNulls {
+		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	}
 	b.rawData[b.length] = v
 	b.length++
 }
@@ -136,13 +154,10 @@ func (b *Decimal128Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Decimal128Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Decimal128Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
 // AppendValues will append the values in the v slice. The valid slice determines which values
@@ -164,6 +179,26 @@ func (b *Decimal128Builder) AppendValues(v []decimal128.Num, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Decimal128Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Decimal128).Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Decimal128Builder) SetValid(i int, v decimal128.Num) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Decimal128Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -182,6 +217,10 @@ func (b *Decimal128Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Decimal128Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -214,8 +253,8 @@ func (b *Decimal128Builder) NewDecimal128Array() (a *Decimal128) {
 func (b *Decimal128Builder) newData() (data *Data) {
 	bytesRequired := arrow.Decimal128Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -177,3 +177,24 @@ func TestDecimal128Slice(t *testing.T) {
 		t.Fatalf("invalid offset: got=%d, want=%d", got, want)
 	}
 }
+
+func TestDecimal128ValidatePrecision(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewDecimal128Builder(mem, &arrow.Decimal128Type{Precision: 3, Scale: 1})
+	defer b.Release()
+
+	b.AppendValues(
+		[]decimal128.Num{decimal128.FromI64(999), decimal128.FromI64(1000), decimal128.FromI64(-999), decimal128.FromI64(-1000)},
+		[]bool{true, false, true, true},
+	)
+	arr := b.NewDecimal128Array()
+	defer arr.Release()
+
+	assert.EqualError(t, arr.ValidatePrecision(), "arrow/array: decimal128 value at index 3 does not fit in precision 3")
+
+	slice := array.NewSlice(arr, 0, 3).(*array.Decimal128)
+	defer slice.Release()
+	assert.NoError(t, slice.ValidatePrecision())
+}
@@ -0,0 +1,127 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDecimal256Builder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDecimal256Builder(mem, &arrow.Decimal256Type{Precision: 76, Scale: 2})
+	defer ab.Release()
+
+	ab.Retain()
+	ab.Release()
+
+	want := []decimal256.Num{
+		decimal256.New(1, 2, 3, 4),
+		decimal256.FromI64(-5),
+		{},
+		decimal256.FromU64(math.MaxUint64),
+		decimal256.FromI64(-1).Negate(),
+	}
+	valids := []bool{true, true, false, true, true}
+
+	for i, valid := range valids {
+		switch {
+		case valid:
+			ab.Append(want[i])
+		default:
+			ab.AppendNull()
+		}
+	}
+
+	assert.Equal(t, 5, ab.Len(), "unexpected Len()")
+	assert.Equal(t, 1, ab.NullN(), "unexpected NullN()")
+
+	a := ab.NewArray().(*array.Decimal256)
+	defer a.Release()
+
+	assert.Zero(t, ab.Len(), "unexpected ArrayBuilder.Len(), NewDecimal256Array did not reset state")
+	assert.Zero(t, ab.Cap(), "unexpected ArrayBuilder.Cap(), NewDecimal256Array did not reset state")
+	assert.Zero(t, ab.NullN(), "unexpected ArrayBuilder.NullN(), NewDecimal256Array did not reset state")
+
+	assert.Equal(t, 1, a.NullN(), "unexpected null count")
+	assert.Equal(t, want, a.Values(), "unexpected Decimal256Values")
+	assert.Equal(t, []byte{0x1b}, a.NullBitmapBytes()[:1])
+	assert.Equal(t, arrow.Decimal256SizeBytes*5, a.Data().Buffers()[1].Len())
+}
+
+func TestDecimal256String(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Decimal256Type{Precision: 76, Scale: 3}
+	b := array.NewDecimal256Builder(mem, dtype)
+	defer b.Release()
+
+	for _, s := range []string{"1.5", "-0.001", "0"} {
+		v, err := decimal256.FromString(s, dtype.Precision, dtype.Scale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Append(v)
+	}
+	b.AppendNull()
+	b.Append(decimal256.MaxDecimal256)
+	b.Append(decimal256.MaxDecimal256.Negate())
+
+	arr := b.NewDecimal256Array()
+	defer arr.Release()
+
+	const max = "9999999999999999999999999999999999999999999999999999999999999999999999999.999"
+	if got, want := arr.String(), "[1.500 -0.001 0.000 (null) "+max+" -"+max+"]"; got != want {
+		t.Fatalf("invalid string:\ngot= %s\nwant=%s", got, want)
+	}
+
+	slice := array.NewSlice(arr, 1, 4).(*array.Decimal256)
+	defer slice.Release()
+
+	if got, want := slice.String(), "[-0.001 0.000 (null)]"; got != want {
+		t.Fatalf("invalid slice: got=%q, want=%q", got, want)
+	}
+	if got, want := slice.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+	if !array.ArraySliceEqual(arr, 1, 4, slice, 0, 3) {
+		t.Fatalf("slices differ")
+	}
+}
+
+func TestDecimal256ValidatePrecision(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewDecimal256Builder(mem, &arrow.Decimal256Type{Precision: 2, Scale: 0})
+	defer b.Release()
+
+	b.AppendValues([]decimal256.Num{decimal256.FromI64(99), decimal256.FromI64(-100)}, nil)
+	arr := b.NewDecimal256Array()
+	defer arr.Release()
+
+	assert.EqualError(t, arr.ValidatePrecision(), "arrow/array: decimal256 value at index 1 does not fit in precision 2")
+}
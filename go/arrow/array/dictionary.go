@@ -0,0 +1,550 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Dictionary is an array of dictionary-encoded values: each element is an
+// index into a dictionary array, holding the distinct values of the array.
+//
+// The buffers of a Dictionary array are the ones of its indices, and its
+// only child is its dictionary.
+type Dictionary struct {
+	array
+	indices Interface
+	dict    Interface
+}
+
+// NewDictionaryData returns a new Dictionary array value from data.
+func NewDictionaryData(data *Data) *Dictionary {
+	a := &Dictionary{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// NewDictionaryArray returns a Dictionary array of data type dtype, whose
+// elements are the values of dict at the provided indices. The buffers of
+// indices and dict are shared, not copied.
+//
+// NewDictionaryArray panics if the data types of indices and dict are not
+// the index and value types of dtype.
+func NewDictionaryArray(dtype *arrow.DictionaryType, indices, dict Interface) *Dictionary {
+	if !arrow.TypeEqual(indices.DataType(), dtype.IndexType) {
+		panic(fmt.Errorf("arrow/array: invalid index type %v for %v", indices.DataType(), dtype))
+	}
+	if !arrow.TypeEqual(dict.DataType(), dtype.ValueType) {
+		panic(fmt.Errorf("arrow/array: invalid dictionary type %v for %v", dict.DataType(), dtype))
+	}
+	id := indices.Data()
+	data := NewData(dtype, id.length, id.buffers, []*Data{dict.Data()}, id.nulls, id.offset)
+	defer data.Release()
+	return NewDictionaryData(data)
+}
+
+// Indices returns the array holding the indices of the elements of a in
+// its dictionary.
+func (a *Dictionary) Indices() Interface { return a.indices }
+
+// Dictionary returns the array holding the dictionary of a.
+func (a *Dictionary) Dictionary() Interface { return a.dict }
+
+// GetValueIndex returns the index, in the dictionary of a, of the value of
+// the i-th element of a.
+func (a *Dictionary) GetValueIndex(i int) int { return indexValue(a.indices, i) }
+
+// indexValue returns the i-th element of indices, an array of an index type.
+func indexValue(indices Interface, i int) int {
+	switch idx := indices.(type) {
+	case *Int8:
+		return int(idx.Value(i))
+	case *Int16:
+		return int(idx.Value(i))
+	case *Int32:
+		return int(idx.Value(i))
+	case *Int64:
+		return int(idx.Value(i))
+	case *Uint8:
+		return int(idx.Value(i))
+	case *Uint16:
+		return int(idx.Value(i))
+	case *Uint32:
+		return int(idx.Value(i))
+	default:
+		return int(idx.(*Uint64).Value(i))
+	}
+}
+
+func (a *Dictionary) String() string {
+	return fmt.Sprintf("{dictionary: %v, indices: %v}", a.dict, a.indices)
+}
+
+func (a *Dictionary) setData(data *Data) {
+	dtype := data.dtype.(*arrow.DictionaryType)
+	if !isDictionaryIndex(dtype.IndexType) {
+		panic(fmt.Errorf("arrow/array: invalid dictionary index type %v", dtype.IndexType))
+	}
+	if len(data.childData) != 1 {
+		panic("arrow/array: dictionary array must have exactly one child")
+	}
+
+	a.array.setData(data)
+	if a.indices != nil {
+		a.indices.Release()
+		a.dict.Release()
+	}
+	id := NewData(dtype.IndexType, data.length, data.buffers, nil, data.nulls, data.offset)
+	defer id.Release()
+	a.indices = MakeFromData(id)
+	a.dict = MakeFromData(data.childData[0])
+}
+
+func (a *Dictionary) Retain() {
+	a.array.Retain()
+	a.indices.Retain()
+	a.dict.Retain()
+}
+
+func (a *Dictionary) Release() {
+	a.array.Release()
+	a.indices.Release()
+	a.dict.Release()
+}
+
+// widerIndexTypes maps the index types that a DictionaryBuilder widens to
+// the next wider index type of the same signedness.
+var widerIndexTypes = map[arrow.Type]arrow.DataType{
+	arrow.INT8:   arrow.PrimitiveTypes.Int16,
+	arrow.INT16:  arrow.PrimitiveTypes.Int32,
+	arrow.INT32:  arrow.PrimitiveTypes.Int64,
+	arrow.UINT8:  arrow.PrimitiveTypes.Uint16,
+	arrow.UINT16: arrow.PrimitiveTypes.Uint32,
+	arrow.UINT32: arrow.PrimitiveTypes.Uint64,
+}
+
+func isDictionaryIndex(dtype arrow.DataType) bool {
+	switch dtype.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return true
+	default:
+		return false
+	}
+}
+
+// DictionaryEncode returns a Dictionary array, with int32 indices, holding
+// the values of arr. The distinct values of arr are copied to the
+// dictionary, in the order of their first occurrence.
+//
+// DictionaryEncode panics if the data type of arr is not supported by
+// DictionaryBuilder.
+func DictionaryEncode(mem memory.Allocator, arr Interface) *Dictionary {
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arr.DataType()}
+	b := NewDictionaryBuilder(mem, dtype)
+	defer b.Release()
+
+	b.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		b.appendValue(arr, i)
+	}
+	return b.NewDictionaryArray()
+}
+
+// DictionaryBuilder is a builder for Dictionary arrays.
+//
+// Appended values are deduplicated with a memo table: each distinct value
+// is appended once to the dictionary, and the index of each element to the
+// builder of the indices, available through the embedded Builder.
+// All the NaNs are deduplicated to the first one appended, and so are -0
+// and +0, as in the hash kernels of package compute.
+// Dictionaries of boolean, binary, string and fixed-width data types are
+// supported.
+//
+// When the dictionary outgrows its index type, the indices appended so far
+// are rewritten with the next wider index type of the same signedness, and
+// the arrays created by the builder have that index type: int8 indices are
+// widened to int16, int16 to int32 and int32 to int64. With
+// WithFixedIndexType, the index type never changes: appending a new value
+// to a full dictionary returns an error, and appends nothing.
+type DictionaryBuilder struct {
+	Builder // builder of the indices
+
+	refCount int64
+	mem      memory.Allocator
+	initial  *arrow.DictionaryType // data type the builder was created with
+	dtype    *arrow.DictionaryType // data type of the array being built
+	fixed    bool                  // whether the index type is never widened
+
+	values  Builder        // builder of the dictionary
+	scratch Builder        // builder of the values parsed by AppendValueFromString
+	memo    map[string]int // indices of the dictionary values, keyed by their bytes
+	key     [8]byte        // scratch space for the keys of numeric values
+
+	appendIndex func(i int)
+	maxIndex    int64
+}
+
+// DictionaryOption configures a DictionaryBuilder.
+type DictionaryOption func(*DictionaryBuilder)
+
+// WithFixedIndexType sets whether the index type of the builder is fixed:
+// a DictionaryBuilder with a fixed index type returns an error when its
+// dictionary is full, rather than widening its indices. The default is
+// false, except for the builders created by NewBuilder.
+func WithFixedIndexType(v bool) DictionaryOption {
+	return func(b *DictionaryBuilder) { b.fixed = v }
+}
+
+// NewDictionaryBuilder returns a builder for Dictionary arrays of data type
+// dtype.
+//
+// NewDictionaryBuilder panics if the index type of dtype is not an integer
+// type, or if its value type is not supported.
+func NewDictionaryBuilder(mem memory.Allocator, dtype *arrow.DictionaryType, opts ...DictionaryOption) *DictionaryBuilder {
+	if !isDictionaryIndex(dtype.IndexType) {
+		panic(fmt.Errorf("arrow/array: invalid dictionary index type %v", dtype.IndexType))
+	}
+	switch dt := dtype.ValueType.(type) {
+	case *arrow.BooleanType, arrow.BinaryDataType:
+	case arrow.FixedWidthDataType:
+		if dt.BitWidth()%8 != 0 {
+			panic(fmt.Errorf("arrow/array: unsupported dictionary value type %v", dt))
+		}
+	default:
+		panic(fmt.Errorf("arrow/array: unsupported dictionary value type %v", dt))
+	}
+
+	b := &DictionaryBuilder{
+		refCount: 1,
+		mem:      mem,
+		initial:  dtype,
+		values:   NewBuilder(mem, dtype.ValueType),
+		memo:     make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.setIndexType(dtype)
+	return b
+}
+
+// setIndexType replaces the builder of the indices of b with an empty one
+// of the index type of dtype, in the nulls mode of the former one, and sets
+// the data type of the array being built to dtype.
+func (b *DictionaryBuilder) setIndexType(dtype *arrow.DictionaryType) {
+	ib := NewBuilder(b.mem, dtype.IndexType)
+	if b.Builder != nil {
+		if nb, ok := b.Builder.(interface{ nullsDisabled() bool }); ok && nb.nullsDisabled() {
+			ib.disableNulls()
+		}
+		b.Builder.Release()
+	}
+	b.Builder, b.dtype = ib, dtype
+
+	switch ib := ib.(type) {
+	case *Int8Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(int8(i)) }, math.MaxInt8
+	case *Int16Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(int16(i)) }, math.MaxInt16
+	case *Int32Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(int32(i)) }, math.MaxInt32
+	case *Int64Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(int64(i)) }, math.MaxInt64
+	case *Uint8Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(uint8(i)) }, math.MaxUint8
+	case *Uint16Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(uint16(i)) }, math.MaxUint16
+	case *Uint32Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(uint32(i)) }, math.MaxUint32
+	case *Uint64Builder:
+		b.appendIndex, b.maxIndex = func(i int) { ib.Append(uint64(i)) }, math.MaxInt64
+	}
+}
+
+// widenIndices rewrites the indices appended to b with the next wider index
+// type. It returns false if the index type of b is fixed, or has no wider
+// index type.
+func (b *DictionaryBuilder) widenIndices() bool {
+	next, ok := widerIndexTypes[b.dtype.IndexType.ID()]
+	if b.fixed || !ok {
+		return false
+	}
+
+	indices := b.Builder.NewArray()
+	defer indices.Release()
+
+	b.setIndexType(&arrow.DictionaryType{IndexType: next, ValueType: b.dtype.ValueType, Ordered: b.dtype.Ordered})
+	b.Builder.Reserve(indices.Len())
+	for i := 0; i < indices.Len(); i++ {
+		if indices.IsNull(i) {
+			b.Builder.AppendNull()
+			continue
+		}
+		b.appendIndex(indexValue(indices, i))
+	}
+	return true
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (b *DictionaryBuilder) Retain() {
+	atomic.AddInt64(&b.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (b *DictionaryBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		b.Builder.Release()
+		b.values.Release()
+		if b.scratch != nil {
+			b.scratch.Release()
+		}
+	}
+}
+
+// Type returns the dictionary data type of the arrays created by the builder.
+func (b *DictionaryBuilder) Type() arrow.DataType { return b.dtype }
+
+// DictionaryLen returns the number of distinct values in the dictionary
+// being built.
+func (b *DictionaryBuilder) DictionaryLen() int { return len(b.memo) }
+
+// AppendString appends v to the builder, whose value type must be a binary
+// or string type.
+func (b *DictionaryBuilder) AppendString(v string) error {
+	bb := b.binaryValues()
+	idx, ok := b.memo[v]
+	if !ok {
+		var err error
+		if idx, err = b.memoize(v); err != nil {
+			return err
+		}
+		bb.AppendString(v)
+	}
+	b.appendIndex(idx)
+	return nil
+}
+
+// AppendBytes appends v to the builder, whose value type must be a binary
+// or string type.
+func (b *DictionaryBuilder) AppendBytes(v []byte) error {
+	bb := b.binaryValues()
+	idx, ok := b.memo[string(v)]
+	if !ok {
+		var err error
+		if idx, err = b.memoize(string(v)); err != nil {
+			return err
+		}
+		bb.Append(v)
+	}
+	b.appendIndex(idx)
+	return nil
+}
+
+// AppendArray appends the elements of arr, a Dictionary array of the value
+// type of b and any index type, in the range [start, end) to b. The values
+// of arr are deduplicated with the ones already in the dictionary of b.
+//
+// AppendArray panics if the dictionary of b cannot hold the new values of
+// arr, after appending the elements of arr before the first one that does
+// not fit.
+func (b *DictionaryBuilder) AppendArray(arr Interface, start, end int) {
+	src, ok := arr.(*Dictionary)
+	if !ok || !arrow.TypeEqual(src.dict.DataType(), b.dtype.ValueType) {
+		panic(fmt.Errorf("arrow/array: cannot append %v array to %v builder", arr.DataType(), b.dtype))
+	}
+	appendArrayLen(arr.DataType(), arr, start, end)
+	for i := start; i < end; i++ {
+		if src.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		if err := b.appendValue(src.dict, src.GetValueIndex(i)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// NewArray creates a Dictionary array from the memory buffers used by the
+// builder and resets the DictionaryBuilder so it can be used to build a new
+// array.
+func (b *DictionaryBuilder) NewArray() Interface {
+	return b.NewDictionaryArray()
+}
+
+// NewDictionaryArray creates a Dictionary array from the memory buffers used
+// by the builder and resets the DictionaryBuilder so it can be used to build
+// a new array.
+// The memo table and the index type are reset as well: successive arrays
+// have independent dictionaries.
+func (b *DictionaryBuilder) NewDictionaryArray() *Dictionary {
+	indices := b.Builder.NewArray()
+	defer indices.Release()
+	dict := b.values.NewArray()
+	defer dict.Release()
+
+	arr := NewDictionaryArray(b.dtype, indices, dict)
+	b.memo = make(map[string]int)
+	if b.dtype != b.initial {
+		b.setIndexType(b.initial)
+	}
+	return arr
+}
+
+// appendValue appends the i-th value of arr, an array of the value type of
+// b, to b.
+func (b *DictionaryBuilder) appendValue(arr Interface, i int) error {
+	if arr.IsNull(i) {
+		b.AppendNull()
+		return nil
+	}
+	key := valueKey(arr.Data(), i, b.key[:])
+	idx, ok := b.memo[string(key)]
+	if !ok {
+		var err error
+		if idx, err = b.memoize(string(key)); err != nil {
+			return err
+		}
+		b.values.AppendArray(arr, i, i+1)
+	}
+	b.appendIndex(idx)
+	return nil
+}
+
+// memoize records key as the bytes of the next value of the dictionary,
+// and returns its index, widening the indices if needed. It returns an
+// error if the dictionary is full.
+func (b *DictionaryBuilder) memoize(key string) (int, error) {
+	idx := len(b.memo)
+	for int64(idx) > b.maxIndex {
+		if !b.widenIndices() {
+			return 0, fmt.Errorf("arrow/array: dictionary of %d values is full for %v indices", idx, b.dtype.IndexType)
+		}
+	}
+	b.memo[key] = idx
+	return idx, nil
+}
+
+// binaryValues returns the builder of the dictionary of a binary or string
+// dictionary builder.
+func (b *DictionaryBuilder) binaryValues() *BinaryBuilder {
+	switch vb := b.values.(type) {
+	case *BinaryBuilder:
+		return vb
+	case *StringBuilder:
+		return vb.builder
+	default:
+		panic(b.valueTypeError("binary"))
+	}
+}
+
+func (b *DictionaryBuilder) valueTypeError(kind string) error {
+	return fmt.Errorf("arrow/array: cannot append %s value to %v builder", kind, b.dtype)
+}
+
+var (
+	falseKey = []byte{0}
+	trueKey  = []byte{1}
+)
+
+// valueKey returns the bytes of the i-th value of data, identifying it in
+// the memo table of a DictionaryBuilder. The keys of floating point values
+// are written to scratch.
+func valueKey(data *Data, i int, scratch []byte) []byte {
+	switch dt := data.dtype.(type) {
+	case *arrow.BooleanType:
+		if bitutil.BitIsSet(data.buffers[1].Bytes(), data.offset+i) {
+			return trueKey
+		}
+		return falseKey
+	case *arrow.Float16Type:
+		v := arrow.Float16Traits.CastFromBytes(data.buffers[1].Bytes())[data.offset+i]
+		arrow.Uint16Traits.PutValue(scratch, canonicalFloat16Bits(v.Uint16()))
+		return scratch[:arrow.Float16SizeBytes]
+	case *arrow.Float32Type:
+		v := arrow.Float32Traits.CastFromBytes(data.buffers[1].Bytes())[data.offset+i]
+		arrow.Float32Traits.PutValue(scratch, canonicalFloat32(v))
+		return scratch[:arrow.Float32SizeBytes]
+	case *arrow.Float64Type:
+		v := arrow.Float64Traits.CastFromBytes(data.buffers[1].Bytes())[data.offset+i]
+		arrow.Float64Traits.PutValue(scratch, canonicalFloat64(v))
+		return scratch[:arrow.Float64SizeBytes]
+	case arrow.FixedWidthDataType:
+		w := dt.BitWidth() / 8
+		j := (data.offset + i) * w
+		return data.buffers[1].Bytes()[j : j+w]
+	default:
+		j := data.offset + i
+		offsets := arrow.Int32Traits.CastFromBytes(data.buffers[1].Bytes())
+		return bufferBytes(data.buffers[2])[offsets[j]:offsets[j+1]]
+	}
+}
+
+// canonicalFloat64, canonicalFloat32 and canonicalFloat16Bits return the
+// value identifying x in the memo table of a DictionaryBuilder: all NaNs are
+// the same value, and so are -0 and +0, as in the hash kernels of package
+// compute.
+func canonicalFloat64(x float64) float64 {
+	switch {
+	case x != x:
+		return math.NaN()
+	case x == 0:
+		return 0
+	default:
+		return x
+	}
+}
+
+func canonicalFloat32(x float32) float32 {
+	switch {
+	case x != x:
+		return float32(math.NaN())
+	case x == 0:
+		return 0
+	default:
+		return x
+	}
+}
+
+func canonicalFloat16Bits(x uint16) uint16 {
+	switch {
+	case x&0x7c00 == 0x7c00 && x&0x03ff != 0: // NaN
+		return 0x7e00
+	case x&0x7fff == 0:
+		return 0
+	default:
+		return x
+	}
+}
+
+var (
+	_ Interface = (*Dictionary)(nil)
+	_ Builder   = (*DictionaryBuilder)(nil)
+)
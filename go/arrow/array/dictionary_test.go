@@ -0,0 +1,471 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryBuilderStrings(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const (
+		n        = 1000000
+		distinct = 50
+	)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+	b := array.NewBuilder(mem, dtype).(*array.DictionaryBuilder)
+	defer b.Release()
+
+	for i := 0; i < n; i++ {
+		if i%1000 == 999 {
+			b.AppendNull()
+			continue
+		}
+		b.AppendString(fmt.Sprintf("value-%02d", i%distinct))
+	}
+	assert.Equal(t, n, b.Len())
+	assert.Equal(t, distinct, b.DictionaryLen())
+
+	arr := b.NewArray().(*array.Dictionary)
+	defer arr.Release()
+
+	assert.Equal(t, n, arr.Len())
+	assert.Equal(t, n/1000, arr.NullN())
+	assert.Equal(t, distinct, arr.Dictionary().Len())
+	assert.True(t, arrow.TypeEqual(arr.Indices().DataType(), arrow.PrimitiveTypes.Int8))
+
+	dict := arr.Dictionary().(*array.String)
+	for _, i := range []int{0, 1, 49, 50, 998, 123456, n - 2} {
+		assert.Equal(t, fmt.Sprintf("value-%02d", i%distinct), dict.Value(arr.GetValueIndex(i)), "element %d", i)
+	}
+	assert.True(t, arr.IsNull(999))
+
+	if err := array.ValidateFull(arr); err != nil {
+		t.Fatal(err)
+	}
+
+	// the builder can be reused, with a new dictionary.
+	b.AppendString("other")
+	arr2 := b.NewArray().(*array.Dictionary)
+	defer arr2.Release()
+	assert.Equal(t, `{dictionary: ["other"], indices: [0]}`, arr2.String())
+}
+
+func TestDictionaryBuilderNumeric(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint16, ValueType: arrow.PrimitiveTypes.Float64}
+	b := array.NewDictionaryBuilder(mem, dtype)
+	defer b.Release()
+
+	for _, v := range []float64{1.5, 2, 1.5, math.NaN(), 2, math.NaN()} {
+		b.AppendFloat64(v)
+	}
+	b.AppendNull()
+	assert.NoError(t, b.AppendValueFromString("2"))
+	assert.NoError(t, b.AppendValueFromString("3.25"))
+	assert.Error(t, b.AppendValueFromString("x"))
+
+	assert.Panics(t, func() { b.AppendInt64(1) })
+	assert.Panics(t, func() { b.AppendString("1") })
+
+	arr := b.NewDictionaryArray()
+	defer arr.Release()
+
+	assert.Equal(t, "[1.5 2 NaN 3.25]", fmt.Sprint(arr.Dictionary()))
+	assert.Equal(t, "[0 1 0 2 1 2 (null) 1 3]", fmt.Sprint(arr.Indices()))
+	assert.Equal(t, "3.25", array.ValueToString(arr, 8))
+	assert.Equal(t, "(null)", array.ValueToString(arr, 6))
+}
+
+func TestDictionaryBuilderFloatKeys(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// all NaNs are the same value, and so are -0 and +0.
+	f64s := []float64{
+		math.Float64frombits(0x7ff8000000000000), math.NaN(), 0, math.Copysign(0, -1),
+		math.Float64frombits(0xfff0000000000001), 1,
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.PrimitiveTypes.Float64}
+		b := array.NewDictionaryBuilder(mem, dtype)
+		defer b.Release()
+
+		for _, v := range f64s {
+			b.AppendFloat64(v)
+		}
+		arr := b.NewDictionaryArray()
+		defer arr.Release()
+		assert.Equal(t, "[NaN 0 1]", fmt.Sprint(arr.Dictionary()))
+		assert.Equal(t, "[0 0 1 1 0 2]", fmt.Sprint(arr.Indices()))
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.PrimitiveTypes.Float32}
+		b := array.NewDictionaryBuilder(mem, dtype)
+		defer b.Release()
+
+		for _, v := range []float32{
+			math.Float32frombits(0x7fc00000), float32(math.NaN()), 0, float32(math.Copysign(0, -1)),
+			math.Float32frombits(0xff800001), 1,
+		} {
+			b.AppendFloat32(v)
+		}
+		arr := b.NewDictionaryArray()
+		defer arr.Release()
+		assert.Equal(t, "[NaN 0 1]", fmt.Sprint(arr.Dictionary()))
+		assert.Equal(t, "[0 0 1 1 0 2]", fmt.Sprint(arr.Indices()))
+	})
+
+	for _, tc := range []struct {
+		name string
+		arr  func() array.Interface
+	}{
+		{"append-float64", func() array.Interface {
+			b := array.NewFloat64Builder(mem)
+			defer b.Release()
+			b.AppendValues(f64s, nil)
+			return b.NewArray()
+		}},
+		{"append-float16", func() array.Interface {
+			b := array.NewFloat16Builder(mem)
+			defer b.Release()
+			for _, v := range f64s {
+				b.Append(float16.New(float32(v)))
+			}
+			return b.NewArray()
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dict := tc.arr()
+			defer dict.Release()
+
+			// a dictionary array with the values as is, appended to a
+			// builder deduplicating them.
+			ib := array.NewInt32Builder(mem)
+			defer ib.Release()
+			for i := 0; i < dict.Len(); i++ {
+				ib.Append(int32(i))
+			}
+			indices := ib.NewArray()
+			defer indices.Release()
+
+			dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: dict.DataType()}
+			src := array.NewDictionaryArray(dtype, indices, dict)
+			defer src.Release()
+
+			b := array.NewDictionaryBuilder(mem, dtype)
+			defer b.Release()
+			b.AppendArray(src, 0, src.Len())
+			arr := b.NewDictionaryArray()
+			defer arr.Release()
+			assert.Equal(t, 3, arr.Dictionary().Len())
+			assert.Equal(t, "[0 0 1 1 0 2]", fmt.Sprint(arr.Indices()))
+		})
+	}
+}
+
+func TestDictionaryBuilderAppendArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, vtype := range []arrow.DataType{
+		arrow.FixedWidthTypes.Boolean,
+		arrow.PrimitiveTypes.Int32,
+		arrow.FixedWidthTypes.Float16,
+		arrow.FixedWidthTypes.MonthInterval,
+		&arrow.FixedSizeBinaryType{ByteWidth: 3},
+		arrow.BinaryTypes.Binary,
+		arrow.BinaryTypes.String,
+	} {
+		t.Run(vtype.Name(), func(t *testing.T) {
+			dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: vtype}
+			b := array.NewDictionaryBuilder(mem, dtype)
+			defer b.Release()
+
+			for _, s := range []string{"1", "0", "1", "1", "0"} {
+				if vtype.ID() == arrow.FIXED_SIZE_BINARY {
+					s += "ab"
+				}
+				if vtype.ID() == arrow.BOOL {
+					s = map[string]string{"1": "true", "0": "false"}[s]
+				}
+				if err := b.AppendValueFromString(s); err != nil {
+					t.Fatal(err)
+				}
+			}
+			b.AppendNull()
+			src := b.NewDictionaryArray()
+			defer src.Release()
+			assert.Equal(t, 2, src.Dictionary().Len())
+			assert.Equal(t, "[0 1 0 0 1 (null)]", fmt.Sprint(src.Indices()))
+
+			// appending a slice of src, to a builder whose dictionary
+			// already holds one of its values.
+			slice := array.NewSlice(src, 1, 6).(*array.Dictionary)
+			defer slice.Release()
+
+			if err := b.AppendValueFromString(array.ValueToString(src, 1)); err != nil {
+				t.Fatal(err)
+			}
+			b.AppendArray(slice, 0, slice.Len())
+			got := b.NewDictionaryArray()
+			defer got.Release()
+
+			assert.Equal(t, 2, got.Dictionary().Len())
+			assert.Equal(t, "[0 0 1 1 0 (null)]", fmt.Sprint(got.Indices()))
+			for i := 0; i < slice.Len(); i++ {
+				assert.Equal(t, array.ValueToString(slice, i), array.ValueToString(got, i+1))
+			}
+			assert.Panics(t, func() { b.AppendArray(src.Dictionary(), 0, 1) })
+		})
+	}
+}
+
+func TestDictionaryBuilderIndexOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.PrimitiveTypes.Int64}
+	b := array.NewDictionaryBuilder(mem, dtype, array.WithFixedIndexType(true))
+	defer b.Release()
+
+	for i := 0; i <= math.MaxInt8; i++ {
+		assert.NoError(t, b.AppendInt64(int64(i)))
+	}
+	assert.NoError(t, b.AppendInt64(0))
+	assert.EqualError(t, b.AppendInt64(-1), "arrow/array: dictionary of 128 values is full for int8 indices")
+	assert.Equal(t, math.MaxInt8+2, b.Len())
+	assert.Equal(t, math.MaxInt8+1, b.DictionaryLen())
+
+	assert.True(t, arrow.TypeEqual(dtype, b.Type()))
+
+	src := array.NewDictionaryBuilder(mem, dtype)
+	defer src.Release()
+	src.AppendInt64(1)
+	src.AppendInt64(-1)
+	src.AppendInt64(2)
+	arr := src.NewArray()
+	defer arr.Release()
+	assert.Panics(t, func() { b.AppendArray(arr, 0, 3) })
+	assert.Equal(t, math.MaxInt8+3, b.Len())
+
+	assert.Panics(t, func() {
+		array.NewDictionaryBuilder(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Float32, ValueType: arrow.PrimitiveTypes.Int64})
+	})
+	assert.Panics(t, func() {
+		array.NewDictionaryBuilder(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.ListOf(arrow.PrimitiveTypes.Int64)})
+	})
+}
+
+func TestDictionaryBuilderIndexWidening(t *testing.T) {
+	for _, tc := range []struct {
+		index, wider arrow.DataType
+		max          int // greatest index of the index type
+	}{
+		{arrow.PrimitiveTypes.Int8, arrow.PrimitiveTypes.Int16, math.MaxInt8},
+		{arrow.PrimitiveTypes.Int16, arrow.PrimitiveTypes.Int32, math.MaxInt16},
+		{arrow.PrimitiveTypes.Uint8, arrow.PrimitiveTypes.Uint16, math.MaxUint8},
+		{arrow.PrimitiveTypes.Uint16, arrow.PrimitiveTypes.Uint32, math.MaxUint16},
+	} {
+		t.Run(tc.index.Name(), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			dtype := &arrow.DictionaryType{IndexType: tc.index, ValueType: arrow.PrimitiveTypes.Int32, Ordered: true}
+			b := array.NewDictionaryBuilder(mem, dtype)
+			defer b.Release()
+
+			// a full dictionary keeps its index type.
+			b.AppendNull()
+			for i := 0; i <= tc.max; i++ {
+				assert.NoError(t, b.AppendInt32(int32(i)))
+			}
+			assert.NoError(t, b.AppendInt32(int32(tc.max)))
+			assert.Equal(t, tc.max+1, b.DictionaryLen())
+			assert.True(t, arrow.TypeEqual(dtype, b.Type()))
+
+			// a new value widens the indices.
+			assert.NoError(t, b.AppendInt32(-1))
+			b.AppendNull()
+			assert.Equal(t, tc.max+2, b.DictionaryLen())
+			assert.Equal(t, tc.max+5, b.Len())
+			assert.Equal(t, 2, b.NullN())
+
+			want := &arrow.DictionaryType{IndexType: tc.wider, ValueType: arrow.PrimitiveTypes.Int32, Ordered: true}
+			assert.True(t, arrow.TypeEqual(want, b.Type()), "type=%v", b.Type())
+
+			arr := b.NewDictionaryArray()
+			defer arr.Release()
+			assert.True(t, arrow.TypeEqual(want, arr.DataType()), "type=%v", arr.DataType())
+			assert.True(t, arr.IsNull(0))
+			for _, i := range []int{1, 2, tc.max + 1, tc.max + 2, tc.max + 3} {
+				assert.Equal(t, array.ValueToString(arr.Dictionary(), arr.GetValueIndex(i)), array.ValueToString(arr, i), "element %d", i)
+			}
+			assert.Equal(t, tc.max, arr.GetValueIndex(tc.max+2))
+			assert.Equal(t, tc.max+1, arr.GetValueIndex(tc.max+3))
+			assert.True(t, arr.IsNull(tc.max+4))
+			if err := array.ValidateFull(arr); err != nil {
+				t.Fatal(err)
+			}
+
+			// the next array starts over with the initial index type.
+			assert.True(t, arrow.TypeEqual(dtype, b.Type()))
+			b.AppendInt32(1)
+			next := b.NewArray()
+			defer next.Release()
+			assert.True(t, arrow.TypeEqual(dtype, next.DataType()))
+
+			// the widened array can be appended to a builder of the initial
+			// index type, as long as its dictionary fits.
+			fixed := array.NewDictionaryBuilder(mem, dtype, array.WithFixedIndexType(true))
+			defer fixed.Release()
+			fixed.AppendArray(arr, 0, tc.max+2)
+			assert.Equal(t, tc.max+1, fixed.DictionaryLen())
+			assert.Panics(t, func() { fixed.AppendArray(arr, tc.max+2, tc.max+4) })
+			assert.Equal(t, tc.max+3, fixed.Len())
+			assert.True(t, arrow.TypeEqual(dtype, fixed.Type()))
+		})
+	}
+
+	t.Run("no-nulls", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+		b := array.NewDictionaryBuilder(mem, dtype)
+		defer b.Release()
+		array.DisableNulls(b)
+
+		for i := 0; i <= math.MaxInt8+1; i++ {
+			assert.NoError(t, b.AppendString(fmt.Sprint(i)))
+		}
+		assert.Panics(t, func() { b.AppendNull() })
+		b.Retain()
+		b.Release()
+
+		arr := b.NewDictionaryArray()
+		defer arr.Release()
+		assert.True(t, arrow.TypeEqual(arrow.PrimitiveTypes.Int16, arr.Indices().DataType()))
+		assert.Equal(t, 0, arr.NullN())
+		assert.Nil(t, arr.Data().Buffers()[0])
+		assert.Equal(t, "128", array.ValueToString(arr, math.MaxInt8+1))
+
+		assert.Panics(t, func() { b.AppendNull() })
+	})
+
+	t.Run("new-builder", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.PrimitiveTypes.Int32}
+		b := array.NewBuilder(mem, dtype).(*array.DictionaryBuilder)
+		defer b.Release()
+		for i := 0; i <= math.MaxInt8; i++ {
+			assert.NoError(t, b.AppendInt32(int32(i)))
+		}
+		assert.EqualError(t, b.AppendInt32(-1), "arrow/array: dictionary of 128 values is full for int8 indices")
+	})
+}
+
+func TestDictionaryArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String}
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 0, 1, 2}, []bool{true, true, false, true})
+	indices := ib.NewArray()
+	defer indices.Release()
+
+	vb := array.NewStringBuilder(mem)
+	defer vb.Release()
+	vb.AppendValues([]string{"a", "b", "c"}, nil)
+	dict := vb.NewArray()
+	defer dict.Release()
+
+	arr := array.NewDictionaryArray(dtype, indices, dict)
+	defer arr.Release()
+
+	assert.Equal(t, 4, arr.Len())
+	assert.Equal(t, 1, arr.NullN())
+	assert.Equal(t, "b", array.ValueToString(arr, 0))
+	assert.Equal(t, "c", array.ValueToString(arr, 3))
+	assert.Equal(t, `{dictionary: ["a" "b" "c"], indices: [1 0 (null) 2]}`, arr.String())
+	assert.Equal(t, "dictionary<values=utf8, indices=int32, ordered=false>", fmt.Sprint(arr.DataType()))
+
+	slice := array.NewSlice(arr, 1, 4).(*array.Dictionary)
+	defer slice.Release()
+	assert.Equal(t, 0, slice.GetValueIndex(0))
+	assert.Equal(t, 2, slice.GetValueIndex(2))
+	assert.Equal(t, 3, slice.Dictionary().Len())
+
+	other := array.NewDictionaryArray(dtype, indices, dict)
+	defer other.Release()
+	assert.True(t, array.ArrayEqual(arr, other))
+	assert.True(t, array.ArrayApproxEqual(arr, other))
+	assert.False(t, array.ArrayEqual(arr, slice))
+
+	assert.Panics(t, func() { array.NewDictionaryArray(dtype, dict, indices) })
+
+	// out-of-bounds indices are reported by ValidateFull.
+	ib.AppendValues([]int32{0, 3}, nil)
+	bad := ib.NewArray()
+	defer bad.Release()
+	invalid := array.NewDictionaryArray(dtype, bad, dict)
+	defer invalid.Release()
+	assert.NoError(t, array.Validate(invalid))
+	assert.Error(t, array.ValidateFull(invalid))
+}
+
+func TestDictionaryEncode(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+	b.AppendValues([]string{"x", "a", "b", "a", "", "x", "b"}, []bool{true, true, true, true, false, true, true})
+	arr := b.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 7)
+	defer slice.Release()
+
+	enc := array.DictionaryEncode(mem, slice)
+	defer enc.Release()
+
+	assert.Equal(t, `{dictionary: ["a" "b" "x"], indices: [0 1 0 (null) 2 1]}`, enc.String())
+	for i := 0; i < slice.Len(); i++ {
+		assert.Equal(t, array.ValueToString(slice, i), array.ValueToString(enc, i))
+	}
+}
@@ -16,5 +16,20 @@
 
 /*
 Package array provides implementations of various Arrow array types.
+
+Records derived from another record, with Record.NewSlice or TransferRecord,
+or built from a schema, with RecordBuilder or NewTableReader, hold that very
+*arrow.Schema value: schema metadata, field metadata and nullability are
+carried over as is.
+
+Arrays follow these conventions on their buffers, whatever their length:
+
+  - a nil, or empty, validity bitmap means the array has no null element;
+  - the offsets buffer of a Binary, String or List array holds Len()+1
+    offsets, past the offset of the array. Empty arrays thus hold a single
+    offset: NewData replaces a nil or too short offsets buffer of an empty
+    array with one holding zeros, so that empty arrays coming from other
+    implementations, which may omit it, behave as the ones made by this
+    package.
 */
 package array
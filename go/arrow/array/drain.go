@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+)
+
+// drainBatchSize is the maximum number of values buffered by the Drain
+// functions before they are appended to the builder.
+const drainBatchSize = 256
+
+// RowValues holds one value per field of the schema of a record builder.
+// Values follow the rules of BatchingWriter.AppendRow: a nil value is a null.
+type RowValues []interface{}
+
+// DrainRows appends the rows received from ch to rb, until ch is closed or
+// ctx is done.
+//
+// DrainRows returns nil when ch is closed, and ctx.Err() when ctx is done.
+// It stops with an error at the first invalid row, which is not appended.
+// In all cases, the rows received before have been appended to rb, which
+// may be drained again from the same channel.
+func DrainRows(ctx context.Context, ch <-chan RowValues, rb *RecordBuilder) error {
+	var (
+		fields  = rb.Schema().Fields()
+		appends = make([]func(), len(fields))
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case row, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if len(row) != len(fields) {
+				return xerrors.Errorf("arrow/array: invalid number of values in row: got=%d, want=%d", len(row), len(fields))
+			}
+			for i, v := range row {
+				app, err := valueAppender(rb.Field(i), v)
+				if err != nil {
+					return xerrors.Errorf("arrow/array: invalid value for field %q: %w", fields[i].Name, err)
+				}
+				appends[i] = app
+			}
+			for _, app := range appends {
+				app()
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+func TestDrainFloat64(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewFloat64Builder(mem)
+	defer b.Release()
+
+	// more values than a batch, with a null every 7 values.
+	const n = 1000
+	ch := make(chan array.Float64Maybe, 10)
+	go func() {
+		defer close(ch)
+		for i := 0; i < n; i++ {
+			ch <- array.Float64Maybe{Value: float64(i), Valid: i%7 != 0}
+		}
+	}()
+
+	if err := array.DrainFloat64(context.Background(), ch, b); err != nil {
+		t.Fatal(err)
+	}
+
+	arr := b.NewFloat64Array()
+	defer arr.Release()
+
+	if got, want := arr.Len(), n; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	for i := 0; i < n; i++ {
+		switch {
+		case i%7 == 0:
+			if arr.IsValid(i) {
+				t.Fatalf("arr[%d]: expected a null", i)
+			}
+		case arr.IsNull(i) || arr.Value(i) != float64(i):
+			t.Fatalf("arr[%d]: got=%v, want=%v", i, arr.Value(i), float64(i))
+		}
+	}
+}
+
+func TestDrainCancel(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+
+	var (
+		ch   = make(chan array.Int32Maybe) // unbuffered: each send is received.
+		errc = make(chan error)
+	)
+	drain := func(ctx context.Context) {
+		errc <- array.DrainInt32(ctx, ch, b)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go drain(ctx)
+	for i := 0; i < 3; i++ {
+		ch <- array.Int32Maybe{Value: int32(i), Valid: i != 1}
+	}
+	cancel()
+	if err := <-errc; !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if got, want := b.Len(), 3; got != want {
+		t.Fatalf("invalid length after cancellation: got=%d, want=%d", got, want)
+	}
+
+	// resume draining the same channel.
+	go drain(context.Background())
+	ch <- array.Int32Maybe{Value: 3, Valid: true}
+	close(ch)
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	arr := b.NewInt32Array()
+	defer arr.Release()
+	if got, want := arr.String(), "[0 (null) 2 3]"; got != want {
+		t.Fatalf("invalid array: got=%s, want=%s", got, want)
+	}
+}
+
+func TestDrainRows(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	ch := make(chan array.RowValues, 4)
+	ch <- array.RowValues{int64(1), "a"}
+	ch <- array.RowValues{int64(2), nil}
+	ch <- array.RowValues{"3", "c"}
+	ch <- array.RowValues{int64(4), "d"}
+	close(ch)
+
+	err := array.DrainRows(context.Background(), ch, rb)
+	if err == nil {
+		t.Fatalf("expected an error for an invalid row")
+	}
+
+	// the invalid row was dropped, the builder is still consistent.
+	if err := array.DrainRows(context.Background(), ch, rb); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := rb.NewRecord()
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := rec.Column(0).(*array.Int64).Int64Values(), []int64{1, 2, 4}; !equalInt64s(got, want) {
+		t.Fatalf("invalid ids: got=%v, want=%v", got, want)
+	}
+	if got, want := rec.Column(1).(*array.String).String(), `["a" (null) "d"]`; got != want {
+		t.Fatalf("invalid names: got=%s, want=%s", got, want)
+	}
+}
+
+func TestDrainRowsCancel(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ch := make(chan array.RowValues)
+	if err := array.DrainRows(ctx, ch, rb); !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if got := rb.Field(0).Len(); got != 0 {
+		t.Fatalf("invalid number of rows: %d", got)
+	}
+}
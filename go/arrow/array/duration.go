@@ -0,0 +1,30 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// AppendDuration appends d, converted to the time unit of the builder.
+// The conversion truncates towards zero: appending 1500 microseconds to a
+// builder of milliseconds appends 1.
+func (b *DurationBuilder) AppendDuration(d time.Duration) {
+	b.Append(arrow.Duration(int64(d) / b.dtype.Unit.Multiplier()))
+}
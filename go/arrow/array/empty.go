@@ -0,0 +1,119 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// MakeEmpty returns an array of length zero of the given data type.
+// Nested types have empty children.
+// The returned array must be Release()'d after use.
+//
+// MakeEmpty panics if dtype is not supported.
+func MakeEmpty(mem memory.Allocator, dtype arrow.DataType) Interface {
+	return MakeNull(mem, dtype, 0)
+}
+
+// MakeNull returns an array of length n of the given data type, where all
+// the elements are null.
+//
+// Values buffers are zero-filled. Lists have zero-length elements, and thus
+// an empty child array. Fixed-size lists and structs have children of the
+// appropriate length, also made of null elements. Dictionaries have zero
+// indices over an empty dictionary.
+// The returned array must be Release()'d after use.
+//
+// MakeNull panics if dtype is not supported.
+func MakeNull(mem memory.Allocator, dtype arrow.DataType, n int) Interface {
+	data := makeNullData(mem, dtype, n)
+	defer data.Release()
+	return MakeFromData(data)
+}
+
+func makeNullData(mem memory.Allocator, dtype arrow.DataType, n int) *Data {
+	if dtype.ID() == arrow.NULL {
+		return NewData(dtype, n, []*memory.Buffer{nil}, nil, n, 0)
+	}
+
+	var (
+		bufs     = []*memory.Buffer{newZeroBuffer(mem, int(bitutil.BytesForBits(int64(n))))}
+		children []*Data
+	)
+
+	switch dt := dtype.(type) {
+	case arrow.FixedWidthDataType:
+		bufs = append(bufs, newZeroBuffer(mem, int(bitutil.BytesForBits(int64(n*dt.BitWidth())))))
+
+	case arrow.BinaryDataType:
+		bufs = append(bufs,
+			newZeroBuffer(mem, (n+1)*arrow.Int32SizeBytes),
+			newZeroBuffer(mem, 0),
+		)
+
+	case *arrow.ListType:
+		bufs = append(bufs, newZeroBuffer(mem, (n+1)*arrow.Int32SizeBytes))
+		children = []*Data{makeNullData(mem, dt.Elem(), 0)}
+
+	case *arrow.MapType:
+		bufs = append(bufs, newZeroBuffer(mem, (n+1)*arrow.Int32SizeBytes))
+		children = []*Data{makeNullData(mem, dt.Elem(), 0)}
+
+	case *arrow.LargeListType:
+		bufs = append(bufs, newZeroBuffer(mem, (n+1)*arrow.Int64SizeBytes))
+		children = []*Data{makeNullData(mem, dt.Elem(), 0)}
+
+	case *arrow.FixedSizeListType:
+		children = []*Data{makeNullData(mem, dt.Elem(), n*int(dt.Len()))}
+
+	case *arrow.StructType:
+		children = make([]*Data, len(dt.Fields()))
+		for i, f := range dt.Fields() {
+			children[i] = makeNullData(mem, f.Type, n)
+		}
+
+	case *arrow.DictionaryType:
+		bw := dt.IndexType.(arrow.FixedWidthDataType).BitWidth()
+		bufs = append(bufs, newZeroBuffer(mem, n*bw/8))
+		children = []*Data{makeNullData(mem, dt.ValueType, 0)}
+
+	default:
+		for _, b := range bufs {
+			b.Release()
+		}
+		panic("arrow/array: unsupported data type " + dtype.Name())
+	}
+
+	data := NewData(dtype, n, bufs, children, n, 0)
+	for _, b := range bufs {
+		b.Release()
+	}
+	for _, c := range children {
+		c.Release()
+	}
+	return data
+}
+
+// newZeroBuffer returns a buffer of n zero bytes.
+func newZeroBuffer(mem memory.Allocator, n int) *memory.Buffer {
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(n)
+	memory.Set(buf.Bytes(), 0)
+	return buf
+}
@@ -0,0 +1,410 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+var makeNullTypes = []arrow.DataType{
+	arrow.Null,
+	arrow.FixedWidthTypes.Boolean,
+	arrow.PrimitiveTypes.Int8,
+	arrow.PrimitiveTypes.Uint16,
+	arrow.PrimitiveTypes.Int32,
+	arrow.PrimitiveTypes.Uint64,
+	arrow.PrimitiveTypes.Float32,
+	arrow.PrimitiveTypes.Float64,
+	arrow.FixedWidthTypes.Float16,
+	arrow.FixedWidthTypes.Date32,
+	arrow.FixedWidthTypes.Date64,
+	arrow.FixedWidthTypes.Timestamp_us,
+	arrow.FixedWidthTypes.Time32ms,
+	arrow.FixedWidthTypes.Time64ns,
+	arrow.FixedWidthTypes.Duration_s,
+	arrow.FixedWidthTypes.MonthInterval,
+	arrow.FixedWidthTypes.DayTimeInterval,
+	&arrow.Decimal128Type{Precision: 10, Scale: 2},
+	&arrow.Decimal256Type{Precision: 50, Scale: 2},
+	&arrow.FixedSizeBinaryType{ByteWidth: 3},
+	arrow.BinaryTypes.Binary,
+	arrow.BinaryTypes.String,
+	arrow.ListOf(arrow.PrimitiveTypes.Int64),
+	arrow.ListOf(arrow.ListOf(arrow.BinaryTypes.String)),
+	arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32),
+	arrow.FixedSizeListOf(3, arrow.StructOf(arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true})),
+	arrow.StructOf(
+		arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		arrow.Field{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+		arrow.Field{Name: "f", Type: arrow.FixedSizeListOf(2, arrow.FixedWidthTypes.Boolean), Nullable: true},
+	),
+	&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String},
+	&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint32, ValueType: arrow.PrimitiveTypes.Float64, Ordered: true},
+}
+
+func TestMakeNull(t *testing.T) {
+	for _, dt := range makeNullTypes {
+		for _, n := range []int{0, 1, 9, 64} {
+			t.Run(fmt.Sprintf("%v/n=%d", dt, n), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				var arr array.Interface
+				if n == 0 {
+					arr = array.MakeEmpty(mem, dt)
+				} else {
+					arr = array.MakeNull(mem, dt, n)
+				}
+				defer arr.Release()
+
+				assert.True(t, arrow.TypeEqual(arr.DataType(), dt))
+				assert.Equal(t, n, arr.Len())
+				assert.Equal(t, n, arr.NullN())
+				for i := 0; i < n; i++ {
+					assert.True(t, arr.IsNull(i), "element %d should be null", i)
+				}
+
+				switch arr := arr.(type) {
+				case *array.List:
+					assert.Equal(t, 0, arr.ListValues().Len())
+				case *array.FixedSizeList:
+					assert.Equal(t, n*int(dt.(*arrow.FixedSizeListType).Len()), arr.ListValues().Len())
+				case *array.Struct:
+					for i := 0; i < arr.NumField(); i++ {
+						assert.Equal(t, n, arr.Field(i).Len())
+						assert.Equal(t, n, arr.Field(i).NullN())
+					}
+				case *array.Dictionary:
+					assert.Equal(t, n, arr.Indices().Len())
+					assert.Equal(t, 0, arr.Dictionary().Len())
+					// dictionaries are not supported by the IPC writer.
+					return
+				}
+
+				checkValidArray(t, mem, arr)
+			})
+		}
+	}
+}
+
+func TestMakeNullUnsupported(t *testing.T) {
+	assert.Panics(t, func() {
+		array.MakeNull(memory.NewGoAllocator(), unionType{}, 1)
+	})
+}
+
+type unionType struct{}
+
+func (unionType) ID() arrow.Type { return arrow.UNION }
+func (unionType) Name() string   { return "union" }
+
+// checkValidArray round-trips arr through an IPC stream, which validates the
+// sizes of its buffers.
+func checkValidArray(t *testing.T, mem memory.Allocator, arr array.Interface) {
+	t.Helper()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "a", Type: arr.DataType(), Nullable: true}}, nil)
+	rec := array.NewRecord(schema, []array.Interface{arr}, int64(arr.Len()))
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("invalid array: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	if !array.RecordEqual(rec, r.Record()) {
+		t.Fatalf("records differ:\ngot:  %v\nwant: %v", r.Record().Column(0), arr)
+	}
+}
+
+func TestNewBuilderType(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtypes := append([]arrow.DataType{
+		&arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "America/New_York"},
+		&arrow.TimestampType{Unit: arrow.Second},
+		&arrow.Time32Type{Unit: arrow.Second},
+		&arrow.Time64Type{Unit: arrow.Microsecond},
+		&arrow.DurationType{Unit: arrow.Nanosecond},
+		&arrow.Decimal128Type{Precision: 38, Scale: 10},
+		arrow.ListOf(&arrow.TimestampType{Unit: arrow.Second, TimeZone: "+05:30"}),
+	}, makeNullTypes...)
+
+	for _, dt := range dtypes {
+		t.Run(fmt.Sprint(dt), func(t *testing.T) {
+			b := array.NewBuilder(mem, dt)
+			defer b.Release()
+
+			if got := b.Type(); !arrow.TypeEqual(got, dt) {
+				t.Fatalf("invalid builder type: got=%v, want=%v", got, dt)
+			}
+
+			b.AppendNull()
+			arr := b.NewArray()
+			defer arr.Release()
+
+			if got := arr.DataType(); !arrow.TypeEqual(got, dt) {
+				t.Fatalf("invalid array type: got=%v, want=%v", got, dt)
+			}
+		})
+	}
+}
+
+// emptyRecords lists the ways to get a zero-row record with a given schema.
+// rec is a non-empty record with that schema.
+var emptyRecords = []struct {
+	name string
+	make func(mem memory.Allocator, rec array.Record) array.Record
+}{
+	{"builder", func(mem memory.Allocator, rec array.Record) array.Record {
+		b := array.NewRecordBuilder(mem, rec.Schema())
+		defer b.Release()
+		return b.NewRecord()
+	}},
+	{"make-empty", func(mem memory.Allocator, rec array.Record) array.Record {
+		return newRecordFromData(rec.Schema(), func(dt arrow.DataType) *array.Data {
+			arr := array.MakeEmpty(mem, dt)
+			defer arr.Release()
+			arr.Data().Retain()
+			return arr.Data()
+		})
+	}},
+	{"slice-head", func(mem memory.Allocator, rec array.Record) array.Record {
+		return rec.NewSlice(0, 0)
+	}},
+	{"slice-tail", func(mem memory.Allocator, rec array.Record) array.Record {
+		return rec.NewSlice(rec.NumRows(), rec.NumRows())
+	}},
+	{"nil-buffers", func(mem memory.Allocator, rec array.Record) array.Record {
+		return newRecordFromData(rec.Schema(), func(dt arrow.DataType) *array.Data {
+			return makeBareData(dt, func() *memory.Buffer { return nil })
+		})
+	}},
+	{"empty-buffers", func(mem memory.Allocator, rec array.Record) array.Record {
+		return newRecordFromData(rec.Schema(), func(dt arrow.DataType) *array.Data {
+			return makeBareData(dt, func() *memory.Buffer { return memory.NewBufferBytes(nil) })
+		})
+	}},
+}
+
+func newRecordFromData(schema *arrow.Schema, newData func(arrow.DataType) *array.Data) array.Record {
+	cols := make([]array.Interface, len(schema.Fields()))
+	for i, f := range schema.Fields() {
+		data := newData(f.Type)
+		cols[i] = array.MakeFromData(data)
+		data.Release()
+	}
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	return array.NewRecord(schema, cols, 0)
+}
+
+// makeBareData returns the data of an empty array of the given type, without
+// validity bitmaps and with all its other buffers created by newBuffer.
+func makeBareData(dtype arrow.DataType, newBuffer func() *memory.Buffer) *array.Data {
+	var (
+		bufs     = []*memory.Buffer{nil}
+		children []*array.Data
+	)
+	switch dt := dtype.(type) {
+	case *arrow.NullType:
+	case arrow.FixedWidthDataType:
+		bufs = append(bufs, newBuffer())
+	case arrow.BinaryDataType:
+		bufs = append(bufs, newBuffer(), newBuffer())
+	case *arrow.ListType:
+		bufs = append(bufs, newBuffer())
+		children = []*array.Data{makeBareData(dt.Elem(), newBuffer)}
+	case *arrow.LargeListType:
+		bufs = append(bufs, newBuffer())
+		children = []*array.Data{makeBareData(dt.Elem(), newBuffer)}
+	case *arrow.MapType:
+		bufs = append(bufs, newBuffer())
+		children = []*array.Data{makeBareData(dt.Elem(), newBuffer)}
+	case *arrow.FixedSizeListType:
+		children = []*array.Data{makeBareData(dt.Elem(), newBuffer)}
+	case *arrow.StructType:
+		for _, f := range dt.Fields() {
+			children = append(children, makeBareData(f.Type, newBuffer))
+		}
+	}
+
+	data := array.NewData(dtype, 0, bufs, children, 0, 0)
+	for _, c := range children {
+		c.Release()
+	}
+	return data
+}
+
+// TestEmptyRecords checks that zero-row records of every type, however they
+// were made, can be formatted, sliced, concatenated, compared and written to
+// and read back from IPC streams and files.
+func TestEmptyRecords(t *testing.T) {
+	for _, name := range arrdata.RecordNames {
+		for _, tc := range emptyRecords {
+			t.Run(name+"/"+tc.name, func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				rec := tc.make(mem, arrdata.Records[name][0])
+				defer rec.Release()
+
+				if got := rec.NumRows(); got != 0 {
+					t.Fatalf("invalid number of rows: got=%d, want=0", got)
+				}
+
+				for i, col := range rec.Columns() {
+					checkEmptyArray(t, mem, col)
+
+					slice := array.NewSlice(col, 0, 0)
+					checkEmptyArray(t, mem, slice)
+					slice.Release()
+
+					cat, err := array.Concatenate(mem, []array.Interface{col, col})
+					if err != nil {
+						t.Fatalf("could not concatenate column %q: %v", rec.ColumnName(i), err)
+					}
+					checkEmptyArray(t, mem, cat)
+					cat.Release()
+				}
+
+				checkEmptyStream(t, mem, rec)
+				checkEmptyFile(t, mem, rec)
+			})
+		}
+	}
+}
+
+func checkEmptyArray(t *testing.T, mem memory.Allocator, arr array.Interface) {
+	t.Helper()
+
+	empty := array.MakeEmpty(mem, arr.DataType())
+	defer empty.Release()
+
+	assert.Equal(t, 0, arr.Len())
+	assert.Equal(t, 0, arr.NullN())
+	assert.Equal(t, fmt.Sprint(empty), fmt.Sprint(arr))
+
+	switch arr := arr.(type) {
+	case *array.Binary:
+		assert.Len(t, arr.ValueOffsets(), 1)
+		assert.Len(t, arr.ValueBytes(), 0)
+	case *array.String, *array.List:
+		assert.NoError(t, array.ValidateOffsets(arr))
+	}
+
+	if !array.ArrayEqual(arr, empty) {
+		t.Fatalf("array not equal to an empty one: %v", arr)
+	}
+}
+
+func checkEmptyStream(t *testing.T, mem memory.Allocator, rec array.Record) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	checkEmptyRecord(t, mem, rec, r.Record())
+}
+
+func checkEmptyFile(t *testing.T, mem memory.Allocator, rec array.Record) {
+	t.Helper()
+
+	f, err := ioutil.TempFile("", "arrow-empty-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	w, err := ipc.NewFileWriter(f, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := r.Record(0)
+	if err != nil {
+		t.Fatalf("could not read record: %v", err)
+	}
+	checkEmptyRecord(t, mem, rec, got)
+}
+
+func checkEmptyRecord(t *testing.T, mem memory.Allocator, want, got array.Record) {
+	t.Helper()
+
+	if !array.RecordEqual(want, got) {
+		t.Fatalf("records differ:\ngot:  %v\nwant: %v", got, want)
+	}
+	for _, col := range got.Columns() {
+		checkEmptyArray(t, mem, col)
+	}
+}
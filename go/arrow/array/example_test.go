@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func ExampleNewRecordBuilder() {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "", "c"}, []bool{true, false, true})
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	fmt.Println("rows:", rec.NumRows())
+	for i, col := range rec.Columns() {
+		fmt.Printf("%s: %v\n", rec.ColumnName(i), col)
+	}
+
+	// Output:
+	// rows: 3
+	// id: [1 2 3]
+	// name: ["a" (null) "c"]
+}
+
+func ExampleNewSlice() {
+	mem := memory.NewGoAllocator()
+
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+
+	b.AppendValues([]int64{1, 2, 3, 4, 5}, []bool{true, true, false, true, true})
+	arr := b.NewArray()
+	defer arr.Release()
+
+	// The slice shares the memory of arr: no value is copied.
+	slice := array.NewSlice(arr, 1, 4)
+	defer slice.Release()
+
+	fmt.Println(slice, slice.Len(), slice.NullN())
+
+	// Output:
+	// [2 (null) 4] 3 1
+}
+
+func ExampleConcatenate() {
+	mem := memory.NewGoAllocator()
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.AppendValues([]string{"a", "b"}, nil)
+	first := b.NewArray()
+	defer first.Release()
+
+	b.AppendNull()
+	b.Append("c")
+	second := b.NewArray()
+	defer second.Release()
+
+	arr, err := array.Concatenate(mem, []array.Interface{first, second})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer arr.Release()
+
+	fmt.Println(arr)
+
+	// Output:
+	// ["a" "b" (null) "c"]
+}
@@ -0,0 +1,233 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingAllocator counts the allocations and reallocations it performs.
+type countingAllocator struct {
+	mem      memory.Allocator
+	allocs   int
+	reallocs int
+}
+
+func (c *countingAllocator) Allocate(size int) []byte {
+	c.allocs++
+	return c.mem.Allocate(size)
+}
+
+func (c *countingAllocator) Reallocate(size int, b []byte) []byte {
+	c.reallocs++
+	return c.mem.Reallocate(size, b)
+}
+
+func (c *countingAllocator) Free(b []byte) { c.mem.Free(b) }
+
+var finishTypes = append([]arrow.DataType{
+	arrow.LargeListOf(arrow.BinaryTypes.String),
+	arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32),
+	&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.BinaryTypes.String},
+}, makeNullTypes...)
+
+func TestBuilderNewArrayEmpty(t *testing.T) {
+	for _, dt := range finishTypes {
+		t.Run(fmt.Sprint(dt), func(t *testing.T) {
+			count := &countingAllocator{mem: memory.NewGoAllocator()}
+			mem := memory.NewCheckedAllocator(count)
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewBuilder(mem, dt)
+			defer b.Release()
+
+			for i := 0; i < 3; i++ {
+				arr := b.NewArray()
+				if err := array.ValidateFull(arr); err != nil {
+					t.Fatalf("invalid empty array: %+v", err)
+				}
+				assert.Equal(t, 0, arr.Len())
+				arr.Release()
+			}
+			assert.Equal(t, 0, count.allocs, "allocations")
+			assert.Equal(t, 0, count.reallocs, "reallocations")
+		})
+	}
+}
+
+func TestBuilderNewArrayAllocs(t *testing.T) {
+	const n = 5
+
+	for _, tc := range []struct {
+		dt     arrow.DataType
+		append func(b array.Builder)
+		allocs int // per array.
+	}{
+		{
+			dt: arrow.PrimitiveTypes.Int64,
+			append: func(b array.Builder) {
+				b.(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, []bool{true, false, true})
+			},
+			allocs: 2, // validity, values.
+		},
+		{
+			dt: arrow.FixedWidthTypes.Boolean,
+			append: func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, []bool{true, false, true})
+			},
+			allocs: 2, // validity, values.
+		},
+		{
+			dt: arrow.BinaryTypes.String,
+			append: func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"a", "bb", "ccc"}, []bool{true, false, true})
+			},
+			allocs: 3, // validity, offsets, values.
+		},
+		{
+			dt: arrow.ListOf(arrow.PrimitiveTypes.Int64),
+			append: func(b array.Builder) {
+				lb := b.(*array.ListBuilder)
+				lb.Append(true)
+				lb.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+				lb.AppendNull()
+			},
+			allocs: 4, // validity, offsets, values validity, values.
+		},
+		{
+			dt: arrow.LargeListOf(arrow.PrimitiveTypes.Int64),
+			append: func(b array.Builder) {
+				lb := b.(*array.LargeListBuilder)
+				lb.Append(true)
+				lb.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+				lb.AppendNull()
+			},
+			allocs: 4, // validity, offsets, values validity, values.
+		},
+	} {
+		t.Run(fmt.Sprint(tc.dt), func(t *testing.T) {
+			count := &countingAllocator{mem: memory.NewGoAllocator()}
+			mem := memory.NewCheckedAllocator(count)
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewBuilder(mem, tc.dt)
+			defer b.Release()
+
+			var want array.Interface
+			for i := 0; i < n; i++ {
+				tc.append(b)
+				arr := b.NewArray()
+				if want == nil {
+					want = arr
+					defer want.Release()
+				} else {
+					if !array.ArrayEqual(want, arr) {
+						t.Fatalf("array %d differs:\ngot= %v\nwant=%v", i, arr, want)
+					}
+					arr.Release()
+				}
+
+				// the builder re-initializes lazily, on the next append.
+				allocs := count.allocs
+				assert.Equal(t, 0, b.Len())
+				assert.Equal(t, 0, b.Cap())
+				assert.Equal(t, allocs, count.allocs)
+			}
+			assert.Equal(t, n*tc.allocs, count.allocs, "allocations")
+			assert.Equal(t, 0, count.reallocs, "reallocations")
+		})
+	}
+}
+
+func TestBuilderReserveNewArray(t *testing.T) {
+	for _, dt := range finishTypes {
+		t.Run(fmt.Sprint(dt), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewBuilder(mem, dt)
+			defer b.Release()
+
+			for i, reserve := range []int{0, 1, 10, 100} {
+				// finish an empty, reserved, builder.
+				b.Reserve(reserve)
+				empty := b.NewArray()
+				if err := array.ValidateFull(empty); err != nil {
+					t.Fatalf("invalid empty array: %+v", err)
+				}
+				assert.Equal(t, 0, empty.Len())
+				empty.Release()
+				assert.Equal(t, 0, b.Cap())
+
+				// reserve again before the first append.
+				b.Reserve(reserve)
+				n := i + 3
+				for j := 0; j < n; j++ {
+					b.AppendNull()
+				}
+				arr := b.NewArray()
+				if err := array.ValidateFull(arr); err != nil {
+					t.Fatalf("invalid array: %+v", err)
+				}
+				assert.Equal(t, n, arr.Len(), fmt.Sprintf("reserve=%d", reserve))
+				assert.Equal(t, n, arr.NullN(), fmt.Sprintf("reserve=%d", reserve))
+				arr.Release()
+			}
+		})
+	}
+}
+
+func TestBuilderResizeKeepsValues(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i, want := range recs[0].Columns() {
+				b := array.NewBuilder(mem, want.DataType())
+				defer b.Release()
+
+				b.AppendArray(want, 0, want.Len())
+				for _, n := range []int{0, 1, want.Len() - 1} {
+					b.Resize(n)
+					if got := b.Len(); got != want.Len() {
+						t.Fatalf("column %d: invalid length after Resize(%d): got=%d, want=%d", i, n, got, want.Len())
+					}
+					if _, ok := b.(*array.NullBuilder); ok {
+						continue // null builders allocate nothing.
+					}
+					if got := b.Cap(); got < want.Len() {
+						t.Fatalf("column %d: invalid capacity after Resize(%d): got=%d, want>=%d", i, n, got, want.Len())
+					}
+				}
+
+				got := b.NewArray()
+				if !array.ArrayEqual(got, want) {
+					t.Fatalf("column %d: invalid array:\ngot= %v\nwant=%v", i, got, want)
+				}
+				got.Release()
+			}
+		})
+	}
+}
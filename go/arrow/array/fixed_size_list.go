@@ -22,7 +22,6 @@ import (
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
-	"github.com/apache/arrow/go/arrow/bitutil"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -78,7 +77,7 @@ func (a *FixedSizeList) setData(data *Data) {
 	a.values = MakeFromData(data.childData[0])
 }
 
-func arrayEqualFixedSizeList(left, right *FixedSizeList) bool {
+func arrayEqualFixedSizeList(left, right *FixedSizeList, opt equalOption) bool {
 	for i := 0; i < left.Len(); i++ {
 		if left.IsNull(i) {
 			continue
@@ -88,7 +87,7 @@ func arrayEqualFixedSizeList(left, right *FixedSizeList) bool {
 			defer l.Release()
 			r := right.newListValue(i)
 			defer r.Release()
-			return ArrayEqual(l, r)
+			return arrayEqual(l, r, opt)
 		}()
 		if !o {
 			return false
@@ -146,6 +145,9 @@ func (b *FixedSizeListBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *FixedSizeListBuilder) Type() arrow.DataType { return arrow.FixedSizeListOf(b.n, b.etype) }
+
 func (b *FixedSizeListBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(v)
@@ -156,23 +158,42 @@ func (b *FixedSizeListBuilder) AppendNull() {
 	b.unsafeAppendBoolToBitmap(false)
 }
 
+// AppendNulls appends n null lists to the builder, in bulk.
+// As with AppendNull, the value builder does not grow: the values of the
+// null lists must still be appended to it, e.g. with its AppendNulls.
+func (b *FixedSizeListBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
 func (b *FixedSizeListBuilder) AppendValues(valid []bool) {
 	b.Reserve(len(valid))
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(valid))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *FixedSizeListBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	var (
+		src  = arr.(*FixedSizeList)
+		data = src.Data()
+		size = int(b.n)
+	)
+	b.Reserve(n)
+	b.builder.unsafeAppendValidity(data, start, n)
+	b.values.AppendArray(src.ListValues(), (data.offset+start)*size, (data.offset+end)*size)
+}
+
 func (b *FixedSizeListBuilder) unsafeAppend(v bool) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(true)
 }
 
 func (b *FixedSizeListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(isValid)
 }
 
 func (b *FixedSizeListBuilder) init(capacity int) {
@@ -199,6 +220,9 @@ func (b *FixedSizeListBuilder) Resize(n int) {
 	}
 }
 
+// ValueBuilder returns the builder of the list's elements.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
 func (b *FixedSizeListBuilder) ValueBuilder() Builder {
 	return b.values
 }
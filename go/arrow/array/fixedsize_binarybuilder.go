@@ -60,10 +60,14 @@ func (b *FixedSizeBinaryBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *FixedSizeBinaryBuilder) Type() arrow.DataType { return b.dtype }
+
+// Append appends v, which must have the byte width of the data type of the
+// builder.
 func (b *FixedSizeBinaryBuilder) Append(v []byte) {
 	if len(v) != b.dtype.ByteWidth {
-		// TODO(alexandre): should we return an error instead?
-		panic("len(v) != b.dtype.ByteWidth")
+		panic(b.invalidLength(len(v)))
 	}
 
 	b.Reserve(1)
@@ -77,9 +81,18 @@ func (b *FixedSizeBinaryBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *FixedSizeBinaryBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.values.Advance(n * b.dtype.ByteWidth)
+	b.unsafeAppendNulls(n)
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
+// Values must either be empty, for null values, or have the byte width of the data type of the
+// builder: nothing is appended otherwise.
 func (b *FixedSizeBinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
 		panic("len(v) != len(valid) && len(valid) != 0")
@@ -89,21 +102,57 @@ func (b *FixedSizeBinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 		return
 	}
 
+	for _, vv := range v {
+		if len(vv) != 0 && len(vv) != b.dtype.ByteWidth {
+			panic(b.invalidLength(len(vv)))
+		}
+	}
+
 	b.Reserve(len(v))
 	for _, vv := range v {
 		switch len(vv) {
 		case 0:
 			b.values.Advance(b.dtype.ByteWidth)
-		case b.dtype.ByteWidth:
-			b.values.Append(vv)
 		default:
-			panic(fmt.Errorf("array: invalid binary length (got=%d, want=%d)", len(vv), b.dtype.ByteWidth))
+			b.values.Append(vv)
 		}
 	}
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *FixedSizeBinaryBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.dtype, arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	var (
+		data  = arr.Data()
+		width = b.dtype.ByteWidth
+	)
+	b.Reserve(n)
+	b.values.Append(data.buffers[1].Bytes()[(data.offset+start)*width : (data.offset+end)*width])
+	b.builder.unsafeAppendValidity(data, start, n)
+}
+
+func (b *FixedSizeBinaryBuilder) invalidLength(n int) error {
+	return fmt.Errorf("array: invalid binary length (got=%d, want=%d)", n, b.dtype.ByteWidth)
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()), or if v does not have
+// the byte width of the builder.
+func (b *FixedSizeBinaryBuilder) SetValid(i int, v []byte) {
+	if len(v) != b.dtype.ByteWidth {
+		panic("arrow/array: invalid value length for fixed-size binary")
+	}
+	b.setValid(i)
+	copy(b.values.Bytes()[i*b.dtype.ByteWidth:], v)
+}
+
 func (b *FixedSizeBinaryBuilder) init(capacity int) {
 	b.builder.init(capacity)
 	b.values.resize(capacity * b.dtype.ByteWidth)
@@ -61,6 +61,32 @@ func TestFixedSizeBinaryBuilder(t *testing.T) {
 	a.Release()
 }
 
+func TestFixedSizeBinaryBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.FixedSizeBinaryType{ByteWidth: 2}
+	b := NewFixedSizeBinaryBuilder(mem, &dtype)
+	defer b.Release()
+
+	b.Append([]byte("ab"))
+	b.AppendNull()
+	b.Append([]byte("cd"))
+
+	b.SetNull(0)
+	b.SetValid(1, []byte("xy"))
+	b.SetNull(2)
+	b.SetValid(2, []byte("zz"))
+	assert.Equal(t, 1, b.NullN())
+	assert.Panics(t, func() { b.SetValid(0, []byte("abc")) })
+
+	a := b.NewFixedSizeBinaryArray()
+	defer a.Release()
+	assert.True(t, a.IsNull(0))
+	assert.Equal(t, []byte("xy"), a.Value(1))
+	assert.Equal(t, []byte("zz"), a.Value(2))
+}
+
 func TestFixedSizeBinaryBuilder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -105,3 +131,37 @@ func TestFixedSizeBinaryBuilder_Empty(t *testing.T) {
 	assert.Equal(t, want, fixedSizeValues(a))
 	a.Release()
 }
+
+func TestFixedSizeBinaryBuilder_InvalidLength(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.FixedSizeBinaryType{ByteWidth: 4}
+	b := NewFixedSizeBinaryBuilder(mem, &dtype)
+	defer b.Release()
+
+	b.Append([]byte("abcd"))
+
+	assert.Equal(t, "array: invalid binary length (got=3, want=4)", panicError(func() {
+		b.Append([]byte("abc"))
+	}))
+	assert.Equal(t, "array: invalid binary length (got=5, want=4)", panicError(func() {
+		b.AppendValues([][]byte{[]byte("efgh"), []byte("ijklm")}, nil)
+	}))
+
+	// nothing was appended by the invalid calls.
+	a := b.NewFixedSizeBinaryArray()
+	defer a.Release()
+	assert.Equal(t, `["abcd"]`, a.String())
+}
+
+// panicError returns the message of the error f panics with, if any.
+func panicError(f func()) (msg string) {
+	defer func() {
+		if err, ok := recover().(error); ok {
+			msg = err.Error()
+		}
+	}()
+	f()
+	return ""
+}
@@ -55,13 +55,18 @@ func (b *Float16Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Float16Builder) Type() arrow.DataType { return arrow.FixedWidthTypes.Float16 }
+
 func (b *Float16Builder) Append(v float16.Num) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
 }
 
 func (b *Float16Builder) UnsafeAppend(v float16.Num) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	if !b.noNulls {
+		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	}
 	b.rawData[b.length] = v
 	b.length++
 }
@@ -71,13 +76,10 @@ func (b *Float16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Float16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Float16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
 // AppendValues will append the values in the v slice. The valid slice determines which values
@@ -99,6 +101,34 @@ func (b *Float16Builder) AppendValues(v []float16.Num, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Float16Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Float16).Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Float16Builder) Value(i int) float16.Num {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Float16Builder) SetValid(i int, v float16.Num) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Float16Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -117,6 +147,10 @@ func (b *Float16Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Float16Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -149,8 +183,8 @@ func (b *Float16Builder) NewFloat16Array() (a *Float16) {
 func (b *Float16Builder) newData() (data *Data) {
 	bytesRequired := arrow.Float16Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.FixedWidthTypes.Float16, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
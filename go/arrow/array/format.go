@@ -0,0 +1,211 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+// BinaryFormat specifies how binary values are rendered by a ValueFormatter.
+type BinaryFormat int
+
+const (
+	BinaryQuoted BinaryFormat = iota // Go-quoted string, as by the String methods of arrays.
+	BinaryHex                        // hexadecimal digits.
+	BinaryBase64                     // standard base64 encoding.
+	BinaryLen                        // length only, as <N bytes>.
+)
+
+func (f BinaryFormat) String() string {
+	switch f {
+	case BinaryQuoted:
+		return "quoted"
+	case BinaryHex:
+		return "hex"
+	case BinaryBase64:
+		return "base64"
+	case BinaryLen:
+		return "len"
+	default:
+		return fmt.Sprintf("BinaryFormat(%d)", int(f))
+	}
+}
+
+// ParseBinaryFormat returns the binary format named s: quoted, hex, base64
+// or len.
+func ParseBinaryFormat(s string) (BinaryFormat, error) {
+	for _, f := range []BinaryFormat{BinaryQuoted, BinaryHex, BinaryBase64, BinaryLen} {
+		if s == f.String() {
+			return f, nil
+		}
+	}
+	return 0, xerrors.Errorf("arrow/array: invalid binary format %q (want quoted, hex, base64 or len)", s)
+}
+
+// FormatOption configures a ValueFormatter.
+type FormatOption func(*ValueFormatter)
+
+// WithMaxCellWidth specifies the maximum number of characters of string
+// values, and of bytes of binary values, that are displayed. Longer values
+// are truncated and followed by an ellipsis and their total length in bytes.
+// Strings are never truncated in the middle of a UTF-8 sequence.
+// The default, zero, displays values in full.
+func WithMaxCellWidth(n int) FormatOption {
+	return func(f *ValueFormatter) {
+		f.maxWidth = n
+	}
+}
+
+// WithBinaryFormat specifies how binary values are rendered.
+// The default is BinaryQuoted.
+func WithBinaryFormat(bf BinaryFormat) FormatOption {
+	return func(f *ValueFormatter) {
+		f.binary = bf
+	}
+}
+
+// ValueFormatter formats the values of String, Binary and FixedSizeBinary
+// arrays.
+// With no option, values are formatted as by the String methods of arrays.
+type ValueFormatter struct {
+	maxWidth int
+	binary   BinaryFormat
+}
+
+// NewValueFormatter returns a formatter configured with opts.
+func NewValueFormatter(opts ...FormatOption) *ValueFormatter {
+	f := &ValueFormatter{}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// FormatString returns the representation of the string value v.
+func (f *ValueFormatter) FormatString(v string) string {
+	if f.maxWidth <= 0 || utf8.RuneCountInString(v) <= f.maxWidth {
+		return fmt.Sprintf("%q", v)
+	}
+
+	n := 0
+	for i := 0; i < f.maxWidth; i++ {
+		_, size := utf8.DecodeRuneInString(v[n:])
+		n += size
+	}
+	return fmt.Sprintf("%q…(%d bytes)", v[:n], len(v))
+}
+
+// FormatBinary returns the representation of the binary value v.
+func (f *ValueFormatter) FormatBinary(v []byte) string {
+	if f.binary == BinaryLen {
+		return fmt.Sprintf("<%d bytes>", len(v))
+	}
+
+	preview, suffix := v, ""
+	if f.maxWidth > 0 && len(v) > f.maxWidth {
+		preview, suffix = v[:f.maxWidth], fmt.Sprintf("…(%d bytes)", len(v))
+	}
+
+	switch f.binary {
+	case BinaryHex:
+		return hex.EncodeToString(preview) + suffix
+	case BinaryBase64:
+		return base64.StdEncoding.EncodeToString(preview) + suffix
+	default:
+		return fmt.Sprintf("%q", preview) + suffix
+	}
+}
+
+// Format returns the string representation of arr, as by its String method,
+// with the values of String, Binary and FixedSizeBinary arrays, nested ones
+// included, formatted as specified by opts.
+func Format(arr Interface, opts ...FormatOption) string {
+	o := new(strings.Builder)
+	NewValueFormatter(opts...).format(o, arr)
+	return o.String()
+}
+
+func (f *ValueFormatter) format(o *strings.Builder, arr Interface) {
+	// elems writes the bracketed list of the elements of arr.
+	elems := func(elem func(i int)) {
+		o.WriteString("[")
+		for i := 0; i < arr.Len(); i++ {
+			if i > 0 {
+				o.WriteString(" ")
+			}
+			if arr.IsNull(i) {
+				o.WriteString("(null)")
+				continue
+			}
+			elem(i)
+		}
+		o.WriteString("]")
+	}
+
+	switch arr := arr.(type) {
+	case *String:
+		elems(func(i int) { o.WriteString(f.FormatString(arr.Value(i))) })
+	case *Binary:
+		elems(func(i int) { o.WriteString(f.FormatBinary(arr.Value(i))) })
+	case *FixedSizeBinary:
+		elems(func(i int) { o.WriteString(f.FormatBinary(arr.Value(i))) })
+	case *Map:
+		f.format(o, arr.List)
+	case *LargeList:
+		elems(func(i int) {
+			sub := arr.newListValue(i)
+			defer sub.Release()
+			f.format(o, sub)
+		})
+	case *List:
+		elems(func(i int) {
+			sub := arr.newListValue(i)
+			defer sub.Release()
+			f.format(o, sub)
+		})
+	case *FixedSizeList:
+		elems(func(i int) {
+			sub := arr.newListValue(i)
+			defer sub.Release()
+			f.format(o, sub)
+		})
+	case *Struct:
+		o.WriteString("{")
+		for i, field := range arr.fields {
+			if i > 0 {
+				o.WriteString(" ")
+			}
+			if !bytes.Equal(arr.NullBitmapBytes(), field.NullBitmapBytes()) {
+				masked := arr.newStructFieldWithParentValidityMask(i)
+				f.format(o, masked)
+				masked.Release()
+				continue
+			}
+			f.format(o, field)
+		}
+		o.WriteString("}")
+	default:
+		fmt.Fprintf(o, "%v", arr)
+	}
+}
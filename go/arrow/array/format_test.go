@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDefault(t *testing.T) {
+	for _, name := range arrdata.RecordNames {
+		for _, rec := range arrdata.Records[name] {
+			for i, col := range rec.Columns() {
+				if got, want := array.Format(col), fmt.Sprintf("%v", col); got != want {
+					t.Fatalf("%s: invalid output for column %q:\ngot= %s\nwant=%s", name, rec.ColumnName(i), got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestValueFormatterString(t *testing.T) {
+	for _, tc := range []struct {
+		v     string
+		width int
+		want  string
+	}{
+		{"1é", 0, `"1é"`},
+		{"1é", 2, `"1é"`},
+		{"1é", 1, `"1"…(3 bytes)`},
+		{"1éa", 2, `"1é"…(4 bytes)`},
+		{"日本語のテキスト", 3, `"日本語"…(24 bytes)`},
+		{"日本語", 3, `"日本語"`},
+		{"", 1, `""`},
+		{"a\n\"b", 2, `"a\n"…(4 bytes)`},
+	} {
+		t.Run(fmt.Sprintf("%q/%d", tc.v, tc.width), func(t *testing.T) {
+			f := array.NewValueFormatter(array.WithMaxCellWidth(tc.width))
+			assert.Equal(t, tc.want, f.FormatString(tc.v))
+		})
+	}
+}
+
+func TestValueFormatterBinary(t *testing.T) {
+	v := []byte{0xff, 0xfe, 0xbe, 0xef, 'x'}
+	for _, tc := range []struct {
+		format array.BinaryFormat
+		width  int
+		want   string
+	}{
+		{array.BinaryQuoted, 0, `"\xff\xfe\xbe\xefx"`},
+		{array.BinaryQuoted, 2, `"\xff\xfe"…(5 bytes)`},
+		{array.BinaryHex, 0, `fffebeef78`},
+		{array.BinaryHex, 4, `fffebeef…(5 bytes)`},
+		{array.BinaryBase64, 0, `//6+73g=`},
+		{array.BinaryBase64, 3, `//6+…(5 bytes)`},
+		{array.BinaryLen, 0, `<5 bytes>`},
+		{array.BinaryLen, 2, `<5 bytes>`},
+	} {
+		t.Run(fmt.Sprintf("%v/%d", tc.format, tc.width), func(t *testing.T) {
+			f := array.NewValueFormatter(array.WithBinaryFormat(tc.format), array.WithMaxCellWidth(tc.width))
+			assert.Equal(t, tc.want, f.FormatBinary(v))
+		})
+	}
+}
+
+func TestParseBinaryFormat(t *testing.T) {
+	for _, want := range []array.BinaryFormat{array.BinaryQuoted, array.BinaryHex, array.BinaryBase64, array.BinaryLen} {
+		got, err := array.ParseBinaryFormat(want.String())
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := array.ParseBinaryFormat("octal")
+	assert.EqualError(t, err, `arrow/array: invalid binary format "octal" (want quoted, hex, base64 or len)`)
+}
+
+func TestFormatNested(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "s", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		arrow.Field{Name: "b", Type: &arrow.FixedSizeBinaryType{ByteWidth: 3}, Nullable: true},
+	)
+	b := array.NewStructBuilder(mem, dtype)
+	defer b.Release()
+
+	var (
+		lb = b.FieldBuilder(0).(*array.ListBuilder)
+		sb = lb.ValueBuilder().(*array.StringBuilder)
+		fb = b.FieldBuilder(1).(*array.FixedSizeBinaryBuilder)
+	)
+	b.Append(true)
+	lb.Append(true)
+	sb.Append(strings.Repeat("é", 10))
+	sb.AppendNull()
+	fb.Append([]byte{1, 2, 3})
+	b.AppendNull()
+	lb.AppendNull()
+	fb.AppendNull()
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	got := array.Format(arr, array.WithMaxCellWidth(2), array.WithBinaryFormat(array.BinaryHex))
+	assert.Equal(t, `{[["éé"…(20 bytes) (null)] (null)] [0102…(3 bytes) (null)]}`, got)
+}
@@ -39,199 +39,6 @@ func NewIntervalData(data *Data) Interface {
 	}
 }
 
-// A type which represents an immutable sequence of arrow.MonthInterval values.
-type MonthInterval struct {
-	array
-	values []arrow.MonthInterval
-}
-
-func NewMonthIntervalData(data *Data) *MonthInterval {
-	a := &MonthInterval{}
-	a.refCount = 1
-	a.setData(data)
-	return a
-}
-
-func (a *MonthInterval) Value(i int) arrow.MonthInterval            { return a.values[i] }
-func (a *MonthInterval) MonthIntervalValues() []arrow.MonthInterval { return a.values }
-
-func (a *MonthInterval) String() string {
-	o := new(strings.Builder)
-	o.WriteString("[")
-	for i, v := range a.values {
-		if i > 0 {
-			fmt.Fprintf(o, " ")
-		}
-		switch {
-		case a.IsNull(i):
-			o.WriteString("(null)")
-		default:
-			fmt.Fprintf(o, "%v", v)
-		}
-	}
-	o.WriteString("]")
-	return o.String()
-}
-
-func (a *MonthInterval) setData(data *Data) {
-	a.array.setData(data)
-	vals := data.buffers[1]
-	if vals != nil {
-		a.values = arrow.MonthIntervalTraits.CastFromBytes(vals.Bytes())
-		beg := a.array.data.offset
-		end := beg + a.array.data.length
-		a.values = a.values[beg:end]
-	}
-}
-
-func arrayEqualMonthInterval(left, right *MonthInterval) bool {
-	for i := 0; i < left.Len(); i++ {
-		if left.IsNull(i) {
-			continue
-		}
-		if left.Value(i) != right.Value(i) {
-			return false
-		}
-	}
-	return true
-}
-
-type MonthIntervalBuilder struct {
-	builder
-
-	data    *memory.Buffer
-	rawData []arrow.MonthInterval
-}
-
-func NewMonthIntervalBuilder(mem memory.Allocator) *MonthIntervalBuilder {
-	return &MonthIntervalBuilder{builder: builder{refCount: 1, mem: mem}}
-}
-
-// Release decreases the reference count by 1.
-// When the reference count goes to zero, the memory is freed.
-func (b *MonthIntervalBuilder) Release() {
-	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
-
-	if atomic.AddInt64(&b.refCount, -1) == 0 {
-		if b.nullBitmap != nil {
-			b.nullBitmap.Release()
-			b.nullBitmap = nil
-		}
-		if b.data != nil {
-			b.data.Release()
-			b.data = nil
-			b.rawData = nil
-		}
-	}
-}
-
-func (b *MonthIntervalBuilder) Append(v arrow.MonthInterval) {
-	b.Reserve(1)
-	b.UnsafeAppend(v)
-}
-
-func (b *MonthIntervalBuilder) AppendNull() {
-	b.Reserve(1)
-	b.UnsafeAppendBoolToBitmap(false)
-}
-
-func (b *MonthIntervalBuilder) UnsafeAppend(v arrow.MonthInterval) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
-}
-
-func (b *MonthIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
-}
-
-// AppendValues will append the values in the v slice. The valid slice determines which values
-// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
-// all values in v are appended and considered valid.
-func (b *MonthIntervalBuilder) AppendValues(v []arrow.MonthInterval, valid []bool) {
-	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
-	}
-
-	if len(v) == 0 {
-		return
-	}
-
-	b.Reserve(len(v))
-	arrow.MonthIntervalTraits.Copy(b.rawData[b.length:], v)
-	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
-}
-
-func (b *MonthIntervalBuilder) init(capacity int) {
-	b.builder.init(capacity)
-
-	b.data = memory.NewResizableBuffer(b.mem)
-	bytesN := arrow.MonthIntervalTraits.BytesRequired(capacity)
-	b.data.Resize(bytesN)
-	b.rawData = arrow.MonthIntervalTraits.CastFromBytes(b.data.Bytes())
-}
-
-// Reserve ensures there is enough space for appending n elements
-// by checking the capacity and calling Resize if necessary.
-func (b *MonthIntervalBuilder) Reserve(n int) {
-	b.builder.reserve(n, b.Resize)
-}
-
-// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
-// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
-func (b *MonthIntervalBuilder) Resize(n int) {
-	nBuilder := n
-	if n < minBuilderCapacity {
-		n = minBuilderCapacity
-	}
-
-	if b.capacity == 0 {
-		b.init(n)
-	} else {
-		b.builder.resize(nBuilder, b.init)
-		b.data.Resize(arrow.MonthIntervalTraits.BytesRequired(n))
-		b.rawData = arrow.MonthIntervalTraits.CastFromBytes(b.data.Bytes())
-	}
-}
-
-// NewArray creates a MonthInterval array from the memory buffers used by the builder and resets the MonthIntervalBuilder
-// so it can be used to build a new array.
-func (b *MonthIntervalBuilder) NewArray() Interface {
-	return b.NewMonthIntervalArray()
-}
-
-// NewMonthIntervalArray creates a MonthInterval array from the memory buffers used by the builder and resets the MonthIntervalBuilder
-// so it can be used to build a new array.
-func (b *MonthIntervalBuilder) NewMonthIntervalArray() (a *MonthInterval) {
-	data := b.newData()
-	a = NewMonthIntervalData(data)
-	data.Release()
-	return
-}
-
-func (b *MonthIntervalBuilder) newData() (data *Data) {
-	bytesRequired := arrow.MonthIntervalTraits.BytesRequired(b.length)
-	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
-	}
-	data = NewData(arrow.FixedWidthTypes.MonthInterval, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
-	b.reset()
-
-	if b.data != nil {
-		b.data.Release()
-		b.data = nil
-		b.rawData = nil
-	}
-
-	return
-}
-
 // A type which represents an immutable sequence of arrow.DayTimeInterval values.
 type DayTimeInterval struct {
 	array
@@ -318,6 +125,9 @@ func (b *DayTimeIntervalBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *DayTimeIntervalBuilder) Type() arrow.DataType { return arrow.FixedWidthTypes.DayTimeInterval }
+
 func (b *DayTimeIntervalBuilder) Append(v arrow.DayTimeInterval) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -328,18 +138,17 @@ func (b *DayTimeIntervalBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *DayTimeIntervalBuilder) UnsafeAppend(v arrow.DayTimeInterval) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *DayTimeIntervalBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *DayTimeIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+func (b *DayTimeIntervalBuilder) UnsafeAppend(v arrow.DayTimeInterval) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -360,6 +169,26 @@ func (b *DayTimeIntervalBuilder) AppendValues(v []arrow.DayTimeInterval, valid [
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *DayTimeIntervalBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*DayTimeInterval).DayTimeIntervalValues()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *DayTimeIntervalBuilder) SetValid(i int, v arrow.DayTimeInterval) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *DayTimeIntervalBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -378,6 +207,10 @@ func (b *DayTimeIntervalBuilder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *DayTimeIntervalBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -410,8 +243,8 @@ func (b *DayTimeIntervalBuilder) NewDayTimeIntervalArray() (a *DayTimeInterval)
 func (b *DayTimeIntervalBuilder) newData() (data *Data) {
 	bytesRequired := arrow.DayTimeIntervalTraits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.FixedWidthTypes.DayTimeInterval, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
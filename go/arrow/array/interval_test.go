@@ -110,46 +110,6 @@ func TestMonthIntervalArray(t *testing.T) {
 	}
 }
 
-func TestMonthIntervalBuilder_Empty(t *testing.T) {
-	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer mem.AssertSize(t, 0)
-
-	want := []arrow.MonthInterval{1, 2, 3, 4}
-
-	b := array.NewMonthIntervalBuilder(mem)
-	defer b.Release()
-
-	miValues := func(a *array.MonthInterval) []arrow.MonthInterval {
-		vs := make([]arrow.MonthInterval, a.Len())
-		for i := range vs {
-			vs[i] = a.Value(i)
-		}
-		return vs
-	}
-
-	b.AppendValues([]arrow.MonthInterval{}, nil)
-	arr := b.NewMonthIntervalArray()
-	assert.Zero(t, arr.Len())
-	arr.Release()
-
-	b.AppendValues(nil, nil)
-	arr = b.NewMonthIntervalArray()
-	assert.Zero(t, arr.Len())
-	arr.Release()
-
-	b.AppendValues([]arrow.MonthInterval{}, nil)
-	b.AppendValues(want, nil)
-	arr = b.NewMonthIntervalArray()
-	assert.Equal(t, want, miValues(arr))
-	arr.Release()
-
-	b.AppendValues(want, nil)
-	b.AppendValues([]arrow.MonthInterval{}, nil)
-	arr = b.NewMonthIntervalArray()
-	assert.Equal(t, want, miValues(arr))
-	arr.Release()
-}
-
 func TestDayTimeArray(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -235,6 +195,48 @@ func TestDayTimeArray(t *testing.T) {
 	}
 }
 
+// TestDayTimeArrayMixedSigns checks that days and milliseconds of opposite
+// signs, interleaved with nulls, survive building, slicing and IPC.
+func TestDayTimeArrayMixedSigns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		want = []arrow.DayTimeInterval{
+			{Days: -1, Milliseconds: 86399999},
+			{},
+			{Days: 3, Milliseconds: -1},
+			{},
+			{Days: -2147483648, Milliseconds: 2147483647},
+		}
+		valids = []bool{true, false, true, false, true}
+	)
+
+	b := array.NewDayTimeIntervalBuilder(mem)
+	defer b.Release()
+
+	b.AppendValues(want, valids)
+	arr := b.NewDayTimeIntervalArray()
+	defer arr.Release()
+
+	assert.Equal(t, 2, arr.NullN())
+	for i := range want {
+		assert.Equal(t, !valids[i], arr.IsNull(i), "validity of element %d", i)
+		if valids[i] {
+			assert.Equal(t, want[i], arr.Value(i), "element %d", i)
+		}
+	}
+	assert.Equal(t, "[{-1 86399999} (null) {3 -1} (null) {-2147483648 2147483647}]", arr.String())
+
+	slice := array.NewSlice(arr, 1, 5).(*array.DayTimeInterval)
+	defer slice.Release()
+	assert.Equal(t, want[2], slice.Value(1))
+	assert.True(t, slice.IsNull(2))
+
+	checkValidArray(t, mem, arr)
+	checkValidArray(t, mem, slice)
+}
+
 func TestDayTimeIntervalBuilder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -0,0 +1,299 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// LargeList represents an immutable sequence of array values, with 64-bit
+// offsets.
+type LargeList struct {
+	array
+	values  Interface
+	offsets []int64
+}
+
+// NewLargeListData returns a new LargeList array value, from data.
+func NewLargeListData(data *Data) *LargeList {
+	a := &LargeList{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+func (a *LargeList) ListValues() Interface { return a.values }
+
+func (a *LargeList) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		if !a.IsValid(i) {
+			o.WriteString("(null)")
+			continue
+		}
+		sub := a.newListValue(i)
+		fmt.Fprintf(o, "%v", sub)
+		sub.Release()
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeList) newListValue(i int) Interface {
+	j := i + a.array.data.offset
+	return NewSlice(a.values, a.offsets[j], a.offsets[j+1])
+}
+
+func (a *LargeList) setData(data *Data) {
+	a.array.setData(data)
+	vals := data.buffers[1]
+	if vals != nil {
+		a.offsets = arrow.Int64Traits.CastFromBytes(vals.Bytes())
+	}
+	a.values = MakeFromData(data.childData[0])
+}
+
+func arrayEqualLargeList(left, right *LargeList, opt equalOption) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		o := func() bool {
+			l := left.newListValue(i)
+			defer l.Release()
+			r := right.newListValue(i)
+			defer r.Release()
+			return arrayEqual(l, r, opt)
+		}()
+		if !o {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of elements in the array.
+func (a *LargeList) Len() int { return a.array.Len() }
+
+func (a *LargeList) Offsets() []int64 { return a.offsets }
+
+func (a *LargeList) Retain() {
+	a.array.Retain()
+	a.values.Retain()
+}
+
+func (a *LargeList) Release() {
+	a.array.Release()
+	a.values.Release()
+}
+
+type LargeListBuilder struct {
+	builder
+
+	etype   arrow.DataType // data type of the list's elements.
+	values  Builder        // value builder for the list's elements.
+	offsets *Int64Builder
+}
+
+// NewLargeListBuilder returns a builder, using the provided memory allocator.
+// The created list builder will create a large list whose elements will be of
+// type etype.
+func NewLargeListBuilder(mem memory.Allocator, etype arrow.DataType) *LargeListBuilder {
+	// offsets are never null: their builder maintains no validity bitmap.
+	offsets := NewInt64Builder(mem)
+	offsets.disableNulls()
+	return &LargeListBuilder{
+		builder: builder{refCount: 1, mem: mem},
+		etype:   etype,
+		values:  NewBuilder(mem, etype),
+		offsets: offsets,
+	}
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+func (b *LargeListBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.nullBitmap != nil {
+			b.nullBitmap.Release()
+			b.nullBitmap = nil
+		}
+		b.values.Release()
+		b.offsets.Release()
+	}
+}
+
+func (b *LargeListBuilder) appendNextOffset() {
+	b.offsets.Append(int64(b.values.Len()))
+}
+
+// Type returns the data type of the arrays created by the builder.
+func (b *LargeListBuilder) Type() arrow.DataType { return arrow.LargeListOf(b.etype) }
+
+func (b *LargeListBuilder) Append(v bool) {
+	b.Reserve(1)
+	b.unsafeAppendBoolToBitmap(v)
+	b.appendNextOffset()
+}
+
+func (b *LargeListBuilder) AppendNull() {
+	b.Reserve(1)
+	b.unsafeAppendBoolToBitmap(false)
+	b.appendNextOffset()
+}
+
+// AppendNulls appends n null lists to the builder, in bulk.
+// The nulls are empty: the value builder does not grow.
+func (b *LargeListBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	off := int64(b.values.Len())
+	for i := 0; i < n; i++ {
+		b.offsets.UnsafeAppend(off)
+	}
+	b.unsafeAppendNulls(n)
+}
+
+func (b *LargeListBuilder) AppendValues(offsets []int64, valid []bool) {
+	b.Reserve(len(valid))
+	b.offsets.AppendValues(offsets, nil)
+	b.builder.unsafeAppendBoolsToBitmap(valid, len(valid))
+}
+
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *LargeListBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	var (
+		src     = arr.(*LargeList)
+		data    = src.Data()
+		offsets = src.Offsets()[data.offset+start : data.offset+start+n+1]
+		shift   = int64(b.values.Len()) - offsets[0]
+	)
+	b.Reserve(n)
+	for _, v := range offsets[:n] {
+		b.offsets.UnsafeAppend(v + shift)
+	}
+	b.builder.unsafeAppendValidity(data, start, n)
+	b.values.AppendArray(src.ListValues(), int(offsets[0]), int(offsets[n]))
+}
+
+func (b *LargeListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
+	b.builder.UnsafeAppendBoolToBitmap(isValid)
+}
+
+func (b *LargeListBuilder) init(capacity int) {
+	b.builder.init(capacity)
+	b.offsets.init(capacity + 1)
+}
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+func (b *LargeListBuilder) Reserve(n int) {
+	b.builder.reserve(n, b.resizeHelper)
+	b.offsets.Reserve(n)
+}
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+func (b *LargeListBuilder) Resize(n int) {
+	b.resizeHelper(n)
+	b.offsets.Resize(n)
+}
+
+func (b *LargeListBuilder) resizeHelper(n int) {
+	if n < minBuilderCapacity {
+		n = minBuilderCapacity
+	}
+
+	if b.capacity == 0 {
+		b.init(n)
+	} else {
+		b.builder.resize(n, b.builder.init)
+	}
+}
+
+// ValueBuilder returns the builder of the list's elements.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
+func (b *LargeListBuilder) ValueBuilder() Builder {
+	return b.values
+}
+
+// NewArray creates a LargeList array from the memory buffers used by the builder and resets the LargeListBuilder
+// so it can be used to build a new array.
+func (b *LargeListBuilder) NewArray() Interface {
+	return b.NewLargeListArray()
+}
+
+// NewLargeListArray creates a LargeList array from the memory buffers used by the builder and resets the LargeListBuilder
+// so it can be used to build a new array.
+func (b *LargeListBuilder) NewLargeListArray() (a *LargeList) {
+	// an empty builder, never reserved, leaves the offsets to NewData.
+	if b.offsets.Cap() > 0 && b.offsets.Len() != b.length+1 {
+		b.appendNextOffset()
+	}
+	data := b.newData()
+	a = NewLargeListData(data)
+	data.Release()
+	return
+}
+
+func (b *LargeListBuilder) newData() (data *Data) {
+	values := b.values.NewArray()
+	defer values.Release()
+
+	var offsets *memory.Buffer
+	if b.offsets != nil {
+		arr := b.offsets.NewInt64Array()
+		defer arr.Release()
+		offsets = arr.Data().buffers[1]
+	}
+
+	data = NewData(
+		arrow.LargeListOf(b.etype), b.length,
+		[]*memory.Buffer{
+			b.nullBitmap,
+			offsets,
+		},
+		[]*Data{values.Data()},
+		b.nulls,
+		0,
+	)
+	b.reset()
+
+	return
+}
+
+var (
+	_ Interface = (*LargeList)(nil)
+	_ Builder   = (*LargeListBuilder)(nil)
+)
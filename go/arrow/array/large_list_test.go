@@ -0,0 +1,215 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestLargeListArray(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	var (
+		vs      = []int32{0, 1, 2, 3, 4, 5, 6}
+		lengths = []int{3, 0, 4}
+		isValid = []bool{true, false, true}
+		offsets = []int64{0, 3, 3, 7}
+	)
+
+	lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+
+	for i := 0; i < 10; i++ {
+		vb := lb.ValueBuilder().(*array.Int32Builder)
+		vb.Reserve(len(vs))
+
+		pos := 0
+		for i, length := range lengths {
+			lb.Append(isValid[i])
+			for j := 0; j < length; j++ {
+				vb.Append(vs[pos])
+				pos++
+			}
+		}
+
+		arr := lb.NewArray().(*array.LargeList)
+		defer arr.Release()
+
+		arr.Retain()
+		arr.Release()
+
+		if got, want := arr.DataType().ID(), arrow.LARGE_LIST; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		if got, want := arr.Len(), len(isValid); got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+
+		for i := range lengths {
+			if got, want := arr.IsValid(i), isValid[i]; got != want {
+				t.Fatalf("got[%d]=%v, want[%d]=%v", i, got, i, want)
+			}
+			if got, want := arr.IsNull(i), lengths[i] == 0; got != want {
+				t.Fatalf("got[%d]=%v, want[%d]=%v", i, got, i, want)
+			}
+		}
+
+		if got, want := arr.Offsets(), offsets; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		varr := arr.ListValues().(*array.Int32)
+		if got, want := varr.Int32Values(), vs; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+	}
+}
+
+func TestLargeListArrayEmpty(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	arr := lb.NewArray().(*array.LargeList)
+	defer arr.Release()
+	if got, want := arr.Len(), 0; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if err := array.ValidateFull(arr); err != nil {
+		t.Fatalf("invalid empty array: %v", err)
+	}
+}
+
+func TestLargeListArraySlice(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	var (
+		vs      = []int32{0, 1, 2, 3, 4, 5, 6}
+		isValid = []bool{true, false, true}
+		offsets = []int64{0, 3, 3, 7}
+	)
+
+	lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+
+	lb.AppendValues(offsets, isValid)
+	vb.AppendValues(vs, nil)
+
+	arr := lb.NewArray().(*array.LargeList)
+	defer arr.Release()
+
+	if got, want := arr.Offsets(), offsets; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if got, want := arr.String(), `[[0 1 2] (null) [3 4 5 6]]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	sub := array.NewSlice(arr, 1, 3).(*array.LargeList)
+	defer sub.Release()
+
+	if got, want := sub.String(), `[(null) [3 4 5 6]]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if err := array.ValidateFull(sub); err != nil {
+		t.Fatalf("invalid slice: %v", err)
+	}
+
+	// appending a slice rebases its offsets.
+	lb.AppendArray(sub, 0, sub.Len())
+	cpy := lb.NewLargeListArray()
+	defer cpy.Release()
+
+	if got, want := cpy.Offsets(), []int64{0, 0, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !array.ArrayEqual(sub, cpy) {
+		t.Fatalf("got=%v, want=%v", cpy, sub)
+	}
+}
+
+func TestLargeListOfStructs(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32},
+		arrow.Field{Name: "b", Type: arrow.BinaryTypes.String},
+	)
+
+	lb := array.NewLargeListBuilder(pool, dtype)
+	defer lb.Release()
+
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	ab := sb.FieldBuilder(0).(*array.Int32Builder)
+	bb := sb.FieldBuilder(1).(*array.StringBuilder)
+
+	lb.Append(true)
+	sb.AppendValues([]bool{true, true})
+	ab.AppendValues([]int32{1, 2}, nil)
+	bb.AppendValues([]string{"a", "b"}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	sb.Append(true)
+	ab.Append(3)
+	bb.Append("c")
+
+	arr := lb.NewLargeListArray()
+	defer arr.Release()
+
+	if got, want := arr.DataType().(*arrow.LargeListType).String(), "large_list<item: struct<a: int32, b: utf8>>"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := arr.Offsets(), []int64{0, 2, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if err := array.ValidateFull(arr); err != nil {
+		t.Fatalf("invalid array: %v", err)
+	}
+}
+
+func TestLargeListValidateOffsets(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	values := array.NewInt32Builder(pool)
+	defer values.Release()
+	values.AppendValues([]int32{1, 2, 3}, nil)
+	vs := values.NewArray()
+	defer vs.Release()
+
+	offsets := memory.NewBufferBytes(arrow.Int64Traits.CastToBytes([]int64{0, 2, 1, 3}))
+	data := array.NewData(arrow.LargeListOf(arrow.PrimitiveTypes.Int32), 3, []*memory.Buffer{nil, offsets}, []*array.Data{vs.Data()}, 0, 0)
+	defer data.Release()
+	arr := array.MakeFromData(data)
+	defer arr.Release()
+
+	if err := array.ValidateFull(arr); err == nil {
+		t.Fatalf("expected an error for decreasing offsets")
+	}
+}
@@ -22,7 +22,6 @@ import (
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
-	"github.com/apache/arrow/go/arrow/bitutil"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -79,7 +78,7 @@ func (a *List) setData(data *Data) {
 	a.values = MakeFromData(data.childData[0])
 }
 
-func arrayEqualList(left, right *List) bool {
+func arrayEqualList(left, right *List, opt equalOption) bool {
 	for i := 0; i < left.Len(); i++ {
 		if left.IsNull(i) {
 			continue
@@ -89,7 +88,7 @@ func arrayEqualList(left, right *List) bool {
 			defer l.Release()
 			r := right.newListValue(i)
 			defer r.Release()
-			return ArrayEqual(l, r)
+			return arrayEqual(l, r, opt)
 		}()
 		if !o {
 			return false
@@ -116,19 +115,33 @@ func (a *List) Release() {
 type ListBuilder struct {
 	builder
 
-	etype   arrow.DataType // data type of the list's elements.
-	values  Builder        // value builder for the list's elements.
+	dtype   *arrow.ListType // data type of the lists.
+	values  Builder         // value builder for the list's elements.
 	offsets *Int32Builder
 }
 
 // NewListBuilder returns a builder, using the provided memory allocator.
 // The created list builder will create a list whose elements will be of type etype.
 func NewListBuilder(mem memory.Allocator, etype arrow.DataType) *ListBuilder {
+	return newListBuilder(mem, arrow.ListOf(etype))
+}
+
+// NewListBuilderWithField returns a builder, using the provided memory
+// allocator. The created list builder will create lists whose elements are
+// described by field.
+func NewListBuilderWithField(mem memory.Allocator, field arrow.Field) *ListBuilder {
+	return newListBuilder(mem, arrow.ListOfField(field))
+}
+
+func newListBuilder(mem memory.Allocator, dtype *arrow.ListType) *ListBuilder {
+	// offsets are never null: their builder maintains no validity bitmap.
+	offsets := NewInt32Builder(mem)
+	offsets.disableNulls()
 	return &ListBuilder{
 		builder: builder{refCount: 1, mem: mem},
-		etype:   etype,
-		values:  NewBuilder(mem, etype),
-		offsets: NewInt32Builder(mem),
+		dtype:   dtype,
+		values:  NewBuilder(mem, dtype.Elem()),
+		offsets: offsets,
 	}
 }
 
@@ -142,16 +155,18 @@ func (b *ListBuilder) Release() {
 			b.nullBitmap.Release()
 			b.nullBitmap = nil
 		}
+		b.values.Release()
+		b.offsets.Release()
 	}
-
-	b.values.Release()
-	b.offsets.Release()
 }
 
 func (b *ListBuilder) appendNextOffset() {
 	b.offsets.Append(int32(b.values.Len()))
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *ListBuilder) Type() arrow.DataType { return b.dtype }
+
 func (b *ListBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(v)
@@ -164,24 +179,53 @@ func (b *ListBuilder) AppendNull() {
 	b.appendNextOffset()
 }
 
+// AppendNulls appends n null lists to the builder, in bulk.
+// The nulls are empty: the value builder does not grow.
+func (b *ListBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	off := int32(b.values.Len())
+	for i := 0; i < n; i++ {
+		b.offsets.UnsafeAppend(off)
+	}
+	b.unsafeAppendNulls(n)
+}
+
 func (b *ListBuilder) AppendValues(offsets []int32, valid []bool) {
 	b.Reserve(len(valid))
 	b.offsets.AppendValues(offsets, nil)
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(valid))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *ListBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+	b.appendList(arr.(*List), start, n)
+}
+
+// appendList appends the n elements of src starting at start to b.
+func (b *ListBuilder) appendList(src *List, start, n int) {
+	var (
+		data    = src.Data()
+		offsets = src.Offsets()[data.offset+start : data.offset+start+n+1]
+		shift   = int32(b.values.Len()) - offsets[0]
+	)
+	b.Reserve(n)
+	for _, v := range offsets[:n] {
+		b.offsets.UnsafeAppend(v + shift)
+	}
+	b.builder.unsafeAppendValidity(data, start, n)
+	b.values.AppendArray(src.ListValues(), int(offsets[0]), int(offsets[n]))
+}
+
 func (b *ListBuilder) unsafeAppend(v bool) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(true)
 }
 
 func (b *ListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(isValid)
 }
 
 func (b *ListBuilder) init(capacity int) {
@@ -215,6 +259,9 @@ func (b *ListBuilder) resizeHelper(n int) {
 	}
 }
 
+// ValueBuilder returns the builder of the list's elements.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
 func (b *ListBuilder) ValueBuilder() Builder {
 	return b.values
 }
@@ -228,7 +275,8 @@ func (b *ListBuilder) NewArray() Interface {
 // NewListArray creates a List array from the memory buffers used by the builder and resets the ListBuilder
 // so it can be used to build a new array.
 func (b *ListBuilder) NewListArray() (a *List) {
-	if b.offsets.Len() != b.length+1 {
+	// an empty builder, never reserved, leaves the offsets to NewData.
+	if b.offsets.Cap() > 0 && b.offsets.Len() != b.length+1 {
 		b.appendNextOffset()
 	}
 	data := b.newData()
@@ -249,7 +297,7 @@ func (b *ListBuilder) newData() (data *Data) {
 	}
 
 	data = NewData(
-		arrow.ListOf(b.etype), b.length,
+		b.dtype, b.length,
 		[]*memory.Buffer{
 			b.nullBitmap,
 			offsets,
@@ -86,6 +86,44 @@ func TestListArray(t *testing.T) {
 	}
 }
 
+func TestListBuilderWithField(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	field := arrow.Field{
+		Name:     "element",
+		Type:     arrow.PrimitiveTypes.Int32,
+		Metadata: arrow.NewMetadata([]string{"k"}, []string{"v"}),
+	}
+	want := arrow.ListOfField(field)
+
+	for _, lb := range []*array.ListBuilder{
+		array.NewListBuilderWithField(pool, field),
+		array.NewBuilder(pool, want).(*array.ListBuilder),
+	} {
+		defer lb.Release()
+		if got := lb.Type(); !arrow.TypeEqual(got, want, arrow.CheckMetadata()) {
+			t.Fatalf("invalid builder type: got=%v, want=%v", got, want)
+		}
+
+		vb := lb.ValueBuilder().(*array.Int32Builder)
+		lb.Append(true)
+		vb.AppendValues([]int32{1, 2}, nil)
+		lb.AppendNull()
+
+		arr := lb.NewArray().(*array.List)
+		defer arr.Release()
+
+		got := arr.DataType().(*arrow.ListType)
+		if !arrow.TypeEqual(got, want, arrow.CheckMetadata()) {
+			t.Fatalf("invalid array type: got=%v, want=%v", got, want)
+		}
+		if got.ElemField().Nullable {
+			t.Fatalf("element field should not be nullable")
+		}
+	}
+}
+
 func TestListArrayEmpty(t *testing.T) {
 	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer pool.AssertSize(t, 0)
@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Map represents an immutable sequence of maps, each made of key-item pairs.
+//
+// A Map is laid out as a List of key-item structs: the entries of the i-th
+// map are the elements of Keys and Items in the range [Offsets()[j],
+// Offsets()[j+1]), with j = i + Offset().
+type Map struct {
+	*List
+	keys, items Interface
+}
+
+// NewMapData returns a new Map array value, from data.
+func NewMapData(data *Data) *Map {
+	a := &Map{List: &List{}}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+func (a *Map) setData(data *Data) {
+	a.List.setData(data)
+	entries := a.values.(*Struct)
+	a.keys = entries.Field(0)
+	a.items = entries.Field(1)
+}
+
+// Keys returns the keys of all the maps of the array.
+func (a *Map) Keys() Interface { return a.keys }
+
+// Items returns the items of all the maps of the array.
+func (a *Map) Items() Interface { return a.items }
+
+// MapBuilder builds Map arrays.
+//
+// A map is started with Append(true). Its entries are then appended, one key
+// to KeyBuilder and one item to ItemBuilder per entry. Append(false), or
+// AppendNull, appends a null map, which is distinct from an empty map.
+// Keys cannot be null.
+type MapBuilder struct {
+	*ListBuilder
+
+	dtype   *arrow.MapType
+	entries *StructBuilder
+	keys    Builder
+	items   Builder
+}
+
+// NewMapBuilder returns a builder, using the provided memory allocator.
+// The created map builder will create maps whose keys and items are of type
+// key and item, with sorted keys if keysSorted is true.
+func NewMapBuilder(mem memory.Allocator, key, item arrow.DataType, keysSorted bool) *MapBuilder {
+	dtype := arrow.MapOf(key, item)
+	dtype.KeysSorted = keysSorted
+
+	b := &MapBuilder{
+		ListBuilder: NewListBuilder(mem, dtype.Elem()),
+		dtype:       dtype,
+	}
+	b.entries = b.ListBuilder.ValueBuilder().(*StructBuilder)
+	b.keys = b.entries.FieldBuilder(0)
+	b.items = b.entries.FieldBuilder(1)
+	DisableNulls(b.entries)
+	DisableNulls(b.keys)
+	return b
+}
+
+// Type returns the data type of the arrays created by the builder.
+func (b *MapBuilder) Type() arrow.DataType { return b.dtype }
+
+// Append appends a new map, valid or null depending on v. The entries of
+// a valid map are appended to KeyBuilder and ItemBuilder afterwards.
+func (b *MapBuilder) Append(v bool) {
+	b.adjustEntries()
+	b.ListBuilder.Append(v)
+}
+
+// AppendNull appends a null map.
+func (b *MapBuilder) AppendNull() { b.Append(false) }
+
+// AppendNulls appends n null maps, in bulk.
+// The nulls are empty: the key and item builders do not grow.
+func (b *MapBuilder) AppendNulls(n int) {
+	b.adjustEntries()
+	b.ListBuilder.AppendNulls(n)
+}
+
+// AppendValues appends len(valid) maps, given the offsets of their entries
+// in KeyBuilder and ItemBuilder.
+func (b *MapBuilder) AppendValues(offsets []int32, valid []bool) {
+	b.adjustEntries()
+	b.ListBuilder.AppendValues(offsets, valid)
+}
+
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *MapBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+	b.adjustEntries()
+	b.ListBuilder.appendList(arr.(*Map).List, start, n)
+}
+
+// AppendValueFromString always returns an error: maps cannot be parsed
+// from strings.
+func (b *MapBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(b.Type())
+}
+
+// KeyBuilder returns the builder of the map's keys.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
+func (b *MapBuilder) KeyBuilder() Builder { return b.keys }
+
+// ItemBuilder returns the builder of the map's items.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
+func (b *MapBuilder) ItemBuilder() Builder { return b.items }
+
+// NewArray creates a Map array from the memory buffers used by the builder and resets the MapBuilder
+// so it can be used to build a new array.
+func (b *MapBuilder) NewArray() Interface {
+	return b.NewMapArray()
+}
+
+// NewMapArray creates a Map array from the memory buffers used by the builder and resets the MapBuilder
+// so it can be used to build a new array.
+//
+// NewMapArray panics if different numbers of keys and items were appended.
+func (b *MapBuilder) NewMapArray() (a *Map) {
+	if nk, ni := b.keys.Len(), b.items.Len(); nk != ni {
+		panic(fmt.Errorf("arrow/array: map builder has %d keys and %d items", nk, ni))
+	}
+	b.adjustEntries()
+	// an empty builder, never reserved, leaves the offsets to NewData.
+	if b.offsets.Cap() > 0 && b.offsets.Len() != b.length+1 {
+		b.appendNextOffset()
+	}
+	data := b.newData()
+	data.dtype = b.dtype
+	a = NewMapData(data)
+	data.Release()
+	return
+}
+
+// adjustEntries appends the entries of the keys appended since its last call
+// to the entries builder.
+func (b *MapBuilder) adjustEntries() {
+	if n := b.keys.Len() - b.entries.Len(); n > 0 {
+		b.entries.Reserve(n)
+		b.entries.unsafeAppendBoolsToBitmap(nil, n)
+	}
+}
+
+var (
+	_ Interface = (*Map)(nil)
+	_ Builder   = (*MapBuilder)(nil)
+)
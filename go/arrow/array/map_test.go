@@ -0,0 +1,166 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewMapBuilder(mem, arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32, false)
+	defer b.Release()
+
+	kb := b.KeyBuilder().(*array.StringBuilder)
+	ib := b.ItemBuilder().(*array.Int32Builder)
+
+	b.Append(true)
+	kb.AppendValues([]string{"a", "b"}, nil)
+	ib.AppendValues([]int32{1, 2}, []bool{true, false})
+	b.AppendNull()
+	b.Append(true) // empty map.
+	b.Append(true)
+	kb.Append("c")
+	ib.Append(3)
+
+	arr := b.NewMapArray()
+	defer arr.Release()
+
+	checkValidArray(t, mem, arr)
+	assert.True(t, arrow.TypeEqual(arr.DataType(), arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32)))
+	assert.Equal(t, 4, arr.Len())
+	assert.Equal(t, 1, arr.NullN())
+	assert.True(t, arr.IsNull(1))
+	assert.True(t, arr.IsValid(2))
+
+	if got, want := arr.Offsets(), []int32{0, 2, 2, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid offsets: got=%v, want=%v", got, want)
+	}
+	assert.Equal(t, `["a" "b" "c"]`, fmt.Sprint(arr.Keys()))
+	assert.Equal(t, 0, arr.Keys().NullN())
+	assert.Equal(t, `[1 (null) 3]`, fmt.Sprint(arr.Items()))
+	assert.Equal(t, `[{["a" "b"] [1 (null)]} (null) {[] []} {["c"] [3]}]`, arr.String())
+
+	// the builder is reset, and can be reused.
+	assert.Equal(t, 0, b.Len())
+	b.Append(true)
+	kb.Append("z")
+	ib.AppendNull()
+	other := b.NewArray().(*array.Map)
+	defer other.Release()
+	assert.Equal(t, `[{["z"] [(null)]}]`, other.String())
+	assert.False(t, array.ArrayEqual(arr, other))
+
+	slice := array.NewSlice(arr, 1, 4)
+	defer slice.Release()
+	assert.Equal(t, `[(null) {[] []} {["c"] [3]}]`, fmt.Sprint(slice))
+	assert.Equal(t, "[{c 3}]", array.ValueToString(slice, 2))
+}
+
+func TestMapBuilderKeys(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewMapBuilder(mem, arrow.PrimitiveTypes.Int64, arrow.BinaryTypes.String, true)
+	defer b.Release()
+
+	assert.True(t, b.Type().(*arrow.MapType).KeysSorted)
+	assert.Panics(t, func() { b.KeyBuilder().AppendNull() })
+
+	b.Append(true)
+	b.KeyBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	b.ItemBuilder().(*array.StringBuilder).Append("one")
+	assert.Panics(t, func() { b.NewArray() })
+
+	assert.Error(t, b.AppendValueFromString("{}"))
+}
+
+func TestMapBuilderAppendArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32)
+	b := array.NewBuilder(mem, dtype).(*array.MapBuilder)
+	defer b.Release()
+
+	kb := b.KeyBuilder().(*array.StringBuilder)
+	ib := b.ItemBuilder().(*array.Int32Builder)
+	for i, keys := range [][]string{{"a"}, nil, {"b", "c"}, {}} {
+		if keys == nil {
+			b.AppendNull()
+			continue
+		}
+		b.Append(true)
+		for _, k := range keys {
+			kb.Append(k)
+			ib.Append(int32(i))
+		}
+	}
+	src := b.NewMapArray()
+	defer src.Release()
+
+	b.Append(true)
+	kb.Append("x")
+	ib.Append(9)
+	b.AppendArray(src, 1, 4)
+	got := b.NewMapArray()
+	defer got.Release()
+
+	checkValidArray(t, mem, got)
+	assert.Equal(t, `[{["x"] [9]} (null) {["b" "c"] [2 2]} {[] []}]`, got.String())
+
+	assert.Panics(t, func() {
+		lb := array.NewListBuilder(mem, dtype.Elem())
+		defer lb.Release()
+		lst := lb.NewArray()
+		defer lst.Release()
+		b.AppendArray(lst, 0, 0)
+	})
+}
+
+func TestMapValidateNullKeys(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32)
+
+	sb := array.NewStructBuilder(mem, dtype.Elem())
+	defer sb.Release()
+	sb.AppendValues([]bool{true, true})
+	sb.FieldBuilder(0).(*array.StringBuilder).AppendValues([]string{"a", ""}, []bool{true, false})
+	sb.FieldBuilder(1).(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	entries := sb.NewArray()
+	defer entries.Release()
+
+	offsets := memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 2}))
+	data := array.NewData(dtype, 1, []*memory.Buffer{nil, offsets}, []*array.Data{entries.Data()}, 0, 0)
+	defer data.Release()
+	arr := array.MakeFromData(data)
+	defer arr.Release()
+
+	assert.NoError(t, array.Validate(arr))
+	assert.Error(t, array.ValidateFull(arr))
+}
@@ -0,0 +1,84 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow/float16"
+)
+
+// minMaxer is implemented by the arrays of numeric and temporal data types,
+// whose values are ordered.
+type minMaxer interface {
+	minMax() (min, max interface{}, ok bool)
+}
+
+// minMax returns the smallest and greatest valid values of a, ignoring NaNs,
+// and false if there is none.
+func (a *Float16) minMax() (min, max interface{}, ok bool) {
+	var lo, hi float16.Num
+	for i, v := range a.values {
+		f := v.Float32()
+		if a.IsNull(i) || f != f {
+			continue
+		}
+		switch {
+		case !ok:
+			lo, hi, ok = v, v, true
+		case f < lo.Float32():
+			lo = v
+		case f > hi.Float32():
+			hi = v
+		}
+	}
+	if !ok {
+		return nil, nil, false
+	}
+	return lo, hi, true
+}
+
+// lessOtherValue reports whether a is smaller than b, for the values returned
+// by the minMax methods that are not generated.
+func lessOtherValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case float16.Num:
+		return a.Float32() < b.(float16.Num).Float32()
+	default:
+		panic("arrow/array: unordered value type")
+	}
+}
+
+// minMaxStats holds the smallest and greatest valid values of the first
+// chunks of a chunked array.
+type minMaxStats struct {
+	n        int         // number of chunks accounted for.
+	min, max interface{} // nil when the chunks have no valid value.
+}
+
+// add accounts for the values of arr.
+func (s *minMaxStats) add(arr minMaxer) {
+	s.n++
+	min, max, ok := arr.minMax()
+	if !ok {
+		return
+	}
+	if s.min == nil || lessValue(min, s.min) {
+		s.min = min
+	}
+	if s.max == nil || lessValue(s.max, max) {
+		s.max = max
+	}
+}
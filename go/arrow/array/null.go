@@ -54,6 +54,12 @@ func NewNullData(data *Data) *Null {
 	return a
 }
 
+// IsNull returns true: all the elements of a Null array are null.
+func (a *Null) IsNull(i int) bool { return true }
+
+// IsValid returns false: all the elements of a Null array are null.
+func (a *Null) IsValid(i int) bool { return false }
+
 func (a *Null) String() string {
 	o := new(strings.Builder)
 	o.WriteString("[")
@@ -95,11 +101,42 @@ func (b *NullBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *NullBuilder) Type() arrow.DataType { return arrow.Null }
+
+// IsNull returns true: all the elements of null arrays are null.
+// IsNull panics if i is not in the range [0, Len()).
+func (b *NullBuilder) IsNull(i int) bool {
+	b.checkIndex(i)
+	return true
+}
+
+// IsValid returns false: all the elements of null arrays are null.
+// IsValid panics if i is not in the range [0, Len()).
+func (b *NullBuilder) IsValid(i int) bool { return !b.IsNull(i) }
+
+// SetNull does nothing but checking i: all the elements of null arrays are
+// null. SetNull panics if i is not in the range [0, Len()).
+func (b *NullBuilder) SetNull(i int) { b.checkIndex(i) }
+
 func (b *NullBuilder) AppendNull() {
 	b.builder.length++
 	b.builder.nulls++
 }
 
+// AppendNulls appends n null values to the builder.
+func (b *NullBuilder) AppendNulls(n int) {
+	b.builder.length += n
+	b.builder.nulls += n
+}
+
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *NullBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	b.builder.length += n
+	b.builder.nulls += n
+}
+
 func (*NullBuilder) Reserve(size int) {}
 func (*NullBuilder) Resize(size int)  {}
 
@@ -34,6 +34,10 @@ func TestNullArray(t *testing.T) {
 	b.AppendNull()
 	b.AppendNull()
 
+	if !b.IsNull(1) || b.IsValid(1) {
+		t.Fatalf("invalid validity of appended null")
+	}
+
 	arr1 := b.NewArray().(*array.Null)
 	defer arr1.Release()
 
@@ -1096,3 +1096,70 @@ func arrayEqualDuration(left, right *Duration) bool {
 	}
 	return true
 }
+
+// A type which represents an immutable sequence of arrow.MonthInterval values.
+type MonthInterval struct {
+	array
+	values []arrow.MonthInterval
+}
+
+// NewMonthIntervalData creates a new MonthInterval.
+func NewMonthIntervalData(data *Data) *MonthInterval {
+	a := &MonthInterval{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Reset resets the array for re-use.
+func (a *MonthInterval) Reset(data *Data) {
+	a.setData(data)
+}
+
+// Value returns the value at the specified index.
+func (a *MonthInterval) Value(i int) arrow.MonthInterval { return a.values[i] }
+
+// Values returns the values.
+func (a *MonthInterval) MonthIntervalValues() []arrow.MonthInterval { return a.values }
+
+// String returns a string representation of the array.
+func (a *MonthInterval) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i, v := range a.values {
+		if i > 0 {
+			fmt.Fprintf(o, " ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			fmt.Fprintf(o, "%v", v)
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *MonthInterval) setData(data *Data) {
+	a.array.setData(data)
+	vals := data.buffers[1]
+	if vals != nil {
+		a.values = arrow.MonthIntervalTraits.CastFromBytes(vals.Bytes())
+		beg := a.array.data.offset
+		end := beg + a.array.data.length
+		a.values = a.values[beg:end]
+	}
+}
+
+func arrayEqualMonthInterval(left, right *MonthInterval) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if left.Value(i) != right.Value(i) {
+			return false
+		}
+	}
+	return true
+}
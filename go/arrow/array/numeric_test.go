@@ -17,8 +17,10 @@
 package array_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
@@ -614,3 +616,217 @@ func TestDate64SliceDataWithNull(t *testing.T) {
 		t.Fatalf("got=%v, want=%v", got, want)
 	}
 }
+
+func BenchmarkInt64Builder_Append(b *testing.B) {
+	const N = 1 << 12
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(b, 0)
+
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+
+	b.SetBytes(int64(N * arrow.Int64SizeBytes))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < N; j++ {
+			if j%10 == 0 {
+				bldr.AppendNull()
+				continue
+			}
+			bldr.Append(int64(j))
+		}
+		arr := bldr.NewArray()
+		arr.Release()
+	}
+}
+
+// BenchmarkInt64Builder_AppendReserve compares appends to a builder growing
+// on demand with appends to a builder reserved up front.
+func BenchmarkInt64Builder_AppendReserve(b *testing.B) {
+	const N = 1 << 20
+
+	for _, reserve := range []bool{false, true} {
+		b.Run(fmt.Sprintf("reserve=%v", reserve), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewInt64Builder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(int64(N * arrow.Int64SizeBytes))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if reserve {
+					bldr.Reserve(N)
+				}
+				for j := 0; j < N; j++ {
+					bldr.Append(int64(j))
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
+
+// BenchmarkInt64Builder_AppendNoNulls compares appends to a builder
+// maintaining a validity bitmap with appends to a builder in no-nulls mode.
+func BenchmarkInt64Builder_AppendNoNulls(b *testing.B) {
+	const N = 1 << 20
+
+	for _, noNulls := range []bool{false, true} {
+		b.Run(fmt.Sprintf("no-nulls=%v", noNulls), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewInt64Builder(mem)
+			defer bldr.Release()
+			if noNulls {
+				array.DisableNulls(bldr)
+			}
+
+			b.SetBytes(int64(N * arrow.Int64SizeBytes))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(N)
+				for j := 0; j < N; j++ {
+					bldr.UnsafeAppend(int64(j))
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
+
+// BenchmarkInt64Builder_UnsafeAppend compares Append with UnsafeAppend,
+// after a single Reserve call.
+func BenchmarkInt64Builder_UnsafeAppend(b *testing.B) {
+	const N = 1 << 20
+
+	for _, unsafe := range []bool{false, true} {
+		b.Run(fmt.Sprintf("unsafe=%v", unsafe), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewInt64Builder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(int64(N * arrow.Int64SizeBytes))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(N)
+				if unsafe {
+					for j := 0; j < N; j++ {
+						bldr.UnsafeAppend(int64(j))
+					}
+				} else {
+					for j := 0; j < N; j++ {
+						bldr.Append(int64(j))
+					}
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
+
+// BenchmarkFloat64Builder_UnsafeAppend compares Append with UnsafeAppend,
+// after a single Reserve call.
+func BenchmarkFloat64Builder_UnsafeAppend(b *testing.B) {
+	const N = 1 << 20
+
+	for _, unsafe := range []bool{false, true} {
+		b.Run(fmt.Sprintf("unsafe=%v", unsafe), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewFloat64Builder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(int64(N * arrow.Float64SizeBytes))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(N)
+				if unsafe {
+					for j := 0; j < N; j++ {
+						bldr.UnsafeAppend(float64(j))
+					}
+				} else {
+					for j := 0; j < N; j++ {
+						bldr.Append(float64(j))
+					}
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
+
+func TestDurationBuilderAppendDuration(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		unit arrow.TimeUnit
+		want []arrow.Duration
+	}{
+		{arrow.Second, []arrow.Duration{90, -1, 0}},
+		{arrow.Millisecond, []arrow.Duration{90000, -1500, 0}},
+		{arrow.Microsecond, []arrow.Duration{90000000, -1500000, 1}},
+		{arrow.Nanosecond, []arrow.Duration{90000000000, -1500000000, 1500}},
+	} {
+		t.Run(tc.unit.String(), func(t *testing.T) {
+			b := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: tc.unit})
+			defer b.Release()
+
+			b.AppendDuration(90 * time.Second)
+			b.AppendDuration(-1500 * time.Millisecond)
+			b.AppendDuration(1500 * time.Nanosecond)
+
+			arr := b.NewDurationArray()
+			defer arr.Release()
+			assert.Equal(t, tc.want, arr.DurationValues())
+		})
+	}
+}
+
+func BenchmarkInt64Builder_AppendValues(b *testing.B) {
+	const N = 1 << 12
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(b, 0)
+
+	vs := make([]int64, N)
+	valid := make([]bool, N)
+	for i := range vs {
+		vs[i] = int64(i)
+		valid[i] = i%10 != 0
+	}
+
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+
+	b.SetBytes(int64(N * arrow.Int64SizeBytes))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		bldr.AppendValues(vs, valid)
+		arr := bldr.NewArray()
+		arr.Release()
+	}
+}
@@ -56,6 +56,11 @@ func (b *Int64Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Int64Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Int64
+}
+
 func (b *Int64Builder) Append(v int64) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -66,18 +71,20 @@ func (b *Int64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Int64Builder) UnsafeAppend(v int64) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Int64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Int64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Int64Builder) UnsafeAppend(v int64) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -98,6 +105,34 @@ func (b *Int64Builder) AppendValues(v []int64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Int64Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Int64).Int64Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Int64Builder) Value(i int) int64 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Int64Builder) SetValid(i int, v int64) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Int64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -116,6 +151,10 @@ func (b *Int64Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Int64Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -148,8 +187,8 @@ func (b *Int64Builder) NewInt64Array() (a *Int64) {
 func (b *Int64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Int64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Int64, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -192,6 +231,11 @@ func (b *Uint64Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Uint64Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Uint64
+}
+
 func (b *Uint64Builder) Append(v uint64) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -202,18 +246,20 @@ func (b *Uint64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Uint64Builder) UnsafeAppend(v uint64) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Uint64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Uint64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Uint64Builder) UnsafeAppend(v uint64) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -234,6 +280,34 @@ func (b *Uint64Builder) AppendValues(v []uint64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Uint64Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Uint64).Uint64Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Uint64Builder) Value(i int) uint64 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Uint64Builder) SetValid(i int, v uint64) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Uint64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -252,6 +326,10 @@ func (b *Uint64Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Uint64Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -284,8 +362,8 @@ func (b *Uint64Builder) NewUint64Array() (a *Uint64) {
 func (b *Uint64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Uint64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Uint64, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -328,6 +406,11 @@ func (b *Float64Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Float64Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Float64
+}
+
 func (b *Float64Builder) Append(v float64) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -338,18 +421,20 @@ func (b *Float64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Float64Builder) UnsafeAppend(v float64) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Float64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Float64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Float64Builder) UnsafeAppend(v float64) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -370,6 +455,34 @@ func (b *Float64Builder) AppendValues(v []float64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Float64Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Float64).Float64Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Float64Builder) Value(i int) float64 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Float64Builder) SetValid(i int, v float64) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Float64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -388,6 +501,10 @@ func (b *Float64Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Float64Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -420,8 +537,8 @@ func (b *Float64Builder) NewFloat64Array() (a *Float64) {
 func (b *Float64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Float64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Float64, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -464,6 +581,11 @@ func (b *Int32Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Int32Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Int32
+}
+
 func (b *Int32Builder) Append(v int32) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -474,18 +596,20 @@ func (b *Int32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Int32Builder) UnsafeAppend(v int32) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Int32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Int32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Int32Builder) UnsafeAppend(v int32) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -506,6 +630,34 @@ func (b *Int32Builder) AppendValues(v []int32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Int32Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Int32).Int32Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Int32Builder) Value(i int) int32 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Int32Builder) SetValid(i int, v int32) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Int32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -524,6 +676,10 @@ func (b *Int32Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Int32Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -556,8 +712,8 @@ func (b *Int32Builder) NewInt32Array() (a *Int32) {
 func (b *Int32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Int32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Int32, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -600,6 +756,11 @@ func (b *Uint32Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Uint32Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Uint32
+}
+
 func (b *Uint32Builder) Append(v uint32) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -610,18 +771,20 @@ func (b *Uint32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Uint32Builder) UnsafeAppend(v uint32) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Uint32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Uint32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Uint32Builder) UnsafeAppend(v uint32) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -642,6 +805,34 @@ func (b *Uint32Builder) AppendValues(v []uint32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Uint32Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Uint32).Uint32Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Uint32Builder) Value(i int) uint32 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Uint32Builder) SetValid(i int, v uint32) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Uint32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -660,6 +851,10 @@ func (b *Uint32Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Uint32Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -692,8 +887,8 @@ func (b *Uint32Builder) NewUint32Array() (a *Uint32) {
 func (b *Uint32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Uint32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Uint32, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -736,6 +931,11 @@ func (b *Float32Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Float32Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Float32
+}
+
 func (b *Float32Builder) Append(v float32) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -746,18 +946,20 @@ func (b *Float32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Float32Builder) UnsafeAppend(v float32) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Float32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Float32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Float32Builder) UnsafeAppend(v float32) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -778,6 +980,34 @@ func (b *Float32Builder) AppendValues(v []float32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Float32Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Float32).Float32Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Float32Builder) Value(i int) float32 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Float32Builder) SetValid(i int, v float32) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Float32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -796,6 +1026,10 @@ func (b *Float32Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Float32Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -828,8 +1062,8 @@ func (b *Float32Builder) NewFloat32Array() (a *Float32) {
 func (b *Float32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Float32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Float32, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -872,6 +1106,11 @@ func (b *Int16Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Int16Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Int16
+}
+
 func (b *Int16Builder) Append(v int16) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -882,18 +1121,20 @@ func (b *Int16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Int16Builder) UnsafeAppend(v int16) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Int16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Int16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Int16Builder) UnsafeAppend(v int16) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -914,6 +1155,34 @@ func (b *Int16Builder) AppendValues(v []int16, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Int16Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Int16).Int16Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Int16Builder) Value(i int) int16 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Int16Builder) SetValid(i int, v int16) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Int16Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -932,6 +1201,10 @@ func (b *Int16Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Int16Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -964,8 +1237,8 @@ func (b *Int16Builder) NewInt16Array() (a *Int16) {
 func (b *Int16Builder) newData() (data *Data) {
 	bytesRequired := arrow.Int16Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Int16, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1008,6 +1281,11 @@ func (b *Uint16Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Uint16Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Uint16
+}
+
 func (b *Uint16Builder) Append(v uint16) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1018,18 +1296,20 @@ func (b *Uint16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Uint16Builder) UnsafeAppend(v uint16) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Uint16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Uint16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Uint16Builder) UnsafeAppend(v uint16) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1050,6 +1330,34 @@ func (b *Uint16Builder) AppendValues(v []uint16, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Uint16Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Uint16).Uint16Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Uint16Builder) Value(i int) uint16 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Uint16Builder) SetValid(i int, v uint16) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Uint16Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1068,6 +1376,10 @@ func (b *Uint16Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Uint16Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1100,8 +1412,8 @@ func (b *Uint16Builder) NewUint16Array() (a *Uint16) {
 func (b *Uint16Builder) newData() (data *Data) {
 	bytesRequired := arrow.Uint16Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Uint16, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1144,6 +1456,11 @@ func (b *Int8Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Int8Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Int8
+}
+
 func (b *Int8Builder) Append(v int8) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1154,18 +1471,20 @@ func (b *Int8Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Int8Builder) UnsafeAppend(v int8) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Int8Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Int8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Int8Builder) UnsafeAppend(v int8) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1186,6 +1505,34 @@ func (b *Int8Builder) AppendValues(v []int8, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Int8Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Int8).Int8Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Int8Builder) Value(i int) int8 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Int8Builder) SetValid(i int, v int8) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Int8Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1204,6 +1551,10 @@ func (b *Int8Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Int8Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1236,8 +1587,8 @@ func (b *Int8Builder) NewInt8Array() (a *Int8) {
 func (b *Int8Builder) newData() (data *Data) {
 	bytesRequired := arrow.Int8Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Int8, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1280,6 +1631,11 @@ func (b *Uint8Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Uint8Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Uint8
+}
+
 func (b *Uint8Builder) Append(v uint8) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1290,18 +1646,20 @@ func (b *Uint8Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Uint8Builder) UnsafeAppend(v uint8) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Uint8Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Uint8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Uint8Builder) UnsafeAppend(v uint8) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1322,6 +1680,34 @@ func (b *Uint8Builder) AppendValues(v []uint8, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Uint8Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Uint8).Uint8Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Uint8Builder) Value(i int) uint8 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Uint8Builder) SetValid(i int, v uint8) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Uint8Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1340,6 +1726,10 @@ func (b *Uint8Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Uint8Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1372,8 +1762,8 @@ func (b *Uint8Builder) NewUint8Array() (a *Uint8) {
 func (b *Uint8Builder) newData() (data *Data) {
 	bytesRequired := arrow.Uint8Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Uint8, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1417,6 +1807,11 @@ func (b *TimestampBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *TimestampBuilder) Type() arrow.DataType {
+	return b.dtype
+}
+
 func (b *TimestampBuilder) Append(v arrow.Timestamp) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1427,18 +1822,20 @@ func (b *TimestampBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *TimestampBuilder) UnsafeAppend(v arrow.Timestamp) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *TimestampBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *TimestampBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *TimestampBuilder) UnsafeAppend(v arrow.Timestamp) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1459,6 +1856,34 @@ func (b *TimestampBuilder) AppendValues(v []arrow.Timestamp, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *TimestampBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Timestamp).TimestampValues()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *TimestampBuilder) Value(i int) arrow.Timestamp {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *TimestampBuilder) SetValid(i int, v arrow.Timestamp) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *TimestampBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1477,6 +1902,10 @@ func (b *TimestampBuilder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *TimestampBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1509,8 +1938,8 @@ func (b *TimestampBuilder) NewTimestampArray() (a *Timestamp) {
 func (b *TimestampBuilder) newData() (data *Data) {
 	bytesRequired := arrow.TimestampTraits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1554,6 +1983,11 @@ func (b *Time32Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Time32Builder) Type() arrow.DataType {
+	return b.dtype
+}
+
 func (b *Time32Builder) Append(v arrow.Time32) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1564,18 +1998,20 @@ func (b *Time32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Time32Builder) UnsafeAppend(v arrow.Time32) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Time32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Time32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Time32Builder) UnsafeAppend(v arrow.Time32) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1596,6 +2032,34 @@ func (b *Time32Builder) AppendValues(v []arrow.Time32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Time32Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Time32).Time32Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Time32Builder) Value(i int) arrow.Time32 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Time32Builder) SetValid(i int, v arrow.Time32) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Time32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1614,6 +2078,10 @@ func (b *Time32Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Time32Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1646,8 +2114,8 @@ func (b *Time32Builder) NewTime32Array() (a *Time32) {
 func (b *Time32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Time32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1691,6 +2159,11 @@ func (b *Time64Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Time64Builder) Type() arrow.DataType {
+	return b.dtype
+}
+
 func (b *Time64Builder) Append(v arrow.Time64) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1701,18 +2174,20 @@ func (b *Time64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Time64Builder) UnsafeAppend(v arrow.Time64) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Time64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Time64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Time64Builder) UnsafeAppend(v arrow.Time64) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1733,6 +2208,34 @@ func (b *Time64Builder) AppendValues(v []arrow.Time64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Time64Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Time64).Time64Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Time64Builder) Value(i int) arrow.Time64 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Time64Builder) SetValid(i int, v arrow.Time64) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Time64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1751,6 +2254,10 @@ func (b *Time64Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Time64Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1783,8 +2290,8 @@ func (b *Time64Builder) NewTime64Array() (a *Time64) {
 func (b *Time64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Time64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1827,6 +2334,11 @@ func (b *Date32Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Date32Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Date32
+}
+
 func (b *Date32Builder) Append(v arrow.Date32) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1837,18 +2349,20 @@ func (b *Date32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Date32Builder) UnsafeAppend(v arrow.Date32) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Date32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Date32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Date32Builder) UnsafeAppend(v arrow.Date32) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -1869,6 +2383,34 @@ func (b *Date32Builder) AppendValues(v []arrow.Date32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Date32Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Date32).Date32Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Date32Builder) Value(i int) arrow.Date32 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Date32Builder) SetValid(i int, v arrow.Date32) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Date32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1887,6 +2429,10 @@ func (b *Date32Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Date32Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -1919,8 +2465,8 @@ func (b *Date32Builder) NewDate32Array() (a *Date32) {
 func (b *Date32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Date32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Date32, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -1963,6 +2509,11 @@ func (b *Date64Builder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *Date64Builder) Type() arrow.DataType {
+	return arrow.PrimitiveTypes.Date64
+}
+
 func (b *Date64Builder) Append(v arrow.Date64) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -1973,18 +2524,20 @@ func (b *Date64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *Date64Builder) UnsafeAppend(v arrow.Date64) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *Date64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *Date64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *Date64Builder) UnsafeAppend(v arrow.Date64) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -2005,6 +2558,34 @@ func (b *Date64Builder) AppendValues(v []arrow.Date64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *Date64Builder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Date64).Date64Values()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *Date64Builder) Value(i int) arrow.Date64 {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *Date64Builder) SetValid(i int, v arrow.Date64) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *Date64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -2023,6 +2604,10 @@ func (b *Date64Builder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *Date64Builder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -2055,8 +2640,8 @@ func (b *Date64Builder) NewDate64Array() (a *Date64) {
 func (b *Date64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Date64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(arrow.PrimitiveTypes.Date64, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -2100,6 +2685,11 @@ func (b *DurationBuilder) Release() {
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *DurationBuilder) Type() arrow.DataType {
+	return b.dtype
+}
+
 func (b *DurationBuilder) Append(v arrow.Duration) {
 	b.Reserve(1)
 	b.UnsafeAppend(v)
@@ -2110,18 +2700,20 @@ func (b *DurationBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
-func (b *DurationBuilder) UnsafeAppend(v arrow.Duration) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.rawData[b.length] = v
-	b.length++
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *DurationBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
 }
 
-func (b *DurationBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *DurationBuilder) UnsafeAppend(v arrow.Duration) {
+	if !b.noNulls {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
 	}
+	b.rawData[b.length] = v
 	b.length++
 }
 
@@ -2142,6 +2734,34 @@ func (b *DurationBuilder) AppendValues(v []arrow.Duration, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *DurationBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*Duration).DurationValues()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *DurationBuilder) Value(i int) arrow.Duration {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *DurationBuilder) SetValid(i int, v arrow.Duration) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
 func (b *DurationBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -2160,6 +2780,10 @@ func (b *DurationBuilder) Reserve(n int) {
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *DurationBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
 	nBuilder := n
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
@@ -2192,8 +2816,8 @@ func (b *DurationBuilder) NewDurationArray() (a *Duration) {
 func (b *DurationBuilder) newData() (data *Data) {
 	bytesRequired := arrow.DurationTraits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
-		// trim buffers
-		b.data.Resize(bytesRequired)
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
 	}
 	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
 	b.reset()
@@ -2207,6 +2831,181 @@ func (b *DurationBuilder) newData() (data *Data) {
 	return
 }
 
+type MonthIntervalBuilder struct {
+	builder
+
+	data    *memory.Buffer
+	rawData []arrow.MonthInterval
+}
+
+func NewMonthIntervalBuilder(mem memory.Allocator) *MonthIntervalBuilder {
+	return &MonthIntervalBuilder{builder: builder{refCount: 1, mem: mem}}
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+func (b *MonthIntervalBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.nullBitmap != nil {
+			b.nullBitmap.Release()
+			b.nullBitmap = nil
+		}
+		if b.data != nil {
+			b.data.Release()
+			b.data = nil
+			b.rawData = nil
+		}
+	}
+}
+
+// Type returns the data type of the arrays created by the builder.
+func (b *MonthIntervalBuilder) Type() arrow.DataType {
+	return arrow.FixedWidthTypes.MonthInterval
+}
+
+func (b *MonthIntervalBuilder) Append(v arrow.MonthInterval) {
+	b.Reserve(1)
+	b.UnsafeAppend(v)
+}
+
+func (b *MonthIntervalBuilder) AppendNull() {
+	b.Reserve(1)
+	b.UnsafeAppendBoolToBitmap(false)
+}
+
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *MonthIntervalBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+// UnsafeAppend appends v to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve beforehand to
+// make room for the new element.
+func (b *MonthIntervalBuilder) UnsafeAppend(v arrow.MonthInterval) {
+	if !b.noNulls {
+		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	}
+	b.rawData[b.length] = v
+	b.length++
+}
+
+// AppendValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *MonthIntervalBuilder) AppendValues(v []arrow.MonthInterval, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		panic("len(v) != len(valid) && len(valid) != 0")
+	}
+
+	if len(v) == 0 {
+		return
+	}
+
+	b.Reserve(len(v))
+	arrow.MonthIntervalTraits.Copy(b.rawData[b.length:], v)
+	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
+}
+
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *MonthIntervalBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	b.Reserve(n)
+	copy(b.rawData[b.length:], arr.(*MonthInterval).MonthIntervalValues()[start:end])
+	b.builder.unsafeAppendValidity(arr.Data(), start, n)
+}
+
+// Value returns the i-th value appended to the builder, which is not
+// meaningful if the element is null.
+// Value panics if i is not in the range [0, Len()).
+func (b *MonthIntervalBuilder) Value(i int) arrow.MonthInterval {
+	b.checkIndex(i)
+	return b.rawData[i]
+}
+
+// SetValid overwrites the i-th value appended to the builder with v, and
+// marks it as valid, e.g. to fix a slot previously set to null.
+// SetValid panics if i is not in the range [0, Len()).
+func (b *MonthIntervalBuilder) SetValid(i int, v arrow.MonthInterval) {
+	b.setValid(i)
+	b.rawData[i] = v
+}
+
+func (b *MonthIntervalBuilder) init(capacity int) {
+	b.builder.init(capacity)
+
+	b.data = memory.NewResizableBuffer(b.mem)
+	bytesN := arrow.MonthIntervalTraits.BytesRequired(capacity)
+	b.data.Resize(bytesN)
+	b.rawData = arrow.MonthIntervalTraits.CastFromBytes(b.data.Bytes())
+}
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+func (b *MonthIntervalBuilder) Reserve(n int) {
+	b.builder.reserve(n, b.Resize)
+}
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+func (b *MonthIntervalBuilder) Resize(n int) {
+	if n < b.length {
+		// never drop the appended values.
+		n = b.length
+	}
+	nBuilder := n
+	if n < minBuilderCapacity {
+		n = minBuilderCapacity
+	}
+
+	if b.capacity == 0 {
+		b.init(n)
+	} else {
+		b.builder.resize(nBuilder, b.init)
+		b.data.Resize(arrow.MonthIntervalTraits.BytesRequired(n))
+		b.rawData = arrow.MonthIntervalTraits.CastFromBytes(b.data.Bytes())
+	}
+}
+
+// NewArray creates a MonthInterval array from the memory buffers used by the builder and resets the MonthIntervalBuilder
+// so it can be used to build a new array.
+func (b *MonthIntervalBuilder) NewArray() Interface {
+	return b.NewMonthIntervalArray()
+}
+
+// NewMonthIntervalArray creates a MonthInterval array from the memory buffers used by the builder and resets the MonthIntervalBuilder
+// so it can be used to build a new array.
+func (b *MonthIntervalBuilder) NewMonthIntervalArray() (a *MonthInterval) {
+	data := b.newData()
+	a = NewMonthIntervalData(data)
+	data.Release()
+	return
+}
+
+func (b *MonthIntervalBuilder) newData() (data *Data) {
+	bytesRequired := arrow.MonthIntervalTraits.BytesRequired(b.length)
+	if bytesRequired > 0 && bytesRequired < b.data.Len() {
+		// trim buffers, without reallocating them.
+		b.data.ResizeNoShrink(bytesRequired)
+	}
+	data = NewData(arrow.FixedWidthTypes.MonthInterval, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
+	b.reset()
+
+	if b.data != nil {
+		b.data.Release()
+		b.data = nil
+		b.rawData = nil
+	}
+
+	return
+}
+
 var (
 	_ Builder = (*Int64Builder)(nil)
 	_ Builder = (*Uint64Builder)(nil)
@@ -2224,4 +3023,5 @@ var (
 	_ Builder = (*Date32Builder)(nil)
 	_ Builder = (*Date64Builder)(nil)
 	_ Builder = (*DurationBuilder)(nil)
+	_ Builder = (*MonthIntervalBuilder)(nil)
 )
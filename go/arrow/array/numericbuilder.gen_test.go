@@ -134,6 +134,97 @@ func TestInt64Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestInt64Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt64Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewInt64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, int64(1), a.Value(0))
+	assert.Equal(t, int64(2), a.Value(2))
+	assert.Equal(t, int64(3), a.Value(3))
+}
+
+func TestInt64Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt64Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(int64(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, int64(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewInt64Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestInt64Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt64Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]int64{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewInt64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, int64(1), a.Value(0))
+	assert.Equal(t, int64(5), a.Value(1))
+	assert.Equal(t, int64(7), a.Value(3))
+}
+
 func TestInt64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -187,10 +278,12 @@ func TestInt64Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewUint64Builder(t *testing.T) {
@@ -300,6 +393,97 @@ func TestUint64Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestUint64Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint64Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewUint64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, uint64(1), a.Value(0))
+	assert.Equal(t, uint64(2), a.Value(2))
+	assert.Equal(t, uint64(3), a.Value(3))
+}
+
+func TestUint64Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint64Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(uint64(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, uint64(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewUint64Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestUint64Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint64Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]uint64{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewUint64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, uint64(1), a.Value(0))
+	assert.Equal(t, uint64(5), a.Value(1))
+	assert.Equal(t, uint64(7), a.Value(3))
+}
+
 func TestUint64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -353,10 +537,12 @@ func TestUint64Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewFloat64Builder(t *testing.T) {
@@ -466,6 +652,97 @@ func TestFloat64Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestFloat64Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat64Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewFloat64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, float64(1), a.Value(0))
+	assert.Equal(t, float64(2), a.Value(2))
+	assert.Equal(t, float64(3), a.Value(3))
+}
+
+func TestFloat64Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat64Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(float64(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, float64(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewFloat64Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestFloat64Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat64Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]float64{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewFloat64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, float64(1), a.Value(0))
+	assert.Equal(t, float64(5), a.Value(1))
+	assert.Equal(t, float64(7), a.Value(3))
+}
+
 func TestFloat64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -519,10 +796,12 @@ func TestFloat64Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewInt32Builder(t *testing.T) {
@@ -632,6 +911,97 @@ func TestInt32Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestInt32Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt32Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewInt32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, int32(1), a.Value(0))
+	assert.Equal(t, int32(2), a.Value(2))
+	assert.Equal(t, int32(3), a.Value(3))
+}
+
+func TestInt32Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt32Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(int32(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, int32(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewInt32Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestInt32Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt32Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]int32{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewInt32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, int32(1), a.Value(0))
+	assert.Equal(t, int32(5), a.Value(1))
+	assert.Equal(t, int32(7), a.Value(3))
+}
+
 func TestInt32Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -685,10 +1055,12 @@ func TestInt32Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewUint32Builder(t *testing.T) {
@@ -798,6 +1170,97 @@ func TestUint32Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestUint32Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint32Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewUint32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, uint32(1), a.Value(0))
+	assert.Equal(t, uint32(2), a.Value(2))
+	assert.Equal(t, uint32(3), a.Value(3))
+}
+
+func TestUint32Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint32Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(uint32(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, uint32(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewUint32Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestUint32Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint32Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]uint32{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewUint32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, uint32(1), a.Value(0))
+	assert.Equal(t, uint32(5), a.Value(1))
+	assert.Equal(t, uint32(7), a.Value(3))
+}
+
 func TestUint32Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -851,10 +1314,12 @@ func TestUint32Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewFloat32Builder(t *testing.T) {
@@ -964,6 +1429,97 @@ func TestFloat32Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestFloat32Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat32Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewFloat32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, float32(1), a.Value(0))
+	assert.Equal(t, float32(2), a.Value(2))
+	assert.Equal(t, float32(3), a.Value(3))
+}
+
+func TestFloat32Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat32Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(float32(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, float32(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewFloat32Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestFloat32Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewFloat32Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]float32{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewFloat32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, float32(1), a.Value(0))
+	assert.Equal(t, float32(5), a.Value(1))
+	assert.Equal(t, float32(7), a.Value(3))
+}
+
 func TestFloat32Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -1017,10 +1573,12 @@ func TestFloat32Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewInt16Builder(t *testing.T) {
@@ -1130,6 +1688,97 @@ func TestInt16Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestInt16Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt16Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewInt16Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, int16(1), a.Value(0))
+	assert.Equal(t, int16(2), a.Value(2))
+	assert.Equal(t, int16(3), a.Value(3))
+}
+
+func TestInt16Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt16Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(int16(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, int16(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewInt16Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestInt16Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt16Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]int16{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewInt16Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, int16(1), a.Value(0))
+	assert.Equal(t, int16(5), a.Value(1))
+	assert.Equal(t, int16(7), a.Value(3))
+}
+
 func TestInt16Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -1183,10 +1832,12 @@ func TestInt16Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewUint16Builder(t *testing.T) {
@@ -1296,18 +1947,109 @@ func TestUint16Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
-func TestUint16Builder_Empty(t *testing.T) {
+func TestUint16Builder_UnsafeAppend(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
 
 	ab := array.NewUint16Builder(mem)
 	defer ab.Release()
 
-	exp := []uint16{0, 1, 2, 3}
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
 
-	ab.AppendValues([]uint16{}, nil)
 	a := ab.NewUint16Array()
-	assert.Zero(t, a.Len())
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, uint16(1), a.Value(0))
+	assert.Equal(t, uint16(2), a.Value(2))
+	assert.Equal(t, uint16(3), a.Value(3))
+}
+
+func TestUint16Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint16Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(uint16(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, uint16(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewUint16Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestUint16Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint16Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]uint16{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewUint16Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, uint16(1), a.Value(0))
+	assert.Equal(t, uint16(5), a.Value(1))
+	assert.Equal(t, uint16(7), a.Value(3))
+}
+
+func TestUint16Builder_Empty(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint16Builder(mem)
+	defer ab.Release()
+
+	exp := []uint16{0, 1, 2, 3}
+
+	ab.AppendValues([]uint16{}, nil)
+	a := ab.NewUint16Array()
+	assert.Zero(t, a.Len())
 	a.Release()
 
 	ab.AppendValues(nil, nil)
@@ -1349,10 +2091,12 @@ func TestUint16Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewInt8Builder(t *testing.T) {
@@ -1462,6 +2206,97 @@ func TestInt8Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestInt8Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt8Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewInt8Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, int8(1), a.Value(0))
+	assert.Equal(t, int8(2), a.Value(2))
+	assert.Equal(t, int8(3), a.Value(3))
+}
+
+func TestInt8Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt8Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(int8(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, int8(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewInt8Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestInt8Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewInt8Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]int8{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewInt8Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, int8(1), a.Value(0))
+	assert.Equal(t, int8(5), a.Value(1))
+	assert.Equal(t, int8(7), a.Value(3))
+}
+
 func TestInt8Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -1515,10 +2350,12 @@ func TestInt8Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewUint8Builder(t *testing.T) {
@@ -1628,6 +2465,97 @@ func TestUint8Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestUint8Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint8Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewUint8Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, uint8(1), a.Value(0))
+	assert.Equal(t, uint8(2), a.Value(2))
+	assert.Equal(t, uint8(3), a.Value(3))
+}
+
+func TestUint8Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint8Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(uint8(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, uint8(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewUint8Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestUint8Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewUint8Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]uint8{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewUint8Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, uint8(1), a.Value(0))
+	assert.Equal(t, uint8(5), a.Value(1))
+	assert.Equal(t, uint8(7), a.Value(3))
+}
+
 func TestUint8Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -1681,10 +2609,12 @@ func TestUint8Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewTimestampBuilder(t *testing.T) {
@@ -1796,6 +2726,100 @@ func TestTimestampBuilder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestTimestampBuilder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.TimestampType{Unit: arrow.Second}
+	ab := array.NewTimestampBuilder(mem, dtype)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewTimestampArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Timestamp(1), a.Value(0))
+	assert.Equal(t, arrow.Timestamp(2), a.Value(2))
+	assert.Equal(t, arrow.Timestamp(3), a.Value(3))
+}
+
+func TestTimestampBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.TimestampType{Unit: arrow.Second}
+	ab := array.NewTimestampBuilder(mem, dtype)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Timestamp(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Timestamp(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewTimestampArray()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestTimestampBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.TimestampType{Unit: arrow.Second}
+	ab := array.NewTimestampBuilder(mem, dtype)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Timestamp{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewTimestampArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Timestamp(1), a.Value(0))
+	assert.Equal(t, arrow.Timestamp(5), a.Value(1))
+	assert.Equal(t, arrow.Timestamp(7), a.Value(3))
+}
+
 func TestTimestampBuilder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -1851,10 +2875,12 @@ func TestTimestampBuilder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewTime32Builder(t *testing.T) {
@@ -1966,6 +2992,100 @@ func TestTime32Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestTime32Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time32Type{Unit: arrow.Second}
+	ab := array.NewTime32Builder(mem, dtype)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewTime32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Time32(1), a.Value(0))
+	assert.Equal(t, arrow.Time32(2), a.Value(2))
+	assert.Equal(t, arrow.Time32(3), a.Value(3))
+}
+
+func TestTime32Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time32Type{Unit: arrow.Second}
+	ab := array.NewTime32Builder(mem, dtype)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Time32(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Time32(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewTime32Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestTime32Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time32Type{Unit: arrow.Second}
+	ab := array.NewTime32Builder(mem, dtype)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Time32{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewTime32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Time32(1), a.Value(0))
+	assert.Equal(t, arrow.Time32(5), a.Value(1))
+	assert.Equal(t, arrow.Time32(7), a.Value(3))
+}
+
 func TestTime32Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -2021,10 +3141,12 @@ func TestTime32Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewTime64Builder(t *testing.T) {
@@ -2136,6 +3258,100 @@ func TestTime64Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestTime64Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	ab := array.NewTime64Builder(mem, dtype)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewTime64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Time64(1), a.Value(0))
+	assert.Equal(t, arrow.Time64(2), a.Value(2))
+	assert.Equal(t, arrow.Time64(3), a.Value(3))
+}
+
+func TestTime64Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	ab := array.NewTime64Builder(mem, dtype)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Time64(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Time64(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewTime64Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestTime64Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	ab := array.NewTime64Builder(mem, dtype)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Time64{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewTime64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Time64(1), a.Value(0))
+	assert.Equal(t, arrow.Time64(5), a.Value(1))
+	assert.Equal(t, arrow.Time64(7), a.Value(3))
+}
+
 func TestTime64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -2191,10 +3407,12 @@ func TestTime64Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewDate32Builder(t *testing.T) {
@@ -2304,6 +3522,97 @@ func TestDate32Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestDate32Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate32Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewDate32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Date32(1), a.Value(0))
+	assert.Equal(t, arrow.Date32(2), a.Value(2))
+	assert.Equal(t, arrow.Date32(3), a.Value(3))
+}
+
+func TestDate32Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate32Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Date32(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Date32(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewDate32Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestDate32Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate32Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Date32{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewDate32Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Date32(1), a.Value(0))
+	assert.Equal(t, arrow.Date32(5), a.Value(1))
+	assert.Equal(t, arrow.Date32(7), a.Value(3))
+}
+
 func TestDate32Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -2357,10 +3666,12 @@ func TestDate32Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewDate64Builder(t *testing.T) {
@@ -2470,6 +3781,97 @@ func TestDate64Builder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestDate64Builder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate64Builder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewDate64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Date64(1), a.Value(0))
+	assert.Equal(t, arrow.Date64(2), a.Value(2))
+	assert.Equal(t, arrow.Date64(3), a.Value(3))
+}
+
+func TestDate64Builder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate64Builder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Date64(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Date64(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewDate64Array()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestDate64Builder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewDate64Builder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Date64{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewDate64Array()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Date64(1), a.Value(0))
+	assert.Equal(t, arrow.Date64(5), a.Value(1))
+	assert.Equal(t, arrow.Date64(7), a.Value(3))
+}
+
 func TestDate64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -2523,10 +3925,12 @@ func TestDate64Builder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
 
 func TestNewDurationBuilder(t *testing.T) {
@@ -2638,6 +4042,100 @@ func TestDurationBuilder_AppendValues(t *testing.T) {
 	a.Release()
 }
 
+func TestDurationBuilder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DurationType{Unit: arrow.Second}
+	ab := array.NewDurationBuilder(mem, dtype)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewDurationArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.Duration(1), a.Value(0))
+	assert.Equal(t, arrow.Duration(2), a.Value(2))
+	assert.Equal(t, arrow.Duration(3), a.Value(3))
+}
+
+func TestDurationBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DurationType{Unit: arrow.Second}
+	ab := array.NewDurationBuilder(mem, dtype)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.Duration(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.Duration(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewDurationArray()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestDurationBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DurationType{Unit: arrow.Second}
+	ab := array.NewDurationBuilder(mem, dtype)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.Duration{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewDurationArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.Duration(1), a.Value(0))
+	assert.Equal(t, arrow.Duration(5), a.Value(1))
+	assert.Equal(t, arrow.Duration(7), a.Value(3))
+}
+
 func TestDurationBuilder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -2693,8 +4191,269 @@ func TestDurationBuilder_Resize(t *testing.T) {
 	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(5)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
+
+	ab.Resize(32)
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
+}
+
+func TestNewMonthIntervalBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	ab.Retain()
+	ab.Release()
+
+	ab.Append(1)
+	ab.Append(2)
+	ab.Append(3)
+	ab.AppendNull()
+	ab.Append(5)
+	ab.Append(6)
+	ab.AppendNull()
+	ab.Append(8)
+	ab.Append(9)
+	ab.Append(10)
+
+	// check state of builder before NewMonthIntervalArray
+	assert.Equal(t, 10, ab.Len(), "unexpected Len()")
+	assert.Equal(t, 2, ab.NullN(), "unexpected NullN()")
+
+	a := ab.NewMonthIntervalArray()
+
+	// check state of builder after NewMonthIntervalArray
+	assert.Zero(t, ab.Len(), "unexpected ArrayBuilder.Len(), NewMonthIntervalArray did not reset state")
+	assert.Zero(t, ab.Cap(), "unexpected ArrayBuilder.Cap(), NewMonthIntervalArray did not reset state")
+	assert.Zero(t, ab.NullN(), "unexpected ArrayBuilder.NullN(), NewMonthIntervalArray did not reset state")
+
+	// check state of array
+	assert.Equal(t, 2, a.NullN(), "unexpected null count")
+	assert.Equal(t, []arrow.MonthInterval{1, 2, 3, 0, 5, 6, 0, 8, 9, 10}, a.MonthIntervalValues(), "unexpected MonthIntervalValues")
+	assert.Equal(t, []byte{0xb7}, a.NullBitmapBytes()[:1]) // 4 bytes due to minBuilderCapacity
+	assert.Len(t, a.MonthIntervalValues(), 10, "unexpected length of MonthIntervalValues")
+
+	a.Release()
+
+	ab.Append(7)
+	ab.Append(8)
+
+	a = ab.NewMonthIntervalArray()
+
+	assert.Equal(t, 0, a.NullN())
+	assert.Equal(t, []arrow.MonthInterval{7, 8}, a.MonthIntervalValues())
+	assert.Len(t, a.MonthIntervalValues(), 2)
+
+	a.Release()
+
+	var (
+		want   = []arrow.MonthInterval{1, 2, 3, 4}
+		valids = []bool{true, true, false, true}
+	)
+
+	ab.AppendValues(want, valids)
+	a = ab.NewMonthIntervalArray()
+
+	sub := array.MakeFromData(a.Data())
+	defer sub.Release()
+
+	if got, want := sub.DataType().ID(), a.DataType().ID(); got != want {
+		t.Fatalf("invalid type: got=%q, want=%q", got, want)
+	}
+
+	if _, ok := sub.(*array.MonthInterval); !ok {
+		t.Fatalf("could not type-assert to array.MonthInterval")
+	}
+
+	if got, want := a.String(), `[1 2 (null) 4]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	slice := array.NewSliceData(a.Data(), 2, 4)
+	defer slice.Release()
+
+	sub1 := array.MakeFromData(slice)
+	defer sub1.Release()
+
+	v, ok := sub1.(*array.MonthInterval)
+	if !ok {
+		t.Fatalf("could not type-assert to array.MonthInterval")
+	}
+
+	if got, want := v.String(), `[(null) 4]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	a.Release()
+}
+
+func TestMonthIntervalBuilder_AppendValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	exp := []arrow.MonthInterval{0, 1, 2, 3}
+	ab.AppendValues(exp, nil)
+	a := ab.NewMonthIntervalArray()
+	assert.Equal(t, exp, a.MonthIntervalValues())
+
+	a.Release()
+}
+
+func TestMonthIntervalBuilder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	ab.Reserve(4)
+	ab.UnsafeAppend(1)
+	ab.UnsafeAppendBoolToBitmap(false)
+	ab.UnsafeAppend(2)
+	ab.UnsafeAppend(3)
+	assert.Equal(t, 4, ab.Len())
+	assert.Equal(t, 1, ab.NullN())
+
+	a := ab.NewMonthIntervalArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.True(t, a.IsNull(1))
+	assert.Equal(t, arrow.MonthInterval(1), a.Value(0))
+	assert.Equal(t, arrow.MonthInterval(2), a.Value(2))
+	assert.Equal(t, arrow.MonthInterval(3), a.Value(3))
+}
+
+func TestMonthIntervalBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	// append past the initial capacity, so that the builder is resized.
+	const n = 100
+	for i := 0; i < n; i++ {
+		if i%10 == 3 {
+			ab.AppendNull()
+			continue
+		}
+		ab.Append(arrow.MonthInterval(i))
+	}
+	assert.True(t, ab.Cap() > 32)
+
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%10 == 3, ab.IsNull(i))
+		assert.Equal(t, i%10 != 3, ab.IsValid(i))
+		if ab.IsValid(i) {
+			assert.Equal(t, arrow.MonthInterval(i), ab.Value(i))
+		}
+	}
+	assert.Panics(t, func() { ab.Value(n) })
+	assert.Panics(t, func() { ab.IsNull(-1) })
+
+	a := ab.NewMonthIntervalArray()
+	defer a.Release()
+	assert.Panics(t, func() { ab.Value(0) })
+}
+
+func TestMonthIntervalBuilder_SetNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]arrow.MonthInterval{1, 2, 3, 4}, []bool{true, true, false, true})
+
+	ab.SetNull(1)
+	ab.SetNull(1)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(1, 5)
+	ab.SetValid(2, 6)
+	assert.Equal(t, 0, ab.NullN())
+	ab.SetNull(2)
+	ab.SetNull(3)
+	assert.Equal(t, 2, ab.NullN())
+	ab.SetValid(3, 7)
+	assert.Equal(t, 1, ab.NullN())
+	assert.Panics(t, func() { ab.SetNull(4) })
+	assert.Panics(t, func() { ab.SetValid(-1, 0) })
+
+	a := ab.NewMonthIntervalArray()
+	defer a.Release()
+
+	assert.Equal(t, 1, a.NullN())
+	assert.Equal(t, []bool{true, true, false, true}, []bool{a.IsValid(0), a.IsValid(1), a.IsValid(2), a.IsValid(3)})
+	assert.Equal(t, arrow.MonthInterval(1), a.Value(0))
+	assert.Equal(t, arrow.MonthInterval(5), a.Value(1))
+	assert.Equal(t, arrow.MonthInterval(7), a.Value(3))
+}
+
+func TestMonthIntervalBuilder_Empty(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	exp := []arrow.MonthInterval{0, 1, 2, 3}
+
+	ab.AppendValues([]arrow.MonthInterval{}, nil)
+	a := ab.NewMonthIntervalArray()
+	assert.Zero(t, a.Len())
+	a.Release()
+
+	ab.AppendValues(nil, nil)
+	a = ab.NewMonthIntervalArray()
+	assert.Zero(t, a.Len())
+	a.Release()
+
+	ab.AppendValues([]arrow.MonthInterval{}, nil)
+	ab.AppendValues(exp, nil)
+	a = ab.NewMonthIntervalArray()
+	assert.Equal(t, exp, a.MonthIntervalValues())
+	a.Release()
+
+	ab.AppendValues(exp, nil)
+	ab.AppendValues([]arrow.MonthInterval{}, nil)
+	a = ab.NewMonthIntervalArray()
+	assert.Equal(t, exp, a.MonthIntervalValues())
+	a.Release()
+}
+
+func TestMonthIntervalBuilder_Resize(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewMonthIntervalBuilder(mem)
+	defer ab.Release()
+
+	assert.Equal(t, 0, ab.Cap())
+	assert.Equal(t, 0, ab.Len())
+
+	ab.Reserve(63)
+	assert.Equal(t, 64, ab.Cap())
+	assert.Equal(t, 0, ab.Len())
+
+	for i := 0; i < 63; i++ {
+		ab.Append(0)
+	}
+	assert.Equal(t, 64, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
+
+	ab.Resize(5)
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 
 	ab.Resize(32)
-	assert.Equal(t, 5, ab.Len())
+	assert.Equal(t, 63, ab.Cap())
+	assert.Equal(t, 63, ab.Len())
 }
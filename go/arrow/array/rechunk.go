@@ -0,0 +1,189 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Rechunk returns a chunked array holding the elements of a, in which
+// adjacent chunks of less than targetRows elements are merged into chunks of
+// at most targetRows elements. Merged chunks are copied, with Concatenate,
+// to buffers allocated with mem; chunks of at least targetRows elements are
+// kept as is, without copying. Empty chunks are dropped.
+// If targetRows is <= 0, all the chunks are merged into one.
+//
+// The returned chunked array must be Release()'d after use.
+func (a *Chunked) Rechunk(mem memory.Allocator, targetRows int64) (*Chunked, error) {
+	return rechunk(mem, a, groupBounds(chunkBounds(a), targetRows))
+}
+
+// RechunkTable returns a table holding the rows of tbl, in which adjacent
+// chunks of less than targetRows rows are merged as with Chunked.Rechunk.
+// The chunks of all the columns of the returned table have the same
+// boundaries, even if the ones of tbl do not: chunks are aligned on the
+// union of the chunk boundaries of the columns of tbl, and sliced without
+// copying when needed.
+//
+// The returned table must be Release()'d after use.
+func RechunkTable(mem memory.Allocator, tbl Table, targetRows int64) (Table, error) {
+	var bounds []int64
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		bounds = append(bounds, chunkBounds(tbl.Column(i).Data())...)
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+	groups := groupBounds(dedupBounds(bounds), targetRows)
+
+	cols := make([]Column, 0, tbl.NumCols())
+	defer func() {
+		for i := range cols {
+			cols[i].Release()
+		}
+	}()
+
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		col := tbl.Column(i)
+		chunks, err := rechunk(mem, col.Data(), groups)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/array: could not rechunk column %q: %w", col.Name(), err)
+		}
+		cols = append(cols, *NewColumn(col.Field(), chunks))
+		chunks.Release()
+	}
+	return NewTable(tbl.Schema(), cols, tbl.NumRows()), nil
+}
+
+// chunkBounds returns the offsets of the non-empty chunks of a, followed by
+// the length of a.
+func chunkBounds(a *Chunked) []int64 {
+	bounds := make([]int64, 0, len(a.chunks)+1)
+	off := int64(0)
+	for _, chunk := range a.chunks {
+		if chunk.Len() == 0 {
+			continue
+		}
+		bounds = append(bounds, off)
+		off += int64(chunk.Len())
+	}
+	return append(bounds, off)
+}
+
+// dedupBounds removes the duplicates from the sorted bounds.
+func dedupBounds(bounds []int64) []int64 {
+	out := bounds[:0]
+	for i, v := range bounds {
+		if i == 0 || v != bounds[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// groupBounds merges the adjacent ranges delimited by bounds of less than
+// target rows into ranges of at most target rows, and returns the bounds of
+// the merged ranges.
+func groupBounds(bounds []int64, target int64) []int64 {
+	if len(bounds) < 2 {
+		return bounds
+	}
+	if target <= 0 {
+		return []int64{bounds[0], bounds[len(bounds)-1]}
+	}
+
+	out := []int64{bounds[0]}
+	for i := 1; i < len(bounds); i++ {
+		var (
+			beg  = out[len(out)-1] // start of the current group
+			prev = bounds[i-1]
+			end  = bounds[i]
+		)
+		switch {
+		case end-prev >= target:
+			// large range: close the current group and keep the range as is.
+			if prev != beg {
+				out = append(out, prev)
+			}
+			out = append(out, end)
+		case end-beg > target:
+			// small range not fitting in the current group: start a new one.
+			out = append(out, prev)
+		}
+	}
+	if out[len(out)-1] != bounds[len(bounds)-1] {
+		out = append(out, bounds[len(bounds)-1])
+	}
+	return out
+}
+
+// rechunk returns a chunked array holding the elements of a, with chunks
+// delimited by bounds. Chunks of a matching a range of bounds are reused as
+// is, and ranges within a chunk are sliced without copying: the other ranges
+// are copied with Concatenate.
+func rechunk(mem memory.Allocator, a *Chunked, bounds []int64) (*Chunked, error) {
+	var (
+		chunks = make([]Interface, 0, len(bounds))
+		idx    = 0        // index of the current chunk
+		off    = int64(0) // offset of the current chunk
+	)
+	defer func() {
+		for _, chunk := range chunks {
+			chunk.Release()
+		}
+	}()
+
+	for i := 1; i < len(bounds); i++ {
+		beg, end := bounds[i-1], bounds[i]
+
+		var parts []Interface
+		for beg < end {
+			for off+int64(a.chunks[idx].Len()) <= beg {
+				off += int64(a.chunks[idx].Len())
+				idx++
+			}
+			var (
+				chunk = a.chunks[idx]
+				lo    = beg - off
+				hi    = imin64(int64(chunk.Len()), end-off)
+			)
+			if lo == 0 && hi == int64(chunk.Len()) {
+				chunk.Retain()
+				parts = append(parts, chunk)
+			} else {
+				parts = append(parts, NewSlice(chunk, lo, hi))
+			}
+			beg = off + hi
+		}
+
+		if len(parts) == 1 {
+			chunks = append(chunks, parts[0])
+			continue
+		}
+		merged, err := Concatenate(mem, parts)
+		for _, part := range parts {
+			part.Release()
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, merged)
+	}
+
+	return NewChunked(a.dtype, chunks), nil
+}
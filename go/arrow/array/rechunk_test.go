@@ -0,0 +1,222 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// makeInt64Chunked returns a chunked array of consecutive int64 values,
+// with chunks of the given sizes. Every 5th value is null.
+func makeInt64Chunked(mem memory.Allocator, sizes ...int) *array.Chunked {
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+
+	var (
+		chunks []array.Interface
+		v      int64
+	)
+	for _, n := range sizes {
+		for i := 0; i < n; i++ {
+			if v%5 == 4 {
+				bld.AppendNull()
+			} else {
+				bld.Append(v)
+			}
+			v++
+		}
+		chunks = append(chunks, bld.NewArray())
+	}
+
+	chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, chunks)
+	for _, chunk := range chunks {
+		chunk.Release()
+	}
+	return chunked
+}
+
+func chunkLens(a *array.Chunked) []int {
+	lens := []int{}
+	for _, chunk := range a.Chunks() {
+		lens = append(lens, chunk.Len())
+	}
+	return lens
+}
+
+// checkInt64Chunked checks that got holds the consecutive values built by
+// makeInt64Chunked.
+func checkInt64Chunked(t *testing.T, got *array.Chunked, n int) {
+	t.Helper()
+
+	if got.Len() != n {
+		t.Fatalf("invalid length: got=%d, want=%d", got.Len(), n)
+	}
+	v := int64(0)
+	for _, chunk := range got.Chunks() {
+		arr := chunk.(*array.Int64)
+		for i := 0; i < arr.Len(); i++ {
+			switch {
+			case v%5 == 4 && arr.IsValid(i):
+				t.Fatalf("value %d: got=%d, want=null", v, arr.Value(i))
+			case v%5 != 4 && (arr.IsNull(i) || arr.Value(i) != v):
+				t.Fatalf("value %d: got=%v", v, array.ValueToString(arr, i))
+			}
+			v++
+		}
+	}
+}
+
+func TestChunkedRechunk(t *testing.T) {
+	for _, tc := range []struct {
+		sizes  []int
+		target int64
+		want   []int
+	}{
+		{[]int{1, 1, 1, 10, 2, 2}, 4, []int{3, 10, 4}},
+		{[]int{2, 3, 2}, 4, []int{2, 3, 2}},
+		{[]int{2, 2, 3, 1}, 4, []int{4, 4}},
+		{[]int{0, 2, 0, 2, 0}, 4, []int{4}},
+		{[]int{5, 6, 7}, 4, []int{5, 6, 7}},
+		{[]int{5, 1, 6}, 0, []int{12}},
+		{[]int{}, 4, []int{}},
+		{[]int{0, 0}, 4, []int{}},
+	} {
+		t.Run(fmt.Sprintf("%v-%d", tc.sizes, tc.target), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			src := makeInt64Chunked(mem, tc.sizes...)
+			defer src.Release()
+
+			got, err := src.Rechunk(mem, tc.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			if lens := chunkLens(got); !reflect.DeepEqual(lens, tc.want) {
+				t.Fatalf("invalid chunks: got=%v, want=%v", lens, tc.want)
+			}
+			checkInt64Chunked(t, got, src.Len())
+
+			// large chunks are reused, without copying.
+			for _, chunk := range src.Chunks() {
+				if tc.target <= 0 || int64(chunk.Len()) < tc.target {
+					continue
+				}
+				found := false
+				for _, c := range got.Chunks() {
+					found = found || c == chunk
+				}
+				if !found {
+					t.Fatalf("large chunk of %d rows was copied", chunk.Len())
+				}
+			}
+		})
+	}
+}
+
+func TestRechunkTable(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "b", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	}, nil)
+
+	a := makeInt64Chunked(mem, 3, 7)
+	defer a.Release()
+	b := makeInt64Chunked(mem, 5, 2, 3)
+	defer b.Release()
+
+	colA := array.NewColumn(schema.Field(0), a)
+	defer colA.Release()
+	colB := array.NewColumn(schema.Field(1), b)
+	defer colB.Release()
+
+	tbl := array.NewTable(schema, []array.Column{*colA, *colB}, -1)
+	defer tbl.Release()
+
+	got, err := array.RechunkTable(mem, tbl, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	if !got.Schema().Equal(schema) || got.NumRows() != 10 {
+		t.Fatalf("invalid table: schema=%v, rows=%d", got.Schema(), got.NumRows())
+	}
+	want := []int{3, 4, 3}
+	for i := 0; i < int(got.NumCols()); i++ {
+		col := got.Column(i).Data()
+		if lens := chunkLens(col); !reflect.DeepEqual(lens, want) {
+			t.Fatalf("invalid chunks for column %d: got=%v, want=%v", i, lens, want)
+		}
+		checkInt64Chunked(t, col, 10)
+	}
+
+	tr := array.NewTableReader(got, -1)
+	defer tr.Release()
+
+	var rows []int64
+	for tr.Next() {
+		rows = append(rows, tr.Record().NumRows())
+	}
+	if want := []int64{3, 4, 3}; !reflect.DeepEqual(rows, want) {
+		t.Fatalf("invalid records: got=%v, want=%v", rows, want)
+	}
+}
+
+func BenchmarkChunkedRechunk(b *testing.B) {
+	for _, bm := range []struct {
+		name   string
+		chunks int
+		size   int
+		target int64
+	}{
+		// large chunks are kept as is: nothing is copied.
+		{"large", 16, 1 << 16, 1 << 10},
+		// small chunks are copied into chunks of the target size.
+		{"small", 1 << 10, 1 << 6, 1 << 16},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			sizes := make([]int, bm.chunks)
+			for i := range sizes {
+				sizes[i] = bm.size
+			}
+			src := makeInt64Chunked(memory.NewGoAllocator(), sizes...)
+			defer src.Release()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				out, err := src.Rechunk(memory.DefaultAllocator, bm.target)
+				if err != nil {
+					b.Fatal(err)
+				}
+				out.Release()
+			}
+		})
+	}
+}
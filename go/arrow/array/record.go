@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -121,6 +122,14 @@ type Record interface {
 	Column(i int) Interface
 	ColumnName(i int) string
 
+	// ColumnByName returns the column of the field with the given name.
+	// ColumnByName returns an error if the record has no field with that
+	// name, or several ones.
+	//
+	// The RecordColumn functions, such as RecordColumnFloat64, also check
+	// the type of the returned column.
+	ColumnByName(name string) (Interface, error)
+
 	// NewSlice constructs a zero-copy slice of the record with the indicated
 	// indices i and j, corresponding to array[i:j].
 	// The returned record must be Release()'d after use.
@@ -128,6 +137,19 @@ type Record interface {
 	// NewSlice panics if the slice is outside the valid range of the record array.
 	// NewSlice panics if j < i.
 	NewSlice(i, j int64) Record
+
+	// ReleaseColumn releases the i-th column of the record, ahead of the
+	// record itself, e.g. to free each column of a wide record once it has
+	// been processed. The column is replaced by a placeholder of the same
+	// data type, whose other methods panic.
+	// The schema and number of rows of the record stay available.
+	// ReleaseColumn is a no-op if the column is already released. It must not
+	// be called concurrently with other methods of the record.
+	ReleaseColumn(i int)
+
+	// RetainedColumns returns the indices of the columns not released with
+	// ReleaseColumn, in increasing order.
+	RetainedColumns() []int
 }
 
 // simpleRecord is a basic, non-lazy in-memory record batch.
@@ -224,6 +246,23 @@ func (rec *simpleRecord) Columns() []Interface    { return rec.arrs }
 func (rec *simpleRecord) Column(i int) Interface  { return rec.arrs[i] }
 func (rec *simpleRecord) ColumnName(i int) string { return rec.schema.Field(i).Name }
 
+func (rec *simpleRecord) ColumnByName(name string) (Interface, error) {
+	switch idx := rec.schema.FieldIndices(name); len(idx) {
+	case 0:
+		return nil, fmt.Errorf("arrow/array: no column named %q", name)
+	case 1:
+		return rec.arrs[idx[0]], nil
+	default:
+		return nil, fmt.Errorf("arrow/array: ambiguous column name %q (%d columns)", name, len(idx))
+	}
+}
+
+// columnTypeError returns the error of a RecordColumn function, when the
+// column named name is not a want array.
+func columnTypeError(name, want string, col Interface) error {
+	return fmt.Errorf("arrow/array: column %q has type %v (%T), want *array.%s", name, col.DataType(), col, want)
+}
+
 // NewSlice constructs a zero-copy slice of the record with the indicated
 // indices i and j, corresponding to array[i:j].
 // The returned record must be Release()'d after use.
@@ -243,6 +282,24 @@ func (rec *simpleRecord) NewSlice(i, j int64) Record {
 	return NewRecord(rec.schema, arrs, j-i)
 }
 
+func (rec *simpleRecord) ReleaseColumn(i int) {
+	if _, ok := rec.arrs[i].(*releasedColumn); ok {
+		return
+	}
+	rec.arrs[i].Release()
+	rec.arrs[i] = &releasedColumn{dtype: rec.schema.Field(i).Type, idx: i, name: rec.schema.Field(i).Name}
+}
+
+func (rec *simpleRecord) RetainedColumns() []int {
+	idx := make([]int, 0, len(rec.arrs))
+	for i, arr := range rec.arrs {
+		if _, ok := arr.(*releasedColumn); !ok {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
 func (rec *simpleRecord) String() string {
 	o := new(strings.Builder)
 	fmt.Fprintf(o, "record:\n  %v\n", rec.schema)
@@ -264,6 +321,10 @@ type RecordBuilder struct {
 }
 
 // NewRecordBuilder returns a builder, using the provided memory allocator and a schema.
+//
+// The builders of the fields declared non-nullable are in no-nulls mode
+// (see DisableNulls), except for null-typed fields: appending a null value
+// to them panics.
 func NewRecordBuilder(mem memory.Allocator, schema *arrow.Schema) *RecordBuilder {
 	b := &RecordBuilder{
 		refCount: 1,
@@ -274,6 +335,9 @@ func NewRecordBuilder(mem memory.Allocator, schema *arrow.Schema) *RecordBuilder
 
 	for i, f := range schema.Fields() {
 		b.fields[i] = NewBuilder(b.mem, f.Type)
+		if !f.Nullable && f.Type.ID() != arrow.NULL {
+			DisableNulls(b.fields[i])
+		}
 	}
 
 	return b
@@ -289,19 +353,26 @@ func (b *RecordBuilder) Retain() {
 func (b *RecordBuilder) Release() {
 	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
 
-	for _, f := range b.fields {
-		f.Release()
-	}
-
 	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		for _, f := range b.fields {
+			f.Release()
+		}
 		b.fields = nil
 	}
 }
 
+// Schema returns the schema of the records created by the builder.
 func (b *RecordBuilder) Schema() *arrow.Schema { return b.schema }
-func (b *RecordBuilder) Fields() []Builder     { return b.fields }
-func (b *RecordBuilder) Field(i int) Builder   { return b.fields[i] }
 
+// Fields returns the builders of the columns, in the order of the fields of
+// the schema. The builders are owned by b.
+func (b *RecordBuilder) Fields() []Builder { return b.fields }
+
+// Field returns the builder of the i-th column. The builder is owned by b.
+func (b *RecordBuilder) Field(i int) Builder { return b.fields[i] }
+
+// Reserve ensures there is enough space for appending size rows to all
+// the columns.
 func (b *RecordBuilder) Reserve(size int) {
 	for _, f := range b.fields {
 		f.Reserve(size)
@@ -314,7 +385,15 @@ func (b *RecordBuilder) Reserve(size int) {
 // The returned Record must be Release()'d after use.
 //
 // NewRecord panics if the fields' builder do not have the same length.
+// The builders are then left untouched, so that the missing values may be
+// appended before calling NewRecord again.
 func (b *RecordBuilder) NewRecord() Record {
+	for i, f := range b.fields {
+		if want := b.fields[0].Len(); f.Len() != want {
+			panic(fmt.Errorf("arrow/array: field %d has %d rows. want=%d", i, f.Len(), want))
+		}
+	}
+
 	cols := make([]Interface, len(b.fields))
 	rows := int64(0)
 
@@ -329,17 +408,139 @@ func (b *RecordBuilder) NewRecord() Record {
 
 	for i, f := range b.fields {
 		cols[i] = f.NewArray()
-		irow := int64(cols[i].Len())
-		if i > 0 && irow != rows {
-			panic(fmt.Errorf("arrow/array: field %d has %d rows. want=%d", i, irow, rows))
-		}
-		rows = irow
+		rows = int64(cols[i].Len())
 	}
 
 	return NewRecord(b.schema, cols, rows)
 }
 
+// RecordToStructArray returns a Struct array whose fields are the columns of
+// rec, without copying them. The data type of the array is the struct of the
+// fields of the schema of rec; the schema metadata is not kept.
+func RecordToStructArray(rec Record) *Struct {
+	children := make([]*Data, rec.NumCols())
+	for i, col := range rec.Columns() {
+		children[i] = col.Data()
+	}
+	dtype := arrow.StructOf(rec.Schema().Fields()...)
+	data := NewData(dtype, int(rec.NumRows()), []*memory.Buffer{nil}, children, 0, 0)
+	defer data.Release()
+	return NewStructData(data)
+}
+
+// StructOption configures the conversion of a Struct array to a record.
+type StructOption func(*structConfig)
+
+type structConfig struct {
+	maskNulls bool
+}
+
+// WithMaskedNulls specifies whether RecordFromStructArray accepts Struct
+// arrays with null elements. Records have no top-level validity: the fields
+// of the null elements are made null in the record instead.
+func WithMaskedNulls(v bool) StructOption {
+	return func(cfg *structConfig) {
+		cfg.maskNulls = v
+	}
+}
+
+// RecordFromStructArray returns a record whose columns are the fields of arr,
+// without copying them, and whose schema is the provided one. When schema is
+// nil, the fields of the struct type of arr are used.
+//
+// RecordFromStructArray returns an error if schema is inconsistent with the
+// data type of arr, or if arr has null elements and WithMaskedNulls(true) is
+// not given. Masked fields have a new validity bitmap, their other buffers
+// are shared.
+func RecordFromStructArray(arr *Struct, schema *arrow.Schema, opts ...StructOption) (Record, error) {
+	var cfg structConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	dtype := arr.DataType().(*arrow.StructType)
+	switch {
+	case schema == nil:
+		schema = arrow.NewSchema(dtype.Fields(), nil)
+	case !arrow.TypeEqual(arrow.StructOf(schema.Fields()...), dtype):
+		return nil, fmt.Errorf("arrow/array: schema %v inconsistent with %v array", schema, dtype)
+	}
+	if arr.NullN() != 0 && !cfg.maskNulls {
+		return nil, fmt.Errorf("arrow/array: cannot convert struct array with %d null elements to a record", arr.NullN())
+	}
+
+	cols := make([]Interface, arr.NumField())
+	for i := range cols {
+		cols[i] = arr.Field(i)
+		if arr.NullN() == 0 || cols[i].DataType().ID() == arrow.NULL {
+			cols[i].Retain()
+			continue
+		}
+		cols[i] = maskNulls(cols[i], arr)
+	}
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	return NewRecord(schema, cols, int64(arr.Len())), nil
+}
+
+// maskNulls returns a copy of field, a field of the struct array parent, with
+// null elements where parent is null. The buffers of field, except its
+// validity bitmap, are shared.
+func maskNulls(field Interface, parent *Struct) Interface {
+	var (
+		data   = field.Data()
+		n      = data.length
+		off    = data.offset
+		bitmap = make([]byte, bitutil.BytesForBits(int64(off+n)))
+		nulls  = 0
+	)
+	for i := 0; i < n; i++ {
+		if parent.IsNull(i) || field.IsNull(i) {
+			nulls++
+			continue
+		}
+		bitutil.SetBit(bitmap, off+i)
+	}
+
+	buffers := make([]*memory.Buffer, len(data.buffers))
+	copy(buffers, data.buffers)
+	buffers[0] = memory.NewBufferBytes(bitmap)
+
+	masked := NewData(data.dtype, n, buffers, data.childData, nulls, off)
+	defer masked.Release()
+	return MakeFromData(masked)
+}
+
 var (
 	_ Record       = (*simpleRecord)(nil)
 	_ RecordReader = (*simpleRecords)(nil)
 )
+
+// releasedColumn is the placeholder of a column released with
+// Record.ReleaseColumn. Its methods panic, but DataType, Retain and Release.
+type releasedColumn struct {
+	dtype arrow.DataType
+	idx   int
+	name  string
+}
+
+func (c *releasedColumn) panic() {
+	panic(fmt.Errorf("arrow/array: column %d (%q) of the record has been released", c.idx, c.name))
+}
+
+func (c *releasedColumn) DataType() arrow.DataType { return c.dtype }
+func (c *releasedColumn) NullN() int               { c.panic(); return 0 }
+func (c *releasedColumn) NullBitmapBytes() []byte  { c.panic(); return nil }
+func (c *releasedColumn) IsNull(i int) bool        { c.panic(); return false }
+func (c *releasedColumn) IsValid(i int) bool       { c.panic(); return false }
+func (c *releasedColumn) Data() *Data              { c.panic(); return nil }
+func (c *releasedColumn) Len() int                 { c.panic(); return 0 }
+func (c *releasedColumn) Retain()                  {}
+func (c *releasedColumn) Release()                 {}
+func (c *releasedColumn) String() string {
+	return fmt.Sprintf("(column %d released)", c.idx)
+}
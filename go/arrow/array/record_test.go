@@ -23,7 +23,9 @@ import (
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestRecord(t *testing.T) {
@@ -389,6 +391,95 @@ func TestRecordBuilder(t *testing.T) {
 	}
 }
 
+func TestRecordBuilderNoNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "nulls", Type: arrow.Null},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	assert.Panics(t, func() { b.Field(0).AppendNull() })
+	assert.Panics(t, func() { b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, []bool{true, false}) })
+	assert.Equal(t, 0, b.Field(0).Len())
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	b.Field(1).AppendNull()
+	b.Field(1).(*array.StringBuilder).Append("b")
+	b.Field(2).AppendNulls(2)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	assert.True(t, rec.Column(0).Data().Buffers()[0] == nil, "validity bitmap of a non-nullable field")
+	assert.Equal(t, 0, rec.Column(0).NullN())
+	assert.Equal(t, 1, rec.Column(1).NullN())
+	assert.Equal(t, 2, rec.Column(2).NullN())
+
+	// the builders stay in no-nulls mode after NewRecord.
+	assert.Panics(t, func() { b.Field(0).AppendNull() })
+}
+
+func TestRecordBuilderLengthMismatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+
+	func() {
+		defer func() {
+			e := recover()
+			if e == nil {
+				t.Fatalf("expected a panic")
+			}
+			if got, want := e.(error).Error(), "arrow/array: field 1 has 2 rows. want=3"; got != want {
+				t.Fatalf("invalid panic message: got=%q, want=%q", got, want)
+			}
+		}()
+		rec := b.NewRecord()
+		rec.Release()
+	}()
+
+	// the builders are left untouched by the failed NewRecord.
+	if got, want := b.Field(0).Len(), 3; got != want {
+		t.Fatalf("invalid length of field 0: got=%d, want=%d", got, want)
+	}
+	b.Field(1).AppendNull()
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := rec.Column(1).(*array.String).Value(1), "b"; got != want {
+		t.Fatalf("invalid value: got=%q, want=%q", got, want)
+	}
+	if !rec.Column(1).IsNull(2) {
+		t.Fatalf("expected a null value")
+	}
+}
+
 type testMessage struct {
 	Foo  *testMessageFoo
 	Bars []*testMessageBar
@@ -707,3 +798,227 @@ func TestRecordBuilderMessages(t *testing.T) {
 		}
 	}
 }
+
+func TestRecordToStructArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, rec := range recs {
+				beg := int64(0)
+				if rec.NumRows() > 1 {
+					beg = 1
+				}
+				slice := rec.NewSlice(beg, rec.NumRows())
+				defer slice.Release()
+
+				arr := array.RecordToStructArray(slice)
+				defer arr.Release()
+
+				if got, want := arr.Len(), int(slice.NumRows()); got != want {
+					t.Fatalf("invalid length: got=%d, want=%d", got, want)
+				}
+				if got, want := arr.NumField(), int(slice.NumCols()); got != want {
+					t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+				}
+				for i, col := range slice.Columns() {
+					if !array.ArrayEqual(arr.Field(i), col) {
+						t.Fatalf("field %d differs:\ngot= %v\nwant=%v", i, arr.Field(i), col)
+					}
+				}
+
+				back, err := array.RecordFromStructArray(arr, slice.Schema())
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer back.Release()
+				if !array.RecordEqual(back, slice) {
+					t.Fatalf("records differ:\ngot= %v\nwant=%v", back, slice)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordFromStructArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "n", Type: arrow.Null, Nullable: true},
+	)
+	b := array.NewStructBuilder(mem, dtype)
+	defer b.Release()
+
+	var (
+		ib = b.FieldBuilder(0).(*array.Int32Builder)
+		sb = b.FieldBuilder(1).(*array.StringBuilder)
+		nb = b.FieldBuilder(2).(*array.NullBuilder)
+	)
+	// the fields of the null elements are valid: only the validity of the
+	// struct makes them null.
+	b.AppendValues([]bool{true, false, true, true, false})
+	for i := 0; i < 5; i++ {
+		ib.Append(int32(i))
+		if i == 2 {
+			sb.AppendNull()
+		} else {
+			sb.Append(fmt.Sprintf("s%d", i))
+		}
+		nb.AppendNull()
+	}
+	arr := b.NewStructArray()
+	defer arr.Release()
+
+	_, err := array.RecordFromStructArray(arr, nil)
+	assert.EqualError(t, err, "arrow/array: cannot convert struct array with 2 null elements to a record")
+
+	md := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	other := arrow.NewSchema([]arrow.Field{dtype.Field(0), dtype.Field(2), dtype.Field(1)}, nil)
+	_, err = array.RecordFromStructArray(arr, other, array.WithMaskedNulls(true))
+	assert.Error(t, err)
+
+	schema := arrow.NewSchema(dtype.Fields(), &md)
+	rec, err := array.RecordFromStructArray(arr, schema, array.WithMaskedNulls(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Release()
+
+	assert.True(t, rec.Schema().Equal(schema))
+	assert.Equal(t, "[0 (null) 2 3 (null)]", fmt.Sprint(rec.Column(0)))
+	assert.Equal(t, `["s0" (null) (null) "s3" (null)]`, fmt.Sprint(rec.Column(1)))
+	assert.Equal(t, 5, rec.Column(2).NullN())
+
+	// the fields of a sliced struct are masked with its own validity.
+	slice := array.NewSlice(arr, 1, 4).(*array.Struct)
+	defer slice.Release()
+	rec2, err := array.RecordFromStructArray(slice, nil, array.WithMaskedNulls(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec2.Release()
+	assert.Equal(t, "[(null) 2 3]", fmt.Sprint(rec2.Column(0)))
+	assert.Equal(t, 1, rec2.Column(0).NullN())
+	assert.NoError(t, array.ValidateFull(rec2.Column(1)))
+
+	// the struct array outlives the record it was created from.
+	rec3, err := array.RecordFromStructArray(arr, nil, array.WithMaskedNulls(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	whole := array.RecordToStructArray(rec3)
+	rec3.Release()
+	assert.Equal(t, "[0 (null) 2 3 (null)]", fmt.Sprint(whole.Field(0)))
+	whole.Release()
+}
+
+func TestRecordColumnByName(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "price", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "dup", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "dup", Type: arrow.PrimitiveTypes.Int32},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Float64Builder).Append(1.5)
+	b.Field(1).(*array.StringBuilder).Append("a")
+	b.Field(2).(*array.Int32Builder).Append(1)
+	b.Field(3).(*array.Int32Builder).Append(2)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	col, err := rec.ColumnByName("name")
+	assert.NoError(t, err)
+	assert.True(t, col == rec.Column(1))
+
+	price, err := array.RecordColumnFloat64(rec, "price")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5}, price.Float64Values())
+
+	name, err := array.RecordColumnString(rec, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", name.Value(0))
+
+	_, err = array.RecordColumnInt64(rec, "price")
+	assert.EqualError(t, err, `arrow/array: column "price" has type float64 (*array.Float64), want *array.Int64`)
+
+	_, err = array.RecordColumnFloat64(rec, "volume")
+	assert.EqualError(t, err, `arrow/array: no column named "volume"`)
+
+	_, err = rec.ColumnByName("dup")
+	assert.EqualError(t, err, `arrow/array: ambiguous column name "dup" (2 columns)`)
+	_, err = array.RecordColumnInt32(rec, "dup")
+	assert.Error(t, err)
+}
+
+func TestRecordReleaseColumn(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const ncols = 3
+	var (
+		fields = make([]arrow.Field, ncols)
+		cols   = make([]array.Interface, ncols)
+		scopes = make([]*memory.CheckedAllocatorScope, ncols)
+	)
+	for i := range cols {
+		// scopes[i] records the memory allocated for the columns before i.
+		scopes[i] = memory.NewCheckedAllocatorScope(mem)
+
+		b := array.NewInt64Builder(mem)
+		for j := 0; j < 1024; j++ {
+			b.Append(int64(i * j))
+		}
+		cols[i] = b.NewArray()
+		b.Release()
+		fields[i] = arrow.Field{Name: fmt.Sprintf("c%d", i), Type: arrow.PrimitiveTypes.Int64}
+	}
+	schema := arrow.NewSchema(fields, nil)
+	rec := array.NewRecord(schema, cols, -1)
+	for _, col := range cols {
+		col.Release()
+	}
+	defer rec.Release()
+
+	for i := ncols - 1; i >= 0; i-- {
+		rec.ReleaseColumn(i)
+		scopes[i].CheckSize(t)
+
+		// released twice is a no-op.
+		rec.ReleaseColumn(i)
+		scopes[i].CheckSize(t)
+
+		want := make([]int, i)
+		for j := range want {
+			want[j] = j
+		}
+		assert.Equal(t, want, rec.RetainedColumns())
+	}
+
+	assert.True(t, rec.Schema().Equal(schema))
+	assert.Equal(t, int64(1024), rec.NumRows())
+	assert.Equal(t, int64(ncols), rec.NumCols())
+	assert.Equal(t, "c1", rec.ColumnName(1))
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, rec.Column(1).DataType())
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			if !ok {
+				t.Fatalf("expected a panic with an error")
+			}
+			assert.Equal(t, `arrow/array: column 1 ("c1") of the record has been released`, err.Error())
+		}()
+		rec.Column(1).Len()
+	}()
+	assert.Panics(t, func() { rec.NewSlice(0, 1) })
+}
@@ -0,0 +1,395 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Rows are a convenience for tooling, templates and small test fixtures:
+// they convert each value on its own, through an interface{}, and are not
+// meant for bulk data.
+//
+// A row maps the names of the fields of a schema to Go values:
+//   - nulls are nil;
+//   - booleans, numbers, decimals and intervals are the values returned by
+//     the Value method of their array, e.g. int32 or float16.Num;
+//   - dates, times of day and timestamps are time.Time values. Dates and
+//     times of day are in UTC, times of day on January 1st, 1970: they are
+//     converted back as the time elapsed since the Unix epoch.
+//     Timestamps are in the time zone of their data type;
+//   - durations are time.Duration values;
+//   - strings are strings, binary values are []byte;
+//   - lists are []interface{}, maps are []interface{} of their entries;
+//   - structs, and the entries of maps, are map[string]interface{}.
+//     Fields with duplicate names collapse to the last one;
+//   - dictionary-encoded values are their decoded value, and values of
+//     custom data types are their storage value.
+
+// RecordToRows returns the rows of rec, in order.
+// The returned values do not reference the memory of rec.
+func RecordToRows(rec Record) []map[string]interface{} {
+	var (
+		fields = rec.Schema().Fields()
+		rows   = make([]map[string]interface{}, rec.NumRows())
+	)
+	for i := range rows {
+		row := make(map[string]interface{}, len(fields))
+		for j, f := range fields {
+			row[f.Name] = rowValue(rec.Column(j), i)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// RecordFromRows creates a record of the provided schema from rows.
+// Missing keys are nulls. In addition to the values returned by
+// RecordToRows, the values accepted by BatchingWriter.AppendRow are
+// accepted, e.g. arrow.Timestamp for timestamps or strings for binary
+// values.
+//
+// RecordFromRows returns an error naming the row, the key and the expected
+// data type of the first value that cannot be converted, including nulls of
+// fields declared non-nullable, or the first key not in the schema. As DictionaryBuilder.AppendArray, it panics if the
+// dictionary of a dictionary-encoded field cannot hold a new value.
+func RecordFromRows(mem memory.Allocator, schema *arrow.Schema, rows []map[string]interface{}) (Record, error) {
+	b := NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	fields := schema.Fields()
+	for i, row := range rows {
+		for k := range row {
+			if !schema.HasField(k) {
+				return nil, xerrors.Errorf("arrow/array: row %d: unknown key %q", i, k)
+			}
+		}
+		for j, f := range fields {
+			app, err := rowAppender(b.Field(j), row[f.Name])
+			if err != nil {
+				return nil, xerrors.Errorf("arrow/array: invalid value in row %d for key %q of type %v: %w", i, f.Name, f.Type, err)
+			}
+			app()
+		}
+	}
+	return b.NewRecord(), nil
+}
+
+// rowValue returns the i-th value of arr, as a row value.
+func rowValue(arr Interface, i int) interface{} {
+	if arr.IsNull(i) {
+		return nil
+	}
+
+	switch arr := arr.(type) {
+	case *Boolean:
+		return arr.Value(i)
+	case *Int8:
+		return arr.Value(i)
+	case *Int16:
+		return arr.Value(i)
+	case *Int32:
+		return arr.Value(i)
+	case *Int64:
+		return arr.Value(i)
+	case *Uint8:
+		return arr.Value(i)
+	case *Uint16:
+		return arr.Value(i)
+	case *Uint32:
+		return arr.Value(i)
+	case *Uint64:
+		return arr.Value(i)
+	case *Float16:
+		return arr.Value(i)
+	case *Float32:
+		return arr.Value(i)
+	case *Float64:
+		return arr.Value(i)
+	case *Decimal128:
+		return arr.Value(i)
+	case *Decimal256:
+		return arr.Value(i)
+	case *MonthInterval:
+		return arr.Value(i)
+	case *DayTimeInterval:
+		return arr.Value(i)
+	case *String:
+		return arr.Value(i)
+	case *Date32:
+		return time.Unix(int64(arr.Value(i))*86400, 0).UTC()
+	case *Date64:
+		return toTime(int64(arr.Value(i)), arrow.Millisecond).UTC()
+	case *Time32:
+		return toTime(int64(arr.Value(i)), arr.DataType().(*arrow.Time32Type).Unit).UTC()
+	case *Time64:
+		return toTime(int64(arr.Value(i)), arr.DataType().(*arrow.Time64Type).Unit).UTC()
+	case *Timestamp:
+		dtype := arr.DataType().(*arrow.TimestampType)
+		loc, err := dtype.GetZone()
+		if err != nil {
+			loc = time.UTC
+		}
+		return toTime(int64(arr.Value(i)), dtype.Unit).In(loc)
+	case *Duration:
+		return time.Duration(int64(arr.Value(i)) * arr.DataType().(*arrow.DurationType).Unit.Multiplier())
+	case *Binary:
+		return append([]byte(nil), arr.Value(i)...)
+	case *FixedSizeBinary:
+		return append([]byte(nil), arr.Value(i)...)
+	case *Map:
+		return rowValue(arr.List, i)
+	case *List:
+		j := arr.Offset() + i
+		return rowValues(arr.ListValues(), int(arr.offsets[j]), int(arr.offsets[j+1]))
+	case *LargeList:
+		j := arr.Offset() + i
+		return rowValues(arr.ListValues(), int(arr.offsets[j]), int(arr.offsets[j+1]))
+	case *FixedSizeList:
+		n := int(arr.DataType().(*arrow.FixedSizeListType).Len())
+		beg := (arr.Offset() + i) * n
+		return rowValues(arr.ListValues(), beg, beg+n)
+	case *Struct:
+		fields := arr.DataType().(*arrow.StructType).Fields()
+		v := make(map[string]interface{}, len(fields))
+		for j, f := range fields {
+			v[f.Name] = rowValue(arr.Field(j), i)
+		}
+		return v
+	case *Custom:
+		return rowValue(arr.storage, i)
+	case *Dictionary:
+		return rowValue(arr.dict, arr.GetValueIndex(i))
+	default:
+		panic(xerrors.Errorf("arrow/array: unsupported data type %v", arr.DataType()))
+	}
+}
+
+func rowValues(arr Interface, beg, end int) []interface{} {
+	vs := make([]interface{}, end-beg)
+	for j := range vs {
+		vs[j] = rowValue(arr, beg+j)
+	}
+	return vs
+}
+
+// rowAppender returns a function appending the row value v to b, or an
+// error if v cannot be appended to b. It extends valueAppender with the
+// row values of temporal and nested data types.
+func rowAppender(b Builder, v interface{}) (func(), error) {
+	if v == nil {
+		if b, ok := b.(*FixedSizeListBuilder); ok {
+			// null lists still hold their values.
+			n := int(b.Type().(*arrow.FixedSizeListType).Len())
+			return func() {
+				b.AppendNull()
+				// unlike AppendNulls, AppendNull also appends to the field
+				// builders of struct values.
+				vb := b.ValueBuilder()
+				for j := 0; j < n; j++ {
+					vb.AppendNull()
+				}
+			}, nil
+		}
+		return valueAppender(b, nil)
+	}
+
+	switch b := b.(type) {
+	case *Date32Builder:
+		if t, ok := v.(time.Time); ok {
+			y, m, d := t.Date()
+			days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+			return func() { b.Append(arrow.Date32(days)) }, nil
+		}
+	case *Date64Builder:
+		if t, ok := v.(time.Time); ok {
+			ms, err := fromTime(t, arrow.Millisecond)
+			if err != nil {
+				return nil, err
+			}
+			return func() { b.Append(arrow.Date64(ms)) }, nil
+		}
+	case *Time32Builder:
+		if t, ok := v.(time.Time); ok {
+			x, err := fromTime(t, b.dtype.Unit)
+			if err == nil && (x < math.MinInt32 || x > math.MaxInt32) {
+				err = strconv.ErrRange
+			}
+			if err != nil {
+				return nil, err
+			}
+			return func() { b.Append(arrow.Time32(x)) }, nil
+		}
+	case *Time64Builder:
+		if t, ok := v.(time.Time); ok {
+			x, err := fromTime(t, b.dtype.Unit)
+			if err != nil {
+				return nil, err
+			}
+			return func() { b.Append(arrow.Time64(x)) }, nil
+		}
+	case *TimestampBuilder:
+		if t, ok := v.(time.Time); ok {
+			x, err := fromTime(t, b.dtype.Unit)
+			if err != nil {
+				return nil, err
+			}
+			return func() { b.Append(arrow.Timestamp(x)) }, nil
+		}
+	case *DurationBuilder:
+		if d, ok := v.(time.Duration); ok {
+			x, err := fromNanos(int64(d), b.dtype.Unit)
+			if err != nil {
+				return nil, err
+			}
+			return func() { b.Append(arrow.Duration(x)) }, nil
+		}
+	case *MapBuilder:
+		entries, ok := v.([]interface{})
+		if !ok {
+			break
+		}
+		var (
+			fields = b.dtype.Elem().Fields()
+			apps   = make([]func(), 0, 2*len(entries))
+		)
+		for j, e := range entries {
+			e, ok := e.(map[string]interface{})
+			if !ok {
+				return nil, xerrors.Errorf("entry %d: cannot use %v (%T) as %v value", j, e, e, b.dtype.Elem())
+			}
+			key, err := rowAppender(b.KeyBuilder(), e[fields[0].Name])
+			if err != nil {
+				return nil, xerrors.Errorf("entry %d: %w", j, err)
+			}
+			item, err := rowAppender(b.ItemBuilder(), e[fields[1].Name])
+			if err != nil {
+				return nil, xerrors.Errorf("entry %d: %w", j, err)
+			}
+			apps = append(apps, key, item)
+		}
+		return func() {
+			b.Append(true)
+			for _, app := range apps {
+				app()
+			}
+		}, nil
+	case *ListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			elems, err := rowAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *LargeListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			elems, err := rowAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *FixedSizeListBuilder:
+		if x, ok := v.([]interface{}); ok {
+			if n := b.Type().(*arrow.FixedSizeListType).Len(); len(x) != int(n) {
+				return nil, xerrors.Errorf("invalid length %d for %v value", len(x), b.Type())
+			}
+			elems, err := rowAppenders(b.ValueBuilder(), x)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				b.Append(true)
+				elems()
+			}, nil
+		}
+	case *StructBuilder:
+		x, ok := v.(map[string]interface{})
+		if !ok {
+			break
+		}
+		dtype := b.Type().(*arrow.StructType)
+		for k := range x {
+			if _, ok := dtype.FieldByName(k); !ok {
+				return nil, xerrors.Errorf("unknown field %q for %v value", k, dtype)
+			}
+		}
+		fields := make([]func(), b.NumField())
+		for j, f := range dtype.Fields() {
+			app, err := rowAppender(b.FieldBuilder(j), x[f.Name])
+			if err != nil {
+				return nil, xerrors.Errorf("field %q: %w", f.Name, err)
+			}
+			fields[j] = app
+		}
+		return func() {
+			b.Append(true)
+			for _, app := range fields {
+				app()
+			}
+		}, nil
+	case *DictionaryBuilder:
+		if b.scratch == nil {
+			b.scratch = NewBuilder(b.mem, b.dtype.ValueType)
+		}
+		app, err := rowAppender(b.scratch, v)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			app()
+			arr := b.scratch.NewArray()
+			defer arr.Release()
+			if err := b.appendValue(arr, 0); err != nil {
+				panic(err)
+			}
+		}, nil
+	case *CustomBuilder:
+		return rowAppender(b.StorageBuilder(), v)
+	}
+	return valueAppender(b, v)
+}
+
+// rowAppenders returns a function appending all of values to b.
+func rowAppenders(b Builder, values []interface{}) (func(), error) {
+	apps := make([]func(), len(values))
+	for i, v := range values {
+		app, err := rowAppender(b, v)
+		if err != nil {
+			return nil, err
+		}
+		apps[i] = app
+	}
+	return func() {
+		for _, app := range apps {
+			app()
+		}
+	}, nil
+}
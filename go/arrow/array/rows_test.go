@@ -0,0 +1,146 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordRowsRoundTrip(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			for i, rec := range recs {
+				rows := array.RecordToRows(rec)
+				if got, want := len(rows), int(rec.NumRows()); got != want {
+					t.Fatalf("record %d: invalid number of rows: got=%d, want=%d", i, got, want)
+				}
+
+				got, err := array.RecordFromRows(mem, rec.Schema(), rows)
+				if err != nil {
+					t.Fatalf("record %d: %+v", i, err)
+				}
+				if !array.RecordEqual(got, rec) {
+					t.Fatalf("record %d differs:\ngot= %v\nwant=%v", i, got, rec)
+				}
+				got.Release()
+			}
+		})
+	}
+}
+
+func TestRecordToRows(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond}, Nullable: true},
+		{Name: "bin", Type: arrow.BinaryTypes.Binary, Nullable: true},
+		{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		{Name: "struct", Type: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.BinaryTypes.String, Nullable: true},
+			arrow.Field{Name: "d", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+		), Nullable: true},
+	}, nil)
+
+	rows := []map[string]interface{}{
+		{
+			"ts":     time.Date(2021, 3, 4, 5, 6, 7, 8e6, time.UTC),
+			"bin":    []byte("raw"),
+			"list":   []interface{}{int32(1), nil, int32(3)},
+			"struct": map[string]interface{}{"a": "x", "d": time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)},
+		},
+		{"list": []interface{}{}, "struct": map[string]interface{}{}},
+	}
+
+	rec, err := array.RecordFromRows(mem, schema, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Release()
+
+	got := array.RecordToRows(rec)
+	assert.True(t, got[0]["ts"].(time.Time).Equal(rows[0]["ts"].(time.Time)))
+	got[0]["ts"] = rows[0]["ts"]
+	assert.Equal(t, rows[0], got[0])
+	assert.Equal(t, map[string]interface{}{
+		"ts":     nil,
+		"bin":    nil,
+		"list":   []interface{}{},
+		"struct": map[string]interface{}{"a": nil, "d": nil},
+	}, got[1])
+}
+
+func TestRecordFromRowsErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "s", Type: arrow.StructOf(arrow.Field{Name: "t", Type: arrow.FixedWidthTypes.Time32s, Nullable: true}), Nullable: true},
+	}, nil)
+
+	for _, tc := range []struct {
+		name string
+		rows []map[string]interface{}
+		want []string
+	}{
+		{
+			name: "type",
+			rows: []map[string]interface{}{{"i": int32(1)}, {"i": 2}},
+			want: []string{"row 1", `key "i"`, "int32"},
+		},
+		{
+			name: "unknown-key",
+			rows: []map[string]interface{}{{"j": int32(1)}},
+			want: []string{"row 0", `unknown key "j"`},
+		},
+		{
+			name: "nested",
+			rows: []map[string]interface{}{{"s": map[string]interface{}{"t": time.Date(1970, 1, 1, 1, 2, 3, 4, time.UTC)}}},
+			want: []string{"row 0", `key "s"`, `field "t"`, "not a whole number of s"},
+		},
+		{
+			name: "struct",
+			rows: []map[string]interface{}{{"s": "t"}},
+			want: []string{"row 0", `key "s"`, "struct<t: time32[s]>"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, err := array.RecordFromRows(mem, schema, tc.rows)
+			if err == nil {
+				rec.Release()
+				t.Fatalf("expected an error")
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("invalid error %q: missing %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,591 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
+	"golang.org/x/xerrors"
+)
+
+// Textual representation of values.
+//
+// AppendValueFromString and ValueToString use the following formats:
+//  - booleans, integers and floating-point numbers: as parsed and formatted
+//    by the strconv package;
+//  - decimals: decimal numbers such as "-123.45", with at most the scale of
+//    the data type as number of fractional digits;
+//  - strings, binary and fixed-size binary values: the bytes of the value;
+//  - dates: "2006-01-02";
+//  - times of day: "15:04:05", optionally followed by fractional seconds;
+//  - timestamps: RFC 3339 (e.g. "2006-01-02T15:04:05.999999999Z07:00").
+//    When parsing, "2006-01-02 15:04:05", "2006-01-02T15:04:05" and
+//    "2006-01-02" are also accepted, in the time zone of the data type;
+//  - durations and month intervals: the integer number of units;
+//  - day-time intervals: "<days>d<milliseconds>ms", e.g. "1d500ms".
+// Nested and null data types have no textual representation from which
+// values may be parsed.
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04:05.999999999"
+)
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	dateLayout,
+}
+
+func parseError(dtype arrow.DataType, s string, err error) error {
+	if nerr, ok := err.(*strconv.NumError); ok {
+		err = nerr.Err
+	}
+	return xerrors.Errorf("arrow/array: could not parse %q as %v: %w", s, dtype, err)
+}
+
+func unsupportedError(dtype arrow.DataType) error {
+	return xerrors.Errorf("arrow/array: cannot parse values of type %v from strings", dtype)
+}
+
+// fromNanos converts ns nanoseconds to the time unit u, and returns an error
+// if ns is not a whole number of units.
+func fromNanos(ns int64, u arrow.TimeUnit) (int64, error) {
+	n := u.Multiplier()
+	if ns%n != 0 {
+		return 0, xerrors.Errorf("value is not a whole number of %v", u)
+	}
+	return ns / n, nil
+}
+
+// toTime converts v units u since the Unix epoch to a time.
+func toTime(v int64, u arrow.TimeUnit) time.Time {
+	per := int64(time.Second) / u.Multiplier()
+	return time.Unix(v/per, (v%per)*u.Multiplier())
+}
+
+// AppendValueFromString parses s as a null value.
+// It always returns an error, as null arrays hold no value.
+func (b *NullBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(arrow.Null)
+}
+
+// AppendValueFromString parses s as a boolean, and appends it.
+func (b *BooleanBuilder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(v)
+	return nil
+}
+
+// AppendValueFromString parses s as an int8, and appends it.
+func (b *Int8Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 8)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(int8(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an int16, and appends it.
+func (b *Int16Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 16)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(int16(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an int32, and appends it.
+func (b *Int32Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(int32(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an int64, and appends it.
+func (b *Int64Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(v)
+	return nil
+}
+
+// AppendValueFromString parses s as a uint8, and appends it.
+func (b *Uint8Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(uint8(v))
+	return nil
+}
+
+// AppendValueFromString parses s as a uint16, and appends it.
+func (b *Uint16Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(uint16(v))
+	return nil
+}
+
+// AppendValueFromString parses s as a uint32, and appends it.
+func (b *Uint32Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(uint32(v))
+	return nil
+}
+
+// AppendValueFromString parses s as a uint64, and appends it.
+func (b *Uint64Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(v)
+	return nil
+}
+
+// AppendValueFromString parses s as a float16, and appends it.
+func (b *Float16Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(float16.New(float32(v)))
+	return nil
+}
+
+// AppendValueFromString parses s as a float32, and appends it.
+func (b *Float32Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(float32(v))
+	return nil
+}
+
+// AppendValueFromString parses s as a float64, and appends it.
+func (b *Float64Builder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(v)
+	return nil
+}
+
+// AppendValueFromString parses s as a decimal number with the precision and
+// scale of the builder, and appends it.
+func (b *Decimal128Builder) AppendValueFromString(s string) error {
+	v, err := decimal256.FromString(s, b.dtype.Precision, b.dtype.Scale)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	n, err := v.ToDecimal128()
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(n)
+	return nil
+}
+
+// AppendValueFromString parses s as a decimal number with the precision and
+// scale of the builder, and appends it.
+func (b *Decimal256Builder) AppendValueFromString(s string) error {
+	v, err := decimal256.FromString(s, b.dtype.Precision, b.dtype.Scale)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(v)
+	return nil
+}
+
+// AppendValueFromString parses s as a date of the form "2006-01-02", and
+// appends it.
+func (b *Date32Builder) AppendValueFromString(s string) error {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	days := t.Unix() / 86400
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return parseError(b.Type(), s, strconv.ErrRange)
+	}
+	b.Append(arrow.Date32(days))
+	return nil
+}
+
+// AppendValueFromString parses s as a date of the form "2006-01-02", and
+// appends it.
+func (b *Date64Builder) AppendValueFromString(s string) error {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.Date64(t.Unix() * 1000))
+	return nil
+}
+
+// parseTimeOfDay parses s as a time of day, and returns it in nanoseconds
+// since midnight.
+func parseTimeOfDay(s string) (int64, error) {
+	t, err := time.Parse(timeLayout, s)
+	if err != nil {
+		return 0, err
+	}
+	return int64(t.Hour())*int64(time.Hour) +
+		int64(t.Minute())*int64(time.Minute) +
+		int64(t.Second())*int64(time.Second) +
+		int64(t.Nanosecond()), nil
+}
+
+// AppendValueFromString parses s as a time of day of the form "15:04:05",
+// optionally followed by fractional seconds, and appends it.
+// s must be a whole number of units of the builder.
+func (b *Time32Builder) AppendValueFromString(s string) error {
+	ns, err := parseTimeOfDay(s)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	v, err := fromNanos(ns, b.dtype.Unit)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.Time32(v))
+	return nil
+}
+
+// AppendValueFromString parses s as a time of day of the form "15:04:05",
+// optionally followed by fractional seconds, and appends it.
+// s must be a whole number of units of the builder.
+func (b *Time64Builder) AppendValueFromString(s string) error {
+	ns, err := parseTimeOfDay(s)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	v, err := fromNanos(ns, b.dtype.Unit)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.Time64(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an RFC 3339 timestamp, and appends it.
+// Timestamps without a UTC offset, such as "2006-01-02 15:04:05", are
+// interpreted in the time zone of the builder.
+// s must be a whole number of units of the builder.
+func (b *TimestampBuilder) AppendValueFromString(s string) error {
+	loc, err := b.dtype.GetZone()
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+
+	var t time.Time
+	for _, layout := range timestampLayouts {
+		t, err = time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return parseError(b.Type(), s, xerrors.New("invalid timestamp"))
+	}
+
+	v, err := fromTime(t, b.dtype.Unit)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.Timestamp(v))
+	return nil
+}
+
+// fromTime converts t to a number of units u since the Unix epoch, and
+// returns an error if t is not a whole number of units or is out of range.
+func fromTime(t time.Time, u arrow.TimeUnit) (int64, error) {
+	var (
+		per = int64(time.Second) / u.Multiplier()
+		sec = t.Unix()
+	)
+	frac, err := fromNanos(int64(t.Nanosecond()), u)
+	if err != nil {
+		return 0, err
+	}
+	if sec > (math.MaxInt64-frac)/per || sec < math.MinInt64/per {
+		return 0, strconv.ErrRange
+	}
+	return sec*per + frac, nil
+}
+
+// AppendValueFromString parses s as an integer number of units of the
+// builder, and appends it.
+func (b *DurationBuilder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.Duration(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an integer number of months, and
+// appends it.
+func (b *MonthIntervalBuilder) AppendValueFromString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.MonthInterval(v))
+	return nil
+}
+
+// AppendValueFromString parses s as an interval of the form
+// "<days>d<milliseconds>ms", and appends it.
+func (b *DayTimeIntervalBuilder) AppendValueFromString(s string) error {
+	i := strings.IndexByte(s, 'd')
+	if i < 0 || !strings.HasSuffix(s, "ms") || i+1 > len(s)-2 {
+		return parseError(b.Type(), s, xerrors.New("invalid interval"))
+	}
+	days, err := strconv.ParseInt(s[:i], 10, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	ms, err := strconv.ParseInt(s[i+1:len(s)-2], 10, 32)
+	if err != nil {
+		return parseError(b.Type(), s, err)
+	}
+	b.Append(arrow.DayTimeInterval{Days: int32(days), Milliseconds: int32(ms)})
+	return nil
+}
+
+// AppendValueFromString appends the bytes of s.
+func (b *BinaryBuilder) AppendValueFromString(s string) error {
+	b.AppendString(s)
+	return nil
+}
+
+// AppendValueFromString appends s.
+func (b *StringBuilder) AppendValueFromString(s string) error {
+	b.Append(s)
+	return nil
+}
+
+// AppendValueFromString appends the bytes of s, which must have the byte
+// width of the builder.
+func (b *FixedSizeBinaryBuilder) AppendValueFromString(s string) error {
+	if len(s) != b.dtype.ByteWidth {
+		return parseError(b.Type(), s, xerrors.Errorf("invalid length %d", len(s)))
+	}
+	b.Append([]byte(s))
+	return nil
+}
+
+// AppendValueFromString always returns an error: lists cannot be parsed
+// from strings.
+func (b *ListBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(b.Type())
+}
+
+// AppendValueFromString always returns an error: lists cannot be parsed
+// from strings.
+func (b *LargeListBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(b.Type())
+}
+
+// AppendValueFromString always returns an error: lists cannot be parsed
+// from strings.
+func (b *FixedSizeListBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(b.Type())
+}
+
+// AppendValueFromString always returns an error: structs cannot be parsed
+// from strings.
+func (b *StructBuilder) AppendValueFromString(s string) error {
+	return unsupportedError(b.Type())
+}
+
+// AppendValueFromString parses s as a value of the value type of the
+// builder, and appends it.
+func (b *DictionaryBuilder) AppendValueFromString(s string) error {
+	if b.scratch == nil {
+		b.scratch = NewBuilder(b.mem, b.dtype.ValueType)
+	}
+	if err := b.scratch.AppendValueFromString(s); err != nil {
+		return err
+	}
+	arr := b.scratch.NewArray()
+	defer arr.Release()
+	return b.appendValue(arr, 0)
+}
+
+// AppendValueOrNullFromString appends a null to b if s is one of the null
+// tokens nulls, and parses s with the AppendValueFromString method of b
+// otherwise. This lets inputs such as CSV files, where nulls are spelled as
+// "" or "NULL", be loaded without a type switch on the builder.
+//
+// Nothing is appended if s cannot be parsed.
+func AppendValueOrNullFromString(b Builder, s string, nulls ...string) error {
+	for _, null := range nulls {
+		if s == null {
+			b.AppendNull()
+			return nil
+		}
+	}
+	return b.AppendValueFromString(s)
+}
+
+// ValueToString returns the textual representation of the i-th element of
+// arr, in the format parsed by the AppendValueFromString method of builders.
+// Null elements are represented as "(null)", as in the String method of
+// arrays.
+//
+// Lists and fixed-size lists are represented as their elements, separated by
+// spaces and enclosed in brackets. Structs are represented as their fields,
+// separated by spaces and enclosed in braces.
+func ValueToString(arr Interface, i int) string {
+	if arr.IsNull(i) {
+		return "(null)"
+	}
+
+	switch arr := arr.(type) {
+	case *Boolean:
+		return strconv.FormatBool(arr.Value(i))
+	case *Int8:
+		return strconv.FormatInt(int64(arr.Value(i)), 10)
+	case *Int16:
+		return strconv.FormatInt(int64(arr.Value(i)), 10)
+	case *Int32:
+		return strconv.FormatInt(int64(arr.Value(i)), 10)
+	case *Int64:
+		return strconv.FormatInt(arr.Value(i), 10)
+	case *Uint8:
+		return strconv.FormatUint(uint64(arr.Value(i)), 10)
+	case *Uint16:
+		return strconv.FormatUint(uint64(arr.Value(i)), 10)
+	case *Uint32:
+		return strconv.FormatUint(uint64(arr.Value(i)), 10)
+	case *Uint64:
+		return strconv.FormatUint(arr.Value(i), 10)
+	case *Float16:
+		return arr.Value(i).String()
+	case *Float32:
+		return strconv.FormatFloat(float64(arr.Value(i)), 'g', -1, 32)
+	case *Float64:
+		return strconv.FormatFloat(arr.Value(i), 'g', -1, 64)
+	case *Decimal128:
+		scale := arr.DataType().(*arrow.Decimal128Type).Scale
+		return decimal256.FromDecimal128(arr.Value(i)).ToString(scale)
+	case *Decimal256:
+		return arr.Value(i).ToString(arr.dtype().Scale)
+	case *Date32:
+		return time.Unix(int64(arr.Value(i))*86400, 0).UTC().Format(dateLayout)
+	case *Date64:
+		ms := int64(arr.Value(i))
+		return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC().Format(dateLayout)
+	case *Time32:
+		ns := int64(arr.Value(i)) * arr.DataType().(*arrow.Time32Type).Unit.Multiplier()
+		return time.Unix(0, ns).UTC().Format(timeLayout)
+	case *Time64:
+		ns := int64(arr.Value(i)) * arr.DataType().(*arrow.Time64Type).Unit.Multiplier()
+		return time.Unix(0, ns).UTC().Format(timeLayout)
+	case *Timestamp:
+		dtype := arr.DataType().(*arrow.TimestampType)
+		loc, err := dtype.GetZone()
+		if err != nil {
+			loc = time.UTC
+		}
+		return toTime(int64(arr.Value(i)), dtype.Unit).In(loc).Format(time.RFC3339Nano)
+	case *Duration:
+		return strconv.FormatInt(int64(arr.Value(i)), 10)
+	case *MonthInterval:
+		return strconv.FormatInt(int64(arr.Value(i)), 10)
+	case *DayTimeInterval:
+		v := arr.Value(i)
+		return strconv.Itoa(int(v.Days)) + "d" + strconv.Itoa(int(v.Milliseconds)) + "ms"
+	case *Binary:
+		return arr.ValueString(i)
+	case *String:
+		return arr.Value(i)
+	case *FixedSizeBinary:
+		return string(arr.Value(i))
+	case *Map:
+		return ValueToString(arr.List, i)
+	case *LargeList:
+		j := arr.Offset() + i
+		beg, end := int(arr.offsets[j]), int(arr.offsets[j+1])
+		return "[" + joinValues(arr.ListValues(), beg, end) + "]"
+	case *List:
+		j := arr.Offset() + i
+		beg, end := int(arr.offsets[j]), int(arr.offsets[j+1])
+		return "[" + joinValues(arr.ListValues(), beg, end) + "]"
+	case *FixedSizeList:
+		n := int(arr.DataType().(*arrow.FixedSizeListType).Len())
+		beg := (arr.Offset() + i) * n
+		return "[" + joinValues(arr.ListValues(), beg, beg+n) + "]"
+	case *Struct:
+		o := new(strings.Builder)
+		o.WriteString("{")
+		for j := 0; j < arr.NumField(); j++ {
+			if j > 0 {
+				o.WriteString(" ")
+			}
+			o.WriteString(ValueToString(arr.Field(j), i))
+		}
+		o.WriteString("}")
+		return o.String()
+	case *Custom:
+		return ValueToString(arr.storage, i)
+	case *Dictionary:
+		return ValueToString(arr.dict, arr.GetValueIndex(i))
+	default:
+		panic(xerrors.Errorf("arrow/array: unsupported data type %v", arr.DataType()))
+	}
+}
+
+func joinValues(arr Interface, beg, end int) string {
+	o := new(strings.Builder)
+	for j := beg; j < end; j++ {
+		if j > beg {
+			o.WriteString(" ")
+		}
+		o.WriteString(ValueToString(arr, j))
+	}
+	return o.String()
+}
@@ -0,0 +1,287 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestAppendValueFromString(t *testing.T) {
+	for _, tc := range []struct {
+		dtype   arrow.DataType
+		values  []string
+		invalid []string
+	}{
+		{arrow.FixedWidthTypes.Boolean, []string{"true", "false"}, []string{"yes", ""}},
+		{arrow.PrimitiveTypes.Int8, []string{"-128", "0", "127"}, []string{"128", "1.5"}},
+		{arrow.PrimitiveTypes.Int16, []string{"-32768", "32767"}, []string{"32768", "x"}},
+		{arrow.PrimitiveTypes.Int32, []string{"-2147483648", "2147483647"}, []string{"2147483648"}},
+		{arrow.PrimitiveTypes.Int64, []string{"-9223372036854775808", "9223372036854775807"}, []string{"9223372036854775808"}},
+		{arrow.PrimitiveTypes.Uint8, []string{"0", "255"}, []string{"256", "-1"}},
+		{arrow.PrimitiveTypes.Uint16, []string{"0", "65535"}, []string{"65536"}},
+		{arrow.PrimitiveTypes.Uint32, []string{"0", "4294967295"}, []string{"4294967296"}},
+		{arrow.PrimitiveTypes.Uint64, []string{"0", "18446744073709551615"}, []string{"18446744073709551616"}},
+		{arrow.FixedWidthTypes.Float16, []string{"-1.5", "0.25", "65504"}, []string{"1,5"}},
+		{arrow.PrimitiveTypes.Float32, []string{"-1.5", "0.1", "3.4028235e+38", "NaN", "+Inf"}, []string{"1e39"}},
+		{arrow.PrimitiveTypes.Float64, []string{"-1.5", "0.1", "1e+300", "-Inf"}, []string{"1e400", "one"}},
+		{&arrow.Decimal128Type{Precision: 10, Scale: 2}, []string{"-123.45", "0.00", "99999999.99"}, []string{"1.234", "100000000.00", "x"}},
+		{&arrow.Decimal256Type{Precision: 40, Scale: 3}, []string{"-1.500", "1234567890123456789012345678901234567.890"}, []string{"1.2345"}},
+		{arrow.FixedWidthTypes.Date32, []string{"1970-01-01", "2021-03-04", "1969-12-31"}, []string{"2021-02-30", "2021-03-04 10:00:00"}},
+		{arrow.FixedWidthTypes.Date64, []string{"1970-01-01", "2021-03-04", "1900-01-01", "1492-10-12"}, []string{"04/03/2021"}},
+		{arrow.FixedWidthTypes.Time32s, []string{"00:00:00", "23:59:59"}, []string{"12:00:00.5", "24:00:00"}},
+		{arrow.FixedWidthTypes.Time32ms, []string{"12:34:56.789"}, []string{"12:34:56.7891"}},
+		{arrow.FixedWidthTypes.Time64us, []string{"12:34:56.789012"}, []string{"12:34:56.7890123"}},
+		{arrow.FixedWidthTypes.Time64ns, []string{"12:34:56.789012345", "01:02:03"}, []string{"1:2"}},
+		{&arrow.TimestampType{Unit: arrow.Second}, []string{"1970-01-01T00:00:00Z", "2021-03-04T05:06:07Z", "1900-01-01T00:00:00Z"}, []string{"2021-03-04T05:06:07.5Z", "tomorrow"}},
+		{&arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "Europe/Paris"}, []string{"2021-03-04T05:06:07.123456789+01:00", "2021-07-04T05:06:07+02:00"}, []string{"2300-01-01T00:00:00Z"}},
+		{&arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "-03:30"}, []string{"2021-03-04T05:06:07.123-03:30"}, nil},
+		{&arrow.DurationType{Unit: arrow.Millisecond}, []string{"-1500", "0", "86400000"}, []string{"1.5s"}},
+		{arrow.FixedWidthTypes.MonthInterval, []string{"-3", "12"}, []string{"2147483648"}},
+		{arrow.FixedWidthTypes.DayTimeInterval, []string{"1d500ms", "-2d-10ms", "0d0ms"}, []string{"1d", "500ms", "d1ms", "1dxms"}},
+		{arrow.BinaryTypes.Binary, []string{"", "\x00\xff", "abc"}, nil},
+		{arrow.BinaryTypes.String, []string{"", "héllo", "a,b"}, nil},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 3}, []string{"abc", "\x00\x01\x02"}, []string{"ab", "abcd"}},
+	} {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+
+			for _, s := range tc.values {
+				if err := b.AppendValueFromString(s); err != nil {
+					t.Fatalf("could not parse %q: %v", s, err)
+				}
+			}
+			for _, s := range tc.invalid {
+				err := b.AppendValueFromString(s)
+				if err == nil {
+					t.Fatalf("expected an error parsing %q", s)
+				}
+				if !strings.Contains(err.Error(), fmt.Sprint(tc.dtype)) {
+					t.Fatalf("error does not mention the data type %v: %v", tc.dtype, err)
+				}
+			}
+			b.AppendNull()
+
+			arr := b.NewArray()
+			defer arr.Release()
+
+			if got, want := arr.Len(), len(tc.values)+1; got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+			for i, want := range tc.values {
+				if got := array.ValueToString(arr, i); got != want {
+					t.Fatalf("invalid value %d: got=%q, want=%q", i, got, want)
+				}
+			}
+			if got, want := array.ValueToString(arr, len(tc.values)), "(null)"; got != want {
+				t.Fatalf("invalid null value: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendValueFromStringUnsupported(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, dtype := range []arrow.DataType{
+		arrow.Null,
+		arrow.ListOf(arrow.PrimitiveTypes.Int32),
+		arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32),
+		arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}),
+	} {
+		b := array.NewBuilder(mem, dtype)
+		err := b.AppendValueFromString("[1 2]")
+		if err == nil {
+			t.Errorf("%v: expected an error", dtype)
+		} else if !strings.Contains(err.Error(), fmt.Sprint(dtype)) {
+			t.Errorf("error does not mention the data type %v: %v", dtype, err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("%v: unexpected value appended", dtype)
+		}
+		b.Release()
+	}
+}
+
+func TestAppendValueOrNullFromString(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		dtype arrow.DataType
+		input []string
+		want  []string
+	}{
+		{arrow.PrimitiveTypes.Int32, []string{"1", "", "NULL", "-3"}, []string{"1", "(null)", "(null)", "-3"}},
+		{arrow.FixedWidthTypes.Boolean, []string{"", "true", "0"}, []string{"(null)", "true", "false"}},
+		{arrow.BinaryTypes.String, []string{"a", "NULL", ""}, []string{"a", "(null)", "(null)"}},
+	} {
+		b := array.NewBuilder(mem, tc.dtype)
+		for _, s := range tc.input {
+			if err := array.AppendValueOrNullFromString(b, s, "", "NULL"); err != nil {
+				t.Fatalf("%v: could not parse %q: %v", tc.dtype, s, err)
+			}
+		}
+		if err := array.AppendValueOrNullFromString(b, "null", "", "NULL"); err == nil && tc.dtype.ID() != arrow.STRING {
+			t.Errorf("%v: expected an error parsing %q", tc.dtype, "null")
+		}
+		arr := b.NewArray()
+		b.Release()
+
+		got := make([]string, len(tc.want))
+		for i := range got {
+			got[i] = array.ValueToString(arr, i)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%v: got=%q, want=%q", tc.dtype, got, tc.want)
+		}
+		arr.Release()
+	}
+}
+
+func TestValueToStringNested(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		arrow.Field{Name: "s", Type: arrow.BinaryTypes.String},
+	)
+	b := array.NewStructBuilder(mem, dtype)
+	defer b.Release()
+
+	var (
+		lb = b.FieldBuilder(0).(*array.ListBuilder)
+		vb = lb.ValueBuilder().(*array.Int32Builder)
+		sb = b.FieldBuilder(1).(*array.StringBuilder)
+	)
+	b.Append(true)
+	lb.Append(true)
+	vb.AppendValues([]int32{1, 2}, []bool{true, false})
+	sb.Append("a")
+	b.AppendNull()
+	b.Append(true)
+	lb.Append(true)
+	vb.Append(3)
+	sb.AppendNull()
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	for i, want := range []string{"{[1 (null)] a}", "(null)", "{[3] (null)}"} {
+		if got := array.ValueToString(arr, i); got != want {
+			t.Fatalf("invalid value %d: got=%q, want=%q", i, got, want)
+		}
+	}
+
+	sub := array.NewSlice(arr, 1, 3)
+	defer sub.Release()
+	if got, want := array.ValueToString(sub, 1), "{[3] (null)}"; got != want {
+		t.Fatalf("invalid sliced value: got=%q, want=%q", got, want)
+	}
+}
+
+func TestValueToStringFloatRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	// all the float16 values, and special and random float32 and float64 ones.
+	f16 := make([]byte, 2<<16)
+	for i := 0; i < 1<<16; i++ {
+		binary.LittleEndian.PutUint16(f16[2*i:], uint16(i))
+	}
+	f32 := make([]byte, 4*1000)
+	rnd.Read(f32)
+	for i, v := range []float32{
+		0, float32(math.Copysign(0, -1)), 0.1, math.MaxFloat32, -math.MaxFloat32,
+		math.SmallestNonzeroFloat32, math.Float32frombits(0x007fffff),
+		float32(math.Inf(+1)), float32(math.Inf(-1)), float32(math.NaN()),
+	} {
+		binary.LittleEndian.PutUint32(f32[4*i:], math.Float32bits(v))
+	}
+	f64 := make([]byte, 8*1000)
+	rnd.Read(f64)
+	for i, v := range []float64{
+		0, math.Copysign(0, -1), 0.1, math.MaxFloat64, -math.MaxFloat64,
+		math.SmallestNonzeroFloat64, math.Float64frombits(0x000fffffffffffff),
+		math.Inf(+1), math.Inf(-1), math.NaN(),
+	} {
+		binary.LittleEndian.PutUint64(f64[8*i:], math.Float64bits(v))
+	}
+
+	for _, tc := range []struct {
+		dtype arrow.DataType
+		raw   []byte
+	}{
+		{arrow.FixedWidthTypes.Float16, f16},
+		{arrow.PrimitiveTypes.Float32, f32},
+		{arrow.PrimitiveTypes.Float64, f64},
+	} {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			var (
+				width = tc.dtype.(arrow.FixedWidthDataType).BitWidth() / 8
+				n     = len(tc.raw) / width
+			)
+			data := array.NewData(tc.dtype, n, []*memory.Buffer{nil, memory.NewBufferBytes(tc.raw)}, nil, 0, 0)
+			defer data.Release()
+			arr := array.MakeFromData(data)
+			defer arr.Release()
+
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+			for i := 0; i < n; i++ {
+				if err := b.AppendValueFromString(array.ValueToString(arr, i)); err != nil {
+					t.Fatalf("could not parse value %d: %v", i, err)
+				}
+			}
+			got := b.NewArray()
+			defer got.Release()
+
+			raw := got.Data().Buffers()[1].Bytes()
+			for i := 0; i < n; i++ {
+				want := array.ValueToString(arr, i)
+				if want == "NaN" {
+					// the payload of NaNs is not preserved.
+					if s := array.ValueToString(got, i); s != want {
+						t.Fatalf("invalid value %d: got=%s, want=%s", i, s, want)
+					}
+					continue
+				}
+				if beg, end := i*width, (i+1)*width; !bytes.Equal(raw[beg:end], tc.raw[beg:end]) {
+					t.Fatalf("invalid value %d: got=%s, want=%s (bits %x, want %x)", i, array.ValueToString(got, i), want, raw[beg:end], tc.raw[beg:end])
+				}
+			}
+		})
+	}
+}
@@ -142,24 +142,61 @@ func (b *StringBuilder) Cap() int { return b.builder.Cap() }
 // NullN returns the number of null values in the array builder.
 func (b *StringBuilder) NullN() int { return b.builder.NullN() }
 
+// IsNull returns whether the i-th element appended to the builder is null.
+func (b *StringBuilder) IsNull(i int) bool { return b.builder.IsNull(i) }
+
+// IsValid returns whether the i-th element appended to the builder is valid.
+func (b *StringBuilder) IsValid(i int) bool { return b.builder.IsValid(i) }
+
+// SetNull sets the i-th element appended to the builder to null.
+func (b *StringBuilder) SetNull(i int) { b.builder.SetNull(i) }
+
+// SetValid overwrites the i-th element appended to the builder with v, and
+// marks it as valid. See BinaryBuilder.SetValid.
+func (b *StringBuilder) SetValid(i int, v string) error {
+	return b.builder.SetValid(i, []byte(v))
+}
+
 // Append appends a string to the builder.
 func (b *StringBuilder) Append(v string) {
 	b.builder.Append([]byte(v))
 }
 
+// UnsafeAppend appends a string to the builder without checking for capacity.
+// It is the caller's responsibility to have called Reserve and ReserveData
+// beforehand to make room for the new element and its bytes.
+func (b *StringBuilder) UnsafeAppend(v string) {
+	b.builder.UnsafeAppend([]byte(v))
+}
+
 // AppendNull appends a null to the builder.
 func (b *StringBuilder) AppendNull() {
 	b.builder.AppendNull()
 }
 
+// AppendNulls appends n null values to the builder, in bulk.
+func (b *StringBuilder) AppendNulls(n int) {
+	b.builder.AppendNulls(n)
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
+//
+// The elements for which valid is false are nulls, even if their value is empty. Their values are
+// still copied to the data buffer, and the offsets and data buffers are reserved once for all the values.
 func (b *StringBuilder) AppendValues(v []string, valid []bool) {
 	b.builder.AppendStringValues(v, valid)
 }
 
-// Value returns the string at index i.
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *StringBuilder) AppendArray(arr Interface, start, end int) {
+	b.builder.AppendArray(arr, start, end)
+}
+
+// Value returns the i-th string appended to the builder, which is empty if
+// the element is null.
+// Value panics if i is not in the range [0, Len()).
 func (b *StringBuilder) Value(i int) string {
 	return string(b.builder.Value(i))
 }
@@ -172,6 +209,39 @@ func (b *StringBuilder) resize(newBits int, init func(int)) {
 	b.builder.resize(newBits, init)
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *StringBuilder) Type() arrow.DataType { return arrow.BinaryTypes.String }
+
+func (b *StringBuilder) disableNulls() {
+	b.builder.disableNulls()
+}
+
+func (b *StringBuilder) nullsDisabled() bool { return b.builder.nullsDisabled() }
+
+// DataLen returns the number of bytes in the data array.
+func (b *StringBuilder) DataLen() int { return b.builder.DataLen() }
+
+// DataCap returns the total number of bytes that can be stored
+// without allocating additional memory.
+func (b *StringBuilder) DataCap() int { return b.builder.DataCap() }
+
+// EstimatedDataSize returns an estimate of the number of data bytes needed
+// to hold Cap() strings, extrapolated from the average length of the
+// strings appended so far.
+func (b *StringBuilder) EstimatedDataSize() int {
+	n := b.builder.Len()
+	if n == 0 {
+		return 0
+	}
+	return int(int64(b.builder.DataLen()) * int64(b.builder.Cap()) / int64(n))
+}
+
+// ReserveData ensures there is enough space for appending n bytes
+// by checking the capacity and resizing the data buffer if necessary.
+func (b *StringBuilder) ReserveData(n int) {
+	b.builder.ReserveData(n)
+}
+
 // Reserve ensures there is enough space for appending n elements
 // by checking the capacity and calling Resize if necessary.
 func (b *StringBuilder) Reserve(n int) {
@@ -199,6 +269,23 @@ func (b *StringBuilder) NewStringArray() (a *String) {
 	return
 }
 
+// EstimateStringBufferSize extrapolates the number of data bytes needed to
+// store totalRows strings, given a sample of those strings: it is the mean
+// length of the strings of sample, times totalRows.
+// EstimateStringBufferSize does not sample strings itself. The sample
+// should be drawn uniformly from the whole input, e.g. with reservoir
+// sampling, for the estimate to be meaningful.
+func EstimateStringBufferSize(sample []string, totalRows int) int {
+	if len(sample) == 0 || totalRows <= 0 {
+		return 0
+	}
+	n := 0
+	for _, v := range sample {
+		n += len(v)
+	}
+	return int(int64(n) * int64(totalRows) / int64(len(sample)))
+}
+
 var (
 	_ Interface = (*String)(nil)
 	_ Builder   = (*StringBuilder)(nil)
@@ -17,6 +17,7 @@
 package array_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
@@ -161,6 +162,25 @@ func TestStringBuilder_Empty(t *testing.T) {
 
 // TestStringReset tests the Reset() method on the String type by creating two different Strings and then
 // reseting the contents of string2 with the values from string1.
+func TestStringBuilder_Value(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewStringBuilder(mem)
+	defer ab.Release()
+
+	ab.AppendValues([]string{"a", "", "héllo", "x"}, []bool{true, false, true, true})
+	ab.Append("last")
+
+	assert.Equal(t, "a", ab.Value(0))
+	assert.True(t, ab.IsNull(1))
+	assert.Equal(t, "", ab.Value(1))
+	assert.Equal(t, "héllo", ab.Value(2))
+	assert.True(t, ab.IsValid(2))
+	assert.Equal(t, "last", ab.Value(4))
+	assert.Panics(t, func() { ab.Value(5) })
+}
+
 func TestStringReset(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	sb1 := array.NewStringBuilder(mem)
@@ -181,3 +201,236 @@ func TestStringReset(t *testing.T) {
 
 	assert.Equal(t, "string1", string2.Value(0))
 }
+
+func TestStringBuilder_UnsafeAppend(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	want := []string{"hello", "", "世界", "arrow"}
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.Reserve(len(want))
+	b.ReserveData(len("hello世界arrow"))
+	for _, v := range want {
+		b.UnsafeAppend(v)
+	}
+	assert.Equal(t, len(want), b.Len())
+	assert.Equal(t, len("hello世界arrow"), b.DataLen())
+
+	arr := b.NewStringArray()
+	defer arr.Release()
+
+	assert.Equal(t, len(want), arr.Len())
+	for i, v := range want {
+		assert.Equal(t, v, arr.Value(i))
+	}
+}
+
+func TestStringBuilder_ReserveData(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.Append("hello")
+	b.AppendNull()
+	b.Append("世界")
+	assert.Equal(t, len("hello世界"), b.DataLen())
+
+	// growing the data buffer keeps the bytes appended so far.
+	b.ReserveData(1 << 10)
+	assert.True(t, b.DataCap() >= b.DataLen()+1<<10, "unexpected DataCap: %d", b.DataCap())
+	assert.Equal(t, len("hello世界"), b.DataLen())
+
+	// the reserved bytes are independent of the reserved elements.
+	capacity, dataCap := b.Cap(), b.DataCap()
+	b.ReserveData(1 << 10)
+	assert.Equal(t, dataCap, b.DataCap())
+	assert.Equal(t, capacity, b.Cap())
+
+	b.Append("arrow")
+	arr := b.NewStringArray()
+	defer arr.Release()
+
+	assert.Equal(t, 0, b.DataLen())
+	assert.Equal(t, 0, b.DataCap())
+	for i, v := range []string{"hello", "", "世界", "arrow"} {
+		assert.Equal(t, v, arr.Value(i))
+	}
+	assert.True(t, arr.IsNull(1))
+}
+
+func TestStringBuilder_AppendValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.AppendValues([]string{"hello", "", "null", "世界"}, []bool{true, false, false, true})
+	b.AppendValues([]string{"", "arrow"}, nil)
+
+	// empty values are nulls if they are not valid, and nulls keep their bytes.
+	assert.Equal(t, len("hellonull世界arrow"), b.DataLen())
+
+	arr := b.NewStringArray()
+	defer arr.Release()
+
+	assert.Equal(t, 6, arr.Len())
+	assert.Equal(t, 2, arr.NullN())
+	for i, want := range []string{"hello", "", "null", "世界", "", "arrow"} {
+		assert.Equal(t, want, arr.Value(i), "value %d", i)
+	}
+	for i, valid := range []bool{true, false, false, true, true, true} {
+		assert.Equal(t, valid, arr.IsValid(i), "validity of value %d", i)
+	}
+}
+
+func TestStringBuilder_EstimatedDataSize(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	assert.Equal(t, 0, b.EstimatedDataSize())
+
+	b.Reserve(64)
+	b.Append("abcd")
+	b.Append("ab")
+	assert.Equal(t, 3*b.Cap(), b.EstimatedDataSize())
+}
+
+func TestEstimateStringBufferSize(t *testing.T) {
+	for _, tc := range []struct {
+		sample []string
+		rows   int
+		want   int
+	}{
+		{nil, 10, 0},
+		{[]string{"a", "bc"}, 0, 0},
+		{[]string{"a", "bcd"}, 10, 20},
+		{[]string{"", "", ""}, 100, 0},
+		{[]string{"abc"}, 1 << 20, 3 << 20},
+	} {
+		got := array.EstimateStringBufferSize(tc.sample, tc.rows)
+		assert.Equal(t, tc.want, got, "sample=%q rows=%d", tc.sample, tc.rows)
+	}
+}
+
+func BenchmarkStringBuilder_Append(b *testing.B) {
+	const N = 1 << 12
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(b, 0)
+
+	vs := []string{"hello", "", "arrow", "string builder benchmark"}
+	size := 0
+	for j := 0; j < N; j++ {
+		size += len(vs[j%len(vs)])
+	}
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < N; j++ {
+			bldr.Append(vs[j%len(vs)])
+		}
+		arr := bldr.NewArray()
+		arr.Release()
+	}
+}
+
+// BenchmarkStringBuilder_AppendReserveData compares appends to a builder
+// growing its data buffer on demand with appends to a builder whose data
+// buffer is reserved up front.
+func BenchmarkStringBuilder_AppendReserveData(b *testing.B) {
+	const N = 1 << 20
+
+	vs := []string{"hello", "", "arrow", "string builder benchmark"}
+	size := 0
+	for j := 0; j < N; j++ {
+		size += len(vs[j%len(vs)])
+	}
+
+	for _, reserve := range []bool{false, true} {
+		b.Run(fmt.Sprintf("reserve=%v", reserve), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewStringBuilder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(N)
+				if reserve {
+					bldr.ReserveData(size)
+				}
+				for j := 0; j < N; j++ {
+					bldr.Append(vs[j%len(vs)])
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
+
+// BenchmarkStringBuilder_AppendValues compares appending strings one at a
+// time with appending them in bulk.
+func BenchmarkStringBuilder_AppendValues(b *testing.B) {
+	const N = 1 << 20
+
+	var (
+		vs    = make([]string, N)
+		valid = make([]bool, N)
+		size  = 0
+	)
+	for j := range vs {
+		vs[j] = []string{"hello", "", "arrow", "string builder benchmark"}[j%4]
+		valid[j] = j%7 != 0
+		size += len(vs[j])
+	}
+
+	for _, bulk := range []bool{false, true} {
+		b.Run(fmt.Sprintf("bulk=%v", bulk), func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			bldr := array.NewStringBuilder(mem)
+			defer bldr.Release()
+
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if bulk {
+					bldr.AppendValues(vs, valid)
+				} else {
+					for j, v := range vs {
+						if valid[j] {
+							bldr.Append(v)
+						} else {
+							bldr.AppendNull()
+						}
+					}
+				}
+				arr := bldr.NewArray()
+				arr.Release()
+			}
+		})
+	}
+}
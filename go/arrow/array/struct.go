@@ -105,10 +105,10 @@ func (a *Struct) setData(data *Data) {
 	}
 }
 
-func arrayEqualStruct(left, right *Struct) bool {
+func arrayEqualStruct(left, right *Struct, opt equalOption) bool {
 	for i, lf := range left.fields {
 		rf := right.fields[i]
-		if !ArrayEqual(lf, rf) {
+		if !arrayEqual(lf, rf, opt) {
 			return false
 		}
 	}
@@ -159,13 +159,15 @@ func (b *StructBuilder) Release() {
 			b.nullBitmap.Release()
 			b.nullBitmap = nil
 		}
-	}
-
-	for _, f := range b.fields {
-		f.Release()
+		for _, f := range b.fields {
+			f.Release()
+		}
 	}
 }
 
+// Type returns the data type of the arrays created by the builder.
+func (b *StructBuilder) Type() arrow.DataType { return b.dtype }
+
 func (b *StructBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(v)
@@ -176,25 +178,46 @@ func (b *StructBuilder) Append(v bool) {
 	}
 }
 
+// AppendValues appends len(valids) structs, valid or null depending on
+// valids. Unlike Append(false), it does not append to the field builders:
+// one value, or null, must be appended to each of them per struct, whether
+// valid or null.
 func (b *StructBuilder) AppendValues(valids []bool) {
 	b.Reserve(len(valids))
 	b.builder.unsafeAppendBoolsToBitmap(valids, len(valids))
 }
 
+// AppendArray appends the elements of arr in the range [start, end) to b.
+func (b *StructBuilder) AppendArray(arr Interface, start, end int) {
+	n := appendArrayLen(b.Type(), arr, start, end)
+	if n == 0 {
+		return
+	}
+
+	src := arr.(*Struct)
+	b.Reserve(n)
+	b.builder.unsafeAppendValidity(src.Data(), start, n)
+	for i, f := range b.fields {
+		f.AppendArray(src.Field(i), start, end)
+	}
+}
+
 func (b *StructBuilder) AppendNull() { b.Append(false) }
 
+// AppendNulls appends n null structs, in bulk. Unlike AppendNull, and as
+// AppendValues, it does not append to the field builders: one value, or
+// null, must be appended to each of them per struct.
+func (b *StructBuilder) AppendNulls(n int) {
+	b.builder.reserve(n, b.resizeHelper)
+	b.unsafeAppendNulls(n)
+}
+
 func (b *StructBuilder) unsafeAppend(v bool) {
-	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(true)
 }
 
 func (b *StructBuilder) unsafeAppendBoolToBitmap(isValid bool) {
-	if isValid {
-		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
-	} else {
-		b.nulls++
-	}
-	b.length++
+	b.builder.UnsafeAppendBoolToBitmap(isValid)
 }
 
 func (b *StructBuilder) init(capacity int) {
@@ -231,9 +254,26 @@ func (b *StructBuilder) resizeHelper(n int) {
 	}
 }
 
-func (b *StructBuilder) NumField() int              { return len(b.fields) }
+func (b *StructBuilder) NumField() int { return len(b.fields) }
+
+// FieldBuilder returns the builder of the i-th field.
+// The returned builder is owned by b: callers using it after b has been
+// released must Retain it.
 func (b *StructBuilder) FieldBuilder(i int) Builder { return b.fields[i] }
 
+// FieldBuilderByName returns the builder of the first field with the given
+// name, in the order of the fields of the struct, or nil if there is no such
+// field. Use FieldBuilder with the indices returned by the FieldIndices
+// method of the data type to access fields sharing a name.
+// As with FieldBuilder, the returned builder is owned by b.
+func (b *StructBuilder) FieldBuilderByName(name string) Builder {
+	idx := b.dtype.(*arrow.StructType).FieldIndices(name)
+	if len(idx) == 0 {
+		return nil
+	}
+	return b.fields[idx[0]]
+}
+
 // NewArray creates a Struct array from the memory buffers used by the builder and resets the StructBuilder
 // so it can be used to build a new array.
 func (b *StructBuilder) NewArray() Interface {
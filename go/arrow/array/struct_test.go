@@ -17,6 +17,7 @@
 package array_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -407,3 +408,64 @@ func TestStructArrayNullBitmap(t *testing.T) {
 		t.Fatalf("invalid string representation:\ngot = %q\nwant= %q", got, want)
 	}
 }
+
+func TestStructBuilderFieldBuilderByName(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	const nfields = 10
+	fields := make([]arrow.Field, 0, nfields+1)
+	for i := 0; i < nfields; i++ {
+		fields = append(fields, arrow.Field{Name: fmt.Sprintf("f%d", i), Type: arrow.PrimitiveTypes.Int64, Nullable: true})
+	}
+	// a duplicate of f3, with another type.
+	fields = append(fields, arrow.Field{Name: "f3", Type: arrow.BinaryTypes.String, Nullable: true})
+
+	sb := array.NewStructBuilder(pool, arrow.StructOf(fields...))
+	defer sb.Release()
+
+	if b := sb.FieldBuilderByName("missing"); b != nil {
+		t.Fatalf("unexpected builder for unknown field: %T", b)
+	}
+	if got, want := sb.FieldBuilderByName("f3"), sb.FieldBuilder(3); got != want {
+		t.Fatalf("duplicate names should resolve to the first field")
+	}
+
+	const nrows = 4
+	sb.AppendValues([]bool{true, false, true, true})
+	for i := 0; i < nrows; i++ {
+		// fill fields in reverse order, by name only.
+		for j := nfields - 1; j >= 0; j-- {
+			b := sb.FieldBuilderByName(fmt.Sprintf("f%d", j)).(*array.Int64Builder)
+			if i == 2 && j%2 == 0 {
+				b.AppendNull()
+				continue
+			}
+			b.Append(int64(10*i + j))
+		}
+		sb.FieldBuilder(nfields).(*array.StringBuilder).Append(fmt.Sprint(i))
+	}
+
+	arr := sb.NewStructArray()
+	defer arr.Release()
+
+	if got, want := arr.Len(), nrows; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if !arr.IsNull(1) || arr.NullN() != 1 {
+		t.Fatalf("invalid validity: nulls=%d", arr.NullN())
+	}
+	for j := 0; j < nfields; j++ {
+		f := arr.Field(j).(*array.Int64)
+		for i := 0; i < nrows; i++ {
+			switch {
+			case i == 2 && j%2 == 0:
+				if f.IsValid(i) {
+					t.Fatalf("field f%d, row %d: expected a null", j, i)
+				}
+			case f.Value(i) != int64(10*i+j):
+				t.Fatalf("field f%d, row %d: got=%d, want=%d", j, i, f.Value(i), 10*i+j)
+			}
+		}
+	}
+}
@@ -17,13 +17,16 @@
 package array
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/debug"
+	"golang.org/x/xerrors"
 )
 
 // Table represents a logical sequence of chunked arrays.
@@ -83,6 +86,12 @@ func (col *Column) Field() arrow.Field       { return col.field }
 func (col *Column) Name() string             { return col.field.Name }
 func (col *Column) DataType() arrow.DataType { return col.field.Type }
 
+// MinMax returns the smallest and greatest valid values of the column.
+// See Chunked.MinMax.
+func (col *Column) MinMax(ctx context.Context) (min, max interface{}, err error) {
+	return col.data.MinMax(ctx)
+}
+
 // NewSlice returns a new zero-copy slice of the column with the indicated
 // indices i and j, corresponding to the column's array[i:j].
 // The returned column must be Release()'d after use.
@@ -105,6 +114,9 @@ type Chunked struct {
 	length int
 	nulls  int
 	dtype  arrow.DataType
+
+	mu    sync.Mutex  // guards chunks in AddChunk and MinMax, and stats.
+	stats minMaxStats // cached bounds of the values, see MinMax.
 }
 
 // NewChunked returns a new chunked array from the slice of arrays.
@@ -147,7 +159,61 @@ func (a *Chunked) Release() {
 		a.chunks = nil
 		a.length = 0
 		a.nulls = 0
+		a.stats = minMaxStats{}
+	}
+}
+
+// AddChunk appends the chunk arr to the chunked array, for tables built by
+// appending record batches. The cached bounds of MinMax are kept, so that
+// only arr is scanned by the next call to MinMax.
+//
+// AddChunk may be called concurrently with MinMax, but not with the other
+// methods of the chunked array. It must not be called on a chunked array
+// shared with a table or a record, whose number of rows would become stale.
+//
+// AddChunk panics if arr does not have the data type of the chunked array.
+func (a *Chunked) AddChunk(arr Interface) {
+	if !arrow.TypeEqual(arr.DataType(), a.dtype) {
+		panic("arrow/array: mismatch data type")
+	}
+	arr.Retain()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.chunks = append(a.chunks, arr)
+	a.length += arr.Len()
+	a.nulls += arr.NullN()
+}
+
+// MinMax returns the smallest and greatest valid values of the chunked array,
+// as returned by the Value method of its chunks, e.g. an int32 or an
+// arrow.Timestamp. NaN values are ignored. min and max are nil when the
+// chunked array has no valid value.
+//
+// The bounds are computed on the first call, and cached: as chunks are
+// immutable, later calls only scan the chunks added since by AddChunk.
+// MinMax may be called simultaneously from multiple goroutines, in which
+// case the chunks are scanned only once.
+//
+// MinMax returns ctx.Err() if ctx is done before all the chunks are scanned,
+// keeping the bounds of the scanned ones for the next call.
+// It returns an error if the data type of the chunked array is not a
+// numeric or temporal one.
+func (a *Chunked) MinMax(ctx context.Context) (min, max interface{}, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, chunk := range a.chunks[a.stats.n:] {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		arr, ok := chunk.(minMaxer)
+		if !ok {
+			return nil, nil, xerrors.Errorf("arrow/array: unsupported data type %v for min/max", a.dtype)
+		}
+		a.stats.add(arr)
 	}
+	return a.stats.min, a.stats.max, nil
 }
 
 func (a *Chunked) Len() int                 { return a.length }
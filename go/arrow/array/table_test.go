@@ -17,12 +17,16 @@
 package array_test
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -216,6 +220,171 @@ func TestChunkedSliceInvalid(t *testing.T) {
 	}
 }
 
+func TestChunkedMinMax(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name     string
+		dtype    arrow.DataType
+		chunks   [][]string
+		min, max interface{}
+	}{
+		{"int32", arrow.PrimitiveTypes.Int32, [][]string{{"3", "-2"}, {}, {"", "7", "1"}}, int32(-2), int32(7)},
+		{"uint8", arrow.PrimitiveTypes.Uint8, [][]string{{"", "255"}, {"0"}}, uint8(0), uint8(255)},
+		{"float64", arrow.PrimitiveTypes.Float64, [][]string{{"NaN", "1.5"}, {"-Inf", ""}, {"NaN"}}, math.Inf(-1), 1.5},
+		{"float16", arrow.FixedWidthTypes.Float16, [][]string{{"0.5", "NaN"}, {"-2"}}, float16.New(-2), float16.New(0.5)},
+		{"timestamp", &arrow.TimestampType{Unit: arrow.Second}, [][]string{{"2021-03-04T00:00:00Z"}, {"1970-01-01T00:00:01Z"}}, arrow.Timestamp(1), arrow.Timestamp(1614816000)},
+		{"all-nulls", arrow.PrimitiveTypes.Int64, [][]string{{""}, {"", ""}}, nil, nil},
+		{"all-nans", arrow.PrimitiveTypes.Float32, [][]string{{"NaN"}}, nil, nil},
+		{"no-chunks", arrow.PrimitiveTypes.Int64, nil, nil, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+
+			chunks := make([]array.Interface, len(tc.chunks))
+			for i, values := range tc.chunks {
+				for _, v := range values {
+					if err := array.AppendValueOrNullFromString(b, v, ""); err != nil {
+						t.Fatal(err)
+					}
+				}
+				chunks[i] = b.NewArray()
+				defer chunks[i].Release()
+			}
+
+			chunked := array.NewChunked(tc.dtype, chunks)
+			defer chunked.Release()
+
+			col := array.NewColumn(arrow.Field{Name: "x", Type: tc.dtype}, chunked)
+			defer col.Release()
+
+			// the second call is served from the cache.
+			for i := 0; i < 2; i++ {
+				min, max, err := col.MinMax(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if min != tc.min || max != tc.max {
+					t.Fatalf("invalid bounds: got=(%v, %v), want=(%v, %v)", min, max, tc.min, tc.max)
+				}
+			}
+		})
+	}
+}
+
+func TestChunkedMinMaxAddChunk(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ctx := context.Background()
+
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+
+	b.AppendValues([]int64{5, 6}, nil)
+	first := b.NewArray()
+	defer first.Release()
+
+	chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, []array.Interface{first})
+	defer chunked.Release()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			min, max, err := chunked.MinMax(ctx)
+			if err != nil || min != int64(5) || max != int64(6) {
+				t.Errorf("invalid bounds: got=(%v, %v, %v)", min, max, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, values := range [][]int64{{7, -1}, {3}} {
+		b.AppendValues(values, nil)
+		arr := b.NewArray()
+		chunked.AddChunk(arr)
+		arr.Release()
+	}
+	b.AppendNull()
+	arr := b.NewArray()
+	chunked.AddChunk(arr)
+	arr.Release()
+
+	if got, want := chunked.Len(), 6; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if got, want := chunked.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+	if got, want := len(chunked.Chunks()), 4; got != want {
+		t.Fatalf("invalid number of chunks: got=%d, want=%d", got, want)
+	}
+
+	min, max, err := chunked.MinMax(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if min != int64(-1) || max != int64(7) {
+		t.Fatalf("invalid bounds: got=(%v, %v), want=(-1, 7)", min, max)
+	}
+
+	f := array.NewFloat64Builder(mem)
+	defer f.Release()
+	f.Append(1)
+	other := f.NewArray()
+	defer other.Release()
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic adding a chunk of another data type")
+		}
+	}()
+	chunked.AddChunk(other)
+}
+
+func TestChunkedMinMaxErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.Append("a")
+	str := sb.NewArray()
+	defer str.Release()
+
+	chunked := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{str})
+	defer chunked.Release()
+
+	if _, _, err := chunked.MinMax(context.Background()); err == nil {
+		t.Fatalf("expected an error on a string chunked array")
+	}
+
+	ib := array.NewInt8Builder(mem)
+	defer ib.Release()
+	ib.Append(1)
+	i8 := ib.NewArray()
+	defer i8.Release()
+
+	chunked = array.NewChunked(arrow.PrimitiveTypes.Int8, []array.Interface{i8})
+	defer chunked.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := chunked.MinMax(ctx); err != context.Canceled {
+		t.Fatalf("invalid error: got=%v, want=%v", err, context.Canceled)
+	}
+	min, max, err := chunked.MinMax(context.Background())
+	if err != nil || min != int8(1) || max != int8(1) {
+		t.Fatalf("invalid bounds: got=(%v, %v, %v)", min, max, err)
+	}
+}
+
 func TestColumn(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -584,7 +753,7 @@ func TestTableFromRecords(t *testing.T) {
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
 			arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64},
 		},
 		nil,
@@ -745,3 +914,48 @@ func TestTableReader(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkChunkedMinMax(b *testing.B) {
+	const (
+		nchunks = 64
+		size    = 1 << 14
+	)
+
+	mem := memory.NewGoAllocator()
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+
+	chunks := make([]array.Interface, nchunks)
+	for i := range chunks {
+		for j := 0; j < size; j++ {
+			bldr.Append(int64(i*size + j))
+		}
+		chunks[i] = bldr.NewArray()
+		defer chunks[i].Release()
+	}
+
+	ctx := context.Background()
+	b.Run("cold", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, chunks)
+			if _, _, err := chunked.MinMax(ctx); err != nil {
+				b.Fatal(err)
+			}
+			chunked.Release()
+		}
+	})
+	b.Run("warm", func(b *testing.B) {
+		chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, chunks)
+		defer chunked.Release()
+		if _, _, err := chunked.MinMax(ctx); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := chunked.MinMax(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,277 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+type transferOption struct {
+	compact bool
+}
+
+// TransferOption is a functional option type used to configure how arrays
+// and records are transferred to another allocator.
+type TransferOption func(*transferOption)
+
+// WithCompaction configures the transfer so that only the elements in view
+// of sliced arrays are copied, and the resulting arrays have a zero offset.
+// By default, buffers are copied in full and offsets are preserved.
+func WithCompaction(v bool) TransferOption {
+	return func(o *transferOption) {
+		o.compact = v
+	}
+}
+
+// TransferArray returns a deep copy of arr, where every buffer, including
+// the ones of child arrays, is allocated with dst.
+// No buffer of the returned array references memory of arr.
+// The returned array must be Release()'d after use.
+//
+// TransferArray panics if the data type of arr is not supported.
+func TransferArray(dst memory.Allocator, arr Interface, opts ...TransferOption) Interface {
+	data := transferData(dst, arr.Data(), newTransferOption(opts...))
+	defer data.Release()
+	return MakeFromData(data)
+}
+
+// TransferRecord returns a deep copy of rec, where every buffer, including
+// the ones of child arrays, is allocated with dst.
+// No buffer of the returned record references memory of rec, which may thus
+// be released, along with the allocator that backs it.
+// The returned record must be Release()'d after use.
+//
+// TransferRecord panics if the data type of a column is not supported.
+func TransferRecord(dst memory.Allocator, rec Record, opts ...TransferOption) Record {
+	var (
+		opt  = newTransferOption(opts...)
+		cols = make([]Interface, rec.NumCols())
+	)
+	for i, col := range rec.Columns() {
+		data := transferData(dst, col.Data(), opt)
+		cols[i] = MakeFromData(data)
+		data.Release()
+	}
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	return NewRecord(rec.Schema(), cols, rec.NumRows())
+}
+
+func newTransferOption(opts ...TransferOption) transferOption {
+	var opt transferOption
+	for _, o := range opts {
+		o(&opt)
+	}
+	return opt
+}
+
+func transferData(dst memory.Allocator, data *Data, opt transferOption) *Data {
+	if opt.compact {
+		out := compactData(dst, data, data.offset, data.length)
+		out.meta = data.meta
+		return out
+	}
+
+	var (
+		bufs     = make([]*memory.Buffer, len(data.buffers))
+		children = make([]*Data, len(data.childData))
+	)
+	for i, buf := range data.buffers {
+		if buf != nil {
+			bufs[i] = copyBuffer(dst, buf.Bytes())
+		}
+	}
+	for i, child := range data.childData {
+		children[i] = transferData(dst, child, opt)
+	}
+
+	out := newOwnedData(data.dtype, data.length, bufs, children, data.nulls, data.offset)
+	out.meta = data.meta
+	return out
+}
+
+// compactData returns a copy of the n elements of data starting at the
+// absolute offset off, with a zero offset.
+func compactData(dst memory.Allocator, data *Data, off, n int) *Data {
+	var (
+		bufs     = make([]*memory.Buffer, len(data.buffers))
+		children []*Data
+		nulls    = UnknownNullCount
+	)
+
+	if data.dtype.ID() == arrow.NULL {
+		return NewData(data.dtype, n, bufs, nil, n, 0)
+	}
+	if dt, ok := data.dtype.(arrow.StorageProvider); ok {
+		storage := NewData(dt.StorageType(), data.length, data.buffers, data.childData, data.nulls, data.offset)
+		defer storage.Release()
+		out := compactData(dst, storage, off, n)
+		out.dtype = dt
+		return out
+	}
+
+	switch {
+	case data.nulls == 0 || data.buffers[0] == nil:
+		nulls = 0
+	default:
+		bufs[0] = copyBits(dst, data.buffers[0].Bytes(), off, n)
+	}
+
+	switch dt := data.dtype.(type) {
+	case arrow.FixedWidthDataType:
+		bw := dt.BitWidth()
+		switch {
+		case bw%8 == 0:
+			sz := bw / 8
+			bufs[1] = copyBuffer(dst, bufferBytes(data.buffers[1])[off*sz:(off+n)*sz])
+		default:
+			bufs[1] = copyBits(dst, bufferBytes(data.buffers[1]), off*bw, n*bw)
+		}
+
+	case arrow.BinaryDataType:
+		var beg, end int32
+		bufs[1], beg, end = rebaseOffsets(dst, data.buffers[1], off, n)
+		bufs[2] = copyBuffer(dst, bufferBytes(data.buffers[2])[beg:end])
+
+	case *arrow.ListType, *arrow.MapType:
+		var beg, end int32
+		bufs[1], beg, end = rebaseOffsets(dst, data.buffers[1], off, n)
+		children = []*Data{compactData(dst, data.childData[0], data.childData[0].offset+int(beg), int(end-beg))}
+
+	case *arrow.LargeListType:
+		var beg, end int64
+		bufs[1], beg, end = rebaseOffsets64(dst, data.buffers[1], off, n)
+		children = []*Data{compactData(dst, data.childData[0], data.childData[0].offset+int(beg), int(end-beg))}
+
+	case *arrow.FixedSizeListType:
+		sz := int(dt.Len())
+		child := data.childData[0]
+		children = []*Data{compactData(dst, child, child.offset+off*sz, n*sz)}
+
+	case *arrow.StructType:
+		children = make([]*Data, len(data.childData))
+		for i, child := range data.childData {
+			children[i] = compactData(dst, child, child.offset+off, n)
+		}
+
+	case *arrow.DictionaryType:
+		// the indices are compacted, the dictionary is copied whole.
+		sz := dt.IndexType.(arrow.FixedWidthDataType).BitWidth() / 8
+		bufs[1] = copyBuffer(dst, bufferBytes(data.buffers[1])[off*sz:(off+n)*sz])
+		dict := data.childData[0]
+		children = []*Data{compactData(dst, dict, dict.offset, dict.length)}
+
+	default:
+		for _, b := range bufs {
+			if b != nil {
+				b.Release()
+			}
+		}
+		panic("arrow/array: unsupported data type " + data.dtype.Name())
+	}
+
+	return newOwnedData(data.dtype, n, bufs, children, nulls, 0)
+}
+
+// newOwnedData returns a new Data, taking ownership of the provided buffers
+// and children.
+func newOwnedData(dtype arrow.DataType, n int, bufs []*memory.Buffer, children []*Data, nulls, offset int) *Data {
+	data := NewData(dtype, n, bufs, children, nulls, offset)
+	for _, b := range bufs {
+		if b != nil {
+			b.Release()
+		}
+	}
+	for _, c := range children {
+		c.Release()
+	}
+	return data
+}
+
+// rebaseOffsets returns a copy of the n+1 offsets starting at off, shifted
+// so that the first one is zero, along with the original first and last offsets.
+func rebaseOffsets(dst memory.Allocator, buf *memory.Buffer, off, n int) (*memory.Buffer, int32, int32) {
+	if n == 0 {
+		return newZeroBuffer(dst, arrow.Int32SizeBytes), 0, 0
+	}
+
+	var (
+		offsets = arrow.Int32Traits.CastFromBytes(buf.Bytes())[off : off+n+1]
+		out     = memory.NewResizableBuffer(dst)
+	)
+	out.Resize(arrow.Int32Traits.BytesRequired(n + 1))
+	vs := arrow.Int32Traits.CastFromBytes(out.Bytes())
+	for i, v := range offsets {
+		vs[i] = v - offsets[0]
+	}
+	return out, offsets[0], offsets[n]
+}
+
+// rebaseOffsets64 is like rebaseOffsets, for 64-bit offsets.
+func rebaseOffsets64(dst memory.Allocator, buf *memory.Buffer, off, n int) (*memory.Buffer, int64, int64) {
+	if n == 0 {
+		return newZeroBuffer(dst, arrow.Int64SizeBytes), 0, 0
+	}
+
+	var (
+		offsets = arrow.Int64Traits.CastFromBytes(buf.Bytes())[off : off+n+1]
+		out     = memory.NewResizableBuffer(dst)
+	)
+	out.Resize(arrow.Int64Traits.BytesRequired(n + 1))
+	vs := arrow.Int64Traits.CastFromBytes(out.Bytes())
+	for i, v := range offsets {
+		vs[i] = v - offsets[0]
+	}
+	return out, offsets[0], offsets[n]
+}
+
+// bufferBytes returns the bytes of buf, which may be nil for empty arrays.
+func bufferBytes(buf *memory.Buffer) []byte {
+	if buf == nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func copyBuffer(dst memory.Allocator, src []byte) *memory.Buffer {
+	buf := memory.NewResizableBuffer(dst)
+	buf.Resize(len(src))
+	copy(buf.Bytes(), src)
+	return buf
+}
+
+// copyBits returns a buffer holding the n bits of src starting at bit off.
+func copyBits(dst memory.Allocator, src []byte, off, n int) *memory.Buffer {
+	if off%8 == 0 {
+		return copyBuffer(dst, src[off/8:off/8+int(bitutil.BytesForBits(int64(n)))])
+	}
+
+	buf := newZeroBuffer(dst, int(bitutil.BytesForBits(int64(n))))
+	out := buf.Bytes()
+	for i := 0; i < n; i++ {
+		if bitutil.BitIsSet(src, off+i) {
+			bitutil.SetBit(out, i)
+		}
+	}
+	return buf
+}
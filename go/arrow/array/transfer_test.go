@@ -0,0 +1,175 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+// poisonAllocator overwrites memory when it is freed, so that reading from
+// a released buffer yields garbage.
+type poisonAllocator struct {
+	mem memory.Allocator
+}
+
+func (p poisonAllocator) Allocate(size int) []byte { return p.mem.Allocate(size) }
+
+func (p poisonAllocator) Reallocate(size int, b []byte) []byte {
+	nb := p.mem.Allocate(size)
+	copy(nb, b)
+	p.Free(b)
+	return nb
+}
+
+func (p poisonAllocator) Free(b []byte) {
+	for i := range b[:cap(b)] {
+		b[:cap(b)][i] = 0xa5
+	}
+	p.mem.Free(b)
+}
+
+func TestTransferRecord(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, compact := range []bool{false, true} {
+				dst := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				for i, want := range recs {
+					src := memory.NewCheckedAllocator(poisonAllocator{memory.NewGoAllocator()})
+					orig := array.TransferRecord(src, want)
+
+					got := array.TransferRecord(dst, orig, array.WithCompaction(compact))
+					orig.Release()
+					src.AssertSize(t, 0)
+
+					if !array.RecordEqual(got, want) {
+						t.Fatalf("invalid record %d (compact=%v):\ngot=%v\nwant=%v", i, compact, got, want)
+					}
+					got.Release()
+				}
+				dst.AssertSize(t, 0)
+			}
+		})
+	}
+}
+
+func TestTransferRecordSlice(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, rec := range recs {
+				if rec.NumRows() < 3 {
+					continue
+				}
+				for _, compact := range []bool{false, true} {
+					mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+					slice := rec.NewSlice(1, rec.NumRows()-1)
+					got := array.TransferRecord(mem, slice, array.WithCompaction(compact))
+
+					if !array.RecordEqual(got, slice) {
+						t.Fatalf("invalid record (compact=%v):\ngot=%v\nwant=%v", compact, got, slice)
+					}
+					for i, col := range got.Columns() {
+						switch {
+						case compact:
+							assert.Equal(t, 0, col.Data().Offset(), "column %d", i)
+						default:
+							assert.Equal(t, slice.Column(i).Data().Offset(), col.Data().Offset(), "column %d", i)
+						}
+						assert.Equal(t, slice.Column(i).NullN(), col.NullN(), "column %d", i)
+					}
+
+					slice.Release()
+					got.Release()
+					mem.AssertSize(t, 0)
+				}
+			}
+		})
+	}
+}
+
+func TestTransferArrayCompaction(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"aaaa", "bbbb", "cc", "dddd"}, []bool{true, true, false, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 3)
+	defer slice.Release()
+
+	got := array.TransferArray(mem, slice, array.WithCompaction(true))
+	defer got.Release()
+
+	assert.True(t, array.ArrayEqual(got, slice))
+	assert.Equal(t, []int32{0, 4, 6}, arrow.Int32Traits.CastFromBytes(got.Data().Buffers()[1].Bytes()))
+	assert.Equal(t, 6, got.Data().Buffers()[2].Len())
+	assert.Equal(t, 1, got.NullN())
+}
+
+func TestTransferDictionary(t *testing.T) {
+	for _, dt := range []*arrow.DictionaryType{
+		{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String},
+		{IndexType: arrow.PrimitiveTypes.Uint16, ValueType: arrow.PrimitiveTypes.Int64},
+		{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.Binary, Ordered: true},
+	} {
+		t.Run(dt.String(), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			bldr := array.NewBuilder(mem, dt).(*array.DictionaryBuilder)
+			defer bldr.Release()
+			for _, v := range []string{"1", "2", "", "1", "3", "", "2"} {
+				if v == "" {
+					bldr.AppendNull()
+					continue
+				}
+				if err := bldr.AppendValueFromString(v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			arr := bldr.NewArray()
+			defer arr.Release()
+
+			slice := array.NewSlice(arr, 2, 6)
+			defer slice.Release()
+
+			for _, compact := range []bool{false, true} {
+				dst := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				got := array.TransferArray(dst, slice, array.WithCompaction(compact))
+				if !array.ArrayEqual(got, slice) {
+					t.Fatalf("invalid array (compact=%v):\ngot= %v\nwant=%v", compact, got, slice)
+				}
+				if compact {
+					assert.Equal(t, 0, got.Data().Offset())
+					assert.Equal(t, 3, got.(*array.Dictionary).Dictionary().Len())
+				}
+				assert.Equal(t, slice.NullN(), got.NullN())
+				got.Release()
+				dst.AssertSize(t, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,441 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"golang.org/x/xerrors"
+)
+
+// offsetsBlockSize is the number of offsets checked at once by
+// validateOffsets before looking for the exact position of a violation.
+const offsetsBlockSize = 256
+
+// ValidateOffsets checks the offsets of a variable-width array (Binary,
+// String, List, LargeList or Map) coming from an untrusted source.
+// It checks that the offsets buffer is large enough for the array, that the
+// offsets are non-negative and monotonically non-decreasing and that they
+// stay within the bounds of the values (the data buffer of Binary and String
+// arrays, the child array of List, LargeList and Map arrays).
+//
+// ValidateOffsets does not panic on corrupted arrays: it returns an error
+// describing the first violation.
+func ValidateOffsets(arr Interface) error {
+	data := arr.Data()
+
+	var size int // number of bytes or elements addressable by the offsets.
+	switch dt := data.dtype.(type) {
+	case arrow.BinaryDataType:
+		if len(data.buffers) != 3 {
+			return xerrors.Errorf("arrow/array: invalid number of buffers for %s array (got=%d, want=3)", dt.Name(), len(data.buffers))
+		}
+		size = len(bufferBytes(data.buffers[2]))
+	case *arrow.ListType, *arrow.LargeListType, *arrow.MapType:
+		if len(data.buffers) != 2 || len(data.childData) != 1 {
+			return xerrors.Errorf("arrow/array: invalid layout for %s array (buffers=%d, children=%d)", dt.Name(), len(data.buffers), len(data.childData))
+		}
+		size = data.childData[0].length
+	default:
+		return xerrors.Errorf("arrow/array: offsets validation not supported for %s arrays", data.dtype.Name())
+	}
+
+	if data.offset < 0 || data.length < 0 {
+		return xerrors.Errorf("arrow/array: invalid array offset=%d and length=%d", data.offset, data.length)
+	}
+	if data.length == 0 {
+		return nil
+	}
+
+	need := data.offset + data.length + 1
+	if _, ok := data.dtype.(*arrow.LargeListType); ok {
+		offsets := arrow.Int64Traits.CastFromBytes(bufferBytes(data.buffers[1]))
+		if len(offsets) < need {
+			return xerrors.Errorf("arrow/array: offsets buffer too small (got=%d offsets, want=%d)", len(offsets), need)
+		}
+		return validateOffsets64(offsets[data.offset:need], size)
+	}
+
+	offsets := arrow.Int32Traits.CastFromBytes(bufferBytes(data.buffers[1]))
+	if len(offsets) < need {
+		return xerrors.Errorf("arrow/array: offsets buffer too small (got=%d offsets, want=%d)", len(offsets), need)
+	}
+
+	return validateOffsets(offsets[data.offset:need], size)
+}
+
+// validateOffsets checks that offsets are non-negative, non-decreasing and
+// lower or equal to size.
+// The offsets are scanned in blocks with a branch-free inner loop; the exact
+// position of a violation is only looked for in the offending block.
+func validateOffsets(offsets []int32, size int) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	if offsets[0] < 0 {
+		return xerrors.Errorf("arrow/array: negative offset %d at index 0", offsets[0])
+	}
+
+	for beg := 1; beg < len(offsets); beg += offsetsBlockSize {
+		end := beg + offsetsBlockSize
+		if end > len(offsets) {
+			end = len(offsets)
+		}
+
+		// the differences of two int32 values cannot overflow an int64:
+		// the sign bit of neg is set iff one of the differences is negative.
+		var (
+			neg  int64
+			prev = offsets[beg-1]
+		)
+		for _, v := range offsets[beg:end] {
+			neg |= int64(v) - int64(prev)
+			prev = v
+		}
+		if neg >= 0 {
+			continue
+		}
+
+		for i := beg; i < end; i++ {
+			if offsets[i] < offsets[i-1] {
+				return xerrors.Errorf("arrow/array: offset %d at index %d is smaller than the previous offset %d", offsets[i], i, offsets[i-1])
+			}
+		}
+	}
+
+	// offsets are non-decreasing: the last one is the largest.
+	if last := offsets[len(offsets)-1]; int(last) > size {
+		return xerrors.Errorf("arrow/array: offset %d at index %d is out of bounds (size=%d)", last, len(offsets)-1, size)
+	}
+	return nil
+}
+
+// validateOffsets64 is like validateOffsets, for the 64-bit offsets of
+// LargeList arrays.
+func validateOffsets64(offsets []int64, size int) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	if offsets[0] < 0 {
+		return xerrors.Errorf("arrow/array: negative offset %d at index 0", offsets[0])
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			return xerrors.Errorf("arrow/array: offset %d at index %d is smaller than the previous offset %d", offsets[i], i, offsets[i-1])
+		}
+	}
+	if last := offsets[len(offsets)-1]; last > int64(size) {
+		return xerrors.Errorf("arrow/array: offset %d at index %d is out of bounds (size=%d)", last, len(offsets)-1, size)
+	}
+	return nil
+}
+
+// ValidateChunkedOffsets calls ValidateOffsets on every chunk of a, in
+// parallel.
+// The error of the first invalid chunk, in chunk order, is returned.
+func ValidateChunkedOffsets(a *Chunked) error {
+	var (
+		chunks = a.Chunks()
+		errs   = make([]error, len(chunks))
+		work   = make(chan int)
+		wg     sync.WaitGroup
+	)
+
+	nworkers := runtime.GOMAXPROCS(0)
+	if nworkers > len(chunks) {
+		nworkers = len(chunks)
+	}
+	wg.Add(nworkers)
+	for i := 0; i < nworkers; i++ {
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				errs[i] = ValidateOffsets(chunks[i])
+			}
+		}()
+	}
+	for i := range chunks {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return xerrors.Errorf("arrow/array: invalid chunk %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the layout of an array coming from an untrusted source,
+// and of its children.
+// It checks that the array has the buffers and children its data type
+// requires, and that they are large enough for its offset and length. See
+// ValidateData.
+//
+// Validate does not look at the values of the array: see ValidateFull.
+func Validate(arr Interface) error {
+	return validateTree(arr.Data(), false)
+}
+
+// ValidateFull checks the layout of an array coming from an untrusted source,
+// as Validate does, and the offsets of its variable-width arrays, as
+// ValidateOffsets does, recursively.
+func ValidateFull(arr Interface) error {
+	return validateTree(arr.Data(), true)
+}
+
+func validateTree(data *Data, full bool) error {
+	if err := ValidateData(data); err != nil {
+		return err
+	}
+	if dt, ok := data.dtype.(arrow.StorageProvider); ok {
+		storage := NewData(dt.StorageType(), data.length, data.buffers, data.childData, data.nulls, data.offset)
+		defer storage.Release()
+		return validateTree(storage, full)
+	}
+	if full && data.length > 0 {
+		switch dt := data.dtype.(type) {
+		case arrow.BinaryDataType:
+			offsets := arrow.Int32Traits.CastFromBytes(data.buffers[1].Bytes())
+			if err := validateOffsets(offsets[data.offset:data.offset+data.length+1], len(bufferBytes(data.buffers[2]))); err != nil {
+				return err
+			}
+		case *arrow.ListType:
+			offsets := arrow.Int32Traits.CastFromBytes(data.buffers[1].Bytes())
+			if err := validateOffsets(offsets[data.offset:data.offset+data.length+1], data.childData[0].length); err != nil {
+				return xerrors.Errorf("arrow/array: invalid %v array: %w", dt, err)
+			}
+		case *arrow.MapType:
+			offsets := arrow.Int32Traits.CastFromBytes(data.buffers[1].Bytes())
+			if err := validateOffsets(offsets[data.offset:data.offset+data.length+1], data.childData[0].length); err != nil {
+				return xerrors.Errorf("arrow/array: invalid %v array: %w", dt, err)
+			}
+		case *arrow.LargeListType:
+			offsets := arrow.Int64Traits.CastFromBytes(data.buffers[1].Bytes())
+			if err := validateOffsets64(offsets[data.offset:data.offset+data.length+1], data.childData[0].length); err != nil {
+				return xerrors.Errorf("arrow/array: invalid %v array: %w", dt, err)
+			}
+		case *arrow.DictionaryType:
+			if err := validateIndices(data); err != nil {
+				return xerrors.Errorf("arrow/array: invalid %v array: %w", dt, err)
+			}
+		}
+	}
+	for i, child := range data.childData {
+		if err := validateTree(child, full); err != nil {
+			return xerrors.Errorf("arrow/array: invalid child %d of %v array: %w", i, data.dtype, err)
+		}
+	}
+	if dt, ok := data.dtype.(*arrow.MapType); ok && full && data.length > 0 {
+		if n := nullKeys(data.childData[0]); n != 0 {
+			return xerrors.Errorf("arrow/array: invalid %v array: %d null keys", dt, n)
+		}
+	}
+	return nil
+}
+
+// nullKeys returns the number of null keys in the entries of a map array.
+func nullKeys(entries *Data) int {
+	if len(entries.childData) == 0 {
+		return 0
+	}
+	keys := entries.childData[0]
+	if keys.nulls == 0 || keys.buffers[0] == nil || keys.length == 0 {
+		return 0
+	}
+	return keys.length - bitutil.CountSetBits(keys.buffers[0].Bytes(), keys.offset, keys.length)
+}
+
+// ValidateData checks that data has the buffers and children its data type
+// requires, and that its buffers are large enough for its offset and length.
+// The values buffers of fixed-width types whose values are reinterpreted in
+// place, such as integers, must also hold a whole number of values.
+// The buffers of the children of data are not checked, and empty arrays are
+// always valid.
+//
+// ValidateData does not panic on corrupted data: it returns an error
+// describing the first violation.
+func ValidateData(data *Data) error {
+	dtype := data.dtype
+	if dt, ok := dtype.(arrow.StorageProvider); ok {
+		dtype = dt.StorageType()
+	}
+
+	invalid := func(format string, args ...interface{}) error {
+		return xerrors.Errorf("arrow/array: invalid %v array: %s", data.dtype, xerrors.Errorf(format, args...))
+	}
+	checkBuffer := func(name string, i, min int) error {
+		if min == 0 {
+			return nil
+		}
+		if n := len(bufferBytes(data.buffers[i])); n < min {
+			return invalid("%s buffer too small (got=%d bytes, want>=%d)", name, n, min)
+		}
+		return nil
+	}
+
+	if data.offset < 0 || data.length < 0 {
+		return invalid("negative offset=%d or length=%d", data.offset, data.length)
+	}
+	if data.length == 0 || dtype.ID() == arrow.NULL {
+		return nil
+	}
+
+	var (
+		nbufs = 1
+		nkids = 0
+	)
+	switch dt := dtype.(type) {
+	case arrow.FixedWidthDataType:
+		nbufs = 2
+	case arrow.BinaryDataType:
+		nbufs = 3
+	case *arrow.ListType:
+		nbufs, nkids = 2, 1
+	case *arrow.MapType:
+		nbufs, nkids = 2, 1
+	case *arrow.LargeListType:
+		nbufs, nkids = 2, 1
+	case *arrow.FixedSizeListType:
+		nkids = 1
+	case *arrow.StructType:
+		nkids = len(dt.Fields())
+	case *arrow.DictionaryType:
+		if !isDictionaryIndex(dt.IndexType) {
+			return invalid("invalid index type %v", dt.IndexType)
+		}
+		nbufs, nkids = 2, 1
+	default:
+		return invalid("unsupported data type")
+	}
+	if len(data.buffers) < nbufs || len(data.childData) != nkids {
+		return invalid("invalid layout (buffers=%d, children=%d), want (buffers>=%d, children=%d)", len(data.buffers), len(data.childData), nbufs, nkids)
+	}
+	for i, child := range data.childData {
+		if child == nil {
+			return invalid("missing child %d", i)
+		}
+	}
+
+	end := data.offset + data.length
+	if data.nulls != 0 && data.buffers[0] != nil {
+		if err := checkBuffer("validity", 0, int(bitutil.BytesForBits(int64(end)))); err != nil {
+			return err
+		}
+	}
+
+	switch dt := dtype.(type) {
+	case arrow.FixedWidthDataType:
+		bw := dt.BitWidth()
+		if bw%8 != 0 {
+			return checkBuffer("values", 1, int(bitutil.BytesForBits(int64(end*bw))))
+		}
+		if err := checkBuffer("values", 1, end*bw/8); err != nil {
+			return err
+		}
+		return validateValues(data)
+
+	case arrow.BinaryDataType, *arrow.ListType, *arrow.MapType:
+		if err := checkBuffer("offsets", 1, (end+1)*arrow.Int32SizeBytes); err != nil {
+			return err
+		}
+		if n := data.buffers[1].Len(); n%arrow.Int32SizeBytes != 0 {
+			return invalid("offsets buffer length %d is not a multiple of %d", n, arrow.Int32SizeBytes)
+		}
+
+	case *arrow.LargeListType:
+		if err := checkBuffer("offsets", 1, (end+1)*arrow.Int64SizeBytes); err != nil {
+			return err
+		}
+		if n := data.buffers[1].Len(); n%arrow.Int64SizeBytes != 0 {
+			return invalid("offsets buffer length %d is not a multiple of %d", n, arrow.Int64SizeBytes)
+		}
+
+	case *arrow.StructType:
+		for i, child := range data.childData {
+			if child.length < end {
+				return invalid("child %d too short (got=%d, want>=%d)", i, child.length, end)
+			}
+		}
+
+	case *arrow.DictionaryType:
+		bw := dt.IndexType.(arrow.FixedWidthDataType).BitWidth()
+		return checkBuffer("indices", 1, end*bw/8)
+	}
+	return nil
+}
+
+// validateValues checks that the values buffer of data, if any, holds a
+// whole number of values and at least the values of data, when data is of a
+// fixed-width type whose values are reinterpreted in place as a slice of
+// the Go type of its values.
+func validateValues(data *Data) error {
+	dtype := data.dtype
+	if dt, ok := dtype.(arrow.StorageProvider); ok {
+		dtype = dt.StorageType()
+	}
+	dt, ok := dtype.(arrow.FixedWidthDataType)
+	if !ok || data.length == 0 || len(data.buffers) < 2 || data.buffers[1] == nil {
+		return nil
+	}
+	if _, ok := dt.(*arrow.FixedSizeBinaryType); ok || dt.BitWidth()%8 != 0 {
+		return nil
+	}
+
+	var (
+		w = dt.BitWidth() / 8
+		n = data.buffers[1].Len()
+	)
+	switch {
+	case n%w != 0:
+		return xerrors.Errorf("arrow/array: invalid %v array: values buffer length %d is not a multiple of the value width %d", data.dtype, n, w)
+	case n < (data.offset+data.length)*w:
+		return xerrors.Errorf("arrow/array: invalid %v array: values buffer too small (got=%d bytes, want>=%d)", data.dtype, n, (data.offset+data.length)*w)
+	}
+	return nil
+}
+
+// debugValidateValues panics, with the error describing the violation, if
+// the values of data are invalid. See validateValues.
+func debugValidateValues(data *Data) {
+	if err := validateValues(data); err != nil {
+		panic(err)
+	}
+}
+
+// validateIndices checks that the valid indices of a dictionary array are
+// within the bounds of its dictionary.
+func validateIndices(data *Data) error {
+	arr := NewDictionaryData(data)
+	defer arr.Release()
+
+	size := arr.dict.Len()
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			continue
+		}
+		if idx := arr.GetValueIndex(i); idx < 0 || idx >= size {
+			return xerrors.Errorf("arrow/array: index %d at position %d is out of bounds (size=%d)", idx, i, size)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build debug
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+func TestMakeFromDataCorruptedValues(t *testing.T) {
+	arrs, corrupted := corruptedValues(t)
+	for i, arr := range arrs {
+		// only the values reinterpreted as Go slices are checked.
+		dt := arr.DataType().(arrow.FixedWidthDataType)
+		if _, fsb := dt.(*arrow.FixedSizeBinaryType); fsb || dt.BitWidth()%8 != 0 {
+			continue
+		}
+		for _, data := range corrupted[i] {
+			func() {
+				defer data.Release()
+				defer func() {
+					if e := recover(); e == nil {
+						t.Errorf("expected a panic for %v array with %d bytes of values", dt, data.Buffers()[1].Len())
+					}
+				}()
+				array.MakeFromData(data).Release()
+			}()
+		}
+	}
+}
@@ -0,0 +1,339 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestString(mem memory.Allocator, vs []string) array.Interface {
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues(vs, nil)
+	return bldr.NewArray()
+}
+
+// withOffsets returns an array sharing the buffers of arr, except for the
+// offsets buffer which is replaced by offsets.
+func withOffsets(arr array.Interface, offsets []int32) array.Interface {
+	data := arr.Data()
+	bufs := append([]*memory.Buffer(nil), data.Buffers()...)
+	bufs[1] = memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets))
+	var children []*array.Data
+	if list, ok := arr.(*array.List); ok {
+		children = []*array.Data{list.ListValues().Data()}
+	}
+	nd := array.NewData(data.DataType(), data.Len(), bufs, children, data.NullN(), data.Offset())
+	defer nd.Release()
+	return array.MakeFromData(nd)
+}
+
+func TestValidateOffsets(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	str := newTestString(mem, []string{"a", "bb", "", "ccc"})
+	defer str.Release()
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int8)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int8Builder)
+	lb.Append(true)
+	vb.AppendValues([]int8{1, 2}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.AppendValues([]int8{3}, nil)
+	list := lb.NewArray()
+	defer list.Release()
+
+	empty := array.MakeFromData(array.NewData(arrow.BinaryTypes.Binary, 0, []*memory.Buffer{nil, nil, nil}, nil, 0, 0))
+	defer empty.Release()
+
+	ints := array.NewInt32Data(array.NewData(arrow.PrimitiveTypes.Int32, 0, []*memory.Buffer{nil, nil}, nil, 0, 0))
+	defer ints.Release()
+
+	ref := func(arr array.Interface) array.Interface {
+		arr.Retain()
+		return arr
+	}
+	slice := func(arr array.Interface, i, j int64) array.Interface {
+		defer arr.Release()
+		return array.NewSlice(arr, i, j)
+	}
+
+	for _, tc := range []struct {
+		name string
+		arr  array.Interface
+		err  string
+	}{
+		{name: "string", arr: ref(str)},
+		{name: "string-slice", arr: array.NewSlice(str, 1, 3)},
+		{name: "list", arr: ref(list)},
+		{name: "list-slice", arr: array.NewSlice(list, 2, 3)},
+		{name: "empty", arr: ref(empty)},
+		{name: "decreasing", arr: withOffsets(str, []int32{0, 1, 3, 2, 6}), err: "offset 2 at index 3 is smaller"},
+		{name: "negative", arr: withOffsets(str, []int32{-1, 1, 3, 3, 6}), err: "negative offset -1"},
+		{name: "out-of-bounds", arr: withOffsets(str, []int32{0, 1, 3, 3, 7}), err: "out of bounds (size=6)"},
+		{name: "list-out-of-bounds", arr: withOffsets(list, []int32{0, 2, 2, 4}), err: "out of bounds (size=3)"},
+		{name: "truncated", arr: withOffsets(str, []int32{0, 1, 3}), err: "offsets buffer too small"},
+		{name: "slice-truncated", arr: slice(withOffsets(str, []int32{0, 1, 3, 3}), 1, 4), err: "offsets buffer too small"},
+		{name: "unsupported", arr: ref(ints), err: "not supported for int32 arrays"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.arr.Release()
+			err := array.ValidateOffsets(tc.arr)
+			switch tc.err {
+			case "":
+				assert.NoError(t, err)
+			default:
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tc.err)
+				}
+			}
+		})
+	}
+}
+
+// validOffsets is a naive reference implementation of the offsets checks.
+func validOffsets(offsets []int32, size int) bool {
+	for i, v := range offsets {
+		if v < 0 || int(v) > size || (i > 0 && v < offsets[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestValidateOffsetsFuzz(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 2000; iter++ {
+		n := rnd.Intn(1000)
+		vs := make([]string, n)
+		for i := range vs {
+			vs[i] = strings.Repeat("x", rnd.Intn(5))
+		}
+		arr := newTestString(mem, vs)
+
+		offsets := append([]int32(nil), arrow.Int32Traits.CastFromBytes(arr.Data().Buffers()[1].Bytes())[:n+1]...)
+		for i := rnd.Intn(3); i > 0; i-- {
+			switch j := rnd.Intn(len(offsets)); rnd.Intn(3) {
+			case 0:
+				offsets[j] = rnd.Int31() - rnd.Int31()
+			case 1:
+				offsets[j] ^= 1 << uint(rnd.Intn(32))
+			default:
+				offsets[j]++
+			}
+		}
+		size := arr.Data().Buffers()[2].Len()
+		if rnd.Intn(10) == 0 {
+			offsets = offsets[:rnd.Intn(len(offsets))]
+		}
+
+		corrupt := withOffsets(arr, offsets)
+		err := array.ValidateOffsets(corrupt)
+		want := len(offsets) == n+1 && validOffsets(offsets, size)
+		if got := err == nil; got != want {
+			t.Fatalf("iter=%d: invalid validation (got=%v, want=%v): err=%v, offsets=%v", iter, got, want, err, offsets)
+		}
+
+		corrupt.Release()
+		arr.Release()
+	}
+}
+
+func TestValidateChunkedOffsets(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var chunks []array.Interface
+	for i := 0; i < 10; i++ {
+		chunks = append(chunks, newTestString(mem, []string{"a", "bb", "", "ccc"}))
+	}
+	valid := array.NewChunked(arrow.BinaryTypes.String, chunks)
+	defer valid.Release()
+	assert.NoError(t, array.ValidateChunkedOffsets(valid))
+
+	for _, i := range []int{7, 8} {
+		orig := chunks[i]
+		chunks[i] = withOffsets(orig, []int32{0, 2, 1, 1, 4})
+		orig.Release()
+	}
+	invalid := array.NewChunked(arrow.BinaryTypes.String, chunks)
+	defer invalid.Release()
+	for _, chunk := range chunks {
+		chunk.Release()
+	}
+
+	err := array.ValidateChunkedOffsets(invalid)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid chunk 7")
+	}
+
+	empty := array.NewChunked(arrow.BinaryTypes.String, nil)
+	defer empty.Release()
+	assert.NoError(t, array.ValidateChunkedOffsets(empty))
+}
+
+func BenchmarkValidateOffsets(b *testing.B) {
+	const n = 1 << 20
+
+	mem := memory.NewGoAllocator()
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.Reserve(n)
+	for i := 0; i < n; i++ {
+		bldr.Append("abc")
+	}
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	b.SetBytes(int64(n * arrow.Int32SizeBytes))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := array.ValidateOffsets(arr); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// withValues returns the data of arr, with its values buffer replaced by
+// the first n bytes of the original one, followed by zeros if n is larger.
+func withValues(data *array.Data, n int) *array.Data {
+	raw := make([]byte, n)
+	copy(raw, data.Buffers()[1].Bytes())
+	bufs := append([]*memory.Buffer(nil), data.Buffers()...)
+	bufs[1] = memory.NewBufferBytes(raw)
+	return array.NewData(data.DataType(), data.Len(), bufs, nil, data.NullN(), data.Offset())
+}
+
+// corruptedValues returns the fixed-width arrays of arrdata, along with
+// copies of their data whose values buffer is too short or does not hold a
+// whole number of values.
+func corruptedValues(t *testing.T) (arrs []array.Interface, corrupted [][]*array.Data) {
+	for _, name := range []string{
+		"primitives", "fixed_width_types", "intervals", "durations",
+		"decimal128", "decimal256", "fixed_size_binaries",
+	} {
+		for _, rec := range arrdata.Records[name] {
+			for _, col := range rec.Columns() {
+				dt, ok := col.DataType().(arrow.FixedWidthDataType)
+				if !ok || col.Len() == 0 {
+					continue
+				}
+
+				var (
+					data = col.Data()
+					bw   = dt.BitWidth()
+					end  = data.Offset() + data.Len()
+					min  = int(bitutil.BytesForBits(int64(end * bw)))
+					bad  = []*array.Data{withValues(data, min-1)}
+				)
+				if _, fsb := dt.(*arrow.FixedSizeBinaryType); !fsb && bw > 8 {
+					bad = append(bad, withValues(data, min+1))
+				}
+				arrs = append(arrs, col)
+				corrupted = append(corrupted, bad)
+			}
+		}
+	}
+	if len(arrs) == 0 {
+		t.Fatalf("no fixed-width arrays")
+	}
+	return arrs, corrupted
+}
+
+func TestValidateDataFixedWidth(t *testing.T) {
+	arrs, corrupted := corruptedValues(t)
+	for i, arr := range arrs {
+		if err := array.ValidateFull(arr); err != nil {
+			t.Fatalf("unexpected error for %v array: %+v", arr.DataType(), err)
+		}
+		for _, data := range corrupted[i] {
+			n := data.Buffers()[1].Len()
+			if err := array.ValidateData(data); err == nil {
+				t.Errorf("expected an error for %v array with %d bytes of values", arr.DataType(), n)
+			}
+			data.Release()
+		}
+	}
+}
+
+func TestValidateDataLayout(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int32}))
+	defer sb.Release()
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Int32Builder).Append(1)
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Int32Builder).Append(2)
+	arr := sb.NewArray()
+	defer arr.Release()
+
+	var (
+		data  = arr.Data()
+		child = arr.(*array.Struct).Field(0).Data()
+	)
+	for _, tc := range []struct {
+		name string
+		data *array.Data
+		err  string
+	}{
+		{
+			"short-child",
+			array.NewData(data.DataType(), 3, data.Buffers(), []*array.Data{child}, 0, 0),
+			"child 0 too short (got=2, want>=3)",
+		},
+		{
+			"missing-child",
+			array.NewData(data.DataType(), 2, data.Buffers(), nil, 0, 0),
+			"invalid layout",
+		},
+		{
+			"short-bitmap",
+			array.NewData(arrow.PrimitiveTypes.Int32, 2, []*memory.Buffer{memory.NewBufferBytes(nil), child.Buffers()[1]}, nil, 1, 0),
+			"validity buffer too small",
+		},
+		{
+			"negative-offset",
+			array.NewData(arrow.PrimitiveTypes.Int32, 2, child.Buffers(), nil, 0, -1),
+			"negative offset=-1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.data.Release()
+			err := array.ValidateData(tc.data)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+}
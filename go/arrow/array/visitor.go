@@ -0,0 +1,277 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
+	"golang.org/x/xerrors"
+)
+
+// Position locates an element visited by Accept.
+type Position struct {
+	Array Interface // array holding the element.
+	Index int       // index of the element in Array.
+	Depth int       // nesting depth of Array: 0 for the array passed to Accept.
+}
+
+// Visitor receives the elements of the arrays visited by Accept.
+//
+// Null elements, of any data type, are passed to VisitNull. Valid elements
+// are passed to the method matching the data type of their array.
+//
+// Elements of list and fixed-size list arrays are bracketed by calls to
+// EnterList and ExitList, between which the n values of the list are
+// visited, at the next nesting depth.
+// Elements of struct arrays are bracketed by calls to EnterStruct and
+// ExitStruct, between which VisitField is called with the index of each
+// field, followed by the visit of the field value, at the next nesting
+// depth.
+//
+// Visiting stops at the first error returned by a method.
+type Visitor interface {
+	VisitNull(pos Position) error
+
+	VisitBoolean(pos Position, v bool) error
+	VisitInt8(pos Position, v int8) error
+	VisitInt16(pos Position, v int16) error
+	VisitInt32(pos Position, v int32) error
+	VisitInt64(pos Position, v int64) error
+	VisitUint8(pos Position, v uint8) error
+	VisitUint16(pos Position, v uint16) error
+	VisitUint32(pos Position, v uint32) error
+	VisitUint64(pos Position, v uint64) error
+	VisitFloat16(pos Position, v float16.Num) error
+	VisitFloat32(pos Position, v float32) error
+	VisitFloat64(pos Position, v float64) error
+	VisitDecimal128(pos Position, v decimal128.Num) error
+	VisitDecimal256(pos Position, v decimal256.Num) error
+	VisitDate32(pos Position, v arrow.Date32) error
+	VisitDate64(pos Position, v arrow.Date64) error
+	VisitTime32(pos Position, v arrow.Time32) error
+	VisitTime64(pos Position, v arrow.Time64) error
+	VisitTimestamp(pos Position, v arrow.Timestamp) error
+	VisitDuration(pos Position, v arrow.Duration) error
+	VisitMonthInterval(pos Position, v arrow.MonthInterval) error
+	VisitDayTimeInterval(pos Position, v arrow.DayTimeInterval) error
+
+	// VisitBinary receives the values of binary arrays. v aliases the memory
+	// of the array.
+	VisitBinary(pos Position, v []byte) error
+	VisitString(pos Position, v string) error
+	// VisitFixedSizeBinary receives the values of fixed-size binary arrays.
+	// v aliases the memory of the array.
+	VisitFixedSizeBinary(pos Position, v []byte) error
+
+	EnterList(pos Position, n int) error
+	ExitList(pos Position) error
+
+	EnterStruct(pos Position) error
+	VisitField(pos Position, i int) error
+	ExitStruct(pos Position) error
+}
+
+// BaseVisitor implements Visitor with methods ignoring the elements they
+// receive. It is meant to be embedded by visitors interested in some data
+// types only.
+type BaseVisitor struct{}
+
+func (BaseVisitor) VisitNull(Position) error                                   { return nil }
+func (BaseVisitor) VisitBoolean(Position, bool) error                          { return nil }
+func (BaseVisitor) VisitInt8(Position, int8) error                             { return nil }
+func (BaseVisitor) VisitInt16(Position, int16) error                           { return nil }
+func (BaseVisitor) VisitInt32(Position, int32) error                           { return nil }
+func (BaseVisitor) VisitInt64(Position, int64) error                           { return nil }
+func (BaseVisitor) VisitUint8(Position, uint8) error                           { return nil }
+func (BaseVisitor) VisitUint16(Position, uint16) error                         { return nil }
+func (BaseVisitor) VisitUint32(Position, uint32) error                         { return nil }
+func (BaseVisitor) VisitUint64(Position, uint64) error                         { return nil }
+func (BaseVisitor) VisitFloat16(Position, float16.Num) error                   { return nil }
+func (BaseVisitor) VisitFloat32(Position, float32) error                       { return nil }
+func (BaseVisitor) VisitFloat64(Position, float64) error                       { return nil }
+func (BaseVisitor) VisitDecimal128(Position, decimal128.Num) error             { return nil }
+func (BaseVisitor) VisitDecimal256(Position, decimal256.Num) error             { return nil }
+func (BaseVisitor) VisitDate32(Position, arrow.Date32) error                   { return nil }
+func (BaseVisitor) VisitDate64(Position, arrow.Date64) error                   { return nil }
+func (BaseVisitor) VisitTime32(Position, arrow.Time32) error                   { return nil }
+func (BaseVisitor) VisitTime64(Position, arrow.Time64) error                   { return nil }
+func (BaseVisitor) VisitTimestamp(Position, arrow.Timestamp) error             { return nil }
+func (BaseVisitor) VisitDuration(Position, arrow.Duration) error               { return nil }
+func (BaseVisitor) VisitMonthInterval(Position, arrow.MonthInterval) error     { return nil }
+func (BaseVisitor) VisitDayTimeInterval(Position, arrow.DayTimeInterval) error { return nil }
+func (BaseVisitor) VisitBinary(Position, []byte) error                         { return nil }
+func (BaseVisitor) VisitString(Position, string) error                         { return nil }
+func (BaseVisitor) VisitFixedSizeBinary(Position, []byte) error                { return nil }
+func (BaseVisitor) EnterList(Position, int) error                              { return nil }
+func (BaseVisitor) ExitList(Position) error                                    { return nil }
+func (BaseVisitor) EnterStruct(Position) error                                 { return nil }
+func (BaseVisitor) VisitField(Position, int) error                             { return nil }
+func (BaseVisitor) ExitStruct(Position) error                                  { return nil }
+
+var _ Visitor = BaseVisitor{}
+
+// Accept visits the elements of arr, in order, with v.
+// Accept returns the first error returned by v.
+func Accept(arr Interface, v Visitor) error {
+	return visitRange(arr, 0, arr.Len(), 0, v)
+}
+
+// visitRange visits the elements of arr in [beg, end).
+func visitRange(arr Interface, beg, end, depth int, v Visitor) error {
+	// each calls fn for each valid element, and v.VisitNull for the others.
+	each := func(fn func(pos Position) error) error {
+		for i := beg; i < end; i++ {
+			var (
+				pos = Position{Array: arr, Index: i, Depth: depth}
+				err error
+			)
+			if arr.IsNull(i) {
+				err = v.VisitNull(pos)
+			} else {
+				err = fn(pos)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch arr := arr.(type) {
+	case *Null:
+		return each(nil)
+	case *Boolean:
+		return each(func(pos Position) error { return v.VisitBoolean(pos, arr.Value(pos.Index)) })
+	case *Int8:
+		return each(func(pos Position) error { return v.VisitInt8(pos, arr.Value(pos.Index)) })
+	case *Int16:
+		return each(func(pos Position) error { return v.VisitInt16(pos, arr.Value(pos.Index)) })
+	case *Int32:
+		return each(func(pos Position) error { return v.VisitInt32(pos, arr.Value(pos.Index)) })
+	case *Int64:
+		return each(func(pos Position) error { return v.VisitInt64(pos, arr.Value(pos.Index)) })
+	case *Uint8:
+		return each(func(pos Position) error { return v.VisitUint8(pos, arr.Value(pos.Index)) })
+	case *Uint16:
+		return each(func(pos Position) error { return v.VisitUint16(pos, arr.Value(pos.Index)) })
+	case *Uint32:
+		return each(func(pos Position) error { return v.VisitUint32(pos, arr.Value(pos.Index)) })
+	case *Uint64:
+		return each(func(pos Position) error { return v.VisitUint64(pos, arr.Value(pos.Index)) })
+	case *Float16:
+		return each(func(pos Position) error { return v.VisitFloat16(pos, arr.Value(pos.Index)) })
+	case *Float32:
+		return each(func(pos Position) error { return v.VisitFloat32(pos, arr.Value(pos.Index)) })
+	case *Float64:
+		return each(func(pos Position) error { return v.VisitFloat64(pos, arr.Value(pos.Index)) })
+	case *Decimal128:
+		return each(func(pos Position) error { return v.VisitDecimal128(pos, arr.Value(pos.Index)) })
+	case *Decimal256:
+		return each(func(pos Position) error { return v.VisitDecimal256(pos, arr.Value(pos.Index)) })
+	case *Date32:
+		return each(func(pos Position) error { return v.VisitDate32(pos, arr.Value(pos.Index)) })
+	case *Date64:
+		return each(func(pos Position) error { return v.VisitDate64(pos, arr.Value(pos.Index)) })
+	case *Time32:
+		return each(func(pos Position) error { return v.VisitTime32(pos, arr.Value(pos.Index)) })
+	case *Time64:
+		return each(func(pos Position) error { return v.VisitTime64(pos, arr.Value(pos.Index)) })
+	case *Timestamp:
+		return each(func(pos Position) error { return v.VisitTimestamp(pos, arr.Value(pos.Index)) })
+	case *Duration:
+		return each(func(pos Position) error { return v.VisitDuration(pos, arr.Value(pos.Index)) })
+	case *MonthInterval:
+		return each(func(pos Position) error { return v.VisitMonthInterval(pos, arr.Value(pos.Index)) })
+	case *DayTimeInterval:
+		return each(func(pos Position) error { return v.VisitDayTimeInterval(pos, arr.Value(pos.Index)) })
+	case *Binary:
+		return each(func(pos Position) error { return v.VisitBinary(pos, arr.Value(pos.Index)) })
+	case *String:
+		return each(func(pos Position) error { return v.VisitString(pos, arr.Value(pos.Index)) })
+	case *FixedSizeBinary:
+		return each(func(pos Position) error { return v.VisitFixedSizeBinary(pos, arr.Value(pos.Index)) })
+
+	case *List:
+		var (
+			offsets = arr.Offsets()
+			off     = arr.Data().Offset()
+		)
+		return each(func(pos Position) error {
+			beg, end := int(offsets[off+pos.Index]), int(offsets[off+pos.Index+1])
+			return visitList(pos, arr.ListValues(), beg, end, v)
+		})
+
+	case *LargeList:
+		var (
+			offsets = arr.Offsets()
+			off     = arr.Data().Offset()
+		)
+		return each(func(pos Position) error {
+			beg, end := int(offsets[off+pos.Index]), int(offsets[off+pos.Index+1])
+			return visitList(pos, arr.ListValues(), beg, end, v)
+		})
+
+	case *FixedSizeList:
+		var (
+			n   = int(arr.DataType().(*arrow.FixedSizeListType).Len())
+			off = arr.Data().Offset()
+		)
+		return each(func(pos Position) error {
+			beg := (off + pos.Index) * n
+			return visitList(pos, arr.ListValues(), beg, beg+n, v)
+		})
+
+	case *Struct:
+		return each(func(pos Position) error {
+			if err := v.EnterStruct(pos); err != nil {
+				return err
+			}
+			for i := 0; i < arr.NumField(); i++ {
+				if err := v.VisitField(pos, i); err != nil {
+					return err
+				}
+				if err := visitRange(arr.Field(i), pos.Index, pos.Index+1, pos.Depth+1, v); err != nil {
+					return err
+				}
+			}
+			return v.ExitStruct(pos)
+		})
+
+	case *Map:
+		return visitRange(arr.List, beg, end, depth, v)
+
+	case *Custom:
+		return visitRange(arr.storage, beg, end, depth, v)
+
+	default:
+		return xerrors.Errorf("arrow/array: unsupported array type %T", arr)
+	}
+}
+
+// visitList visits the element of a list array at pos, made of the values
+// in [beg, end).
+func visitList(pos Position, values Interface, beg, end int, v Visitor) error {
+	if err := v.EnterList(pos, end-beg); err != nil {
+		return err
+	}
+	if err := visitRange(values, beg, end, pos.Depth+1, v); err != nil {
+		return err
+	}
+	return v.ExitList(pos)
+}
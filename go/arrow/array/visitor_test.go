@@ -0,0 +1,212 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// recorder records the events of a visit, and the nesting depth of the
+// visited values.
+type recorder struct {
+	array.BaseVisitor
+	events []string
+}
+
+func (r *recorder) add(pos array.Position, format string, args ...interface{}) error {
+	r.events = append(r.events, fmt.Sprintf(format, args...)+fmt.Sprintf("@%d", pos.Depth))
+	return nil
+}
+
+func (r *recorder) VisitNull(pos array.Position) error { return r.add(pos, "null") }
+func (r *recorder) VisitInt64(pos array.Position, v int64) error {
+	return r.add(pos, "%d", v)
+}
+func (r *recorder) VisitString(pos array.Position, v string) error {
+	return r.add(pos, "%q", v)
+}
+func (r *recorder) EnterList(pos array.Position, n int) error { return r.add(pos, "[%d", n) }
+func (r *recorder) ExitList(pos array.Position) error         { return r.add(pos, "]") }
+func (r *recorder) EnterStruct(pos array.Position) error      { return r.add(pos, "{") }
+func (r *recorder) VisitField(pos array.Position, i int) error {
+	return r.add(pos, "%s:", pos.Array.DataType().(*arrow.StructType).Field(i).Name)
+}
+func (r *recorder) ExitStruct(pos array.Position) error { return r.add(pos, "}") }
+
+func TestAccept(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64},
+		arrow.Field{Name: "b", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	)
+	bld := array.NewStructBuilder(mem, dtype)
+	defer bld.Release()
+
+	var (
+		a  = bld.FieldBuilder(0).(*array.Int64Builder)
+		b  = bld.FieldBuilder(1).(*array.ListBuilder)
+		bv = b.ValueBuilder().(*array.StringBuilder)
+	)
+
+	bld.Append(true)
+	a.Append(1)
+	b.Append(true)
+	bv.AppendValues([]string{"x", "y"}, nil)
+
+	bld.AppendNull() // also appends nulls to the fields.
+
+	bld.Append(true)
+	a.Append(3)
+	b.AppendNull()
+
+	bld.Append(true)
+	a.Append(4)
+	b.Append(true)
+	bv.AppendValues([]string{"z"}, []bool{false})
+
+	arr := bld.NewArray()
+	defer arr.Release()
+
+	r := new(recorder)
+	if err := array.Accept(arr, r); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"{@0", "a:@0", "1@1", "b:@0", "[2@1", `"x"@2`, `"y"@2`, "]@1", "}@0",
+		"null@0",
+		"{@0", "a:@0", "3@1", "b:@0", "null@1", "}@0",
+		"{@0", "a:@0", "4@1", "b:@0", "[1@1", "null@2", "]@1", "}@0",
+	}
+	if !reflect.DeepEqual(r.events, want) {
+		t.Fatalf("invalid events:\ngot= %q\nwant=%q", r.events, want)
+	}
+
+	slice := array.NewSlice(arr, 2, 4)
+	defer slice.Release()
+
+	r = new(recorder)
+	if err := array.Accept(slice, r); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := r.events, want[10:]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid events for slice:\ngot= %q\nwant=%q", got, want)
+	}
+}
+
+type failer struct {
+	array.BaseVisitor
+	n int
+}
+
+var errStop = xerrors.New("stop")
+
+func (f *failer) VisitInt32(pos array.Position, v int32) error {
+	f.n++
+	if v == 2 {
+		return errStop
+	}
+	return nil
+}
+
+func TestAcceptError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt32Builder(mem)
+	defer bld.Release()
+	bld.AppendValues([]int32{1, 2, 3}, nil)
+	arr := bld.NewArray()
+	defer arr.Release()
+
+	f := new(failer)
+	if err := array.Accept(arr, f); err != errStop {
+		t.Fatalf("invalid error: got=%v, want=%v", err, errStop)
+	}
+	if f.n != 2 {
+		t.Fatalf("invalid number of visited elements: got=%d, want=2", f.n)
+	}
+}
+
+type summer struct {
+	array.BaseVisitor
+	sum int64
+}
+
+func (s *summer) VisitInt64(pos array.Position, v int64) error {
+	s.sum += v
+	return nil
+}
+
+func TestAcceptAllocs(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr := makeInt64s(mem, 1024)
+	defer arr.Release()
+
+	var s summer
+	allocs := testing.AllocsPerRun(10, func() {
+		if err := array.Accept(arr, &s); err != nil {
+			t.Fatal(err)
+		}
+	})
+	// the closures of a visit are allocated once per array, not per element.
+	if allocs > 2 {
+		t.Fatalf("too many allocations per visit: %v", allocs)
+	}
+}
+
+func BenchmarkAccept(b *testing.B) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(b, 0)
+
+	const n = 1 << 16
+	arr := makeInt64s(mem, n)
+	defer arr.Release()
+
+	var s summer
+	b.SetBytes(int64(n * arrow.Int64SizeBytes))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := array.Accept(arr, &s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func makeInt64s(mem memory.Allocator, n int) array.Interface {
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+	for i := 0; i < n; i++ {
+		if i%10 == 0 {
+			bld.AppendNull()
+			continue
+		}
+		bld.Append(int64(i))
+	}
+	return bld.NewArray()
+}
@@ -0,0 +1,224 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arrowtest provides helpers to test implementations of the
+// interfaces of the arrow package.
+package arrowtest // import "github.com/apache/arrow/go/arrow/arrowtest"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// CheckCustomType checks that the custom data type dtype can be built,
+// printed, sliced, transferred, written to an IPC stream and read back.
+//
+// Arrays of dtype are filled with sample values derived from its storage
+// type, one element out of four being null.
+func CheckCustomType(t testing.TB, dtype arrow.StorageProvider) {
+	t.Helper()
+
+	const n = 10
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	if got, want := dtype.ID(), arrow.EXTENSION; got != want {
+		t.Fatalf("invalid type ID for %v: got=%v, want=%v", dtype, got, want)
+	}
+
+	b := array.NewBuilder(mem, dtype)
+	defer b.Release()
+
+	if got := b.Type(); !arrow.TypeEqual(got, dtype) {
+		t.Fatalf("invalid builder type: got=%v, want=%v", got, dtype)
+	}
+	if err := fill(b, n); err != nil {
+		t.Fatalf("could not build %v array: %+v", dtype, err)
+	}
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	if got := arr.DataType(); !arrow.TypeEqual(got, dtype) {
+		t.Fatalf("invalid array type: got=%v, want=%v", got, dtype)
+	}
+	if got := arr.Len(); got != n {
+		t.Fatalf("invalid array length: got=%d, want=%d", got, n)
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "custom", Type: dtype, Nullable: true}}, nil)
+	if got, want := schema.String(), dtype.String(); !strings.Contains(got, want) {
+		t.Fatalf("schema does not print data type %q:\n%s", want, got)
+	}
+
+	rec := array.NewRecord(schema, []array.Interface{arr}, n)
+	defer rec.Release()
+
+	buf, err := ipc.WriteStreamBytes([]array.Record{rec}, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatalf("could not write %v array: %+v", dtype, err)
+	}
+
+	recs, got, err := ipc.ReadStreamBytes(buf, ipc.WithAllocator(mem), ipc.WithCustomTypes(dtype))
+	if err != nil {
+		t.Fatalf("could not read %v array: %+v", dtype, err)
+	}
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	if !got.Equal(schema) {
+		t.Fatalf("invalid schema read back:\ngot:\n%v\nwant:\n%v", got, schema)
+	}
+	if len(recs) != 1 {
+		t.Fatalf("invalid number of records read back: got=%d, want=1", len(recs))
+	}
+	if !array.RecordEqual(recs[0], rec) {
+		t.Fatalf("invalid record read back:\ngot:\n%v\nwant:\n%v", recs[0], rec)
+	}
+
+	slice := array.NewSlice(arr, 1, n-1)
+	defer slice.Release()
+
+	if got := slice.DataType(); !arrow.TypeEqual(got, dtype) {
+		t.Fatalf("invalid slice type: got=%v, want=%v", got, dtype)
+	}
+	if !array.ArraySliceEqual(slice, 0, int64(slice.Len()), arr, 1, n-1) {
+		t.Fatalf("invalid slice:\ngot= %v\nwant=%v", slice, arr)
+	}
+
+	for _, compact := range []bool{false, true} {
+		out := array.TransferArray(mem, slice, array.WithCompaction(compact))
+		if got := out.DataType(); !arrow.TypeEqual(got, dtype) {
+			out.Release()
+			t.Fatalf("invalid type of transferred array (compaction=%v): got=%v, want=%v", compact, got, dtype)
+		}
+		if !array.ArrayEqual(out, slice) {
+			out.Release()
+			t.Fatalf("invalid transferred array (compaction=%v):\ngot= %v\nwant=%v", compact, out, slice)
+		}
+		out.Release()
+	}
+}
+
+// fill appends n sample values to b.
+func fill(b array.Builder, n int) error {
+	switch b := b.(type) {
+	case *array.CustomBuilder:
+		return fill(b.StorageBuilder(), n)
+
+	case *array.StructBuilder:
+		for i := 0; i < n; i++ {
+			b.Append(!isNull(i))
+		}
+		for i := 0; i < b.NumField(); i++ {
+			if err := fill(b.FieldBuilder(i), n); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *array.ListBuilder:
+		for i := 0; i < n; i++ {
+			if isNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(true)
+			if err := fill(b.ValueBuilder(), i%3); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *array.LargeListBuilder:
+		for i := 0; i < n; i++ {
+			if isNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(true)
+			if err := fill(b.ValueBuilder(), i%3); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *array.FixedSizeListBuilder:
+		size := int(b.Type().(*arrow.FixedSizeListType).Len())
+		for i := 0; i < n; i++ {
+			b.Append(true)
+		}
+		return fill(b.ValueBuilder(), n*size)
+	}
+
+	for i := 0; i < n; i++ {
+		if isNull(i) {
+			b.AppendNull()
+			continue
+		}
+		s, ok := sample(b.Type(), i)
+		if !ok {
+			return fmt.Errorf("arrowtest: no sample values for %v", b.Type())
+		}
+		if err := b.AppendValueFromString(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isNull(i int) bool { return i%4 == 3 }
+
+// sample returns the textual representation of the i-th sample value of
+// data type dt.
+func sample(dt arrow.DataType, i int) (string, bool) {
+	switch dt := dt.(type) {
+	case *arrow.BooleanType:
+		return strconv.FormatBool(i%2 == 0), true
+	case *arrow.FixedSizeBinaryType:
+		return strings.Repeat(string(rune('a'+i%26)), dt.ByteWidth), true
+	case *arrow.Date32Type, *arrow.Date64Type:
+		return fmt.Sprintf("2021-01-%02d", 1+i%28), true
+	case *arrow.Time32Type, *arrow.Time64Type:
+		return fmt.Sprintf("12:34:%02d", i%60), true
+	case *arrow.TimestampType:
+		return fmt.Sprintf("2021-01-02T12:34:%02dZ", i%60), true
+	case *arrow.DayTimeIntervalType:
+		return fmt.Sprintf("%dd%dms", i, 10*i), true
+	case *arrow.StringType, *arrow.BinaryType:
+		return fmt.Sprintf("value-%d", i), true
+	}
+
+	switch {
+	case arrow.IsPrimitive(dt.ID()):
+		return strconv.Itoa(i % 100), true
+	case dt.ID() == arrow.DECIMAL, dt.ID() == arrow.DECIMAL256,
+		dt.ID() == arrow.DURATION, dt.ID() == arrow.INTERVAL:
+		return strconv.Itoa(i % 10), true
+	}
+	return "", false
+}
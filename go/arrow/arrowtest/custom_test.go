@@ -0,0 +1,76 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowtest_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/arrowtest"
+)
+
+// geoPoint is an example custom data type, holding the latitude and
+// longitude of points.
+type geoPoint struct{}
+
+func (geoPoint) ID() arrow.Type              { return arrow.EXTENSION }
+func (geoPoint) Name() string                { return "example.geo-point" }
+func (geoPoint) String() string              { return "geo_point" }
+func (geoPoint) StorageType() arrow.DataType { return geoPointStorage }
+
+var geoPointStorage = arrow.StructOf(
+	arrow.Field{Name: "lat", Type: arrow.PrimitiveTypes.Float64},
+	arrow.Field{Name: "lon", Type: arrow.PrimitiveTypes.Float64},
+)
+
+// tagged is an example custom data type, with a parameter, holding strings.
+type tagged struct {
+	tag string
+}
+
+func (tagged) ID() arrow.Type              { return arrow.EXTENSION }
+func (tagged) Name() string                { return "example.tagged" }
+func (t tagged) String() string            { return "tagged<" + t.tag + ">" }
+func (tagged) StorageType() arrow.DataType { return arrow.BinaryTypes.String }
+
+func TestCheckCustomType(t *testing.T) {
+	for _, dt := range []arrow.StorageProvider{
+		geoPoint{},
+		tagged{tag: "color"},
+	} {
+		t.Run(dt.String(), func(t *testing.T) {
+			arrowtest.CheckCustomType(t, dt)
+		})
+	}
+}
+
+func TestCustomTypeEqual(t *testing.T) {
+	for _, tc := range []struct {
+		a, b arrow.DataType
+		want bool
+	}{
+		{geoPoint{}, geoPoint{}, true},
+		{tagged{tag: "a"}, tagged{tag: "a"}, true},
+		{tagged{tag: "a"}, tagged{tag: "b"}, false},
+		{geoPoint{}, tagged{tag: "a"}, false},
+		{geoPoint{}, geoPointStorage, false},
+	} {
+		if got := arrow.TypeEqual(tc.a, tc.b); got != tc.want {
+			t.Errorf("TypeEqual(%v, %v): got=%v, want=%v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
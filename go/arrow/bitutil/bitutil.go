@@ -127,6 +127,39 @@ func countSetBitsWithOffset(buf []byte, offset, n int) int {
 	return count
 }
 
+// CopyBitmap copies the length bits of src starting at bit srcOffset to dst,
+// starting at bit dstOffset. The other bits of dst are left untouched.
+func CopyBitmap(src []byte, srcOffset, length int, dst []byte, dstOffset int) {
+	// leading bits, up to a byte boundary of dst.
+	for ; length > 0 && dstOffset%8 != 0; length-- {
+		SetBitTo(dst, dstOffset, BitIsSet(src, srcOffset))
+		srcOffset++
+		dstOffset++
+	}
+
+	var (
+		nbytes = length / 8
+		out    = dst[dstOffset/8 : dstOffset/8+nbytes]
+		in     = src[srcOffset/8:]
+		shift  = uint(srcOffset % 8)
+	)
+	if shift == 0 {
+		copy(out, in[:nbytes])
+	} else {
+		for i := range out {
+			out[i] = in[i]>>shift | in[i+1]<<(8-shift)
+		}
+	}
+	srcOffset += nbytes * 8
+	dstOffset += nbytes * 8
+	length -= nbytes * 8
+
+	// trailing bits.
+	for i := 0; i < length; i++ {
+		SetBitTo(dst, dstOffset+i, BitIsSet(src, srcOffset+i))
+	}
+}
+
 func roundUp(v, f int) int {
 	return (v + (f - 1)) / f * f
 }
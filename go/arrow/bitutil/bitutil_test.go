@@ -17,6 +17,7 @@
 package bitutil_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -197,6 +198,55 @@ func TestCountSetBitsOffset(t *testing.T) {
 	}
 }
 
+func TestCopyBitmap(t *testing.T) {
+	const bufSize = 40
+
+	rng := rand.New(rand.NewSource(0))
+	src := make([]byte, bufSize)
+	if _, err := rng.Read(src); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, srcOffset := range []int{0, 1, 3, 7, 8, 9, 17, 64} {
+		for _, dstOffset := range []int{0, 1, 5, 7, 8, 13, 64} {
+			for _, length := range []int{0, 1, 5, 8, 9, 31, 64, 100, bufSize*8 - 64 - 1} {
+				dst := make([]byte, bufSize)
+				for i := range dst {
+					dst[i] = 0xa5
+				}
+				want := append([]byte(nil), dst...)
+				for i := 0; i < length; i++ {
+					bitutil.SetBitTo(want, dstOffset+i, bitutil.BitIsSet(src, srcOffset+i))
+				}
+
+				bitutil.CopyBitmap(src, srcOffset, length, dst, dstOffset)
+				if !bytes.Equal(dst, want) {
+					t.Errorf("src=%d, dst=%d, n=%d: got=%x, want=%x", srcOffset, dstOffset, length, dst, want)
+				}
+			}
+		}
+	}
+}
+
+// TestBitmapOpsAllocs checks that the bitmap operations, called on every
+// element of arrays, do not allocate.
+func TestBitmapOpsAllocs(t *testing.T) {
+	src := make([]byte, 128)
+	dst := make([]byte, 128)
+	for _, tc := range []struct {
+		name string
+		op   func()
+	}{
+		{"SetBitTo", func() { bitutil.SetBitTo(dst, 13, true) }},
+		{"CountSetBits", func() { intval = bitutil.CountSetBits(src, 3, 1000) }},
+		{"CopyBitmap", func() { bitutil.CopyBitmap(src, 3, 1000, dst, 5) }},
+	} {
+		if n := testing.AllocsPerRun(100, tc.op); n != 0 {
+			t.Errorf("%s: got %v allocations per run, want 0", tc.name, n)
+		}
+	}
+}
+
 func bbits(v ...int32) []byte {
 	return tools.IntsToBitsLSB(v...)
 }
@@ -238,6 +288,7 @@ func benchmarkCountSetBitsN(b *testing.B, offset, n int) {
 	for i := 0; i < nn; i++ {
 		buf[i] = src[i&0x3]
 	}
+	b.SetBytes(int64(nn))
 	b.ResetTimer()
 	var res int
 	for i := 0; i < b.N; i++ {
@@ -285,3 +336,31 @@ func BenchmarkCountSetBitsOffset_1000(b *testing.B) {
 func BenchmarkCountSetBitsOffset_1024(b *testing.B) {
 	benchmarkCountSetBitsN(b, 1, 1024)
 }
+
+// BenchmarkCopyBitmap copies bitmaps of 64KiB, between byte-aligned offsets
+// and between offsets shifted by a few bits.
+func BenchmarkCopyBitmap(b *testing.B) {
+	const n = 64 << 10 // bytes
+
+	src := make([]byte, n+1)
+	rand.New(rand.NewSource(0)).Read(src)
+	dst := make([]byte, n+1)
+
+	for _, tc := range []struct {
+		name           string
+		srcOff, dstOff int
+	}{
+		{"aligned", 0, 0},
+		{"src-unaligned", 3, 0},
+		{"dst-unaligned", 0, 5},
+		{"unaligned", 3, 5},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			b.SetBytes(n)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bitutil.CopyBitmap(src, tc.srcOff, n*8, dst, tc.dstOff)
+			}
+		})
+	}
+}
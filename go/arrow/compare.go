@@ -51,7 +51,21 @@ func TypeEqual(left, right DataType, opts ...TypeEqualOption) bool {
 		return false
 	}
 
-	// StructType is the only type that has metadata.
+	// the field of the elements of a list may have metadata.
+	if l, ok := left.(*ListType); ok {
+		r := right.(*ListType)
+		switch {
+		case l.elem.Name != r.elem.Name:
+			return false
+		case l.elem.Nullable != r.elem.Nullable:
+			return false
+		case cfg.metadata && !reflect.DeepEqual(l.elem.Metadata, r.elem.Metadata):
+			return false
+		}
+		return TypeEqual(l.elem.Type, r.elem.Type, opts...)
+	}
+
+	// StructType is the only other type that has metadata.
 	l, ok := left.(*StructType)
 	if !ok || cfg.metadata {
 		return reflect.DeepEqual(left, right)
@@ -69,38 +69,56 @@ func TestTypeEqual(t *testing.T) {
 			&TimestampType{Unit: Second, TimeZone: "UTC"}, &TimestampType{Unit: Nanosecond, TimeZone: "CET"}, false, false,
 		},
 		{
-			&ListType{PrimitiveTypes.Uint64}, &ListType{PrimitiveTypes.Uint64}, true, false,
+			ListOf(PrimitiveTypes.Uint64), ListOf(PrimitiveTypes.Uint64), true, false,
 		},
 		{
-			&ListType{PrimitiveTypes.Uint64}, &ListType{PrimitiveTypes.Uint32}, false, false,
+			ListOf(PrimitiveTypes.Uint64), ListOf(PrimitiveTypes.Uint32), false, false,
 		},
 		{
-			&ListType{&Time32Type{Unit: Millisecond}}, &ListType{&Time32Type{Unit: Millisecond}}, true, false,
+			ListOf(&Time32Type{Unit: Millisecond}), ListOf(&Time32Type{Unit: Millisecond}), true, false,
 		},
 		{
-			&ListType{&Time32Type{Unit: Millisecond}}, &ListType{&Time32Type{Unit: Second}}, false, false,
+			ListOf(&Time32Type{Unit: Millisecond}), ListOf(&Time32Type{Unit: Second}), false, false,
 		},
 		{
-			&ListType{&ListType{PrimitiveTypes.Uint16}}, &ListType{&ListType{PrimitiveTypes.Uint16}}, true, false,
+			ListOf(ListOf(PrimitiveTypes.Uint16)), ListOf(ListOf(PrimitiveTypes.Uint16)), true, false,
 		},
 		{
-			&ListType{&ListType{PrimitiveTypes.Uint16}}, &ListType{&ListType{PrimitiveTypes.Uint8}}, false, false,
+			ListOf(ListOf(PrimitiveTypes.Uint16)), ListOf(ListOf(PrimitiveTypes.Uint8)), false, false,
 		},
 		{
-			&ListType{&ListType{&ListType{PrimitiveTypes.Uint16}}}, &ListType{&ListType{PrimitiveTypes.Uint8}}, false, false,
+			ListOf(ListOf(ListOf(PrimitiveTypes.Uint16))), ListOf(ListOf(PrimitiveTypes.Uint8)), false, false,
+		},
+		{
+			ListOf(PrimitiveTypes.Uint16), ListOfField(Field{Name: "item", Type: PrimitiveTypes.Uint16, Nullable: true}), true, true,
+		},
+		{
+			ListOf(PrimitiveTypes.Uint16), ListOfField(Field{Name: "item", Type: PrimitiveTypes.Uint16}), false, false,
+		},
+		{
+			ListOf(PrimitiveTypes.Uint16), ListOfField(Field{Name: "element", Type: PrimitiveTypes.Uint16, Nullable: true}), false, false,
+		},
+		{
+			ListOf(PrimitiveTypes.Uint16), ListOfField(Field{Name: "item", Type: PrimitiveTypes.Uint16, Nullable: true, Metadata: NewMetadata([]string{"k"}, []string{"v"})}), true, false,
+		},
+		{
+			ListOf(PrimitiveTypes.Uint16), ListOfField(Field{Name: "item", Type: PrimitiveTypes.Uint16, Nullable: true, Metadata: NewMetadata([]string{"k"}, []string{"v"})}), false, true,
+		},
+		{
+			ListOf(ListOf(PrimitiveTypes.Uint16)), ListOf(ListOfField(Field{Name: "item", Type: PrimitiveTypes.Uint16})), false, false,
 		},
 		{
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			false, true,
 		},
@@ -109,13 +127,13 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			false, false,
 		},
@@ -124,13 +142,13 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f0", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f0": 0},
+				index: map[string][]int{"f0": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			false, false,
 		},
@@ -139,14 +157,14 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			false, true,
 		},
@@ -155,14 +173,14 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			false, false,
 		},
@@ -171,13 +189,13 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f2", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f2": 0},
+				index: map[string][]int{"f2": {0}},
 			},
 			false, false,
 		},
@@ -187,14 +205,14 @@ func TestTypeEqual(t *testing.T) {
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			true, false,
 		},
@@ -204,14 +222,14 @@ func TestTypeEqual(t *testing.T) {
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			true, false,
 		},
@@ -221,7 +239,7 @@ func TestTypeEqual(t *testing.T) {
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 				meta:  MetadataFrom(map[string]string{"k1": "v1"}),
 			},
 			&StructType{
@@ -229,7 +247,7 @@ func TestTypeEqual(t *testing.T) {
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 				meta:  MetadataFrom(map[string]string{"k1": "v1"}),
 			},
 			true, true,
@@ -239,14 +257,14 @@ func TestTypeEqual(t *testing.T) {
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 				meta:  MetadataFrom(map[string]string{"k1": "v1"}),
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint32, Nullable: true},
 				},
-				index: map[string]int{"f1": 0},
+				index: map[string][]int{"f1": {0}},
 				meta:  MetadataFrom(map[string]string{"k1": "v2"}),
 			},
 			true, false,
@@ -257,14 +275,14 @@ func TestTypeEqual(t *testing.T) {
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true, Metadata: MetadataFrom(map[string]string{"k1": "v1"})},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			&StructType{
 				fields: []Field{
 					Field{Name: "f1", Type: PrimitiveTypes.Uint16, Nullable: true, Metadata: MetadataFrom(map[string]string{"k1": "v2"})},
 					Field{Name: "f2", Type: PrimitiveTypes.Float32, Nullable: false},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 			false, true,
 		},
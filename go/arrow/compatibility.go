@@ -0,0 +1,228 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// CompatibilityOptions specifies the differences between an expected and an
+// actual schema that SchemaCompatibility accepts.
+type CompatibilityOptions struct {
+	// AllowExtraFields accepts actual fields absent from the expected schema.
+	AllowExtraFields bool
+
+	// AllowNullable accepts nullable actual fields for non-nullable expected
+	// fields. Non-nullable actual fields are always accepted for nullable
+	// expected fields.
+	AllowNullable bool
+
+	// AllowWidening accepts actual fields of a numeric type that converts
+	// without loss to the expected numeric type, e.g. int32 for int64 or
+	// uint16 for float32.
+	AllowWidening bool
+}
+
+// FieldStatus classifies an expected field against the actual schema.
+type FieldStatus int
+
+const (
+	FieldExact        FieldStatus = iota // same data type
+	FieldWidened                         // numeric data type widening to the expected one
+	FieldMissing                         // no actual field of that name
+	FieldTypeMismatch                    // incompatible data type
+)
+
+func (s FieldStatus) String() string {
+	switch s {
+	case FieldExact:
+		return "exact"
+	case FieldWidened:
+		return "widened"
+	case FieldMissing:
+		return "missing"
+	case FieldTypeMismatch:
+		return "type mismatch"
+	default:
+		return fmt.Sprintf("FieldStatus(%d)", int(s))
+	}
+}
+
+// FieldCompatibility describes how an expected field is matched by the
+// actual schema.
+type FieldCompatibility struct {
+	Expected Field
+	Actual   Field // zero if the field is missing
+	Index    int   // index of Actual in the actual schema, or -1
+	Status   FieldStatus
+	Nullable bool // whether Actual is nullable while Expected is not
+	OK       bool // whether the field is accepted by the options
+}
+
+func (fc FieldCompatibility) String() string {
+	o := new(strings.Builder)
+	fmt.Fprintf(o, "%q: %v", fc.Expected.Name, fc.Status)
+	if fc.Status == FieldWidened || fc.Status == FieldTypeMismatch {
+		fmt.Fprintf(o, " (got=%v, want=%v)", fc.Actual.Type, fc.Expected.Type)
+	}
+	if fc.Nullable {
+		o.WriteString(", nullable")
+	}
+	return o.String()
+}
+
+// CompatibilityReport describes how an actual schema matches an expected one.
+type CompatibilityReport struct {
+	Fields []FieldCompatibility // one per expected field, in order
+	Extra  []Field              // actual fields absent from the expected schema
+	Opts   CompatibilityOptions
+}
+
+// Compatible returns whether the actual schema is compatible with the
+// expected one.
+func (r CompatibilityReport) Compatible() bool {
+	return len(r.problems()) == 0
+}
+
+func (r CompatibilityReport) problems() []string {
+	var out []string
+	for _, fc := range r.Fields {
+		if !fc.OK {
+			out = append(out, fc.String())
+		}
+	}
+	if !r.Opts.AllowExtraFields {
+		for _, f := range r.Extra {
+			out = append(out, fmt.Sprintf("%q: unexpected field", f.Name))
+		}
+	}
+	return out
+}
+
+func (r CompatibilityReport) String() string {
+	o := new(strings.Builder)
+	fmt.Fprintf(o, "schema compatibility:\n  fields: %d", len(r.Fields))
+	for _, fc := range r.Fields {
+		mark := "ok"
+		if !fc.OK {
+			mark = "error"
+		}
+		fmt.Fprintf(o, "\n    - %v [%s]", fc, mark)
+	}
+	if len(r.Extra) > 0 {
+		mark := "error"
+		if r.Opts.AllowExtraFields {
+			mark = "ok"
+		}
+		fmt.Fprintf(o, "\n  extra fields: %d", len(r.Extra))
+		for _, f := range r.Extra {
+			fmt.Fprintf(o, "\n    - %q: %v [%s]", f.Name, f.Type, mark)
+		}
+	}
+	return o.String()
+}
+
+// SchemaCompatibility checks whether the actual schema, e.g. the one of a
+// stream from a producer, is compatible with the expected schema of a
+// consumer, and returns a report classifying each expected field.
+//
+// Fields are matched by name, regardless of their order; the n-th expected
+// field of a given name matches the n-th actual field of that name.
+// Nested data types must be equal.
+// The error is non-nil if the schemas are not compatible with opts: the
+// report is returned in both cases.
+func SchemaCompatibility(expected, actual *Schema, opts CompatibilityOptions) (CompatibilityReport, error) {
+	report := CompatibilityReport{
+		Fields: make([]FieldCompatibility, len(expected.Fields())),
+		Opts:   opts,
+	}
+
+	var (
+		used = make([]bool, len(actual.Fields()))
+		seen = make(map[string]int)
+	)
+	for i, want := range expected.Fields() {
+		fc := FieldCompatibility{Expected: want, Index: -1, Status: FieldMissing}
+		if idx := actual.FieldIndices(want.Name); seen[want.Name] < len(idx) {
+			fc.Index = idx[seen[want.Name]]
+			fc.Actual = actual.Field(fc.Index)
+			used[fc.Index] = true
+			fc.Status = typeStatus(want.Type, fc.Actual.Type)
+			fc.Nullable = fc.Actual.Nullable && !want.Nullable
+		}
+		seen[want.Name]++
+
+		switch fc.Status {
+		case FieldExact:
+			fc.OK = true
+		case FieldWidened:
+			fc.OK = opts.AllowWidening
+		}
+		if fc.Nullable && !opts.AllowNullable {
+			fc.OK = false
+		}
+		report.Fields[i] = fc
+	}
+	for i, f := range actual.Fields() {
+		if !used[i] {
+			report.Extra = append(report.Extra, f)
+		}
+	}
+
+	if problems := report.problems(); len(problems) > 0 {
+		return report, xerrors.Errorf("arrow: incompatible schema: %s", strings.Join(problems, ", "))
+	}
+	return report, nil
+}
+
+func typeStatus(want, got DataType) FieldStatus {
+	switch {
+	case TypeEqual(got, want):
+		return FieldExact
+	case widens(got, want):
+		return FieldWidened
+	default:
+		return FieldTypeMismatch
+	}
+}
+
+// widenings lists the numeric data types each numeric data type converts to
+// without loss.
+var widenings = map[Type][]Type{
+	INT8:    {INT16, INT32, INT64, FLOAT16, FLOAT32, FLOAT64},
+	INT16:   {INT32, INT64, FLOAT32, FLOAT64},
+	INT32:   {INT64, FLOAT64},
+	UINT8:   {UINT16, UINT32, UINT64, INT16, INT32, INT64, FLOAT16, FLOAT32, FLOAT64},
+	UINT16:  {UINT32, UINT64, INT32, INT64, FLOAT32, FLOAT64},
+	UINT32:  {UINT64, INT64, FLOAT64},
+	FLOAT16: {FLOAT32, FLOAT64},
+	FLOAT32: {FLOAT64},
+}
+
+// widens returns whether values of the data type from convert without loss
+// to the data type to.
+func widens(from, to DataType) bool {
+	for _, id := range widenings[from.ID()] {
+		if id == to.ID() {
+			return true
+		}
+	}
+	return false
+}
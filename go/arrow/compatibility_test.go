@@ -0,0 +1,217 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaCompatibility(t *testing.T) {
+	expected := NewSchema([]Field{
+		{Name: "id", Type: PrimitiveTypes.Int64},
+		{Name: "score", Type: PrimitiveTypes.Float64, Nullable: true},
+		{Name: "name", Type: BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	for _, tc := range []struct {
+		name   string
+		actual []Field
+		opts   CompatibilityOptions
+		status []FieldStatus
+		extra  int
+		err    string
+	}{
+		{
+			name: "exact",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Int64},
+				{Name: "score", Type: PrimitiveTypes.Float64, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			status: []FieldStatus{FieldExact, FieldExact, FieldExact},
+		},
+		{
+			name: "reordered-and-stricter",
+			actual: []Field{
+				{Name: "name", Type: BinaryTypes.String},
+				{Name: "score", Type: PrimitiveTypes.Float64},
+				{Name: "id", Type: PrimitiveTypes.Int64},
+			},
+			status: []FieldStatus{FieldExact, FieldExact, FieldExact},
+		},
+		{
+			name: "widened",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Int32},
+				{Name: "score", Type: PrimitiveTypes.Float32, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			status: []FieldStatus{FieldWidened, FieldWidened, FieldExact},
+			err:    `arrow: incompatible schema: "id": widened (got=int32, want=int64), "score": widened (got=float32, want=float64)`,
+		},
+		{
+			name: "widened-allowed",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Uint32},
+				{Name: "score", Type: PrimitiveTypes.Int16, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			opts:   CompatibilityOptions{AllowWidening: true},
+			status: []FieldStatus{FieldWidened, FieldWidened, FieldExact},
+		},
+		{
+			name: "narrowed",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Uint64},
+				{Name: "score", Type: PrimitiveTypes.Int64, Nullable: true},
+				{Name: "name", Type: BinaryTypes.Binary, Nullable: true},
+			},
+			opts:   CompatibilityOptions{AllowWidening: true},
+			status: []FieldStatus{FieldTypeMismatch, FieldTypeMismatch, FieldTypeMismatch},
+			err:    `arrow: incompatible schema: "id": type mismatch (got=uint64, want=int64), "score": type mismatch (got=int64, want=float64), "name": type mismatch (got=binary, want=utf8)`,
+		},
+		{
+			name: "missing-and-extra",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Int64},
+				{Name: "label", Type: BinaryTypes.String},
+			},
+			status: []FieldStatus{FieldExact, FieldMissing, FieldMissing},
+			extra:  1,
+			err:    `arrow: incompatible schema: "score": missing, "name": missing, "label": unexpected field`,
+		},
+		{
+			name: "extra-allowed",
+			actual: []Field{
+				{Name: "label", Type: BinaryTypes.String},
+				{Name: "id", Type: PrimitiveTypes.Int64},
+				{Name: "score", Type: PrimitiveTypes.Float64, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			opts:   CompatibilityOptions{AllowExtraFields: true},
+			status: []FieldStatus{FieldExact, FieldExact, FieldExact},
+			extra:  1,
+		},
+		{
+			name: "nullable",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Int64, Nullable: true},
+				{Name: "score", Type: PrimitiveTypes.Float64, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			status: []FieldStatus{FieldExact, FieldExact, FieldExact},
+			err:    `arrow: incompatible schema: "id": exact, nullable`,
+		},
+		{
+			name: "nullable-allowed",
+			actual: []Field{
+				{Name: "id", Type: PrimitiveTypes.Int8, Nullable: true},
+				{Name: "score", Type: PrimitiveTypes.Float64, Nullable: true},
+				{Name: "name", Type: BinaryTypes.String, Nullable: true},
+			},
+			opts:   CompatibilityOptions{AllowNullable: true, AllowWidening: true},
+			status: []FieldStatus{FieldWidened, FieldExact, FieldExact},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := NewSchema(tc.actual, nil)
+			report, err := SchemaCompatibility(expected, actual, tc.opts)
+			switch {
+			case tc.err == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tc.err != "" && (err == nil || err.Error() != tc.err):
+				t.Fatalf("invalid error:\ngot= %v\nwant=%s", err, tc.err)
+			}
+			if got, want := report.Compatible(), tc.err == ""; got != want {
+				t.Fatalf("invalid compatibility: got=%v, want=%v", got, want)
+			}
+
+			if got, want := len(report.Fields), len(tc.status); got != want {
+				t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+			}
+			for i, fc := range report.Fields {
+				if fc.Status != tc.status[i] {
+					t.Errorf("field %q: got=%v, want=%v", fc.Expected.Name, fc.Status, tc.status[i])
+				}
+				switch {
+				case fc.Status == FieldMissing && fc.Index != -1:
+					t.Errorf("field %q: invalid index %d for missing field", fc.Expected.Name, fc.Index)
+				case fc.Status != FieldMissing && actual.Field(fc.Index).Name != fc.Expected.Name:
+					t.Errorf("field %q: invalid index %d", fc.Expected.Name, fc.Index)
+				}
+			}
+			if got, want := len(report.Extra), tc.extra; got != want {
+				t.Fatalf("invalid number of extra fields: got=%d, want=%d", got, want)
+			}
+		})
+	}
+}
+
+func TestSchemaCompatibilityDuplicates(t *testing.T) {
+	expected := NewSchema([]Field{
+		{Name: "a", Type: PrimitiveTypes.Int64},
+		{Name: "a", Type: PrimitiveTypes.Float64},
+	}, nil)
+	actual := NewSchema([]Field{
+		{Name: "a", Type: PrimitiveTypes.Int64},
+		{Name: "b", Type: PrimitiveTypes.Int64},
+		{Name: "a", Type: PrimitiveTypes.Float64},
+		{Name: "a", Type: PrimitiveTypes.Int8},
+	}, nil)
+
+	report, err := SchemaCompatibility(expected, actual, CompatibilityOptions{AllowExtraFields: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int{0, 2} {
+		if got := report.Fields[i].Index; got != want {
+			t.Errorf("field %d: got index %d, want %d", i, got, want)
+		}
+	}
+	if len(report.Extra) != 2 || report.Extra[0].Name != "b" || report.Extra[1].Type.ID() != INT8 {
+		t.Errorf("invalid extra fields: %v", report.Extra)
+	}
+}
+
+func TestCompatibilityReportString(t *testing.T) {
+	expected := NewSchema([]Field{
+		{Name: "id", Type: PrimitiveTypes.Int64},
+		{Name: "score", Type: PrimitiveTypes.Float64},
+	}, nil)
+	actual := NewSchema([]Field{
+		{Name: "id", Type: PrimitiveTypes.Int32, Nullable: true},
+		{Name: "label", Type: BinaryTypes.String},
+	}, nil)
+
+	report, err := SchemaCompatibility(expected, actual, CompatibilityOptions{AllowWidening: true, AllowExtraFields: true})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := strings.Join([]string{
+		"schema compatibility:",
+		"  fields: 2",
+		`    - "id": widened (got=int32, want=int64), nullable [error]`,
+		`    - "score": missing [error]`,
+		"  extra fields: 1",
+		`    - "label": utf8 [ok]`,
+	}, "\n")
+	if got := report.String(); got != want {
+		t.Fatalf("invalid report:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,545 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"golang.org/x/xerrors"
+)
+
+// Scalar holds a single, possibly null, value of a given data type.
+//
+// Value holds the Go representation of the value, as returned by the Value
+// method of the arrays of that data type (e.g. int32 for INT32 or
+// arrow.Timestamp for TIMESTAMP). Value is nil when the scalar is null.
+type Scalar struct {
+	Type  arrow.DataType
+	Value interface{}
+}
+
+// IsValid reports whether the scalar is not null.
+func (s Scalar) IsValid() bool { return s.Value != nil }
+
+// AggKind is the kind of reduction computed by an aggregation.
+type AggKind int
+
+const (
+	// AggCount counts the valid values of a column, or the rows of the
+	// records when no column is specified.
+	// The result is an INT64.
+	AggCount AggKind = iota
+	// AggSum sums the valid values of a numeric or duration column.
+	// The result is an INT64 for signed integers, a UINT64 for unsigned
+	// integers, a FLOAT64 for floating point numbers and has the type of
+	// the column for durations.
+	// The result is null when no valid value was seen.
+	AggSum
+	// AggMin computes the smallest valid value of a numeric or temporal column.
+	// NaN values are ignored.
+	// The result has the type of the column, and is null when no valid
+	// value was seen.
+	AggMin
+	// AggMax computes the greatest valid value of a numeric or temporal column.
+	// NaN values are ignored.
+	// The result has the type of the column, and is null when no valid
+	// value was seen.
+	AggMax
+)
+
+func (k AggKind) String() string {
+	switch k {
+	case AggCount:
+		return "count"
+	case AggSum:
+		return "sum"
+	case AggMin:
+		return "min"
+	case AggMax:
+		return "max"
+	default:
+		return "invalid"
+	}
+}
+
+// AggSpec describes an aggregation over a column.
+type AggSpec struct {
+	Kind   AggKind
+	Column string // name of the aggregated column.
+	Name   string // name of the result. Defaults to "kind(column)", e.g. "sum(x)".
+}
+
+func (spec AggSpec) name() string {
+	if spec.Name != "" {
+		return spec.Name
+	}
+	return spec.Kind.String() + "(" + spec.Column + ")"
+}
+
+// AggregatingReader is a record reader that passes through the records of
+// another reader, while computing running aggregates over their columns.
+//
+// Snapshot may be called at any time, including from another goroutine than
+// the one iterating over the records. Snapshots are consistent: they reflect
+// all the records returned by Next so far, and no part of any other record.
+// Once Next has returned false, Snapshot returns the final results.
+type AggregatingReader struct {
+	refCount int64
+
+	inner array.RecordReader
+	cols  []int // index of the aggregated column of each aggregation; -1 for row counts.
+	names []string
+
+	mu   sync.Mutex
+	aggs []aggregator
+	done bool
+	err  error
+}
+
+// NewAggregatingReader returns a reader passing through the records of inner,
+// and computing the aggregations described by specs.
+//
+// NewAggregatingReader returns an error if a column does not exist in the
+// schema of inner, if a column does not support the requested aggregation or
+// if two aggregations have the same name.
+func NewAggregatingReader(inner array.RecordReader, specs []AggSpec) (*AggregatingReader, error) {
+	var (
+		schema = inner.Schema()
+		seen   = make(map[string]bool, len(specs))
+		r      = &AggregatingReader{
+			refCount: 1,
+			inner:    inner,
+			cols:     make([]int, len(specs)),
+			names:    make([]string, len(specs)),
+			aggs:     make([]aggregator, len(specs)),
+		}
+	)
+
+	for i, spec := range specs {
+		name := spec.name()
+		if seen[name] {
+			return nil, xerrors.Errorf("arrow/compute: duplicate aggregation %q", name)
+		}
+		seen[name] = true
+		r.names[i] = name
+
+		if spec.Kind == AggCount && spec.Column == "" {
+			r.cols[i] = -1
+			r.aggs[i] = &countAgg{rows: true}
+			continue
+		}
+
+		idx := schema.FieldIndices(spec.Column)
+		switch len(idx) {
+		case 0:
+			return nil, xerrors.Errorf("arrow/compute: aggregation %q: no column named %q", name, spec.Column)
+		case 1:
+		default:
+			return nil, xerrors.Errorf("arrow/compute: aggregation %q: ambiguous column name %q", name, spec.Column)
+		}
+		r.cols[i] = idx[0]
+
+		agg, err := newAggregator(spec.Kind, schema.Field(idx[0]).Type)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/compute: aggregation %q: %w", name, err)
+		}
+		r.aggs[i] = agg
+	}
+
+	inner.Retain()
+	return r, nil
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (r *AggregatingReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the underlying reader is released.
+// Release may be called simultaneously from multiple goroutines.
+func (r *AggregatingReader) Release() {
+	debug.Assert(atomic.LoadInt64(&r.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		r.inner.Release()
+		r.inner = nil
+	}
+}
+
+// Schema returns the schema of the underlying reader.
+func (r *AggregatingReader) Schema() *arrow.Schema { return r.inner.Schema() }
+
+// Next advances the underlying reader to the next record, and updates the
+// aggregations with its content.
+func (r *AggregatingReader) Next() bool {
+	if r.done {
+		return false
+	}
+
+	if !r.inner.Next() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.done = true
+		if inner, ok := r.inner.(interface{ Err() error }); ok {
+			r.err = inner.Err()
+		}
+		return false
+	}
+
+	rec := r.inner.Record()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, agg := range r.aggs {
+		switch col := r.cols[i]; col {
+		case -1:
+			agg.(*countAgg).n += rec.NumRows()
+		default:
+			agg.update(rec.Column(col))
+		}
+	}
+	return true
+}
+
+// Record returns the current record of the underlying reader.
+func (r *AggregatingReader) Record() array.Record { return r.inner.Record() }
+
+// Err returns the error of the underlying reader, if it exposes one,
+// once Next has returned false.
+func (r *AggregatingReader) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Done reports whether the underlying reader is exhausted, i.e. whether
+// Snapshot returns the final results of the aggregations.
+func (r *AggregatingReader) Done() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.done
+}
+
+// Snapshot returns the current results of the aggregations, keyed by name.
+func (r *AggregatingReader) Snapshot() map[string]Scalar {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make(map[string]Scalar, len(r.aggs))
+	for i, agg := range r.aggs {
+		res[r.names[i]] = agg.result()
+	}
+	return res
+}
+
+type aggregator interface {
+	update(arr array.Interface)
+	result() Scalar
+}
+
+func newAggregator(kind AggKind, dtype arrow.DataType) (aggregator, error) {
+	switch kind {
+	case AggCount:
+		return &countAgg{}, nil
+	case AggSum:
+		cls, ok := numericClassOf(dtype)
+		if !ok || (cls == classSigned && !isSummable(dtype)) {
+			return nil, xerrors.Errorf("unsupported data type %v for %v", dtype, kind)
+		}
+		var otype arrow.DataType
+		switch {
+		case dtype.ID() == arrow.DURATION:
+			otype = dtype
+		case cls == classSigned:
+			otype = arrow.PrimitiveTypes.Int64
+		case cls == classUnsigned:
+			otype = arrow.PrimitiveTypes.Uint64
+		default:
+			otype = arrow.PrimitiveTypes.Float64
+		}
+		return &sumAgg{dtype: otype, cls: cls}, nil
+	case AggMin, AggMax:
+		cls, ok := numericClassOf(dtype)
+		if !ok {
+			return nil, xerrors.Errorf("unsupported data type %v for %v", dtype, kind)
+		}
+		return &minMaxAgg{dtype: dtype, cls: cls, max: kind == AggMax}, nil
+	default:
+		return nil, xerrors.Errorf("invalid aggregation kind %d", int(kind))
+	}
+}
+
+type countAgg struct {
+	rows bool
+	n    int64
+}
+
+func (agg *countAgg) update(arr array.Interface) {
+	agg.n += int64(arr.Len() - arr.NullN())
+}
+
+func (agg *countAgg) result() Scalar {
+	return Scalar{Type: arrow.PrimitiveTypes.Int64, Value: agg.n}
+}
+
+type sumAgg struct {
+	dtype arrow.DataType
+	cls   numericClass
+	valid bool
+	i     int64
+	u     uint64
+	f     float64
+}
+
+func (agg *sumAgg) update(arr array.Interface) {
+	if arr.Len() == arr.NullN() {
+		return
+	}
+	agg.valid = true
+	switch agg.cls {
+	case classSigned:
+		v := int64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) {
+				agg.i += v(i)
+			}
+		}
+	case classUnsigned:
+		v := uint64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) {
+				agg.u += v(i)
+			}
+		}
+	default:
+		v := float64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) {
+				agg.f += v(i)
+			}
+		}
+	}
+}
+
+func (agg *sumAgg) result() Scalar {
+	if !agg.valid {
+		return Scalar{Type: agg.dtype}
+	}
+	switch {
+	case agg.dtype.ID() == arrow.DURATION:
+		return Scalar{Type: agg.dtype, Value: arrow.Duration(agg.i)}
+	case agg.cls == classSigned:
+		return Scalar{Type: agg.dtype, Value: agg.i}
+	case agg.cls == classUnsigned:
+		return Scalar{Type: agg.dtype, Value: agg.u}
+	default:
+		return Scalar{Type: agg.dtype, Value: agg.f}
+	}
+}
+
+type minMaxAgg struct {
+	dtype arrow.DataType
+	cls   numericClass
+	max   bool
+	valid bool
+	i     int64
+	u     uint64
+	f     float64
+}
+
+func (agg *minMaxAgg) update(arr array.Interface) {
+	switch agg.cls {
+	case classSigned:
+		v := int64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				continue
+			}
+			x := v(i)
+			if !agg.valid || (agg.max && x > agg.i) || (!agg.max && x < agg.i) {
+				agg.i, agg.valid = x, true
+			}
+		}
+	case classUnsigned:
+		v := uint64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				continue
+			}
+			x := v(i)
+			if !agg.valid || (agg.max && x > agg.u) || (!agg.max && x < agg.u) {
+				agg.u, agg.valid = x, true
+			}
+		}
+	default:
+		v := float64Values(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				continue
+			}
+			x := v(i)
+			if math.IsNaN(x) {
+				continue
+			}
+			if !agg.valid || (agg.max && x > agg.f) || (!agg.max && x < agg.f) {
+				agg.f, agg.valid = x, true
+			}
+		}
+	}
+}
+
+func (agg *minMaxAgg) result() Scalar {
+	if !agg.valid {
+		return Scalar{Type: agg.dtype}
+	}
+
+	var v interface{}
+	switch agg.dtype.ID() {
+	case arrow.INT8:
+		v = int8(agg.i)
+	case arrow.INT16:
+		v = int16(agg.i)
+	case arrow.INT32:
+		v = int32(agg.i)
+	case arrow.INT64:
+		v = agg.i
+	case arrow.UINT8:
+		v = uint8(agg.u)
+	case arrow.UINT16:
+		v = uint16(agg.u)
+	case arrow.UINT32:
+		v = uint32(agg.u)
+	case arrow.UINT64:
+		v = agg.u
+	case arrow.FLOAT16:
+		v = float16.New(float32(agg.f))
+	case arrow.FLOAT32:
+		v = float32(agg.f)
+	case arrow.FLOAT64:
+		v = agg.f
+	case arrow.DATE32:
+		v = arrow.Date32(agg.i)
+	case arrow.DATE64:
+		v = arrow.Date64(agg.i)
+	case arrow.TIMESTAMP:
+		v = arrow.Timestamp(agg.i)
+	case arrow.TIME32:
+		v = arrow.Time32(agg.i)
+	case arrow.TIME64:
+		v = arrow.Time64(agg.i)
+	case arrow.DURATION:
+		v = arrow.Duration(agg.i)
+	}
+	return Scalar{Type: agg.dtype, Value: v}
+}
+
+// numericClass is the Go type numeric values are widened to while aggregating.
+type numericClass int
+
+const (
+	classSigned numericClass = iota
+	classUnsigned
+	classFloat
+)
+
+func numericClassOf(dtype arrow.DataType) (numericClass, bool) {
+	switch dtype.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP,
+		arrow.TIME32, arrow.TIME64, arrow.DURATION:
+		return classSigned, true
+	case arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return classUnsigned, true
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return classFloat, true
+	default:
+		return 0, false
+	}
+}
+
+// isSummable reports whether values of a signed data type can be added.
+func isSummable(dtype arrow.DataType) bool {
+	switch dtype.ID() {
+	case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP, arrow.TIME32, arrow.TIME64:
+		return false
+	default:
+		return true
+	}
+}
+
+func int64Values(arr array.Interface) func(i int) int64 {
+	switch arr := arr.(type) {
+	case *array.Int8:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int16:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int32:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int64:
+		return func(i int) int64 { return arr.Value(i) }
+	case *array.Date32:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Date64:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Timestamp:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Time32:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Time64:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Duration:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	default:
+		panic(xerrors.Errorf("arrow/compute: invalid data type %v", arr.DataType()))
+	}
+}
+
+func uint64Values(arr array.Interface) func(i int) uint64 {
+	switch arr := arr.(type) {
+	case *array.Uint8:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint16:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint32:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint64:
+		return func(i int) uint64 { return arr.Value(i) }
+	default:
+		panic(xerrors.Errorf("arrow/compute: invalid data type %v", arr.DataType()))
+	}
+}
+
+func float64Values(arr array.Interface) func(i int) float64 {
+	switch arr := arr.(type) {
+	case *array.Float16:
+		return func(i int) float64 { return float64(arr.Value(i).Float32()) }
+	case *array.Float32:
+		return func(i int) float64 { return float64(arr.Value(i)) }
+	case *array.Float64:
+		return func(i int) float64 { return arr.Value(i) }
+	default:
+		panic(xerrors.Errorf("arrow/compute: invalid data type %v", arr.DataType()))
+	}
+}
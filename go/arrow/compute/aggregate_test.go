@@ -0,0 +1,289 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatingReader(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "u16", Type: arrow.PrimitiveTypes.Uint16, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
+		{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	var (
+		rnd  = rand.New(rand.NewSource(1))
+		bldr = array.NewRecordBuilder(mem, schema)
+		recs []array.Record
+	)
+	defer bldr.Release()
+
+	for i := 0; i < 20; i++ {
+		n := rnd.Intn(50)
+		for j := 0; j < n; j++ {
+			valid := rnd.Intn(4) != 0
+			if !valid {
+				for _, fb := range bldr.Fields() {
+					fb.AppendNull()
+				}
+				continue
+			}
+			bldr.Field(0).(*array.Int32Builder).Append(rnd.Int31() - math.MaxInt32/2)
+			bldr.Field(1).(*array.Uint16Builder).Append(uint16(rnd.Intn(math.MaxUint16)))
+			f := rnd.NormFloat64()
+			if rnd.Intn(10) == 0 {
+				f = math.NaN()
+			}
+			bldr.Field(2).(*array.Float64Builder).Append(f)
+			bldr.Field(3).(*array.TimestampBuilder).Append(arrow.Timestamp(rnd.Int63()))
+			bldr.Field(4).(*array.StringBuilder).Append("x")
+		}
+		recs = append(recs, bldr.NewRecord())
+	}
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	specs := []compute.AggSpec{
+		{Kind: compute.AggCount},
+		{Kind: compute.AggCount, Column: "s"},
+		{Kind: compute.AggSum, Column: "i32"},
+		{Kind: compute.AggSum, Column: "u16"},
+		{Kind: compute.AggMin, Column: "i32"},
+		{Kind: compute.AggMax, Column: "u16"},
+		{Kind: compute.AggMin, Column: "f64"},
+		{Kind: compute.AggMax, Column: "f64", Name: "hi"},
+		{Kind: compute.AggMin, Column: "ts"},
+		{Kind: compute.AggMax, Column: "ts"},
+	}
+
+	inner, err := array.NewRecordReader(schema, recs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inner.Release()
+
+	r, err := compute.NewAggregatingReader(inner, specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	// snapshots may be taken while the records flow through.
+	var (
+		wg   sync.WaitGroup
+		quit = make(chan struct{})
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		prev := int64(-1)
+		for {
+			select {
+			case <-quit:
+				return
+			default:
+			}
+			n := r.Snapshot()["count()"].Value.(int64)
+			if n < prev {
+				t.Errorf("row count went backwards: %d < %d", n, prev)
+				return
+			}
+			prev = n
+		}
+	}()
+
+	nrecs := 0
+	for r.Next() {
+		if r.Record() != recs[nrecs] {
+			t.Fatalf("record %d was not passed through", nrecs)
+		}
+		nrecs++
+	}
+	close(quit)
+	wg.Wait()
+
+	assert.Equal(t, len(recs), nrecs)
+	assert.True(t, r.Done())
+	assert.NoError(t, r.Err())
+
+	tbl := array.NewTableFromRecords(schema, recs)
+	defer tbl.Release()
+
+	want := recompute(tbl)
+	got := r.Snapshot()
+	assert.Equal(t, len(want), len(got))
+	for name, v := range want {
+		assert.Equal(t, v, got[name].Value, "aggregation %q", name)
+	}
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, got["sum(i32)"].Type)
+	assert.Equal(t, arrow.PrimitiveTypes.Uint64, got["sum(u16)"].Type)
+	assert.Equal(t, arrow.FixedWidthTypes.Timestamp_ms, got["max(ts)"].Type)
+}
+
+// recompute computes the aggregations of TestAggregatingReader over the
+// whole table.
+func recompute(tbl array.Table) map[string]interface{} {
+	var (
+		rows, strs     int64
+		sumI32         int64
+		sumU16         uint64
+		minI32         int32 = math.MaxInt32
+		maxU16         uint16
+		minF64, maxF64 = math.Inf(+1), math.Inf(-1)
+		minTS          = arrow.Timestamp(math.MaxInt64)
+		maxTS          = arrow.Timestamp(math.MinInt64)
+	)
+
+	chunks := func(i int) []array.Interface { return tbl.Column(i).Data().Chunks() }
+	rows = tbl.NumRows()
+	for _, c := range chunks(0) {
+		c := c.(*array.Int32)
+		for i := 0; i < c.Len(); i++ {
+			if c.IsValid(i) {
+				sumI32 += int64(c.Value(i))
+				if c.Value(i) < minI32 {
+					minI32 = c.Value(i)
+				}
+			}
+		}
+	}
+	for _, c := range chunks(1) {
+		c := c.(*array.Uint16)
+		for i := 0; i < c.Len(); i++ {
+			if c.IsValid(i) {
+				sumU16 += uint64(c.Value(i))
+				if c.Value(i) > maxU16 {
+					maxU16 = c.Value(i)
+				}
+			}
+		}
+	}
+	for _, c := range chunks(2) {
+		c := c.(*array.Float64)
+		for i := 0; i < c.Len(); i++ {
+			if c.IsValid(i) && !math.IsNaN(c.Value(i)) {
+				minF64 = math.Min(minF64, c.Value(i))
+				maxF64 = math.Max(maxF64, c.Value(i))
+			}
+		}
+	}
+	for _, c := range chunks(3) {
+		c := c.(*array.Timestamp)
+		for i := 0; i < c.Len(); i++ {
+			if c.IsValid(i) {
+				if c.Value(i) < minTS {
+					minTS = c.Value(i)
+				}
+				if c.Value(i) > maxTS {
+					maxTS = c.Value(i)
+				}
+			}
+		}
+	}
+	for _, c := range chunks(4) {
+		strs += int64(c.Len() - c.NullN())
+	}
+
+	return map[string]interface{}{
+		"count()":  rows,
+		"count(s)": strs,
+		"sum(i32)": sumI32,
+		"sum(u16)": sumU16,
+		"min(i32)": minI32,
+		"max(u16)": maxU16,
+		"min(f64)": minF64,
+		"hi":       maxF64,
+		"min(ts)":  minTS,
+		"max(ts)":  maxTS,
+	}
+}
+
+func TestAggregatingReaderEmpty(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "f", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+	}, nil)
+
+	inner, err := array.NewRecordReader(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inner.Release()
+
+	r, err := compute.NewAggregatingReader(inner, []compute.AggSpec{
+		{Kind: compute.AggCount, Column: "f"},
+		{Kind: compute.AggSum, Column: "f"},
+		{Kind: compute.AggMax, Column: "f"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	assert.False(t, r.Done())
+	for r.Next() {
+	}
+	assert.True(t, r.Done())
+
+	got := r.Snapshot()
+	assert.Equal(t, int64(0), got["count(f)"].Value)
+	assert.False(t, got["sum(f)"].IsValid())
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, got["sum(f)"].Type)
+	assert.False(t, got["max(f)"].IsValid())
+	assert.Equal(t, arrow.PrimitiveTypes.Float32, got["max(f)"].Type)
+}
+
+func TestAggregatingReaderInvalid(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "s", Type: arrow.BinaryTypes.String},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+	}, nil)
+
+	inner, err := array.NewRecordReader(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inner.Release()
+
+	for _, specs := range [][]compute.AggSpec{
+		{{Kind: compute.AggSum, Column: "missing"}},
+		{{Kind: compute.AggMin, Column: "s"}},
+		{{Kind: compute.AggSum, Column: "ts"}},
+		{{Kind: compute.AggCount, Column: "s"}, {Kind: compute.AggMin, Column: "ts", Name: "count(s)"}},
+	} {
+		_, err := compute.NewAggregatingReader(inner, specs)
+		assert.Error(t, err, "specs %v", specs)
+	}
+}
@@ -0,0 +1,155 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// And returns the element-wise logical conjunction of a and b.
+//
+// Nulls follow Kleene logic: false && null is false, true && null is null.
+func And(mem memory.Allocator, a, b *array.Boolean) (*array.Boolean, error) {
+	if err := checkSameLayout(a, b); err != nil {
+		return nil, err
+	}
+
+	var (
+		va, xa = validityWords(a), booleanWords(a)
+		vb, xb = validityWords(b), booleanWords(b)
+		valid  = make([]uint64, len(va))
+		values = make([]uint64, len(va))
+	)
+	for w := range va {
+		// a result is known as soon as one of the operands is a valid false.
+		valid[w] = (va[w] & vb[w]) | (va[w] &^ xa[w]) | (vb[w] &^ xb[w])
+		values[w] = va[w] & xa[w] & vb[w] & xb[w]
+	}
+	return newBooleanFromWords(mem, a.Len(), valid, values), nil
+}
+
+// Or returns the element-wise logical disjunction of a and b.
+//
+// Nulls follow Kleene logic: true || null is true, false || null is null.
+func Or(mem memory.Allocator, a, b *array.Boolean) (*array.Boolean, error) {
+	if err := checkSameLayout(a, b); err != nil {
+		return nil, err
+	}
+
+	var (
+		va, xa = validityWords(a), booleanWords(a)
+		vb, xb = validityWords(b), booleanWords(b)
+		valid  = make([]uint64, len(va))
+		values = make([]uint64, len(va))
+	)
+	for w := range va {
+		// a result is known as soon as one of the operands is a valid true.
+		ta, tb := va[w]&xa[w], vb[w]&xb[w]
+		valid[w] = (va[w] & vb[w]) | ta | tb
+		values[w] = ta | tb
+	}
+	return newBooleanFromWords(mem, a.Len(), valid, values), nil
+}
+
+// Not returns the element-wise logical negation of a.
+// Nulls stay null.
+func Not(mem memory.Allocator, a *array.Boolean) *array.Boolean {
+	var (
+		valid  = validityWords(a)
+		xa     = booleanWords(a)
+		values = make([]uint64, len(valid))
+	)
+	for w := range valid {
+		values[w] = valid[w] &^ xa[w]
+	}
+	return newBooleanFromWords(mem, a.Len(), valid, values)
+}
+
+// IsNull returns a boolean array, without nulls, telling whether each
+// element of arr is null.
+func IsNull(mem memory.Allocator, arr array.Interface) *array.Boolean {
+	var (
+		n      = arr.Len()
+		valid  = validityWords(arr)
+		values = make([]uint64, len(valid))
+	)
+	for w := range valid {
+		values[w] = ^valid[w]
+	}
+	return newBooleanFromWords(mem, n, allSet(n), clearTail(values, n))
+}
+
+// IsValid returns a boolean array, without nulls, telling whether each
+// element of arr is valid.
+func IsValid(mem memory.Allocator, arr array.Interface) *array.Boolean {
+	n := arr.Len()
+	return newBooleanFromWords(mem, n, allSet(n), validityWords(arr))
+}
+
+// allSet returns n set bits, packed in 64-bit words.
+func allSet(n int) []uint64 {
+	words := make([]uint64, (n+63)/64)
+	for i := range words {
+		words[i] = ^uint64(0)
+	}
+	return clearTail(words, n)
+}
+
+// clearTail clears the bits of words past n, and returns words.
+func clearTail(words []uint64, n int) []uint64 {
+	if r := n % 64; r != 0 {
+		words[len(words)-1] &= (1 << uint(r)) - 1
+	}
+	return words
+}
+
+// booleanWords returns the values of arr packed in 64-bit words.
+func booleanWords(arr *array.Boolean) []uint64 {
+	n := arr.Len()
+	if n == 0 {
+		return nil
+	}
+	data := arr.Data()
+	return bitmapWords(data.Buffers()[1].Bytes(), data.Offset(), n)
+}
+
+// newBooleanFromWords creates a boolean array of length n from its validity
+// and values bits, packed in 64-bit words.
+func newBooleanFromWords(mem memory.Allocator, n int, valid, values []uint64) *array.Boolean {
+	var (
+		bitmap = wordsToBuffer(mem, valid, n)
+		data   = wordsToBuffer(mem, values, n)
+	)
+	defer bitmap.Release()
+	defer data.Release()
+
+	nulls := n - countSetBits(valid)
+	return makeArray(arrow.FixedWidthTypes.Boolean, n, []*memory.Buffer{bitmap, data}, nulls).(*array.Boolean)
+}
+
+func wordsToBuffer(mem memory.Allocator, words []uint64, n int) *memory.Buffer {
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(int(bitutil.BytesForBits(int64(n))))
+	out := buf.Bytes()
+	for i := range out {
+		out[i] = byte(words[i/8] >> (8 * uint(i%8)))
+	}
+	return buf
+}
@@ -0,0 +1,138 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// newBools returns a boolean array from a string of 't' (true), 'f' (false)
+// and '.' (null) characters.
+func newBools(mem memory.Allocator, s string) *array.Boolean {
+	bld := array.NewBooleanBuilder(mem)
+	defer bld.Release()
+	for _, c := range s {
+		switch c {
+		case 't':
+			bld.Append(true)
+		case 'f':
+			bld.Append(false)
+		default:
+			bld.AppendNull()
+		}
+	}
+	return bld.NewBooleanArray()
+}
+
+func assertBools(t *testing.T, got *array.Boolean, want string) {
+	t.Helper()
+	if got.Len() != len(want) {
+		t.Fatalf("invalid length: got=%d, want=%d", got.Len(), len(want))
+	}
+	nulls := 0
+	for i, c := range want {
+		switch {
+		case c == '.':
+			nulls++
+			if got.IsValid(i) {
+				t.Fatalf("invalid value at %d: got=%v, want=null (%v)", i, got.Value(i), got)
+			}
+		case got.IsNull(i) || got.Value(i) != (c == 't'):
+			t.Fatalf("invalid value at %d: got=%v, want=%c", i, got, c)
+		}
+	}
+	if got.NullN() != nulls {
+		t.Fatalf("invalid number of nulls: got=%d, want=%d", got.NullN(), nulls)
+	}
+}
+
+func TestKleeneLogic(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	a := newBools(mem, "tttfff...")
+	defer a.Release()
+	b := newBools(mem, "tf.tf.tf.")
+	defer b.Release()
+
+	and, err := compute.And(mem, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer and.Release()
+	assertBools(t, and, "tf.fff.f.")
+
+	or, err := compute.Or(mem, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer or.Release()
+	assertBools(t, or, "ttttf.t..")
+
+	not := compute.Not(mem, a)
+	defer not.Release()
+	assertBools(t, not, "fffttt...")
+
+	short := newBools(mem, "tf")
+	defer short.Release()
+	if _, err := compute.And(mem, a, short); err == nil {
+		t.Fatalf("expected a length mismatch error")
+	}
+}
+
+func TestIsNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const pattern = "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t" + "t.f..tf.t"
+	arr := newBools(mem, pattern)
+	defer arr.Release()
+
+	// slice at an offset which is not a multiple of 8, across a word boundary.
+	sub := array.NewSlice(arr, 3, 70).(*array.Boolean)
+	defer sub.Release()
+
+	var isNull, isValid []byte
+	for _, c := range pattern[3:70] {
+		switch c {
+		case '.':
+			isNull, isValid = append(isNull, 't'), append(isValid, 'f')
+		default:
+			isNull, isValid = append(isNull, 'f'), append(isValid, 't')
+		}
+	}
+
+	got := compute.IsNull(mem, sub)
+	defer got.Release()
+	assertBools(t, got, string(isNull))
+
+	got = compute.IsValid(mem, sub)
+	defer got.Release()
+	assertBools(t, got, string(isValid))
+
+	not := compute.Not(mem, sub)
+	defer not.Release()
+	for i := 0; i < sub.Len(); i++ {
+		if not.IsValid(i) != sub.IsValid(i) || (sub.IsValid(i) && not.Value(i) == sub.Value(i)) {
+			t.Fatalf("invalid negation at %d: got=%v, input=%v", i, not, sub)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Cast returns an array holding the values of arr converted to the data
+// type to.
+//
+// The supported casts are:
+//   - between timestamps of any time unit and time zone, and between
+//     durations of any time unit.
+//     Conversions to a coarser time unit truncate towards zero. Conversions
+//     to a finer time unit return an error naming the first overflowing row,
+//     unless WithSaturation(true) is used, in which case overflowing values
+//     are clamped to the int64 range.
+//   - between float16, float32 and float64.
+//     Values are rounded to the nearest representable value. Finite values
+//     beyond the range of the target type return an error naming the first
+//     overflowing row, unless WithSaturation(true) is used, in which case
+//     they are clamped to the largest finite values of the target type.
+//     NaNs and infinities are kept.
+//   - between lists and large lists of the same element type.
+//     The elements are shared with arr. Casts to a list return an error
+//     naming the first row whose offsets overflow int32.
+//   - from a dictionary-encoded array to the data type of its dictionary,
+//     decoding the values.
+//
+// Nulls stay null.
+func Cast(mem memory.Allocator, arr array.Interface, to arrow.DataType, opts ...Option) (array.Interface, error) {
+	cfg := newConfig(opts...)
+
+	switch src := arr.(type) {
+	case *array.Timestamp:
+		dtype, ok := to.(*arrow.TimestampType)
+		if !ok {
+			break
+		}
+		from := src.DataType().(*arrow.TimestampType).Unit
+
+		vs := make([]arrow.Timestamp, src.Len())
+		copy(vs, src.TimestampValues())
+		for i := range vs {
+			if src.IsNull(i) {
+				vs[i] = 0
+			}
+		}
+		if i, err := arrow.ConvertTimestampValues(vs, vs, from, dtype.Unit, cfg.saturate); err != nil {
+			return nil, castError(i, arr.DataType(), to, err)
+		}
+
+		bldr := array.NewTimestampBuilder(mem, dtype)
+		defer bldr.Release()
+		bldr.AppendValues(vs, validity(arr))
+		return bldr.NewArray(), nil
+
+	case *array.Duration:
+		dtype, ok := to.(*arrow.DurationType)
+		if !ok {
+			break
+		}
+		from := src.DataType().(*arrow.DurationType).Unit
+
+		vs := make([]arrow.Duration, src.Len())
+		copy(vs, src.DurationValues())
+		for i := range vs {
+			if src.IsNull(i) {
+				vs[i] = 0
+			}
+		}
+		if i, err := arrow.ConvertDurationValues(vs, vs, from, dtype.Unit, cfg.saturate); err != nil {
+			return nil, castError(i, arr.DataType(), to, err)
+		}
+
+		bldr := array.NewDurationBuilder(mem, dtype)
+		defer bldr.Release()
+		bldr.AppendValues(vs, validity(arr))
+		return bldr.NewArray(), nil
+
+	case *array.List:
+		dtype, ok := to.(*arrow.LargeListType)
+		if !ok || !arrow.TypeEqual(dtype.Elem(), src.DataType().(*arrow.ListType).Elem()) {
+			break
+		}
+		return castList(mem, src, src.ListValues(), dtype)
+
+	case *array.LargeList:
+		dtype, ok := to.(*arrow.ListType)
+		if !ok || !arrow.TypeEqual(dtype.Elem(), src.DataType().(*arrow.LargeListType).Elem()) {
+			break
+		}
+		return castList(mem, src, src.ListValues(), dtype)
+
+	case *array.Dictionary:
+		if !arrow.TypeEqual(to, src.Dictionary().DataType()) {
+			break
+		}
+		return decodeDictionary(mem, src)
+
+	case *array.Float16, *array.Float32, *array.Float64:
+		var max float64
+		switch to.ID() {
+		case arrow.FLOAT16:
+			max = maxFloat16
+		case arrow.FLOAT32:
+			max = math.MaxFloat32
+		case arrow.FLOAT64:
+			max = math.MaxFloat64
+		default:
+			return nil, xerrors.Errorf("arrow/compute: unsupported cast from %v to %v", arr.DataType(), to)
+		}
+
+		var (
+			v  = float64Values(arr)
+			vs = make([]float64, arr.Len())
+		)
+		for i := range vs {
+			if arr.IsNull(i) {
+				continue
+			}
+			x := v(i)
+			if math.Abs(x) > max && !math.IsInf(x, 0) {
+				if !cfg.saturate {
+					return nil, castError(i, arr.DataType(), to, xerrors.Errorf("arrow/compute: overflow converting %v", x))
+				}
+				x = math.Copysign(max, x)
+			}
+			vs[i] = x
+		}
+		return newFloatArray(mem, to, vs, validity(arr)), nil
+	}
+
+	return nil, xerrors.Errorf("arrow/compute: unsupported cast from %v to %v", arr.DataType(), to)
+}
+
+// castList returns the list or large list array of data type to, holding
+// the elements of the list or large list arr, whose values are values.
+func castList(mem memory.Allocator, arr, values array.Interface, to arrow.DataType) (array.Interface, error) {
+	var (
+		data = arr.Data()
+		n    = data.Offset() + data.Len() + 1
+		offs = memory.NewResizableBuffer(mem)
+	)
+	defer offs.Release()
+
+	// the offsets keep the offset of arr, so that its validity bitmap and
+	// its values can be shared.
+	switch to.ID() {
+	case arrow.LARGE_LIST:
+		offs.Resize(arrow.Int64Traits.BytesRequired(n))
+		memory.Set(offs.Bytes(), 0)
+		out := arrow.Int64Traits.CastFromBytes(offs.Bytes())[data.Offset():]
+		for i, v := range int32Offsets(data) {
+			out[i] = int64(v)
+		}
+	default:
+		offs.Resize(arrow.Int32Traits.BytesRequired(n))
+		memory.Set(offs.Bytes(), 0)
+		out := arrow.Int32Traits.CastFromBytes(offs.Bytes())[data.Offset():]
+		for i, v := range int64Offsets(data) {
+			if v > math.MaxInt32 {
+				// the i-th offset ends the row i-1.
+				row := i - 1
+				if row < 0 {
+					row = 0
+				}
+				return nil, castError(row, arr.DataType(), to, xerrors.Errorf("offset %d overflows int32", v))
+			}
+			out[i] = int32(v)
+		}
+	}
+
+	out := array.NewData(
+		to, data.Len(),
+		[]*memory.Buffer{data.Buffers()[0], offs},
+		[]*array.Data{values.Data()},
+		data.NullN(), data.Offset(),
+	)
+	defer out.Release()
+	return array.MakeFromData(out), nil
+}
+
+// decodeDictionary returns the values of the dictionary-encoded array arr.
+func decodeDictionary(mem memory.Allocator, arr *array.Dictionary) (array.Interface, error) {
+	values := arr.Dictionary()
+	if arr.NullN() > 0 {
+		// null elements take a null appended to the values of the
+		// dictionary.
+		bldr := array.NewBuilder(mem, values.DataType())
+		defer bldr.Release()
+		bldr.AppendNull()
+		null := bldr.NewArray()
+		defer null.Release()
+
+		var err error
+		values, err = array.Concatenate(mem, []array.Interface{values, null})
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/compute: could not decode dictionary: %w", err)
+		}
+		defer values.Release()
+	}
+
+	idx := make([]int, arr.Len())
+	for i := range idx {
+		if arr.IsNull(i) {
+			idx[i] = values.Len() - 1
+			continue
+		}
+		idx[i] = arr.GetValueIndex(i)
+	}
+	return take(mem, values, idx)
+}
+
+// maxFloat16 is the largest finite float16 value.
+const maxFloat16 = 65504
+
+// newFloatArray returns a floating-point array of data type dtype, holding
+// the values vs rounded to dtype.
+func newFloatArray(mem memory.Allocator, dtype arrow.DataType, vs []float64, valid []bool) array.Interface {
+	switch dtype.ID() {
+	case arrow.FLOAT16:
+		out := make([]float16.Num, len(vs))
+		for i, v := range vs {
+			out[i] = float16.New(float32(v))
+		}
+		bldr := array.NewFloat16Builder(mem)
+		defer bldr.Release()
+		bldr.AppendValues(out, valid)
+		return bldr.NewArray()
+	case arrow.FLOAT32:
+		out := make([]float32, len(vs))
+		for i, v := range vs {
+			out[i] = float32(v)
+		}
+		bldr := array.NewFloat32Builder(mem)
+		defer bldr.Release()
+		bldr.AppendValues(out, valid)
+		return bldr.NewArray()
+	default:
+		bldr := array.NewFloat64Builder(mem)
+		defer bldr.Release()
+		bldr.AppendValues(vs, valid)
+		return bldr.NewArray()
+	}
+}
+
+func castError(row int, from, to arrow.DataType, err error) error {
+	return xerrors.Errorf("arrow/compute: could not cast row %d from %v to %v: %w", row, from, to, err)
+}
+
+// validity returns the validity of each element of arr, or nil if arr has
+// no nulls.
+func validity(arr array.Interface) []bool {
+	if arr.NullN() == 0 {
+		return nil
+	}
+	valid := make([]bool, arr.Len())
+	for i := range valid {
+		valid[i] = arr.IsValid(i)
+	}
+	return valid
+}
@@ -0,0 +1,246 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCastTimestamp(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const max arrow.Timestamp = math.MaxInt64 / 1000000
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Millisecond})
+	defer bldr.Release()
+	// the null slot holds a value which would overflow if converted.
+	bldr.AppendValues([]arrow.Timestamp{1, math.MaxInt64, max, max + 1, -max - 1}, []bool{true, false, true, true, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	ns := &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+
+	head := array.NewSlice(arr, 0, 3)
+	defer head.Release()
+	got, err := compute.Cast(mem, head, ns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.True(t, arrow.TypeEqual(got.DataType(), ns))
+	assert.Equal(t, []arrow.Timestamp{1e6, 0, max * 1000000}, got.(*array.Timestamp).TimestampValues())
+	assert.True(t, got.IsNull(1))
+
+	_, err = compute.Cast(mem, arr, ns)
+	if err == nil || !strings.Contains(err.Error(), "could not cast row 3") {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	got, err = compute.Cast(mem, arr, ns, compute.WithSaturation(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.Equal(t, []arrow.Timestamp{1e6, 0, max * 1000000, math.MaxInt64, math.MinInt64}, got.(*array.Timestamp).TimestampValues())
+
+	s := &arrow.TimestampType{Unit: arrow.Second}
+	got, err = compute.Cast(mem, arr, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.Equal(t, []arrow.Timestamp{0, 0, max / 1000, (max + 1) / 1000, (-max - 1) / 1000}, got.(*array.Timestamp).TimestampValues())
+}
+
+func TestCastDuration(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: arrow.Second})
+	defer bldr.Release()
+	bldr.AppendValues([]arrow.Duration{-2, math.MinInt64 / 1000}, nil)
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	got, err := compute.Cast(mem, arr, &arrow.DurationType{Unit: arrow.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.Equal(t, []arrow.Duration{-2000, math.MinInt64 / 1000 * 1000}, got.(*array.Duration).DurationValues())
+
+	_, err = compute.Cast(mem, arr, &arrow.DurationType{Unit: arrow.Microsecond})
+	if err == nil || !strings.Contains(err.Error(), "could not cast row 1") {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	_, err = compute.Cast(mem, arr, arrow.PrimitiveTypes.Int64)
+	if err == nil || !strings.Contains(err.Error(), "unsupported cast") {
+		t.Fatalf("invalid error: %v", err)
+	}
+}
+
+func TestCastFloat(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]float64{1.5, math.MaxFloat64, math.Inf(-1), -1e300}, []bool{true, false, true, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	head := array.NewSlice(arr, 0, 3)
+	defer head.Release()
+	got, err := compute.Cast(mem, head, arrow.PrimitiveTypes.Float32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.Equal(t, []float32{1.5, 0, float32(math.Inf(-1))}, got.(*array.Float32).Float32Values())
+	assert.True(t, got.IsNull(1))
+
+	_, err = compute.Cast(mem, arr, arrow.PrimitiveTypes.Float32)
+	if err == nil || !strings.Contains(err.Error(), "could not cast row 3") {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	got, err = compute.Cast(mem, arr, arrow.FixedWidthTypes.Float16, compute.WithSaturation(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	f16 := got.(*array.Float16)
+	assert.Equal(t, float32(1.5), f16.Value(0).Float32())
+	assert.Equal(t, float32(-65504), f16.Value(3).Float32())
+
+	_, err = compute.Cast(mem, arr, arrow.PrimitiveTypes.Int64)
+	if err == nil {
+		t.Fatalf("expected an error casting float64 to int64")
+	}
+}
+
+func TestCastList(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer bldr.Release()
+	vb := bldr.ValueBuilder().(*array.Int32Builder)
+	bldr.Append(true)
+	vb.AppendValues([]int32{1, 2}, nil)
+	bldr.AppendNull()
+	bldr.Append(true)
+	bldr.Append(true)
+	vb.AppendValues([]int32{3, 4, 5}, nil)
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 4)
+	defer slice.Release()
+
+	large, err := compute.Cast(mem, slice, arrow.LargeListOf(arrow.PrimitiveTypes.Int32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer large.Release()
+	assert.Equal(t, "[(null) [] [3 4 5]]", large.(*array.LargeList).String())
+	if err := array.ValidateFull(large); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := compute.Cast(mem, large, arrow.ListOf(arrow.PrimitiveTypes.Int32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Release()
+	assert.True(t, array.ArrayEqual(slice, list), "got=%v, want=%v", list, slice)
+
+	_, err = compute.Cast(mem, arr, arrow.LargeListOf(arrow.PrimitiveTypes.Int64))
+	assert.EqualError(t, err, "arrow/compute: unsupported cast from list<item: int32> to large_list<item: int64>")
+}
+
+func TestCastLargeListOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// the offsets are only checked: the values are never read.
+	values := array.NewData(arrow.PrimitiveTypes.Int8, 0, []*memory.Buffer{nil, nil}, nil, 0, 0)
+	defer values.Release()
+	offsets := memory.NewBufferBytes(arrow.Int64Traits.CastToBytes([]int64{0, 1, math.MaxInt32 + 1}))
+	data := array.NewData(arrow.LargeListOf(arrow.PrimitiveTypes.Int8), 2, []*memory.Buffer{nil, offsets}, []*array.Data{values}, 0, 0)
+	defer data.Release()
+	arr := array.MakeFromData(data)
+	defer arr.Release()
+
+	_, err := compute.Cast(mem, arr, arrow.ListOf(arrow.PrimitiveTypes.Int8))
+	assert.EqualError(t, err, "arrow/compute: could not cast row 1 from large_list<item: int8> to list<item: int8>: offset 2147483648 overflows int32")
+}
+
+func TestCastDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+	bldr := array.NewDictionaryBuilder(mem, dtype)
+	defer bldr.Release()
+	for _, v := range []string{"b", "a", "", "b"} {
+		if v == "" {
+			bldr.AppendNull()
+			continue
+		}
+		if err := bldr.AppendString(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		name string
+		arr  array.Interface
+		want string
+	}{
+		{"all", arr, `["b" "a" (null) "b"]`},
+		{"no-nulls", array.NewSlice(arr, 0, 2), `["b" "a"]`},
+		{"empty", array.NewSlice(arr, 1, 1), `[]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.arr != arr {
+				defer tc.arr.Release()
+			}
+			got, err := compute.Cast(mem, tc.arr, arrow.BinaryTypes.String)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+			assert.Equal(t, tc.want, got.(*array.String).String())
+			assert.Equal(t, tc.arr.NullN(), got.NullN())
+		})
+	}
+
+	_, err := compute.Cast(mem, arr, arrow.BinaryTypes.Binary)
+	assert.EqualError(t, err, "arrow/compute: unsupported cast from dictionary<values=utf8, indices=int8, ordered=false> to binary")
+}
@@ -0,0 +1,63 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"math/bits"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Coalesce returns an array holding, for each row, the first non-null value
+// found among arrs, or a null if all of them are null.
+//
+// All arrays must have the same length and data type.
+func Coalesce(mem memory.Allocator, arrs ...array.Interface) (array.Interface, error) {
+	if err := checkSameLayout(arrs...); err != nil {
+		return nil, err
+	}
+
+	var (
+		n     = arrs[0].Len()
+		src   = make([]int, n)
+		valid = validityWords(arrs[0])
+	)
+
+	for k := 1; k < len(arrs) && countSetBits(valid) < n; k++ {
+		vk := validityWords(arrs[k])
+		for w := range valid {
+			// rows still missing a value, that arrs[k] can fill.
+			fill := vk[w] &^ valid[w]
+			for fill != 0 {
+				src[w*64+bits.TrailingZeros64(fill)] = k
+				fill &= fill - 1
+			}
+			valid[w] |= vk[w]
+		}
+	}
+
+	return gather(mem, arrs, src, valid)
+}
+
+func countSetBits(words []uint64) int {
+	n := 0
+	for _, w := range words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
@@ -0,0 +1,226 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesce(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+
+	bld.AppendValues([]int64{1, 0, 0, 4, 0}, []bool{true, false, false, true, false})
+	a := bld.NewArray()
+	defer a.Release()
+
+	bld.AppendValues([]int64{10, 20, 0, 40, 0}, []bool{true, true, false, true, false})
+	b := bld.NewArray()
+	defer b.Release()
+
+	bld.AppendValues([]int64{100, 200, 300, 400, 0}, []bool{true, true, true, true, false})
+	c := bld.NewArray()
+	defer c.Release()
+
+	bld.AppendValues([]int64{1, 20, 300, 4, 0}, []bool{true, true, true, true, false})
+	want := bld.NewArray()
+	defer want.Release()
+
+	got, err := compute.Coalesce(mem, a, b, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	if !array.ArrayEqual(got, want) {
+		t.Fatalf("invalid result:\ngot= %v\nwant=%v", got, want)
+	}
+	assert.Equal(t, 1, got.NullN())
+}
+
+func TestCoalesceSliced(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const N = 150
+	bld := array.NewFloat64Builder(mem)
+	defer bld.Release()
+
+	for i := 0; i < N+3; i++ {
+		if i%3 == 0 {
+			bld.AppendNull()
+			continue
+		}
+		bld.Append(float64(i))
+	}
+	arr := bld.NewArray()
+	defer arr.Release()
+
+	a := array.NewSlice(arr, 3, N+3)
+	defer a.Release()
+
+	for i := 0; i < N; i++ {
+		bld.Append(-1)
+	}
+	b := bld.NewArray()
+	defer b.Release()
+
+	got, err := compute.Coalesce(mem, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	vs := got.(*array.Float64)
+	assert.Equal(t, 0, vs.NullN())
+	for i := 0; i < N; i++ {
+		want := float64(i + 3)
+		if (i+3)%3 == 0 {
+			want = -1
+		}
+		assert.Equal(t, want, vs.Value(i), "value %d", i)
+	}
+}
+
+func TestCoalesceTypes(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name string
+		dt   arrow.DataType
+		a, b func(array.Builder)
+		want func(array.Builder)
+	}{
+		{
+			name: "bool",
+			dt:   arrow.FixedWidthTypes.Boolean,
+			a: func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{true, false, false}, []bool{true, false, true})
+			},
+			b: func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{false, true, true}, nil)
+			},
+			want: func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{true, true, false}, nil)
+			},
+		},
+		{
+			name: "string",
+			dt:   arrow.BinaryTypes.String,
+			a: func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"a", "", "c"}, []bool{true, false, true})
+			},
+			b: func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"x", "y", ""}, []bool{true, true, false})
+			},
+			want: func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"a", "y", "c"}, nil)
+			},
+		},
+		{
+			name: "binary",
+			dt:   arrow.BinaryTypes.Binary,
+			a: func(b array.Builder) {
+				b.(*array.BinaryBuilder).AppendValues([][]byte{nil, []byte("b")}, []bool{false, true})
+			},
+			b: func(b array.Builder) {
+				b.(*array.BinaryBuilder).AppendValues([][]byte{nil, nil}, []bool{false, false})
+			},
+			want: func(b array.Builder) {
+				b.(*array.BinaryBuilder).AppendValues([][]byte{nil, []byte("b")}, []bool{false, true})
+			},
+		},
+		{
+			name: "fixed_size_binary",
+			dt:   &arrow.FixedSizeBinaryType{ByteWidth: 3},
+			a: func(b array.Builder) {
+				b.(*array.FixedSizeBinaryBuilder).AppendValues([][]byte{[]byte("abc"), nil}, []bool{true, false})
+			},
+			b: func(b array.Builder) {
+				b.(*array.FixedSizeBinaryBuilder).AppendValues([][]byte{[]byte("xyz"), []byte("uvw")}, nil)
+			},
+			want: func(b array.Builder) {
+				b.(*array.FixedSizeBinaryBuilder).AppendValues([][]byte{[]byte("abc"), []byte("uvw")}, nil)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			build := func(fct func(array.Builder)) array.Interface {
+				b := array.NewBuilder(mem, tc.dt)
+				defer b.Release()
+				fct(b)
+				return b.NewArray()
+			}
+
+			a := build(tc.a)
+			defer a.Release()
+			b := build(tc.b)
+			defer b.Release()
+			want := build(tc.want)
+			defer want.Release()
+
+			got, err := compute.Coalesce(mem, a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			if !array.ArrayEqual(got, want) {
+				t.Fatalf("invalid result:\ngot= %v\nwant=%v", got, want)
+			}
+		})
+	}
+}
+
+func TestCoalesceErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	_, err := compute.Coalesce(mem)
+	assert.Error(t, err)
+
+	i64 := array.NewInt64Builder(mem)
+	defer i64.Release()
+	i64.AppendValues([]int64{1, 2, 3}, nil)
+	a := i64.NewArray()
+	defer a.Release()
+	i64.AppendValues([]int64{1, 2}, nil)
+	b := i64.NewArray()
+	defer b.Release()
+
+	_, err = compute.Coalesce(mem, a, b)
+	assert.EqualError(t, err, "arrow/compute: length mismatch for array 1: got=2, want=3")
+
+	f64 := array.NewFloat64Builder(mem)
+	defer f64.Release()
+	f64.AppendValues([]float64{1, 2, 3}, nil)
+	c := f64.NewArray()
+	defer c.Release()
+
+	_, err = compute.Coalesce(mem, a, c)
+	assert.Error(t, err)
+}
@@ -0,0 +1,306 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// CompareOp is a comparison operator.
+type CompareOp int
+
+const (
+	Equal        CompareOp = iota // ==
+	NotEqual                      // !=
+	Less                          // <
+	LessEqual                     // <=
+	Greater                       // >
+	GreaterEqual                  // >=
+)
+
+func (op CompareOp) String() string {
+	switch op {
+	case Equal:
+		return "=="
+	case NotEqual:
+		return "!="
+	case Less:
+		return "<"
+	case LessEqual:
+		return "<="
+	case Greater:
+		return ">"
+	case GreaterEqual:
+		return ">="
+	default:
+		return "invalid"
+	}
+}
+
+// unordered is the result of a three-way comparison involving a NaN.
+const unordered = 2
+
+// eval returns the result of op, given the three-way comparison c of its
+// operands.
+func (op CompareOp) eval(c int) bool {
+	switch op {
+	case Equal:
+		return c == 0
+	case NotEqual:
+		return c != 0
+	case Less:
+		return c == -1
+	case LessEqual:
+		return c == -1 || c == 0
+	case Greater:
+		return c == +1
+	case GreaterEqual:
+		return c == +1 || c == 0
+	default:
+		return false
+	}
+}
+
+// CompareScalar compares each element of arr with the scalar s using op,
+// and returns a boolean array holding the results.
+//
+// By default, null elements of arr yield nulls, and comparing with a null
+// scalar yields an array of nulls.
+// With WithNullsEqual(true), nulls are equal to each other and different
+// from any valid value: the results are never null, and ordering comparisons
+// (Less, LessEqual, Greater, GreaterEqual) between a null and a valid value
+// are false.
+// Comparisons involving a NaN are always false, except for NotEqual.
+//
+// The data type of s must be the one of arr. Numeric, boolean, binary and
+// string data types are supported.
+func CompareScalar(mem memory.Allocator, op CompareOp, arr array.Interface, s Scalar, opts ...Option) (*array.Boolean, error) {
+	if op < Equal || op > GreaterEqual {
+		return nil, xerrors.Errorf("arrow/compute: invalid comparison operator %d", int(op))
+	}
+	if !arrow.TypeEqual(arr.DataType(), s.Type) {
+		return nil, xerrors.Errorf("arrow/compute: type mismatch: cannot compare %v array with %v scalar", arr.DataType(), s.Type)
+	}
+
+	var (
+		cfg    = newConfig(opts...)
+		n      = arr.Len()
+		valid  = validityWords(arr)
+		values = make([]uint64, len(valid))
+	)
+
+	if !s.IsValid() {
+		if !cfg.nullsEq {
+			return newBooleanFromWords(mem, n, make([]uint64, len(valid)), values), nil
+		}
+		// null elements are equal to s, valid ones are unordered with s.
+		for w := range valid {
+			if op.eval(0) {
+				values[w] |= ^valid[w]
+			}
+			if op.eval(unordered) {
+				values[w] |= valid[w]
+			}
+		}
+		return newBooleanFromWords(mem, n, allSet(n), clearTail(values, n)), nil
+	}
+
+	cmp, err := comparer(arr, s)
+	if err != nil {
+		return nil, err
+	}
+
+	forEachSetBit(valid, func(i int) {
+		if op.eval(cmp(i)) {
+			values[i/64] |= 1 << uint(i%64)
+		}
+	})
+	if !cfg.nullsEq {
+		return newBooleanFromWords(mem, n, valid, values), nil
+	}
+
+	// null elements are unordered with the valid scalar.
+	if op.eval(unordered) {
+		for w := range values {
+			values[w] |= ^valid[w]
+		}
+	}
+	return newBooleanFromWords(mem, n, allSet(n), clearTail(values, n)), nil
+}
+
+// comparer returns a function computing the three-way comparison of the
+// i-th element of arr with s.
+func comparer(arr array.Interface, s Scalar) (func(i int) int, error) {
+	invalid := xerrors.Errorf("arrow/compute: invalid value %v (%T) for %v scalar", s.Value, s.Value, s.Type)
+
+	if cls, ok := numericClassOf(arr.DataType()); ok {
+		switch cls {
+		case classSigned:
+			x, ok := int64Scalar(s.Value)
+			if !ok {
+				return nil, invalid
+			}
+			v := int64Values(arr)
+			return func(i int) int { return cmpInt64(v(i), x) }, nil
+		case classUnsigned:
+			x, ok := uint64Scalar(s.Value)
+			if !ok {
+				return nil, invalid
+			}
+			v := uint64Values(arr)
+			return func(i int) int { return cmpUint64(v(i), x) }, nil
+		default:
+			x, ok := float64Scalar(s.Value)
+			if !ok {
+				return nil, invalid
+			}
+			v := float64Values(arr)
+			return func(i int) int { return cmpFloat64(v(i), x) }, nil
+		}
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		x, ok := s.Value.(bool)
+		if !ok {
+			return nil, invalid
+		}
+		return func(i int) int { return cmpBool(arr.Value(i), x) }, nil
+	case *array.String:
+		x, ok := s.Value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		return func(i int) int { return strings.Compare(arr.Value(i), x) }, nil
+	case *array.Binary:
+		x, ok := s.Value.([]byte)
+		if !ok {
+			return nil, invalid
+		}
+		return func(i int) int { return bytes.Compare(arr.Value(i), x) }, nil
+	default:
+		return nil, xerrors.Errorf("arrow/compute: unsupported data type %v", arr.DataType())
+	}
+}
+
+func int64Scalar(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case arrow.Date32:
+		return int64(v), true
+	case arrow.Date64:
+		return int64(v), true
+	case arrow.Timestamp:
+		return int64(v), true
+	case arrow.Time32:
+		return int64(v), true
+	case arrow.Time64:
+		return int64(v), true
+	case arrow.Duration:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func uint64Scalar(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func float64Scalar(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float16.Num:
+		return float64(v.Float32()), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func cmpInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	default:
+		return 0
+	}
+}
+
+func cmpUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	default:
+		return 0
+	}
+}
+
+func cmpFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	case a == b:
+		return 0
+	default:
+		return unordered
+	}
+}
+
+func cmpBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	default:
+		return +1
+	}
+}
@@ -0,0 +1,165 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestCompareScalar(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt16Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int16{-2, 0, 0, 3, 5}, []bool{true, true, false, true, true})
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	ub := array.NewUint64Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint64{0, 1 << 63, math.MaxUint64}, nil)
+	uints := ub.NewArray()
+	defer uints.Release()
+
+	fb := array.NewFloat32Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float32{1.5, float32(math.NaN()), -1}, nil)
+	floats := fb.NewArray()
+	defer floats.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "b", "", "c"}, []bool{true, true, false, true})
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	bb := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	defer bb.Release()
+	bb.AppendValues([][]byte{[]byte("x"), nil, []byte("xy")}, nil)
+	bins := bb.NewArray()
+	defer bins.Release()
+
+	bools := newBools(mem, "tf.")
+	defer bools.Release()
+
+	i16 := arrow.PrimitiveTypes.Int16
+	for _, tc := range []struct {
+		name string
+		op   compute.CompareOp
+		arr  array.Interface
+		s    compute.Scalar
+		want string
+	}{
+		{"int-eq", compute.Equal, ints, compute.Scalar{Type: i16, Value: int16(0)}, "ft.ff"},
+		{"int-ne", compute.NotEqual, ints, compute.Scalar{Type: i16, Value: int16(0)}, "tf.tt"},
+		{"int-lt", compute.Less, ints, compute.Scalar{Type: i16, Value: int16(3)}, "tt.ff"},
+		{"int-le", compute.LessEqual, ints, compute.Scalar{Type: i16, Value: int16(3)}, "tt.tf"},
+		{"int-gt", compute.Greater, ints, compute.Scalar{Type: i16, Value: int16(0)}, "ff.tt"},
+		{"int-ge", compute.GreaterEqual, ints, compute.Scalar{Type: i16, Value: int16(0)}, "ft.tt"},
+		{"int-null", compute.Equal, ints, compute.Scalar{Type: i16}, "....."},
+		{"uint-gt", compute.Greater, uints, compute.Scalar{Type: arrow.PrimitiveTypes.Uint64, Value: uint64(1)}, "ftt"},
+		{"float-lt", compute.Less, floats, compute.Scalar{Type: arrow.PrimitiveTypes.Float32, Value: float32(2)}, "tft"},
+		{"float-ne", compute.NotEqual, floats, compute.Scalar{Type: arrow.PrimitiveTypes.Float32, Value: float32(1.5)}, "ftt"},
+		{"string-ge", compute.GreaterEqual, strs, compute.Scalar{Type: arrow.BinaryTypes.String, Value: "b"}, "ft.t"},
+		{"binary-eq", compute.Equal, bins, compute.Scalar{Type: arrow.BinaryTypes.Binary, Value: []byte("x")}, "tff"},
+		{"bool-lt", compute.Less, bools, compute.Scalar{Type: arrow.FixedWidthTypes.Boolean, Value: true}, "ft."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := compute.CompareScalar(mem, tc.op, tc.arr, tc.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+			assertBools(t, got, tc.want)
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		op   compute.CompareOp
+		s    compute.Scalar
+		err  string
+	}{
+		{"type-mismatch", compute.Equal, compute.Scalar{Type: arrow.PrimitiveTypes.Int32, Value: int32(0)}, "type mismatch"},
+		{"invalid-value", compute.Equal, compute.Scalar{Type: i16, Value: "0"}, "invalid value"},
+		{"invalid-op", compute.CompareOp(42), compute.Scalar{Type: i16, Value: int16(0)}, "invalid comparison operator"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := compute.CompareScalar(mem, tc.op, ints, tc.s)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestCompareScalarNullsEqual(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "", "b", "", "a"}, []bool{true, false, true, false, true})
+	arr := sb.NewArray()
+	defer arr.Release()
+
+	var (
+		str  = arrow.BinaryTypes.String
+		a    = compute.Scalar{Type: str, Value: "a"}
+		null = compute.Scalar{Type: str}
+	)
+	for _, tc := range []struct {
+		name    string
+		op      compute.CompareOp
+		s       compute.Scalar
+		sql     string
+		nullsEq string
+	}{
+		{"eq-valid", compute.Equal, a, "t.f.t", "tffft"},
+		{"ne-valid", compute.NotEqual, a, "f.t.f", "ftttf"},
+		{"le-valid", compute.LessEqual, a, "t.f.t", "tffft"},
+		{"gt-valid", compute.Greater, a, "f.t.f", "fftff"},
+		{"eq-null", compute.Equal, null, ".....", "ftftf"},
+		{"ne-null", compute.NotEqual, null, ".....", "tftft"},
+		{"ge-null", compute.GreaterEqual, null, ".....", "ftftf"},
+		{"lt-null", compute.Less, null, ".....", "fffff"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := compute.CompareScalar(mem, tc.op, arr, tc.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+			assertBools(t, got, tc.sql)
+
+			got, err = compute.CompareScalar(mem, tc.op, arr, tc.s, compute.WithNullsEqual(true))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+			assertBools(t, got, tc.nullsEq)
+		})
+	}
+}
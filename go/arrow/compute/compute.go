@@ -0,0 +1,296 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compute provides element-wise kernels operating on Arrow arrays.
+//
+// Kernels never modify their inputs. Arrays they return are allocated with
+// the provided memory.Allocator and must be Release()'d after use.
+//
+// Kernels returning records, such as FilterRecord, keep the rows and columns
+// structure of their input: the returned records share the *arrow.Schema of
+// the input record, along with its metadata and the nullability and metadata
+// of its fields.
+package compute // import "github.com/apache/arrow/go/arrow/compute"
+
+import (
+	"math/bits"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// NullHandling specifies how element-wise kernels treat null inputs.
+type NullHandling int
+
+const (
+	// NullLoses ignores null inputs: a result is null only when all the
+	// corresponding inputs are null.
+	NullLoses NullHandling = iota
+	// NullWins propagates null inputs: a result is null as soon as one of
+	// the corresponding inputs is null.
+	NullWins
+)
+
+type config struct {
+	nulls      NullHandling
+	nullsEq    bool
+	saturate   bool
+	rawFloats  bool // hash floating point numbers by their exact bit pattern.
+	fixedIndex bool // never widen the index type of unified dictionaries.
+}
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{nulls: NullLoses}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// Option is a functional option to configure kernels.
+type Option func(*config)
+
+// WithNullHandling specifies how null inputs are handled by element-wise kernels.
+// The default is NullLoses.
+func WithNullHandling(v NullHandling) Option {
+	return func(cfg *config) {
+		cfg.nulls = v
+	}
+}
+
+// WithNullsEqual specifies whether comparison and hash kernels consider nulls
+// as equal to each other.
+//
+// The default is false: as in SQL, comparing a null yields a null, and
+// nulls have null hashes.
+// When v is true, a null is equal to another null and different from any
+// valid value, comparisons never yield nulls and all nulls have the same hash.
+func WithNullsEqual(v bool) Option {
+	return func(cfg *config) {
+		cfg.nullsEq = v
+	}
+}
+
+// WithSaturation specifies whether Cast clamps values overflowing the
+// range of the target data type instead of returning an error.
+// The default is false.
+func WithSaturation(v bool) Option {
+	return func(cfg *config) {
+		cfg.saturate = v
+	}
+}
+
+// WithFloatCanonicalization specifies whether hash kernels canonicalize
+// floating point numbers before hashing them: all NaNs, whatever their sign
+// and payload, then have the same hash, and so do negative and positive
+// zeros.
+//
+// The default is true, so that hash-based grouping and deduplication yield a
+// single entry for all NaNs, and one for all zeros.
+// When v is false, floating point numbers are hashed by their exact bit
+// pattern: NaNs with different payloads, as well as -0 and +0, have
+// different hashes.
+func WithFloatCanonicalization(v bool) Option {
+	return func(cfg *config) {
+		cfg.rawFloats = !v
+	}
+}
+
+// WithIndexUpcast specifies whether UnifyDictionaries widens the index type
+// of the dictionary-encoded arrays it returns when their unified dictionary
+// outgrows the index type of its inputs, rather than returning an error.
+// The default is true.
+func WithIndexUpcast(v bool) Option {
+	return func(cfg *config) {
+		cfg.fixedIndex = !v
+	}
+}
+
+// checkSameLayout returns an error if the arrays do not all have the same
+// length and data type.
+func checkSameLayout(arrs ...array.Interface) error {
+	if len(arrs) == 0 {
+		return xerrors.Errorf("arrow/compute: no input arrays")
+	}
+	ref := arrs[0]
+	for i, arr := range arrs[1:] {
+		if got, want := arr.Len(), ref.Len(); got != want {
+			return xerrors.Errorf("arrow/compute: length mismatch for array %d: got=%d, want=%d", i+1, got, want)
+		}
+		if !arrow.TypeEqual(arr.DataType(), ref.DataType()) {
+			return xerrors.Errorf("arrow/compute: type mismatch for array %d: got=%v, want=%v", i+1, arr.DataType(), ref.DataType())
+		}
+	}
+	return nil
+}
+
+// validityWords returns the validity bitmap of arr, starting at its first
+// element, packed in 64-bit words.
+// Bits past the length of arr are always cleared.
+func validityWords(arr array.Interface) []uint64 {
+	var (
+		n     = arr.Len()
+		words = make([]uint64, (n+63)/64)
+	)
+	switch {
+	case n == 0:
+		return words
+	case arr.NullN() == 0:
+		for i := range words {
+			words[i] = ^uint64(0)
+		}
+	case arr.NullN() == n || len(arr.NullBitmapBytes()) == 0:
+		return words
+	default:
+		return bitmapWords(arr.NullBitmapBytes(), arr.Data().Offset(), n)
+	}
+
+	if r := n % 64; r != 0 {
+		words[len(words)-1] &= (1 << uint(r)) - 1
+	}
+	return words
+}
+
+// bitmapWords returns the n bits of bitmap starting at offset, packed in
+// 64-bit words.
+// Bits past n are always cleared.
+func bitmapWords(bitmap []byte, offset, n int) []uint64 {
+	words := make([]uint64, (n+63)/64)
+	if offset%8 == 0 {
+		bytes := bitmap[offset/8 : offset/8+int(bitutil.BytesForBits(int64(n)))]
+		for i, b := range bytes {
+			words[i/8] |= uint64(b) << (8 * uint(i%8))
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if bitutil.BitIsSet(bitmap, offset+i) {
+				words[i/64] |= 1 << uint(i%64)
+			}
+		}
+	}
+
+	if r := n % 64; r != 0 {
+		words[len(words)-1] &= (1 << uint(r)) - 1
+	}
+	return words
+}
+
+// forEachSetBit calls fn with the index of each bit set in words.
+func forEachSetBit(words []uint64, fn func(i int)) {
+	for w, word := range words {
+		for word != 0 {
+			fn(w*64 + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+// gather creates a new array of the same type as arrs, taking the i-th value
+// from arrs[src[i]] when the i-th bit of valid is set, and a null otherwise.
+func gather(mem memory.Allocator, arrs []array.Interface, src []int, valid []uint64) (array.Interface, error) {
+	var (
+		dtype = arrs[0].DataType()
+		n     = arrs[0].Len()
+	)
+
+	if dtype.ID() == arrow.NULL {
+		return array.NewNull(n), nil
+	}
+
+	bitmap := memory.NewResizableBuffer(mem)
+	defer bitmap.Release()
+	bitmap.Resize(int(bitutil.BytesForBits(int64(n))))
+	memory.Set(bitmap.Bytes(), 0)
+
+	nulls := n
+	forEachSetBit(valid, func(i int) {
+		bitutil.SetBit(bitmap.Bytes(), i)
+		nulls--
+	})
+
+	switch dt := dtype.(type) {
+	case *arrow.BooleanType:
+		values := memory.NewResizableBuffer(mem)
+		defer values.Release()
+		values.Resize(int(bitutil.BytesForBits(int64(n))))
+		memory.Set(values.Bytes(), 0)
+
+		forEachSetBit(valid, func(i int) {
+			if arrs[src[i]].(*array.Boolean).Value(i) {
+				bitutil.SetBit(values.Bytes(), i)
+			}
+		})
+		return makeArray(dtype, n, []*memory.Buffer{bitmap, values}, nulls), nil
+
+	case arrow.FixedWidthDataType:
+		width := dt.BitWidth() / 8
+		values := memory.NewResizableBuffer(mem)
+		defer values.Release()
+		values.Resize(n * width)
+		memory.Set(values.Bytes(), 0)
+
+		raw := make([][]byte, len(arrs))
+		for k, arr := range arrs {
+			if buf := arr.Data().Buffers()[1]; buf != nil {
+				raw[k] = buf.Bytes()[arr.Data().Offset()*width:]
+			}
+		}
+
+		out := values.Bytes()
+		forEachSetBit(valid, func(i int) {
+			copy(out[i*width:(i+1)*width], raw[src[i]][i*width:(i+1)*width])
+		})
+		return makeArray(dtype, n, []*memory.Buffer{bitmap, values}, nulls), nil
+
+	case *arrow.BinaryType:
+		bldr := array.NewBinaryBuilder(mem, dt)
+		defer bldr.Release()
+		bldr.Reserve(n)
+		for i := 0; i < n; i++ {
+			if bitutil.BitIsNotSet(bitmap.Bytes(), i) {
+				bldr.AppendNull()
+				continue
+			}
+			bldr.Append(arrs[src[i]].(*array.Binary).Value(i))
+		}
+		return bldr.NewArray(), nil
+
+	case *arrow.StringType:
+		bldr := array.NewStringBuilder(mem)
+		defer bldr.Release()
+		bldr.Reserve(n)
+		for i := 0; i < n; i++ {
+			if bitutil.BitIsNotSet(bitmap.Bytes(), i) {
+				bldr.AppendNull()
+				continue
+			}
+			bldr.Append(arrs[src[i]].(*array.String).Value(i))
+		}
+		return bldr.NewArray(), nil
+
+	default:
+		return nil, xerrors.Errorf("arrow/compute: unsupported data type %v", dtype)
+	}
+}
+
+func makeArray(dtype arrow.DataType, n int, buffers []*memory.Buffer, nulls int) array.Interface {
+	data := array.NewData(dtype, n, buffers, nil, nulls, 0)
+	defer data.Release()
+	return array.MakeFromData(data)
+}
@@ -0,0 +1,174 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// UnifyDictionaries returns the chunks of a dictionary-encoded array,
+// re-encoded with a single dictionary holding the distinct values of all
+// the dictionaries of chunks: the returned arrays share their dictionary,
+// and their indices can be compared and concatenated.
+//
+// chunks must all be Dictionary arrays of the same data type. When the
+// unified dictionary outgrows the index type of chunks, the returned arrays
+// have the next wider index type that can address it, unless
+// WithIndexUpcast(false) is given, in which case an error is returned.
+func UnifyDictionaries(mem memory.Allocator, chunks []array.Interface, opts ...Option) ([]array.Interface, error) {
+	if len(chunks) == 0 {
+		return nil, nil
+	}
+	dtype, ok := chunks[0].DataType().(*arrow.DictionaryType)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: cannot unify dictionaries of %v arrays", chunks[0].DataType())
+	}
+	for _, chunk := range chunks[1:] {
+		if !arrow.TypeEqual(chunk.DataType(), dtype) {
+			return nil, xerrors.Errorf("arrow/compute: cannot unify dictionaries of %v and %v arrays", dtype, chunk.DataType())
+		}
+	}
+	cfg := newConfig(opts...)
+
+	// the translation of the indices of each chunk to the unified
+	// dictionary is the slice of the indices of the unified dictionary
+	// builder where the dictionary of the chunk was appended, followed by
+	// the index of null elements.
+	var (
+		bldr  = array.NewDictionaryBuilder(mem, dtype)
+		bases = make([]int, len(chunks))
+	)
+	defer bldr.Release()
+	for k, chunk := range chunks {
+		bases[k] = bldr.Len()
+		appendDictionary(mem, bldr, chunk.(*array.Dictionary).Dictionary())
+	}
+	nullIndex := bldr.Len()
+	bldr.AppendNull()
+
+	unified := bldr.NewDictionaryArray()
+	defer unified.Release()
+
+	if cfg.fixedIndex && !arrow.TypeEqual(unified.DataType(), dtype) {
+		return nil, xerrors.Errorf(
+			"arrow/compute: unified dictionary of %d values overflows %v indices",
+			unified.Dictionary().Len(), dtype.IndexType,
+		)
+	}
+	utype := unified.DataType().(*arrow.DictionaryType)
+
+	out := make([]array.Interface, 0, len(chunks))
+	for k, chunk := range chunks {
+		var (
+			src = chunk.(*array.Dictionary)
+			idx = make([]int, src.Len())
+		)
+		for i := range idx {
+			if src.IsNull(i) {
+				idx[i] = nullIndex
+				continue
+			}
+			idx[i] = bases[k] + src.GetValueIndex(i)
+		}
+		indices, err := take(mem, unified.Indices(), idx)
+		if err != nil {
+			for _, arr := range out {
+				arr.Release()
+			}
+			return nil, err
+		}
+		out = append(out, array.NewDictionaryArray(utype, indices, unified.Dictionary()))
+		indices.Release()
+	}
+	return out, nil
+}
+
+// appendDictionary appends the values of dict to bldr, in order.
+func appendDictionary(mem memory.Allocator, bldr *array.DictionaryBuilder, dict array.Interface) {
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.Reserve(dict.Len())
+	for i := 0; i < dict.Len(); i++ {
+		ib.UnsafeAppend(int64(i))
+	}
+	indices := ib.NewArray()
+	defer indices.Release()
+
+	dtype := &arrow.DictionaryType{IndexType: indices.DataType(), ValueType: dict.DataType()}
+	arr := array.NewDictionaryArray(dtype, indices, dict)
+	defer arr.Release()
+
+	bldr.AppendArray(arr, 0, arr.Len())
+}
+
+// UnifyTableDictionaries returns a table holding the columns of tbl, where
+// the chunks of each dictionary-encoded column share a single dictionary,
+// as unified by UnifyDictionaries.
+//
+// The fields of dictionary-encoded columns whose indices were widened have
+// the wider index type in the schema of the returned table. The returned
+// table keeps the metadata of the schema of tbl, and the nullability and
+// metadata of its fields.
+// The returned table must be Release()'d after use.
+func UnifyTableDictionaries(mem memory.Allocator, tbl array.Table, opts ...Option) (array.Table, error) {
+	var (
+		schema  = tbl.Schema()
+		fields  = make([]arrow.Field, 0, tbl.NumCols())
+		cols    = make([]array.Column, 0, tbl.NumCols())
+		changed = false
+	)
+	defer func() {
+		for i := range cols {
+			cols[i].Release()
+		}
+	}()
+
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		col := tbl.Column(i)
+		field := col.Field()
+		if field.Type.ID() != arrow.DICTIONARY {
+			fields = append(fields, field)
+			cols = append(cols, *array.NewColumn(field, col.Data()))
+			continue
+		}
+
+		chunks, err := UnifyDictionaries(mem, col.Data().Chunks(), opts...)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/compute: could not unify dictionaries of column %q: %w", field.Name, err)
+		}
+		if len(chunks) > 0 && !arrow.TypeEqual(chunks[0].DataType(), field.Type) {
+			field.Type = chunks[0].DataType()
+			changed = true
+		}
+		data := array.NewChunked(field.Type, chunks)
+		for _, chunk := range chunks {
+			chunk.Release()
+		}
+		fields = append(fields, field)
+		cols = append(cols, *array.NewColumn(field, data))
+		data.Release()
+	}
+
+	if changed {
+		md := schema.Metadata()
+		schema = arrow.NewSchema(fields, &md)
+	}
+	return array.NewTable(schema, cols, tbl.NumRows()), nil
+}
@@ -0,0 +1,232 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDictionary returns a dictionary-encoded array of the values, where
+// empty strings are nulls.
+func newDictionary(mem memory.Allocator, dtype *arrow.DictionaryType, vs ...string) *array.Dictionary {
+	b := array.NewDictionaryBuilder(mem, dtype, array.WithFixedIndexType(true))
+	defer b.Release()
+	for _, v := range vs {
+		if v == "" {
+			b.AppendNull()
+			continue
+		}
+		if err := b.AppendValueFromString(v); err != nil {
+			panic(err)
+		}
+	}
+	return b.NewDictionaryArray()
+}
+
+// distinctValues returns n distinct values, starting at first.
+func distinctValues(first, n int) []string {
+	vs := make([]string, n)
+	for i := range vs {
+		vs[i] = fmt.Sprint(first + i)
+	}
+	return vs
+}
+
+func TestUnifyDictionaries(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+	a := newDictionary(mem, dtype, "a", "b", "", "a")
+	defer a.Release()
+	b := newDictionary(mem, dtype, "c", "x", "b", "", "c")
+	defer b.Release()
+	slice := array.NewSlice(b, 2, 5)
+	defer slice.Release()
+
+	chunks := []array.Interface{a, slice, b}
+	got, err := compute.UnifyDictionaries(mem, chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		for _, arr := range got {
+			arr.Release()
+		}
+	}()
+
+	assert.Len(t, got, len(chunks))
+	for k, arr := range got {
+		dict := arr.(*array.Dictionary)
+		assert.True(t, arrow.TypeEqual(dtype, arr.DataType()), "chunk %d: type=%v", k, arr.DataType())
+		assert.Equal(t, `["a" "b" "c" "x"]`, fmt.Sprint(dict.Dictionary()), "chunk %d", k)
+		assert.Equal(t, chunks[k].Len(), arr.Len(), "chunk %d", k)
+		assert.Equal(t, chunks[k].NullN(), arr.NullN(), "chunk %d", k)
+		for i := 0; i < arr.Len(); i++ {
+			assert.Equal(t, array.ValueToString(chunks[k], i), array.ValueToString(arr, i), "chunk %d, element %d", k, i)
+		}
+		if err := array.ValidateFull(arr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, "[0 1 (null) 0]", fmt.Sprint(got[0].(*array.Dictionary).Indices()))
+	assert.Equal(t, "[1 (null) 2]", fmt.Sprint(got[1].(*array.Dictionary).Indices()))
+	assert.Equal(t, "[2 3 1 (null) 2]", fmt.Sprint(got[2].(*array.Dictionary).Indices()))
+
+	none, err := compute.UnifyDictionaries(mem, nil)
+	assert.NoError(t, err)
+	assert.Len(t, none, 0)
+}
+
+func TestUnifyDictionariesIndexOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.PrimitiveTypes.Int32}
+	a := newDictionary(mem, dtype, distinctValues(0, 100)...)
+	defer a.Release()
+
+	for _, tc := range []struct {
+		distinct int // number of values of the unified dictionary
+		upcast   bool
+		want     arrow.DataType
+		err      string
+	}{
+		{128, true, arrow.PrimitiveTypes.Int8, ""},
+		{128, false, arrow.PrimitiveTypes.Int8, ""},
+		{129, true, arrow.PrimitiveTypes.Int16, ""},
+		{129, false, nil, "arrow/compute: unified dictionary of 129 values overflows int8 indices"},
+	} {
+		t.Run(fmt.Sprintf("%d-upcast=%v", tc.distinct, tc.upcast), func(t *testing.T) {
+			// the values of b overlap the ones of a.
+			b := newDictionary(mem, dtype, distinctValues(tc.distinct-100, 100)...)
+			defer b.Release()
+
+			got, err := compute.UnifyDictionaries(mem, []array.Interface{a, b}, compute.WithIndexUpcast(tc.upcast))
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, got)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				for _, arr := range got {
+					arr.Release()
+				}
+			}()
+
+			for k, arr := range got {
+				dict := arr.(*array.Dictionary)
+				assert.True(t, arrow.TypeEqual(tc.want, dict.Indices().DataType()), "chunk %d: type=%v", k, arr.DataType())
+				assert.Equal(t, tc.distinct, dict.Dictionary().Len())
+			}
+			assert.Equal(t, 99, got[0].(*array.Dictionary).GetValueIndex(99))
+			assert.Equal(t, tc.distinct-1, got[1].(*array.Dictionary).GetValueIndex(99))
+			assert.Equal(t, fmt.Sprint(tc.distinct-1), array.ValueToString(got[1], 99))
+		})
+	}
+}
+
+func TestUnifyDictionariesInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	a := newDictionary(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}, "a")
+	defer a.Release()
+	b := newDictionary(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.BinaryTypes.String}, "a")
+	defer b.Release()
+
+	_, err := compute.UnifyDictionaries(mem, []array.Interface{a, b})
+	assert.EqualError(t, err, "arrow/compute: cannot unify dictionaries of dictionary<values=utf8, indices=int8, ordered=false> and dictionary<values=utf8, indices=int16, ordered=false> arrays")
+
+	_, err = compute.UnifyDictionaries(mem, []array.Interface{a.Dictionary()})
+	assert.EqualError(t, err, "arrow/compute: cannot unify dictionaries of utf8 arrays")
+}
+
+func TestUnifyTableDictionaries(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.PrimitiveTypes.Int32}
+	md := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "n", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "d", Type: dtype, Nullable: true, Metadata: md},
+	}, &md)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+
+	var recs []array.Record
+	for _, first := range []int{0, 100} {
+		for i := 0; i < 100; i++ {
+			ib.Append(int64(first + i))
+		}
+		n := ib.NewArray()
+		d := newDictionary(mem, dtype, distinctValues(first, 100)...)
+		recs = append(recs, array.NewRecord(schema, []array.Interface{n, d}, 100))
+		n.Release()
+		d.Release()
+	}
+	tbl := array.NewTableFromRecords(schema, recs)
+	defer tbl.Release()
+	for _, rec := range recs {
+		rec.Release()
+	}
+
+	_, err := compute.UnifyTableDictionaries(mem, tbl, compute.WithIndexUpcast(false))
+	assert.EqualError(t, err, `arrow/compute: could not unify dictionaries of column "d": arrow/compute: unified dictionary of 200 values overflows int8 indices`)
+
+	got, err := compute.UnifyTableDictionaries(mem, tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, tbl.NumRows(), got.NumRows())
+	assert.Equal(t, md, got.Schema().Metadata())
+	assert.Equal(t, schema.Field(0), got.Schema().Field(0))
+
+	field := got.Schema().Field(1)
+	assert.Equal(t, "d", field.Name)
+	assert.True(t, field.Nullable)
+	assert.Equal(t, md, field.Metadata)
+	assert.True(t, arrow.TypeEqual(
+		&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.PrimitiveTypes.Int32},
+		field.Type,
+	), "type=%v", field.Type)
+
+	chunks := got.Column(1).Data().Chunks()
+	assert.Len(t, chunks, 2)
+	for k, chunk := range chunks {
+		assert.Equal(t, 200, chunk.(*array.Dictionary).Dictionary().Len())
+		for _, i := range []int{0, 99} {
+			assert.Equal(t, fmt.Sprint(100*k+i), array.ValueToString(chunk, i))
+			assert.Equal(t, 100*k+i, chunk.(*array.Dictionary).GetValueIndex(i))
+		}
+	}
+	assert.True(t, tbl.Column(0).Data() == got.Column(0).Data(), "column n is copied")
+}
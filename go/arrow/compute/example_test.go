@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func ExampleFilterRecord() {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "city", Type: arrow.BinaryTypes.String},
+		{Name: "temp", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.StringBuilder).AppendValues([]string{"Paris", "Oslo", "Rome", "Lima"}, nil)
+	b.Field(1).(*array.Float64Builder).AppendValues([]float64{21.5, 12, 0, 18}, []bool{true, true, false, true})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	// Keep the rows whose temperature is at least 15 degrees.
+	// Null temperatures yield null in the mask, and are dropped.
+	mask, err := compute.CompareScalar(mem, compute.GreaterEqual, rec.Column(1), compute.Scalar{
+		Type:  arrow.PrimitiveTypes.Float64,
+		Value: 15.0,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer mask.Release()
+
+	out, err := compute.FilterRecord(mem, rec, mask)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Release()
+
+	for i, col := range out.Columns() {
+		fmt.Printf("%s: %v\n", out.ColumnName(i), col)
+	}
+
+	// Output:
+	// city: ["Paris" "Lima"]
+	// temp: [21.5 18]
+}
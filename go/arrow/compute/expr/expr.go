@@ -0,0 +1,473 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expr implements a small language of boolean expressions over the
+// columns of a record, to filter its rows.
+//
+// An expression combines comparisons of columns with literals using the
+// && (and), || (or) and ! (not) operators and parentheses:
+//
+//  int32s > 10 && strings != null
+//  !(float64s < 0.5 || bools == true) && names == "arrow"
+//
+// Comparison operators are ==, !=, <, <=, > and >=. Literals are integers,
+// floating point numbers, double-quoted strings, true, false and null.
+// A literal may appear on either side of a comparison, and a boolean column
+// may be used on its own as a condition.
+// Columns are referred to by name; names which are not identifiers, or
+// which clash with a keyword, must be enclosed in backquotes.
+//
+// Comparing a column with null tests whether its values are null (with ==)
+// or valid (with !=). Other comparisons involving a null value are null,
+// and && and || follow Kleene logic: rows for which the expression is null
+// are filtered out.
+package expr // import "github.com/apache/arrow/go/arrow/compute/expr"
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Expr is a compiled expression, bound to a schema.
+type Expr struct {
+	src    string
+	schema *arrow.Schema
+	root   node
+}
+
+// Compile parses src and checks it against schema.
+//
+// Compile returns an error if src is malformed, if it refers to a column
+// missing from schema or if a column is compared with a literal of an
+// incompatible type.
+func Compile(schema *arrow.Schema, src string) (*Expr, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks, schema: schema}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, p.unexpected(tok)
+	}
+	return &Expr{src: src, schema: schema, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string { return e.src }
+
+// Schema returns the schema the expression was compiled against.
+func (e *Expr) Schema() *arrow.Schema { return e.schema }
+
+// Eval evaluates the expression on the rows of rec, and returns a boolean
+// array holding the results.
+//
+// The schema of rec must be the one the expression was compiled against.
+func (e *Expr) Eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	if !rec.Schema().Equal(e.schema) {
+		return nil, xerrors.Errorf("arrow/compute/expr: record schema does not match the schema of the expression")
+	}
+	return e.root.eval(mem, rec)
+}
+
+// Filter returns a new record holding the rows of rec for which the
+// expression is true.
+//
+// The schema of rec must be the one the expression was compiled against.
+func (e *Expr) Filter(mem memory.Allocator, rec array.Record) (array.Record, error) {
+	mask, err := e.Eval(mem, rec)
+	if err != nil {
+		return nil, err
+	}
+	defer mask.Release()
+	return compute.FilterRecord(mem, rec, mask)
+}
+
+type node interface {
+	eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error)
+}
+
+// cmpNode compares a column with a literal.
+type cmpNode struct {
+	col int
+	op  compute.CompareOp
+	lit compute.Scalar
+}
+
+func (n *cmpNode) eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	return compute.CompareScalar(mem, n.op, rec.Column(n.col), n.lit)
+}
+
+// nullNode checks whether the values of a column are null or valid.
+type nullNode struct {
+	col    int
+	isNull bool
+}
+
+func (n *nullNode) eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	if n.isNull {
+		return compute.IsNull(mem, rec.Column(n.col)), nil
+	}
+	return compute.IsValid(mem, rec.Column(n.col)), nil
+}
+
+// colNode uses the values of a boolean column as a condition.
+type colNode struct {
+	col int
+}
+
+func (n *colNode) eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	arr := rec.Column(n.col).(*array.Boolean)
+	arr.Retain()
+	return arr, nil
+}
+
+type notNode struct {
+	x node
+}
+
+func (n *notNode) eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	x, err := n.x.eval(mem, rec)
+	if err != nil {
+		return nil, err
+	}
+	defer x.Release()
+	return compute.Not(mem, x), nil
+}
+
+// logicNode combines two conditions with && or ||.
+type logicNode struct {
+	and  bool
+	l, r node
+}
+
+func (n *logicNode) eval(mem memory.Allocator, rec array.Record) (*array.Boolean, error) {
+	l, err := n.l.eval(mem, rec)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Release()
+
+	r, err := n.r.eval(mem, rec)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Release()
+
+	if n.and {
+		return compute.And(mem, l, r)
+	}
+	return compute.Or(mem, l, r)
+}
+
+// parser is a recursive descent parser for the grammar:
+//
+//  or      = and { "||" and } .
+//  and     = unary { "&&" unary } .
+//  unary   = "!" unary | "(" or ")" | operand [ cmpop operand ] .
+//  operand = column | literal .
+type parser struct {
+	toks   []token
+	pos    int
+	schema *arrow.Schema
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	tok := p.toks[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) unexpected(tok token) error {
+	return xerrors.Errorf("arrow/compute/expr: syntax error at offset %d: unexpected %v", tok.pos, tok)
+}
+
+func (p *parser) parseOr() (node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &logicNode{and: false, l: x, r: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokAnd {
+		p.next()
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = &logicNode{and: true, l: x, r: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch tok := p.peek(); tok.kind {
+	case tokNot:
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x: x}, nil
+
+	case tokLParen:
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != tokRParen {
+			return nil, p.unexpected(tok)
+		}
+		return x, nil
+	}
+
+	lhs, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokOp {
+		if lhs.kind != tokIdent {
+			return nil, xerrors.Errorf("arrow/compute/expr: literal %v at offset %d is not a condition", lhs, lhs.pos)
+		}
+		col, err := p.column(lhs)
+		if err != nil {
+			return nil, err
+		}
+		if dtype := p.schema.Field(col).Type; dtype.ID() != arrow.BOOL {
+			return nil, xerrors.Errorf("arrow/compute/expr: column %q (%v) is not a boolean condition", lhs.text, dtype)
+		}
+		return &colNode{col: col}, nil
+	}
+
+	op := p.next()
+	rhs, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return p.comparison(lhs, op, rhs)
+}
+
+func (p *parser) parseOperand() (token, error) {
+	switch tok := p.next(); tok.kind {
+	case tokIdent, tokInt, tokFloat, tokString, tokTrue, tokFalse, tokNull:
+		return tok, nil
+	default:
+		return tok, p.unexpected(tok)
+	}
+}
+
+// column returns the index of the column named by tok.
+func (p *parser) column(tok token) (int, error) {
+	switch idx := p.schema.FieldIndices(tok.text); len(idx) {
+	case 0:
+		return 0, xerrors.Errorf("arrow/compute/expr: unknown column %q at offset %d", tok.text, tok.pos)
+	case 1:
+		return idx[0], nil
+	default:
+		return 0, xerrors.Errorf("arrow/compute/expr: ambiguous column %q at offset %d", tok.text, tok.pos)
+	}
+}
+
+// comparison returns the node comparing a column with a literal.
+func (p *parser) comparison(lhs, op, rhs token) (node, error) {
+	cmp := cmpOps[op.text]
+	switch {
+	case lhs.kind == tokIdent && rhs.kind == tokIdent:
+		return nil, xerrors.Errorf("arrow/compute/expr: cannot compare column %q with column %q: only comparisons with literals are supported", lhs.text, rhs.text)
+	case lhs.kind != tokIdent && rhs.kind != tokIdent:
+		return nil, xerrors.Errorf("arrow/compute/expr: comparison at offset %d does not involve a column", lhs.pos)
+	case lhs.kind != tokIdent:
+		// literal op column: swap the operands.
+		lhs, rhs = rhs, lhs
+		cmp = swappedOps[cmp]
+	}
+
+	col, err := p.column(lhs)
+	if err != nil {
+		return nil, err
+	}
+
+	if rhs.kind == tokNull {
+		switch cmp {
+		case compute.Equal:
+			return &nullNode{col: col, isNull: true}, nil
+		case compute.NotEqual:
+			return &nullNode{col: col, isNull: false}, nil
+		default:
+			return nil, xerrors.Errorf("arrow/compute/expr: column %q can only be compared with null using == or !=", lhs.text)
+		}
+	}
+
+	dtype := p.schema.Field(col).Type
+	lit, err := literal(dtype, rhs)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/compute/expr: invalid comparison of column %q (%v) with %v: %w", lhs.text, dtype, rhs, err)
+	}
+	return &cmpNode{col: col, op: cmp, lit: lit}, nil
+}
+
+var (
+	cmpOps = map[string]compute.CompareOp{
+		"==": compute.Equal,
+		"!=": compute.NotEqual,
+		"<":  compute.Less,
+		"<=": compute.LessEqual,
+		">":  compute.Greater,
+		">=": compute.GreaterEqual,
+	}
+
+	// swappedOps maps an operator to the one giving the same result when
+	// its operands are swapped.
+	swappedOps = map[compute.CompareOp]compute.CompareOp{
+		compute.Equal:        compute.Equal,
+		compute.NotEqual:     compute.NotEqual,
+		compute.Less:         compute.Greater,
+		compute.LessEqual:    compute.GreaterEqual,
+		compute.Greater:      compute.Less,
+		compute.GreaterEqual: compute.LessEqual,
+	}
+)
+
+// literal converts tok into a scalar of the given data type.
+func literal(dtype arrow.DataType, tok token) (compute.Scalar, error) {
+	var (
+		v   interface{}
+		err error
+	)
+	switch dtype.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		v, err = intLiteral(dtype, tok)
+	case arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		v, err = uintLiteral(dtype, tok)
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		v, err = floatLiteral(dtype, tok)
+	case arrow.BOOL:
+		switch tok.kind {
+		case tokTrue:
+			v = true
+		case tokFalse:
+			v = false
+		default:
+			err = xerrors.New("a boolean literal is required")
+		}
+	case arrow.STRING:
+		if tok.kind != tokString {
+			return compute.Scalar{}, xerrors.New("a string literal is required")
+		}
+		v = tok.text
+	case arrow.BINARY:
+		if tok.kind != tokString {
+			return compute.Scalar{}, xerrors.New("a string literal is required")
+		}
+		v = []byte(tok.text)
+	default:
+		return compute.Scalar{}, xerrors.New("unsupported column type")
+	}
+	if err != nil {
+		return compute.Scalar{}, err
+	}
+	return compute.Scalar{Type: dtype, Value: v}, nil
+}
+
+func intLiteral(dtype arrow.DataType, tok token) (interface{}, error) {
+	if tok.kind != tokInt {
+		return nil, xerrors.New("an integer literal is required")
+	}
+	bitSize := dtype.(arrow.FixedWidthDataType).BitWidth()
+	v, err := strconv.ParseInt(tok.text, 10, bitSize)
+	if err != nil {
+		return nil, xerrors.Errorf("value out of range")
+	}
+	switch bitSize {
+	case 8:
+		return int8(v), nil
+	case 16:
+		return int16(v), nil
+	case 32:
+		return int32(v), nil
+	default:
+		return v, nil
+	}
+}
+
+func uintLiteral(dtype arrow.DataType, tok token) (interface{}, error) {
+	if tok.kind != tokInt {
+		return nil, xerrors.New("an integer literal is required")
+	}
+	bitSize := dtype.(arrow.FixedWidthDataType).BitWidth()
+	v, err := strconv.ParseUint(tok.text, 10, bitSize)
+	if err != nil {
+		return nil, xerrors.Errorf("value out of range")
+	}
+	switch bitSize {
+	case 8:
+		return uint8(v), nil
+	case 16:
+		return uint16(v), nil
+	case 32:
+		return uint32(v), nil
+	default:
+		return v, nil
+	}
+}
+
+func floatLiteral(dtype arrow.DataType, tok token) (interface{}, error) {
+	if tok.kind != tokInt && tok.kind != tokFloat {
+		return nil, xerrors.New("a numeric literal is required")
+	}
+	v, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil && !math.IsInf(v, 0) {
+		return nil, err
+	}
+	switch dtype.ID() {
+	case arrow.FLOAT16:
+		return float16.New(float32(v)), nil
+	case arrow.FLOAT32:
+		return float32(v), nil
+	default:
+		return v, nil
+	}
+}
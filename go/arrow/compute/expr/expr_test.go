@@ -0,0 +1,192 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute/expr"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func makeRecord(mem memory.Allocator) array.Record {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+		{Name: "u32", Type: arrow.PrimitiveTypes.Uint32},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "ok", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "my col", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	valid := []bool{true, true, false, true, true}
+	bld.Field(0).(*array.Int8Builder).AppendValues([]int8{-10, 0, 0, 10, 127}, valid)
+	bld.Field(1).(*array.Uint32Builder).AppendValues([]uint32{1, 2, 3, 4, 5}, nil)
+	bld.Field(2).(*array.Float64Builder).AppendValues([]float64{0.5, 1.5, 2.5, 3.5, 4.5}, nil)
+	bld.Field(3).(*array.StringBuilder).AppendValues([]string{"a", "", "c", "d", "e"}, []bool{true, false, true, true, true})
+	bld.Field(4).(*array.BooleanBuilder).AppendValues([]bool{true, false, true, false, false}, []bool{true, true, true, true, false})
+	bld.Field(5).(*array.Int64Builder).AppendValues([]int64{1, 2, 3, 4, 5}, nil)
+	bld.Field(6).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{1, 2, 3, 4, 5}, nil)
+	return bld.NewRecord()
+}
+
+func TestEval(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := makeRecord(mem)
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		src  string
+		want string // 't' (true), 'f' (false) or '.' (null) for each row.
+	}{
+		{"i8 > 0", "ff.tt"},
+		{"0 < i8", "ff.tt"},
+		{"i8 >= -10 && i8 <= 10", "tt.tf"},
+		{"i8 == null", "fftff"},
+		{"null != i8", "ttftt"},
+		{"u32 != 3", "ttftt"},
+		{"f64 < 2", "ttfff"},
+		{"f64 > 2.5e0", "ffftt"},
+		{`str == "c"`, "f.tff"},
+		{`str != null && str < "d"`, "tftff"},
+		{"ok", "tftf."},
+		{"!ok", "ftft."},
+		{"ok == false", "ftft."},
+		{"`my col` >= 4", "ffftt"},
+		{"ok || u32 > 4", "tftft"},
+		{"ok && u32 > 4", "ffff."},
+		{"!(i8 > 0 || str == null) && f64 < 3", "tf.ff"},
+		{"u32 == 1 || u32 == 2 && f64 > 1", "ttfff"},
+		{"(u32 == 1 || u32 == 2) && f64 > 1", "ftfff"},
+	} {
+		t.Run(tc.src, func(t *testing.T) {
+			e, err := expr.Compile(rec.Schema(), tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := e.Eval(mem, rec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			var o strings.Builder
+			for i := 0; i < got.Len(); i++ {
+				switch {
+				case got.IsNull(i):
+					o.WriteByte('.')
+				case got.Value(i):
+					o.WriteByte('t')
+				default:
+					o.WriteByte('f')
+				}
+			}
+			if o.String() != tc.want {
+				t.Fatalf("invalid result: got=%q, want=%q", o.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := makeRecord(mem)
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		src string
+		err string
+	}{
+		{"", "unexpected end of expression"},
+		{"i8 >", "unexpected end of expression"},
+		{"(i8 > 0", "unexpected end of expression"},
+		{"i8 > 0)", "unexpected ')'"},
+		{"i8 > 0 && && u32 < 1", "unexpected '&&'"},
+		{"i8 = 0", "unexpected character '='"},
+		{`str == "abc`, "missing closing quote"},
+		{"missing > 0", `unknown column "missing"`},
+		{"i8 > u32", `cannot compare column "i8" with column "u32"`},
+		{"1 < 2", "does not involve a column"},
+		{"42", "is not a condition"},
+		{"u32", `column "u32" (uint32) is not a boolean condition`},
+		{"i8 > 128", `column "i8" (int8) with '128': value out of range`},
+		{"u32 > -1", `column "u32" (uint32) with '-1': value out of range`},
+		{"i8 > 1.5", `column "i8" (int8) with '1.5': an integer literal is required`},
+		{`i8 == "1"`, `column "i8" (int8) with "1": an integer literal is required`},
+		{"str == 1", `column "str" (utf8) with '1': a string literal is required`},
+		{"ok == 1", `column "ok" (bool) with '1': a boolean literal is required`},
+		{"f64 > true", `column "f64" (float64) with 'true': a numeric literal is required`},
+		{"i8 < null", `column "i8" can only be compared with null`},
+		{"ts > 1", `column "ts" (timestamp[s, tz=UTC]) with '1': unsupported column type`},
+	} {
+		t.Run(tc.src, func(t *testing.T) {
+			_, err := expr.Compile(rec.Schema(), tc.src)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error:\ngot= %v\nwant=%q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := makeRecord(mem)
+	defer rec.Release()
+
+	e, err := expr.Compile(rec.Schema(), "i8 >= 0 && str != null")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.String(), "i8 >= 0 && str != null"; got != want {
+		t.Fatalf("invalid source: got=%q, want=%q", got, want)
+	}
+
+	got, err := e.Filter(mem, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	if got, want := got.NumRows(), int64(2); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := got.Column(3).(*array.String).Value(0), "d"; got != want {
+		t.Fatalf("invalid value: got=%q, want=%q", got, want)
+	}
+
+	other := arrow.NewSchema(rec.Schema().Fields()[:1], nil)
+	e, err = expr.Compile(other, "i8 > 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Eval(mem, rec); err == nil {
+		t.Fatalf("expected an error evaluating against another schema")
+	}
+}
@@ -0,0 +1,198 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokTrue
+	tokFalse
+	tokNull
+	tokOp     // a comparison operator.
+	tokAnd    // &&
+	tokOr     // ||
+	tokNot    // !
+	tokLParen // (
+	tokRParen // )
+)
+
+type token struct {
+	kind tokenKind
+	pos  int    // byte offset of the token in the source.
+	text string // source text, or unquoted value of identifiers and strings.
+}
+
+func (tok token) String() string {
+	switch tok.kind {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return strconv.Quote(tok.text)
+	default:
+		return "'" + tok.text + "'"
+	}
+}
+
+// lex splits src into tokens. The last token is always tokEOF.
+func lex(src string) ([]token, error) {
+	var toks []token
+	for pos := 0; ; {
+		for pos < len(src) {
+			r, size := utf8.DecodeRuneInString(src[pos:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			pos += size
+		}
+		if pos == len(src) {
+			return append(toks, token{kind: tokEOF, pos: pos}), nil
+		}
+
+		tok, n, err := next(src, pos)
+		if err != nil {
+			return nil, err
+		}
+		toks = append(toks, tok)
+		pos += n
+	}
+}
+
+// next returns the token starting at pos in src, and the length of its
+// source text.
+func next(src string, pos int) (token, int, error) {
+	rest := src[pos:]
+	for _, op := range []struct {
+		text string
+		kind tokenKind
+	}{
+		{"&&", tokAnd}, {"||", tokOr},
+		{"==", tokOp}, {"!=", tokOp}, {"<=", tokOp}, {">=", tokOp},
+		{"<", tokOp}, {">", tokOp},
+		{"!", tokNot}, {"(", tokLParen}, {")", tokRParen},
+	} {
+		if strings.HasPrefix(rest, op.text) {
+			return token{kind: op.kind, pos: pos, text: op.text}, len(op.text), nil
+		}
+	}
+
+	switch c := rest[0]; {
+	case c == '"':
+		n, err := quotedLen(rest)
+		if err != nil {
+			return token{}, 0, xerrors.Errorf("arrow/compute/expr: invalid string at offset %d: %w", pos, err)
+		}
+		s, err := strconv.Unquote(rest[:n])
+		if err != nil {
+			return token{}, 0, xerrors.Errorf("arrow/compute/expr: invalid string at offset %d: %w", pos, err)
+		}
+		return token{kind: tokString, pos: pos, text: s}, n, nil
+
+	case c == '`':
+		n, err := quotedLen(rest)
+		if err != nil {
+			return token{}, 0, xerrors.Errorf("arrow/compute/expr: invalid column name at offset %d: %w", pos, err)
+		}
+		return token{kind: tokIdent, pos: pos, text: rest[1 : n-1]}, n, nil
+
+	case c == '-' || c == '+' || c == '.' || ('0' <= c && c <= '9'):
+		n := 1
+		for n < len(rest) && isNumberChar(rest[n], rest[n-1]) {
+			n++
+		}
+		text := rest[:n]
+		if _, err := strconv.ParseInt(text, 10, 64); err == nil || isIntOverflow(err) {
+			return token{kind: tokInt, pos: pos, text: text}, n, nil
+		}
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return token{}, 0, xerrors.Errorf("arrow/compute/expr: invalid number %q at offset %d", text, pos)
+		}
+		return token{kind: tokFloat, pos: pos, text: text}, n, nil
+
+	case c == '_' || unicode.IsLetter(rune(c)) || c >= utf8.RuneSelf:
+		n := 0
+		for n < len(rest) {
+			r, size := utf8.DecodeRuneInString(rest[n:])
+			if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				break
+			}
+			n += size
+		}
+		if n == 0 {
+			break
+		}
+		tok := token{kind: tokIdent, pos: pos, text: rest[:n]}
+		switch tok.text {
+		case "true":
+			tok.kind = tokTrue
+		case "false":
+			tok.kind = tokFalse
+		case "null":
+			tok.kind = tokNull
+		}
+		return tok, n, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(rest)
+	return token{}, 0, xerrors.Errorf("arrow/compute/expr: unexpected character %q at offset %d", r, pos)
+}
+
+// quotedLen returns the length of the quoted string at the start of s,
+// quotes included.
+func quotedLen(s string) (int, error) {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case quote:
+			return i + 1, nil
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		}
+	}
+	return 0, xerrors.New("missing closing quote")
+}
+
+func isNumberChar(c, prev byte) bool {
+	switch {
+	case '0' <= c && c <= '9', c == '.', c == 'e', c == 'E':
+		return true
+	case c == '-' || c == '+':
+		return prev == 'e' || prev == 'E'
+	default:
+		return false
+	}
+}
+
+func isIntOverflow(err error) bool {
+	var nerr *strconv.NumError
+	return xerrors.As(err, &nerr) && nerr.Err == strconv.ErrRange
+}
@@ -0,0 +1,288 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Filter returns a new array holding the elements of arr for which mask is
+// true. Elements for which mask is false or null are dropped.
+//
+// mask must have the same length as arr.
+func Filter(mem memory.Allocator, arr array.Interface, mask *array.Boolean) (array.Interface, error) {
+	if got, want := mask.Len(), arr.Len(); got != want {
+		return nil, xerrors.Errorf("arrow/compute: length mismatch for filter mask: got=%d, want=%d", got, want)
+	}
+	return take(mem, arr, selection(mask))
+}
+
+// FilterRecord returns a new record holding the rows of rec for which mask
+// is true. Rows for which mask is false or null are dropped.
+// The returned record has the schema of rec.
+//
+// mask must have the same length as rec.
+func FilterRecord(mem memory.Allocator, rec array.Record, mask *array.Boolean) (array.Record, error) {
+	if got, want := int64(mask.Len()), rec.NumRows(); got != want {
+		return nil, xerrors.Errorf("arrow/compute: length mismatch for filter mask: got=%d, want=%d", got, want)
+	}
+
+	idx := selection(mask)
+	cols := make([]array.Interface, 0, rec.NumCols())
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	for i, col := range rec.Columns() {
+		arr, err := take(mem, col, idx)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/compute: could not filter column %q: %w", rec.ColumnName(i), err)
+		}
+		cols = append(cols, arr)
+	}
+	return array.NewRecord(rec.Schema(), cols, int64(len(idx))), nil
+}
+
+// selection returns the indices of the valid, true, elements of mask.
+func selection(mask *array.Boolean) []int {
+	var (
+		valid  = validityWords(mask)
+		values = booleanWords(mask)
+		idx    = make([]int, 0, mask.Len()-mask.NullN())
+	)
+	for w := range valid {
+		valid[w] &= values[w]
+	}
+	forEachSetBit(valid, func(i int) {
+		idx = append(idx, i)
+	})
+	return idx
+}
+
+// take creates a new array holding the elements of arr at the given indices.
+func take(mem memory.Allocator, arr array.Interface, idx []int) (array.Interface, error) {
+	var (
+		dtype = arr.DataType()
+		data  = arr.Data()
+		n     = len(idx)
+	)
+
+	if dtype.ID() == arrow.NULL {
+		return array.NewNull(n), nil
+	}
+
+	bitmap := memory.NewResizableBuffer(mem)
+	defer bitmap.Release()
+	bitmap.Resize(int(bitutil.BytesForBits(int64(n))))
+	memory.Set(bitmap.Bytes(), 0)
+
+	nulls := 0
+	for i, j := range idx {
+		if arr.IsNull(j) {
+			nulls++
+			continue
+		}
+		bitutil.SetBit(bitmap.Bytes(), i)
+	}
+
+	var (
+		buffers  = []*memory.Buffer{bitmap}
+		children []*array.Data
+	)
+
+	switch dt := dtype.(type) {
+	case *arrow.BooleanType:
+		values := memory.NewResizableBuffer(mem)
+		defer values.Release()
+		values.Resize(int(bitutil.BytesForBits(int64(n))))
+		memory.Set(values.Bytes(), 0)
+
+		src := arr.(*array.Boolean)
+		for i, j := range idx {
+			if src.IsValid(j) && src.Value(j) {
+				bitutil.SetBit(values.Bytes(), i)
+			}
+		}
+		buffers = append(buffers, values)
+
+	case arrow.FixedWidthDataType:
+		width := dt.BitWidth() / 8
+		values := memory.NewResizableBuffer(mem)
+		defer values.Release()
+		values.Resize(n * width)
+		memory.Set(values.Bytes(), 0)
+
+		if buf := data.Buffers()[1]; buf != nil {
+			var (
+				raw = buf.Bytes()[data.Offset()*width:]
+				out = values.Bytes()
+			)
+			for i, j := range idx {
+				copy(out[i*width:(i+1)*width], raw[j*width:(j+1)*width])
+			}
+		}
+		buffers = append(buffers, values)
+
+	case arrow.BinaryDataType:
+		var (
+			offsets = int32Offsets(data)
+			raw     []byte
+		)
+		if buf := data.Buffers()[2]; buf != nil {
+			raw = buf.Bytes()
+		}
+
+		offs := memory.NewResizableBuffer(mem)
+		defer offs.Release()
+		offs.Resize(arrow.Int32Traits.BytesRequired(n + 1))
+		out := arrow.Int32Traits.CastFromBytes(offs.Bytes())
+
+		out[0] = 0
+		for i, j := range idx {
+			out[i+1] = out[i] + offsets[j+1] - offsets[j]
+		}
+
+		values := memory.NewResizableBuffer(mem)
+		defer values.Release()
+		values.Resize(int(out[n]))
+		for i, j := range idx {
+			copy(values.Bytes()[out[i]:out[i+1]], raw[offsets[j]:offsets[j+1]])
+		}
+		buffers = append(buffers, offs, values)
+
+	case *arrow.ListType, *arrow.MapType:
+		offsets := int32Offsets(data)
+
+		offs := memory.NewResizableBuffer(mem)
+		defer offs.Release()
+		offs.Resize(arrow.Int32Traits.BytesRequired(n + 1))
+		out := arrow.Int32Traits.CastFromBytes(offs.Bytes())
+
+		var sub []int
+		out[0] = 0
+		for i, j := range idx {
+			for k := offsets[j]; k < offsets[j+1]; k++ {
+				sub = append(sub, int(k))
+			}
+			out[i+1] = int32(len(sub))
+		}
+
+		child, err := take(mem, listValues(arr), sub)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		buffers = append(buffers, offs)
+		children = []*array.Data{child.Data()}
+
+	case *arrow.LargeListType:
+		offsets := int64Offsets(data)
+
+		offs := memory.NewResizableBuffer(mem)
+		defer offs.Release()
+		offs.Resize(arrow.Int64Traits.BytesRequired(n + 1))
+		out := arrow.Int64Traits.CastFromBytes(offs.Bytes())
+
+		var sub []int
+		out[0] = 0
+		for i, j := range idx {
+			for k := offsets[j]; k < offsets[j+1]; k++ {
+				sub = append(sub, int(k))
+			}
+			out[i+1] = int64(len(sub))
+		}
+
+		child, err := take(mem, arr.(*array.LargeList).ListValues(), sub)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		buffers = append(buffers, offs)
+		children = []*array.Data{child.Data()}
+
+	case *arrow.FixedSizeListType:
+		var (
+			size = int(dt.Len())
+			sub  = make([]int, 0, n*size)
+		)
+		for _, j := range idx {
+			beg := (data.Offset() + j) * size
+			for k := beg; k < beg+size; k++ {
+				sub = append(sub, k)
+			}
+		}
+
+		child, err := take(mem, arr.(*array.FixedSizeList).ListValues(), sub)
+		if err != nil {
+			return nil, err
+		}
+		defer child.Release()
+		children = []*array.Data{child.Data()}
+
+	case *arrow.StructType:
+		src := arr.(*array.Struct)
+		for i := range dt.Fields() {
+			child, err := take(mem, src.Field(i), idx)
+			if err != nil {
+				return nil, err
+			}
+			defer child.Release()
+			children = append(children, child.Data())
+		}
+
+	default:
+		return nil, xerrors.Errorf("arrow/compute: unsupported data type %v", dtype)
+	}
+
+	out := array.NewData(dtype, n, buffers, children, nulls, 0)
+	defer out.Release()
+	return array.MakeFromData(out), nil
+}
+
+// listValues returns the values of a List or Map array.
+func listValues(arr array.Interface) array.Interface {
+	if arr, ok := arr.(*array.Map); ok {
+		return arr.ListValues()
+	}
+	return arr.(*array.List).ListValues()
+}
+
+// int32Offsets returns the offsets of a variable-width array, starting at its
+// first element.
+func int32Offsets(data *array.Data) []int32 {
+	if data.Len() == 0 {
+		return []int32{0}
+	}
+	offsets := arrow.Int32Traits.CastFromBytes(data.Buffers()[1].Bytes())
+	return offsets[data.Offset() : data.Offset()+data.Len()+1]
+}
+
+// int64Offsets returns the offsets of a LargeList array, starting at its
+// first element.
+func int64Offsets(data *array.Data) []int64 {
+	if data.Len() == 0 {
+		return []int64{0}
+	}
+	offsets := arrow.Int64Traits.CastFromBytes(data.Buffers()[1].Bytes())
+	return offsets[data.Offset() : data.Offset()+data.Len()+1]
+}
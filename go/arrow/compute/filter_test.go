@@ -0,0 +1,201 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	mask := newBools(mem, "tf.tt")
+	defer mask.Release()
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 2, 3, 0, 5}, []bool{true, true, true, false, true})
+	ints := ib.NewArray()
+	defer ints.Release()
+	ib.AppendValues([]int32{1, 0, 5}, []bool{true, false, true})
+	wantInts := ib.NewArray()
+	defer wantInts.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "bb", "ccc", "", "eeeee"}, []bool{true, true, true, true, false})
+	strs := sb.NewArray()
+	defer strs.Release()
+	sb.AppendValues([]string{"a", "", ""}, []bool{true, true, false})
+	wantStrs := sb.NewArray()
+	defer wantStrs.Release()
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int8)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int8Builder)
+	for _, vs := range [][]int8{{1}, {2, 2}, nil, {4, 4, 4, 4}, {}} {
+		if vs == nil {
+			lb.AppendNull()
+			continue
+		}
+		lb.Append(true)
+		vb.AppendValues(vs, nil)
+	}
+	lists := lb.NewArray()
+	defer lists.Release()
+	for _, vs := range [][]int8{{1}, {4, 4, 4, 4}, {}} {
+		lb.Append(true)
+		vb.AppendValues(vs, nil)
+	}
+	wantLists := lb.NewArray()
+	defer wantLists.Release()
+
+	stb := array.NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Boolean, Nullable: true}))
+	defer stb.Release()
+	fb := stb.FieldBuilder(0).(*array.BooleanBuilder)
+	for _, v := range []bool{true, false, true, false, true} {
+		stb.Append(true)
+		fb.Append(v)
+	}
+	structs := stb.NewArray()
+	defer structs.Release()
+	for _, v := range []bool{true, false, true} {
+		stb.Append(true)
+		fb.Append(v)
+	}
+	wantStructs := stb.NewArray()
+	defer wantStructs.Release()
+
+	for _, tc := range []struct {
+		name      string
+		arr, want array.Interface
+	}{
+		{"int32", ints, wantInts},
+		{"string", strs, wantStrs},
+		{"list", lists, wantLists},
+		{"struct", structs, wantStructs},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := compute.Filter(mem, tc.arr, mask)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			if !array.ArrayEqual(got, tc.want) {
+				t.Fatalf("invalid result:\ngot= %v\nwant=%v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("sliced", func(t *testing.T) {
+		sub := array.NewSlice(strs, 1, 5)
+		defer sub.Release()
+		m := newBools(mem, "ftft")
+		defer m.Release()
+
+		got, err := compute.Filter(mem, sub, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer got.Release()
+		assert.Equal(t, 2, got.Len())
+		assert.Equal(t, "ccc", got.(*array.String).Value(0))
+		assert.True(t, got.IsNull(1))
+	})
+
+	t.Run("length-mismatch", func(t *testing.T) {
+		m := newBools(mem, "tt")
+		defer m.Release()
+		_, err := compute.Filter(mem, ints, m)
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "s", Type: arrow.BinaryTypes.String},
+	}, nil)
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	bld.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	bld.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b", "c"}, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	mask := newBools(mem, "ftt")
+	defer mask.Release()
+
+	got, err := compute.FilterRecord(mem, rec, mask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, int64(2), got.NumRows())
+	assert.True(t, got.Schema().Equal(schema))
+	assert.Equal(t, []int64{2, 3}, got.Column(0).(*array.Int64).Int64Values())
+	assert.Equal(t, "c", got.Column(1).(*array.String).Value(1))
+}
+
+func TestFilterDropsMetadata(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	md := arrow.NewMetadata([]string{"source"}, []string{"part-0.csv"})
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2, 3}, nil)
+	plain := ib.NewArray()
+	defer plain.Release()
+	arr := array.MakeFromDataWithMetadata(plain.Data(), &md)
+	defer arr.Release()
+
+	mask := newBools(mem, "ftt")
+	defer mask.Release()
+
+	got, err := compute.Filter(mem, arr, mask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	assert.Nil(t, got.Data().Metadata())
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	rec := array.NewRecord(schema, []array.Interface{arr}, 3)
+	defer rec.Release()
+
+	frec, err := compute.FilterRecord(mem, rec, mask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer frec.Release()
+	assert.Nil(t, frec.Column(0).Data().Metadata())
+}
@@ -0,0 +1,170 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+
+	// nullHash is the hash of nulls when they are considered equal.
+	nullHash = 0x9e3779b97f4a7c15
+
+	// canonicalNaN is the bit pattern all NaNs are hashed as, unless
+	// floating point canonicalization is disabled.
+	canonicalNaN = 0x7ff8000000000001
+)
+
+// Hash returns a 64-bit hash of each element of arr.
+//
+// Hashes are consistent with CompareScalar: elements which compare equal
+// have the same hash. In particular, positive and negative zeros have the
+// same hash.
+// By default, null elements have null hashes, so that they never match any
+// other element. With WithNullsEqual(true), all nulls have the same valid
+// hash, so that hash-based deduplication agrees with comparisons using the
+// same option.
+//
+// By default, all NaNs have the same hash, whatever their sign and payload,
+// so that hashing a floating point column yields a single group of NaNs.
+// See WithFloatCanonicalization.
+//
+// Numeric, boolean, binary and string data types are supported.
+// Hashes are stable within a process but may change across releases.
+func Hash(mem memory.Allocator, arr array.Interface, opts ...Option) (*array.Uint64, error) {
+	cfg := newConfig(opts...)
+	hash, err := hasher(arr, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		n      = arr.Len()
+		valid  = validityWords(arr)
+		values = make([]uint64, n)
+	)
+	forEachSetBit(valid, func(i int) {
+		values[i] = hash(i)
+	})
+	if cfg.nullsEq {
+		for i := range values {
+			if valid[i/64]&(1<<uint(i%64)) == 0 {
+				values[i] = nullHash
+			}
+		}
+		valid = allSet(n)
+	}
+
+	var (
+		bitmap = wordsToBuffer(mem, valid, n)
+		data   = memory.NewResizableBuffer(mem)
+	)
+	defer bitmap.Release()
+	defer data.Release()
+	data.Resize(arrow.Uint64Traits.BytesRequired(n))
+	copy(arrow.Uint64Traits.CastFromBytes(data.Bytes()), values)
+
+	nulls := n - countSetBits(valid)
+	return makeArray(arrow.PrimitiveTypes.Uint64, n, []*memory.Buffer{bitmap, data}, nulls).(*array.Uint64), nil
+}
+
+// hasher returns a function computing the hash of the i-th element of arr.
+func hasher(arr array.Interface, cfg *config) (func(i int) uint64, error) {
+	if cls, ok := numericClassOf(arr.DataType()); ok {
+		switch cls {
+		case classSigned:
+			v := int64Values(arr)
+			return func(i int) uint64 { return hashUint64(uint64(v(i))) }, nil
+		case classUnsigned:
+			v := uint64Values(arr)
+			return func(i int) uint64 { return hashUint64(v(i)) }, nil
+		default:
+			v := float64Values(arr)
+			if cfg.rawFloats {
+				return func(i int) uint64 { return hashUint64(math.Float64bits(v(i))) }, nil
+			}
+			return func(i int) uint64 { return hashUint64(canonicalFloatBits(v(i))) }, nil
+		}
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		return func(i int) uint64 {
+			if arr.Value(i) {
+				return hashUint64(1)
+			}
+			return hashUint64(0)
+		}, nil
+	case *array.String:
+		return func(i int) uint64 { return hashString(arr.Value(i)) }, nil
+	case *array.Binary:
+		return func(i int) uint64 { return hashBytes(arr.Value(i)) }, nil
+	default:
+		return nil, xerrors.Errorf("arrow/compute: unsupported data type %v", arr.DataType())
+	}
+}
+
+// canonicalFloatBits returns the bit pattern of x, where all NaNs have the
+// same bit pattern, and so do -0 and +0.
+func canonicalFloatBits(x float64) uint64 {
+	switch {
+	case x != x:
+		return canonicalNaN
+	case x == 0:
+		return 0 // -0 == +0
+	default:
+		return math.Float64bits(x)
+	}
+}
+
+// hashUint64 returns the FNV-1a hash of the little-endian bytes of v.
+func hashUint64(v uint64) uint64 {
+	h := uint64(fnvOffset64)
+	for i := uint(0); i < 64; i += 8 {
+		h ^= (v >> i) & 0xff
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// hashBytes returns the FNV-1a hash of b.
+func hashBytes(b []byte) uint64 {
+	h := uint64(fnvOffset64)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// hashString returns the FNV-1a hash of s.
+func hashString(s string) uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
@@ -0,0 +1,195 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// scalarAt returns the i-th element of arr as a scalar.
+func scalarAt(arr array.Interface, i int) compute.Scalar {
+	s := compute.Scalar{Type: arr.DataType()}
+	if arr.IsNull(i) {
+		return s
+	}
+	switch arr := arr.(type) {
+	case *array.Int64:
+		s.Value = arr.Value(i)
+	case *array.Float64:
+		s.Value = arr.Value(i)
+	case *array.String:
+		s.Value = arr.Value(i)
+	case *array.Boolean:
+		s.Value = arr.Value(i)
+	}
+	return s
+}
+
+func TestHashConsistentWithCompare(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	valids := []bool{true, false, true, true, false, true, true, false}
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 0, 2, 1, 7, -1, 2, 0}, valids)
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float64{0, 0, math.Copysign(0, -1), 1.5, 0, 1.5, math.NaN(), 0}, valids)
+	floats := fb.NewArray()
+	defer floats.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "", "", "a", "x", "b", "", ""}, valids)
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	bools := newBools(mem, "t.ff.tf.")
+	defer bools.Release()
+
+	for _, arr := range []array.Interface{ints, floats, strs, bools} {
+		for _, nullsEq := range []bool{false, true} {
+			t.Run(arr.DataType().Name(), func(t *testing.T) {
+				opt := compute.WithNullsEqual(nullsEq)
+				hashes, err := compute.Hash(mem, arr, opt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer hashes.Release()
+
+				if got, want := hashes.NullN(), arr.NullN(); nullsEq && got != 0 || !nullsEq && got != want {
+					t.Fatalf("invalid number of null hashes: got=%d (nulls-equal=%v)", got, nullsEq)
+				}
+
+				for i := 0; i < arr.Len(); i++ {
+					eq, err := compute.CompareScalar(mem, compute.Equal, arr, scalarAt(arr, i), opt)
+					if err != nil {
+						t.Fatal(err)
+					}
+					for j := 0; j < arr.Len(); j++ {
+						// equal elements must have the same valid hash.
+						if eq.IsValid(j) && eq.Value(j) {
+							if hashes.IsNull(i) || hashes.IsNull(j) || hashes.Value(i) != hashes.Value(j) {
+								t.Errorf("elements %d and %d are equal but have different hashes (nulls-equal=%v)", i, j, nullsEq)
+							}
+						}
+					}
+					eq.Release()
+				}
+			})
+		}
+	}
+}
+
+func TestHashUnsupported(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int8)
+	defer lb.Release()
+	lb.AppendNull()
+	arr := lb.NewArray()
+	defer arr.Release()
+
+	if _, err := compute.Hash(mem, arr); err == nil {
+		t.Fatalf("expected an error hashing a list array")
+	}
+}
+
+func TestHashFloatCanonicalization(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	nans := []float64{
+		math.NaN(),
+		math.Float64frombits(0x7ff8000000000000), // quiet NaN, no payload.
+		math.Float64frombits(0x7ff0000000000001), // signaling NaN.
+		math.Float64frombits(0xfff8000000000000), // negative NaN.
+		math.Float64frombits(0x7ffdeadbeef00000), // payload.
+	}
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues(nans, nil)
+	fb.AppendValues([]float64{0, math.Copysign(0, -1)}, nil)
+	arr := fb.NewArray()
+	defer arr.Release()
+
+	f32 := array.NewFloat32Builder(mem)
+	defer f32.Release()
+	f32.AppendValues([]float32{
+		math.Float32frombits(0x7fc00001),
+		math.Float32frombits(0x7fc00000),
+		math.Float32frombits(0xffc00000),
+		math.Float32frombits(0x7fa00000),
+		math.Float32frombits(0x7fc0beef),
+	}, nil)
+	f32.AppendValues([]float32{0, float32(math.Copysign(0, -1))}, nil)
+	arr32 := f32.NewArray()
+	defer arr32.Release()
+
+	distinct := func(hashes *array.Uint64, beg, end int) int {
+		set := make(map[uint64]bool)
+		for i := beg; i < end; i++ {
+			set[hashes.Value(i)] = true
+		}
+		return len(set)
+	}
+
+	for _, arr := range []array.Interface{arr, arr32} {
+		t.Run(arr.DataType().Name(), func(t *testing.T) {
+			n := len(nans)
+
+			hashes, err := compute.Hash(mem, arr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer hashes.Release()
+
+			if got := distinct(hashes, 0, n); got != 1 {
+				t.Fatalf("invalid number of distinct NaN hashes: got=%d, want=1", got)
+			}
+			if got := distinct(hashes, n, n+2); got != 1 {
+				t.Fatalf("invalid number of distinct zero hashes: got=%d, want=1", got)
+			}
+
+			raw, err := compute.Hash(mem, arr, compute.WithFloatCanonicalization(false))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer raw.Release()
+
+			if got := distinct(raw, 0, n); got != n {
+				t.Fatalf("invalid number of distinct NaN hashes: got=%d, want=%d", got, n)
+			}
+			if got := distinct(raw, n, n+2); got != 2 {
+				t.Fatalf("invalid number of distinct zero hashes: got=%d, want=2", got)
+			}
+		})
+	}
+}
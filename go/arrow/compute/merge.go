@@ -0,0 +1,538 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// NullPlacement specifies where nulls are placed in a sort order.
+type NullPlacement int
+
+const (
+	// NullsAtEnd sorts nulls after all the valid values.
+	NullsAtEnd NullPlacement = iota
+	// NullsAtStart sorts nulls before all the valid values.
+	NullsAtStart
+)
+
+// SortKey describes the column records are sorted by.
+//
+// Numeric, temporal, boolean, binary and string columns may be sort keys.
+// NaN values are greater than any other floating point number, and equal
+// to each other.
+// Nulls are placed according to Nulls, whatever the direction of the sort.
+type SortKey struct {
+	Column     string
+	Descending bool
+	Nulls      NullPlacement
+}
+
+// MergeReader is a record reader merging the records of several readers,
+// each sorted by the same key, into a single sorted stream.
+//
+// Only the current record of each input, and the records referenced by the
+// merged batch being assembled, are held in memory.
+type MergeReader struct {
+	refCount int64
+
+	mem    memory.Allocator
+	schema *arrow.Schema
+	key    SortKey
+	col    int // index of the key column.
+	rows   int // number of rows of the merged batches.
+	order  func(x *sortColumn, i int, y *sortColumn, j int) int
+
+	srcs []*mergeSource
+	tree []int // loser tree over the head rows of srcs; tree[0] is the winner.
+	cur  array.Record
+	err  error
+}
+
+// mergeSource is an input of a MergeReader.
+type mergeSource struct {
+	r    array.RecordReader
+	rec  array.Record // current record, nil once r is exhausted.
+	key  sortColumn   // key column of rec.
+	row  int          // index of the head row in rec.
+	base int64        // number of rows of r before rec.
+}
+
+// mergeRecordID identifies a record of an input.
+type mergeRecordID struct {
+	src  int   // index of the input.
+	base int64 // number of rows of the input before the record.
+}
+
+// mergeRun is a range of consecutive rows of an input record.
+type mergeRun struct {
+	rec      array.Record
+	id       mergeRecordID
+	beg, end int
+}
+
+// MergeSorted returns a reader merging the records of readers, each sorted
+// by key, into records of batchRows rows sorted by key.
+// The last record may be shorter.
+// Rows with equal keys are returned in the order of readers.
+//
+// All readers must have the same schema, which is the schema of the merged
+// records. MergeSorted retains the readers, and releases them when the
+// returned reader is released.
+//
+// Inputs are checked while they are read: when an input is not sorted by
+// key, Next returns false and Err reports the index of the input and the
+// row at which the order is broken.
+func MergeSorted(mem memory.Allocator, readers []array.RecordReader, key SortKey, batchRows int) (*MergeReader, error) {
+	if len(readers) == 0 {
+		return nil, xerrors.Errorf("arrow/compute: no input to merge")
+	}
+	if batchRows <= 0 {
+		return nil, xerrors.Errorf("arrow/compute: invalid number of rows per batch %d", batchRows)
+	}
+
+	schema := readers[0].Schema()
+	for i, r := range readers[1:] {
+		if !r.Schema().Equal(schema) {
+			return nil, xerrors.Errorf("arrow/compute: schema mismatch for input %d:\ngot= %v\nwant=%v", i+1, r.Schema(), schema)
+		}
+	}
+
+	idx := schema.FieldIndices(key.Column)
+	switch len(idx) {
+	case 0:
+		return nil, xerrors.Errorf("arrow/compute: no column named %q", key.Column)
+	case 1:
+	default:
+		return nil, xerrors.Errorf("arrow/compute: ambiguous column name %q", key.Column)
+	}
+
+	order, err := keyOrder(key, schema.Field(idx[0]).Type)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MergeReader{
+		refCount: 1,
+		mem:      mem,
+		schema:   schema,
+		key:      key,
+		col:      idx[0],
+		rows:     batchRows,
+		order:    order,
+		srcs:     make([]*mergeSource, len(readers)),
+	}
+	for i, r := range readers {
+		r.Retain()
+		m.srcs[i] = &mergeSource{r: r, row: -1}
+	}
+	return m, nil
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (m *MergeReader) Retain() {
+	atomic.AddInt64(&m.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the current record and the input
+// readers are released.
+// Release may be called simultaneously from multiple goroutines.
+func (m *MergeReader) Release() {
+	debug.Assert(atomic.LoadInt64(&m.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&m.refCount, -1) == 0 {
+		if m.cur != nil {
+			m.cur.Release()
+			m.cur = nil
+		}
+		for _, src := range m.srcs {
+			if src.rec != nil {
+				src.rec.Release()
+				src.rec = nil
+			}
+			src.r.Release()
+		}
+		m.srcs = nil
+	}
+}
+
+// Schema returns the schema of the merged records.
+func (m *MergeReader) Schema() *arrow.Schema { return m.schema }
+
+// Record returns the current merged record.
+// The record is owned by m and is only valid until the next call to Next.
+func (m *MergeReader) Record() array.Record { return m.cur }
+
+// Err returns the first error encountered while reading or merging the
+// inputs.
+func (m *MergeReader) Err() error { return m.err }
+
+// Next assembles the next merged record.
+// It returns false once all the inputs are exhausted, or on error.
+func (m *MergeReader) Next() bool {
+	if m.cur != nil {
+		m.cur.Release()
+		m.cur = nil
+	}
+	if m.err != nil {
+		return false
+	}
+
+	if m.tree == nil {
+		for i := range m.srcs {
+			if m.err = m.advance(i); m.err != nil {
+				return false
+			}
+		}
+		m.tree = make([]int, len(m.srcs))
+		m.tree[0] = m.build(1)
+	}
+
+	var runs []mergeRun
+	defer func() {
+		for _, run := range runs {
+			run.rec.Release()
+		}
+	}()
+
+	for n := 0; n < m.rows; n++ {
+		w := m.tree[0]
+		src := m.srcs[w]
+		if src.rec == nil {
+			break // all the inputs are exhausted.
+		}
+
+		if k := len(runs) - 1; k >= 0 && runs[k].rec == src.rec && runs[k].end == src.row {
+			runs[k].end++
+		} else {
+			src.rec.Retain()
+			runs = append(runs, mergeRun{
+				rec: src.rec,
+				id:  mergeRecordID{src: w, base: src.base},
+				beg: src.row,
+				end: src.row + 1,
+			})
+		}
+
+		if m.err = m.advance(w); m.err != nil {
+			return false
+		}
+		m.replay(w)
+	}
+
+	if len(runs) == 0 {
+		return false
+	}
+	m.cur, m.err = m.gather(runs)
+	return m.err == nil
+}
+
+// advance moves the i-th input to its next row, reading its next non-empty
+// record when needed, and checks that it is sorted.
+func (m *MergeReader) advance(i int) error {
+	src := m.srcs[i]
+	src.row++
+	if src.rec != nil && src.row < int(src.rec.NumRows()) {
+		return nil
+	}
+
+	for src.r.Next() {
+		rec := src.r.Record()
+		if rec.NumRows() == 0 {
+			continue
+		}
+
+		key := newSortColumn(rec.Column(m.col))
+		if src.rec != nil {
+			last := int(src.rec.NumRows()) - 1
+			if m.order(&src.key, last, &key, 0) > 0 {
+				return m.unsorted(i, src.base+int64(last)+1)
+			}
+			src.base += src.rec.NumRows()
+			src.rec.Release()
+		}
+		for j := 1; j < int(rec.NumRows()); j++ {
+			if m.order(&key, j-1, &key, j) > 0 {
+				src.rec = nil
+				return m.unsorted(i, src.base+int64(j))
+			}
+		}
+
+		rec.Retain()
+		src.rec, src.key, src.row = rec, key, 0
+		return nil
+	}
+
+	if src.rec != nil {
+		src.base += src.rec.NumRows()
+		src.rec.Release()
+		src.rec, src.key = nil, sortColumn{}
+	}
+	if r, ok := src.r.(interface{ Err() error }); ok && r.Err() != nil {
+		return xerrors.Errorf("arrow/compute: could not read input %d: %w", i, r.Err())
+	}
+	return nil
+}
+
+func (m *MergeReader) unsorted(i int, row int64) error {
+	return xerrors.Errorf("arrow/compute: input %d is not sorted by %q at row %d", i, m.key.Column, row)
+}
+
+// beats reports whether the head row of the i-th input comes before the one
+// of the j-th input. Exhausted inputs come last.
+func (m *MergeReader) beats(i, j int) bool {
+	x, y := m.srcs[i], m.srcs[j]
+	switch {
+	case x.rec == nil:
+		return false
+	case y.rec == nil:
+		return true
+	}
+	if c := m.order(&x.key, x.row, &y.key, y.row); c != 0 {
+		return c < 0
+	}
+	return i < j
+}
+
+// build fills the loser tree below node, and returns the winner of node.
+// Nodes are numbered from 1, the inputs being the leaves len(m.srcs) to
+// 2*len(m.srcs)-1.
+func (m *MergeReader) build(node int) int {
+	k := len(m.srcs)
+	if node >= k {
+		return node - k
+	}
+	l, r := m.build(2*node), m.build(2*node+1)
+	if m.beats(l, r) {
+		m.tree[node] = r
+		return l
+	}
+	m.tree[node] = l
+	return r
+}
+
+// replay updates the loser tree after the head row of the i-th input changed.
+func (m *MergeReader) replay(i int) {
+	winner := i
+	for node := (i + len(m.srcs)) / 2; node >= 1; node /= 2 {
+		if m.beats(m.tree[node], winner) {
+			m.tree[node], winner = winner, m.tree[node]
+		}
+	}
+	m.tree[0] = winner
+}
+
+// gather creates a record from the rows of runs.
+//
+// A single run is sliced from its record, without copy. Otherwise, the
+// ranges of rows used in each input record are concatenated, and the rows
+// of the runs are taken from the concatenation.
+func (m *MergeReader) gather(runs []mergeRun) (array.Record, error) {
+	if len(runs) == 1 {
+		run := runs[0]
+		return run.rec.NewSlice(int64(run.beg), int64(run.end)), nil
+	}
+
+	type part struct {
+		rec    array.Record
+		lo, hi int // range of the used rows of rec.
+		off    int // offset of the range in the concatenation.
+	}
+	var (
+		parts []part
+		index = make(map[mergeRecordID]int)
+	)
+	for _, run := range runs {
+		k, ok := index[run.id]
+		if !ok {
+			k = len(parts)
+			index[run.id] = k
+			parts = append(parts, part{rec: run.rec, lo: run.beg, hi: run.end})
+		}
+		if run.beg < parts[k].lo {
+			parts[k].lo = run.beg
+		}
+		if run.end > parts[k].hi {
+			parts[k].hi = run.end
+		}
+	}
+	for k := 1; k < len(parts); k++ {
+		parts[k].off = parts[k-1].off + parts[k-1].hi - parts[k-1].lo
+	}
+
+	var idx []int
+	for _, run := range runs {
+		p := parts[index[run.id]]
+		for row := run.beg; row < run.end; row++ {
+			idx = append(idx, p.off+row-p.lo)
+		}
+	}
+
+	cols := make([]array.Interface, len(m.schema.Fields()))
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	arrs := make([]array.Interface, len(parts))
+	for i, f := range m.schema.Fields() {
+		for k, p := range parts {
+			arrs[k] = array.NewSlice(p.rec.Column(i), int64(p.lo), int64(p.hi))
+		}
+		col, err := m.take(arrs, idx)
+		for _, arr := range arrs {
+			arr.Release()
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/compute: could not merge column %q: %w", f.Name, err)
+		}
+		cols[i] = col
+	}
+
+	return array.NewRecord(m.schema, cols, int64(len(idx))), nil
+}
+
+// take returns the elements at the given indices of the concatenation of arrs.
+func (m *MergeReader) take(arrs []array.Interface, idx []int) (array.Interface, error) {
+	if len(arrs) == 1 {
+		return take(m.mem, arrs[0], idx)
+	}
+	cat, err := array.Concatenate(m.mem, arrs)
+	if err != nil {
+		return nil, err
+	}
+	defer cat.Release()
+	return take(m.mem, cat, idx)
+}
+
+// sortColumn gives typed access to the values of a sort key column.
+// Only the accessor matching the data type of the column is set.
+type sortColumn struct {
+	arr    array.Interface
+	ints   func(i int) int64
+	uints  func(i int) uint64
+	floats func(i int) float64
+	strs   func(i int) string
+	bytes  func(i int) []byte
+}
+
+func newSortColumn(arr array.Interface) sortColumn {
+	col := sortColumn{arr: arr}
+	if cls, ok := numericClassOf(arr.DataType()); ok {
+		switch cls {
+		case classSigned:
+			col.ints = int64Values(arr)
+		case classUnsigned:
+			col.uints = uint64Values(arr)
+		default:
+			col.floats = float64Values(arr)
+		}
+		return col
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		col.ints = func(i int) int64 {
+			if arr.Value(i) {
+				return 1
+			}
+			return 0
+		}
+	case *array.String:
+		col.strs = arr.Value
+	case *array.Binary:
+		col.bytes = arr.Value
+	}
+	return col
+}
+
+// keyOrder returns a function comparing the i-th value of x with the j-th
+// value of y, according to key. Both columns must be of type dtype.
+func keyOrder(key SortKey, dtype arrow.DataType) (func(x *sortColumn, i int, y *sortColumn, j int) int, error) {
+	var cmp func(x *sortColumn, i int, y *sortColumn, j int) int
+	if cls, ok := numericClassOf(dtype); ok {
+		switch cls {
+		case classSigned:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return cmpInt64(x.ints(i), y.ints(j)) }
+		case classUnsigned:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return cmpUint64(x.uints(i), y.uints(j)) }
+		default:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return cmpSortFloat64(x.floats(i), y.floats(j)) }
+		}
+	} else {
+		switch dtype.ID() {
+		case arrow.BOOL:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return cmpInt64(x.ints(i), y.ints(j)) }
+		case arrow.STRING:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return strings.Compare(x.strs(i), y.strs(j)) }
+		case arrow.BINARY:
+			cmp = func(x *sortColumn, i int, y *sortColumn, j int) int { return bytes.Compare(x.bytes(i), y.bytes(j)) }
+		default:
+			return nil, xerrors.Errorf("arrow/compute: unsupported sort key data type %v", dtype)
+		}
+	}
+
+	nullFirst := -1
+	if key.Nulls == NullsAtEnd {
+		nullFirst = +1
+	}
+	return func(x *sortColumn, i int, y *sortColumn, j int) int {
+		switch xnull, ynull := x.arr.IsNull(i), y.arr.IsNull(j); {
+		case xnull && ynull:
+			return 0
+		case xnull:
+			return nullFirst
+		case ynull:
+			return -nullFirst
+		}
+		c := cmp(x, i, y, j)
+		if key.Descending {
+			return -c
+		}
+		return c
+	}, nil
+}
+
+// cmpSortFloat64 is a three-way comparison of floating point numbers where
+// NaN is greater than any other number, and equal to itself.
+func cmpSortFloat64(a, b float64) int {
+	if c := cmpFloat64(a, b); c != unordered {
+		return c
+	}
+	switch anan, bnan := math.IsNaN(a), math.IsNaN(b); {
+	case anan && bnan:
+		return 0
+	case anan:
+		return +1
+	default:
+		return -1
+	}
+}
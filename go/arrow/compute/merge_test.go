@@ -0,0 +1,522 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+var mergeSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "key", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	{Name: "src", Type: arrow.PrimitiveTypes.Int32},
+	{Name: "row", Type: arrow.PrimitiveTypes.Int64},
+}, nil)
+
+// mergeRow is a row of a record of mergeSchema.
+type mergeRow struct {
+	key      *int64
+	src, row int
+}
+
+// mergeLess reports whether key x sorts strictly before key y.
+func mergeLess(key compute.SortKey, x, y *int64) bool {
+	switch {
+	case x == nil || y == nil:
+		if x == nil && y == nil {
+			return false
+		}
+		return (x == nil) == (key.Nulls == compute.NullsAtStart)
+	case key.Descending:
+		return *x > *y
+	default:
+		return *x < *y
+	}
+}
+
+// makeMergeInput creates the records of an input sorted by key, with
+// batches of random sizes, some of them empty.
+func makeMergeInput(mem memory.Allocator, rnd *rand.Rand, key compute.SortKey, src int) ([]array.Record, []mergeRow) {
+	rows := make([]mergeRow, rnd.Intn(60))
+	for i := range rows {
+		if rnd.Intn(5) != 0 {
+			v := int64(rnd.Intn(30))
+			rows[i].key = &v
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return mergeLess(key, rows[i].key, rows[j].key) })
+	for i := range rows {
+		rows[i].src, rows[i].row = src, i
+	}
+
+	bldr := array.NewRecordBuilder(mem, mergeSchema)
+	defer bldr.Release()
+
+	var recs []array.Record
+	for beg := 0; beg < len(rows) || len(recs) == 0; {
+		end := beg + rnd.Intn(15)
+		if end > len(rows) {
+			end = len(rows)
+		}
+		for _, row := range rows[beg:end] {
+			if row.key == nil {
+				bldr.Field(0).AppendNull()
+			} else {
+				bldr.Field(0).(*array.Int64Builder).Append(*row.key)
+			}
+			bldr.Field(1).(*array.Int32Builder).Append(int32(row.src))
+			bldr.Field(2).(*array.Int64Builder).Append(int64(row.row))
+		}
+		recs = append(recs, bldr.NewRecord())
+		beg = end
+	}
+	return recs, rows
+}
+
+func newRecordReaders(t *testing.T, schema *arrow.Schema, inputs [][]array.Record) []array.RecordReader {
+	t.Helper()
+	readers := make([]array.RecordReader, len(inputs))
+	for i, recs := range inputs {
+		r, err := array.NewRecordReader(schema, recs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		readers[i] = r
+	}
+	return readers
+}
+
+func releaseReaders(readers []array.RecordReader) {
+	for _, r := range readers {
+		r.Release()
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	for _, key := range []compute.SortKey{
+		{Column: "key"},
+		{Column: "key", Nulls: compute.NullsAtStart},
+		{Column: "key", Descending: true},
+		{Column: "key", Descending: true, Nulls: compute.NullsAtStart},
+	} {
+		t.Run(fmt.Sprintf("desc=%v/nulls-first=%v", key.Descending, key.Nulls == compute.NullsAtStart), func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			rnd := rand.New(rand.NewSource(1))
+			for _, batchRows := range []int{1, 7, 64, 1000} {
+				var (
+					inputs = make([][]array.Record, 5)
+					want   []mergeRow
+				)
+				for i := range inputs {
+					recs, rows := makeMergeInput(mem, rnd, key, i)
+					inputs[i] = recs
+					want = append(want, rows...)
+				}
+				sort.SliceStable(want, func(i, j int) bool { return mergeLess(key, want[i].key, want[j].key) })
+
+				readers := newRecordReaders(t, mergeSchema, inputs)
+				for _, recs := range inputs {
+					for _, rec := range recs {
+						rec.Release()
+					}
+				}
+
+				r, err := compute.MergeSorted(mem, readers, key, batchRows)
+				releaseReaders(readers)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var got []mergeRow
+				for r.Next() {
+					rec := r.Record()
+					if n := int(rec.NumRows()); n != batchRows && len(got)+n != len(want) {
+						t.Fatalf("batch-rows=%d: invalid number of rows in a batch: got=%d", batchRows, n)
+					}
+					var (
+						keys = rec.Column(0).(*array.Int64)
+						srcs = rec.Column(1).(*array.Int32)
+						rows = rec.Column(2).(*array.Int64)
+					)
+					for i := 0; i < keys.Len(); i++ {
+						row := mergeRow{src: int(srcs.Value(i)), row: int(rows.Value(i))}
+						if keys.IsValid(i) {
+							v := keys.Value(i)
+							row.key = &v
+						}
+						got = append(got, row)
+					}
+				}
+				if err := r.Err(); err != nil {
+					t.Fatal(err)
+				}
+				r.Release()
+
+				if len(got) != len(want) {
+					t.Fatalf("batch-rows=%d: invalid number of rows: got=%d, want=%d", batchRows, len(got), len(want))
+				}
+				for i := range want {
+					if got[i].src != want[i].src || got[i].row != want[i].row {
+						t.Fatalf("batch-rows=%d: invalid row %d: got=(src=%d, row=%d), want=(src=%d, row=%d)",
+							batchRows, i, got[i].src, got[i].row, want[i].src, want[i].row)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMergeSortedStrings(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true}}, nil)
+	makeRecord := func(vs []string, valid []bool) array.Record {
+		b := array.NewRecordBuilder(mem, schema)
+		defer b.Release()
+		b.Field(0).(*array.StringBuilder).AppendValues(vs, valid)
+		return b.NewRecord()
+	}
+
+	inputs := [][]array.Record{
+		{makeRecord([]string{"", "pear", "fig"}, []bool{false, true, true})},
+		{makeRecord([]string{""}, []bool{false}), makeRecord([]string{"plum", "apple"}, nil)},
+	}
+	readers := newRecordReaders(t, schema, inputs)
+	defer releaseReaders(readers)
+	for _, recs := range inputs {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}
+
+	r, err := compute.MergeSorted(mem, readers, compute.SortKey{Column: "s", Descending: true, Nulls: compute.NullsAtStart}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("expected a record: %+v", r.Err())
+	}
+	if got, want := r.Record().Column(0).(*array.String).String(), `[(null) (null) "plum" "pear" "fig" "apple"]`; got != want {
+		t.Fatalf("invalid merged column:\ngot= %s\nwant=%s", got, want)
+	}
+	if r.Next() {
+		t.Fatalf("expected a single record")
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMergeSortedUnsorted(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		second [][]int64
+		err    string
+	}{
+		{
+			name:   "within-batch",
+			second: [][]int64{{1, 2}, {5, 3}},
+			err:    `arrow/compute: input 1 is not sorted by "key" at row 3`,
+		},
+		{
+			name:   "across-batches",
+			second: [][]int64{{1, 5}, {}, {3}},
+			err:    `arrow/compute: input 1 is not sorted by "key" at row 2`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			bldr := array.NewRecordBuilder(mem, mergeSchema)
+			defer bldr.Release()
+			makeRecord := func(keys []int64) array.Record {
+				bldr.Field(0).(*array.Int64Builder).AppendValues(keys, nil)
+				bldr.Field(1).(*array.Int32Builder).AppendValues(make([]int32, len(keys)), nil)
+				bldr.Field(2).(*array.Int64Builder).AppendValues(make([]int64, len(keys)), nil)
+				return bldr.NewRecord()
+			}
+
+			inputs := [][]array.Record{{makeRecord([]int64{0, 1, 2, 3, 4, 5, 6})}}
+			var second []array.Record
+			for _, keys := range tc.second {
+				second = append(second, makeRecord(keys))
+			}
+			inputs = append(inputs, second)
+
+			readers := newRecordReaders(t, mergeSchema, inputs)
+			defer releaseReaders(readers)
+			for _, recs := range inputs {
+				for _, rec := range recs {
+					rec.Release()
+				}
+			}
+
+			r, err := compute.MergeSorted(mem, readers, compute.SortKey{Column: "key"}, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			for r.Next() {
+			}
+			if r.Err() == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := r.Err().Error(); got != tc.err {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, tc.err)
+			}
+		})
+	}
+}
+
+func TestMergeSortedInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	other := arrow.NewSchema([]arrow.Field{
+		{Name: "key", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+	}, nil)
+
+	for _, tc := range []struct {
+		name    string
+		schemas []*arrow.Schema
+		key     string
+		rows    int
+		err     string
+	}{
+		{"no-input", nil, "key", 10, "arrow/compute: no input to merge"},
+		{"batch-rows", []*arrow.Schema{mergeSchema}, "key", 0, "arrow/compute: invalid number of rows per batch 0"},
+		{"schema", []*arrow.Schema{mergeSchema, other}, "key", 10, "arrow/compute: schema mismatch for input 1"},
+		{"column", []*arrow.Schema{mergeSchema}, "nope", 10, `arrow/compute: no column named "nope"`},
+		{"type", []*arrow.Schema{other}, "l", 10, "arrow/compute: unsupported sort key data type list<item: int64>"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var readers []array.RecordReader
+			for _, schema := range tc.schemas {
+				r, err := array.NewRecordReader(schema, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Release()
+				readers = append(readers, r)
+			}
+
+			_, err := compute.MergeSorted(mem, readers, compute.SortKey{Column: tc.key}, tc.rows)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := err.Error(); len(got) < len(tc.err) || got[:len(tc.err)] != tc.err {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, tc.err)
+			}
+		})
+	}
+}
+
+// peakAllocator records the peak of the memory allocated through it.
+type peakAllocator struct {
+	*memory.CheckedAllocator
+	cur, peak int
+}
+
+func (a *peakAllocator) Allocate(size int) []byte {
+	a.update(size)
+	return a.CheckedAllocator.Allocate(size)
+}
+
+func (a *peakAllocator) Reallocate(size int, b []byte) []byte {
+	a.update(size - len(b))
+	return a.CheckedAllocator.Reallocate(size, b)
+}
+
+func (a *peakAllocator) Free(b []byte) {
+	a.update(-len(b))
+	a.CheckedAllocator.Free(b)
+}
+
+func (a *peakAllocator) update(delta int) {
+	a.cur += delta
+	if a.cur > a.peak {
+		a.peak = a.cur
+	}
+}
+
+// genReader generates, one at a time, the records of one of n inputs sorted
+// in descending order: its keys are the non-positive integers congruent to
+// -src modulo n.
+type genReader struct {
+	refCount int64
+	bldr     *array.RecordBuilder
+	src, n   int
+	batches  int
+	rows     int
+	next     int64 // index of the next generated row.
+	cur      array.Record
+}
+
+func newGenReader(mem memory.Allocator, src, n, batches, rows int) *genReader {
+	return &genReader{
+		refCount: 1,
+		bldr:     array.NewRecordBuilder(mem, mergeSchema),
+		src:      src,
+		n:        n,
+		batches:  batches,
+		rows:     rows,
+	}
+}
+
+func (r *genReader) Retain() { atomic.AddInt64(&r.refCount, 1) }
+
+func (r *genReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		if r.cur != nil {
+			r.cur.Release()
+		}
+		r.bldr.Release()
+	}
+}
+
+func (r *genReader) Schema() *arrow.Schema { return mergeSchema }
+
+func (r *genReader) Record() array.Record { return r.cur }
+
+func (r *genReader) Next() bool {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+	if r.batches == 0 {
+		return false
+	}
+	r.batches--
+
+	var (
+		keys = r.bldr.Field(0).(*array.Int64Builder)
+		srcs = r.bldr.Field(1).(*array.Int32Builder)
+		rows = r.bldr.Field(2).(*array.Int64Builder)
+	)
+	r.bldr.Reserve(r.rows)
+	for i := 0; i < r.rows; i++ {
+		keys.UnsafeAppend(-r.next*int64(r.n) - int64(r.src))
+		srcs.UnsafeAppend(int32(r.src))
+		rows.UnsafeAppend(r.next)
+		r.next++
+	}
+	r.cur = r.bldr.NewRecord()
+	return true
+}
+
+// mergeGenerated merges n generated inputs of batches records of rows rows,
+// and returns the number of merged rows.
+func mergeGenerated(mem memory.Allocator, n, batches, rows int) (int64, error) {
+	readers := make([]array.RecordReader, n)
+	for i := range readers {
+		readers[i] = newGenReader(mem, i, n, batches, rows)
+		defer readers[i].Release()
+	}
+
+	r, err := compute.MergeSorted(mem, readers, compute.SortKey{Column: "key", Descending: true}, rows)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Release()
+
+	var (
+		total int64
+		last  int64 = 1
+	)
+	for r.Next() {
+		keys := r.Record().Column(0).(*array.Int64)
+		for _, v := range keys.Int64Values() {
+			if v >= last {
+				return 0, fmt.Errorf("invalid order at row %d: %d after %d", total, v, last)
+			}
+			last = v
+			total++
+		}
+	}
+	return total, r.Err()
+}
+
+func TestMergeSortedMemory(t *testing.T) {
+	const (
+		n       = 8
+		batches = 50
+		rows    = 1000
+	)
+
+	mem := &peakAllocator{CheckedAllocator: memory.NewCheckedAllocator(memory.NewGoAllocator())}
+	defer mem.AssertSize(t, 0)
+
+	// the memory of a single generated batch.
+	gen := newGenReader(mem, 0, 1, 1, rows)
+	gen.Next()
+	batch := mem.cur
+	gen.Release()
+	mem.peak = 0
+
+	total, err := mergeGenerated(mem, n, batches, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := total, int64(n*batches*rows); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	// each input holds its current batch and, at most, the previous one,
+	// while the output batch is assembled.
+	if got, max := mem.peak, (2*n+2)*batch; got > max {
+		t.Fatalf("too much memory allocated: got=%d bytes, want<=%d (%d batches)", got, max, got/batch)
+	}
+}
+
+func BenchmarkMergeSorted(b *testing.B) {
+	const (
+		n       = 8
+		batches = 16
+		rows    = 1 << 16
+	)
+
+	mem := &peakAllocator{CheckedAllocator: memory.NewCheckedAllocator(memory.NewGoAllocator())}
+	defer mem.AssertSize(b, 0)
+
+	b.SetBytes(int64(n * batches * rows * (2*arrow.Int64SizeBytes + arrow.Int32SizeBytes)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := mergeGenerated(mem, n, batches, rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	b.Logf("peak memory: %d bytes, for %d bytes of input", mem.peak, n*batches*rows*(2*arrow.Int64SizeBytes+arrow.Int32SizeBytes))
+}
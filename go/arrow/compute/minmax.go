@@ -0,0 +1,162 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"math/bits"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// ElementwiseMax returns an array holding, for each row, the greatest of the
+// values of a and b.
+//
+// a and b must have the same length and the same numeric or temporal data type.
+// Nulls are handled according to the WithNullHandling option.
+// NaN values are unordered: when either input is NaN, the value of a is returned.
+func ElementwiseMax(mem memory.Allocator, a, b array.Interface, opts ...Option) (array.Interface, error) {
+	return elementwise(mem, a, b, true, opts...)
+}
+
+// ElementwiseMin returns an array holding, for each row, the smallest of the
+// values of a and b.
+//
+// a and b must have the same length and the same numeric or temporal data type.
+// Nulls are handled according to the WithNullHandling option.
+// NaN values are unordered: when either input is NaN, the value of a is returned.
+func ElementwiseMin(mem memory.Allocator, a, b array.Interface, opts ...Option) (array.Interface, error) {
+	return elementwise(mem, a, b, false, opts...)
+}
+
+func elementwise(mem memory.Allocator, a, b array.Interface, max bool, opts ...Option) (array.Interface, error) {
+	cfg := newConfig(opts...)
+
+	if err := checkSameLayout(a, b); err != nil {
+		return nil, err
+	}
+
+	// pickB reports whether the i-th value of b should be selected over
+	// the one of a, when both are valid.
+	var (
+		pickB func(i int) bool
+		err   error
+	)
+	switch {
+	case max:
+		pickB, err = lessFunc(a, b)
+	default:
+		pickB, err = lessFunc(b, a)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		n     = a.Len()
+		src   = make([]int, n)
+		va    = validityWords(a)
+		vb    = validityWords(b)
+		valid = make([]uint64, len(va))
+	)
+
+	for w := range valid {
+		both := va[w] & vb[w]
+		for word := both; word != 0; word &= word - 1 {
+			i := w*64 + bits.TrailingZeros64(word)
+			if pickB(i) {
+				src[i] = 1
+			}
+		}
+
+		switch cfg.nulls {
+		case NullWins:
+			valid[w] = both
+		default:
+			valid[w] = va[w] | vb[w]
+			for word := vb[w] &^ va[w]; word != 0; word &= word - 1 {
+				src[w*64+bits.TrailingZeros64(word)] = 1
+			}
+		}
+	}
+
+	return gather(mem, []array.Interface{a, b}, src, valid)
+}
+
+// lessFunc returns a function reporting whether the i-th value of x is
+// smaller than the i-th value of y.
+func lessFunc(x, y array.Interface) (func(i int) bool, error) {
+	switch x := x.(type) {
+	case *array.Int8:
+		y := y.(*array.Int8)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Int16:
+		y := y.(*array.Int16)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Int32:
+		y := y.(*array.Int32)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Int64:
+		y := y.(*array.Int64)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Uint8:
+		y := y.(*array.Uint8)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Uint16:
+		y := y.(*array.Uint16)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Uint32:
+		y := y.(*array.Uint32)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Uint64:
+		y := y.(*array.Uint64)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Float16:
+		y := y.(*array.Float16)
+		return func(i int) bool { return x.Value(i).Float32() < y.Value(i).Float32() }, nil
+	case *array.Float32:
+		y := y.(*array.Float32)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Float64:
+		y := y.(*array.Float64)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Date32:
+		y := y.(*array.Date32)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Date64:
+		y := y.(*array.Date64)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Timestamp:
+		y := y.(*array.Timestamp)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Time32:
+		y := y.(*array.Time32)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Time64:
+		y := y.(*array.Time64)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.Duration:
+		y := y.(*array.Duration)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	case *array.MonthInterval:
+		y := y.(*array.MonthInterval)
+		return func(i int) bool { return x.Value(i) < y.Value(i) }, nil
+	default:
+		return nil, xerrors.Errorf("arrow/compute: unsupported data type %v", x.DataType())
+	}
+}
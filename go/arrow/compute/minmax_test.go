@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElementwiseMinMax(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt32Builder(mem)
+	defer bld.Release()
+
+	newArray := func(vs []int32, valid []bool) array.Interface {
+		bld.AppendValues(vs, valid)
+		return bld.NewArray()
+	}
+
+	a := newArray([]int32{1, 5, 0, 3, 0}, []bool{true, true, false, true, false})
+	defer a.Release()
+	b := newArray([]int32{2, 4, 7, 0, 0}, []bool{true, true, true, false, false})
+	defer b.Release()
+
+	for _, tc := range []struct {
+		name  string
+		fct   func(memory.Allocator, array.Interface, array.Interface, ...compute.Option) (array.Interface, error)
+		nulls compute.NullHandling
+		vs    []int32
+		valid []bool
+	}{
+		{"max-null-loses", compute.ElementwiseMax, compute.NullLoses, []int32{2, 5, 7, 3, 0}, []bool{true, true, true, true, false}},
+		{"max-null-wins", compute.ElementwiseMax, compute.NullWins, []int32{2, 5, 0, 0, 0}, []bool{true, true, false, false, false}},
+		{"min-null-loses", compute.ElementwiseMin, compute.NullLoses, []int32{1, 4, 7, 3, 0}, []bool{true, true, true, true, false}},
+		{"min-null-wins", compute.ElementwiseMin, compute.NullWins, []int32{1, 4, 0, 0, 0}, []bool{true, true, false, false, false}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := newArray(tc.vs, tc.valid)
+			defer want.Release()
+
+			got, err := tc.fct(mem, a, b, compute.WithNullHandling(tc.nulls))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			if !array.ArrayEqual(got, want) {
+				t.Fatalf("invalid result:\ngot= %v\nwant=%v", got, want)
+			}
+		})
+	}
+}
+
+func TestElementwiseMaxTimestamp(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dt := &arrow.TimestampType{Unit: arrow.Millisecond}
+	bld := array.NewTimestampBuilder(mem, dt)
+	defer bld.Release()
+
+	bld.AppendValues([]arrow.Timestamp{10, 20, 30}, nil)
+	a := bld.NewArray()
+	defer a.Release()
+
+	bld.AppendValues([]arrow.Timestamp{15, 5, 30}, nil)
+	b := bld.NewArray()
+	defer b.Release()
+
+	got, err := compute.ElementwiseMax(mem, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, dt, got.DataType())
+	assert.Equal(t, []arrow.Timestamp{15, 20, 30}, got.(*array.Timestamp).TimestampValues())
+}
+
+func TestElementwiseErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewStringBuilder(mem)
+	defer bld.Release()
+	bld.AppendValues([]string{"a", "b"}, nil)
+	a := bld.NewArray()
+	defer a.Release()
+
+	_, err := compute.ElementwiseMax(mem, a, a)
+	assert.EqualError(t, err, "arrow/compute: unsupported data type utf8")
+
+	i8 := array.NewInt8Builder(mem)
+	defer i8.Release()
+	i8.AppendValues([]int8{1}, nil)
+	b := i8.NewArray()
+	defer b.Release()
+
+	_, err = compute.ElementwiseMin(mem, b, a)
+	assert.Error(t, err)
+}
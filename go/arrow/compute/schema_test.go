@@ -0,0 +1,170 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/compute/expr"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// TestRecordSchemaPropagation checks that the record-producing APIs of the
+// array and compute packages carry over the schema of their input record,
+// along with its metadata and the nullability and metadata of its fields.
+func TestRecordSchemaPropagation(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{
+			Name:     "id",
+			Type:     arrow.PrimitiveTypes.Int64,
+			Metadata: arrow.NewMetadata([]string{"unit"}, []string{"count"}),
+		},
+		{
+			Name:     "name",
+			Type:     arrow.BinaryTypes.String,
+			Nullable: true,
+			Metadata: arrow.NewMetadata([]string{"lang"}, []string{"en"}),
+		},
+	}, func() *arrow.Metadata {
+		md := arrow.NewMetadata([]string{"origin", "version"}, []string{"test", "1"})
+		return &md
+	}())
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3, 4}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "", "c", "d"}, []bool{true, false, true, true})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	check := func(t *testing.T, got array.Record) {
+		t.Helper()
+		defer got.Release()
+		if got.Schema() != schema {
+			t.Fatalf("schema was not carried over:\ngot=%v\nwant=%v", got.Schema(), schema)
+		}
+		// guard against in-place modifications of the shared schema.
+		if md := got.Schema().Metadata(); md.Len() != 2 || md.FindKey("origin") != 0 {
+			t.Fatalf("invalid schema metadata: %v", md)
+		}
+		for i, f := range got.Schema().Fields() {
+			if !f.Equal(schema.Field(i)) {
+				t.Fatalf("invalid field %d: got=%v, want=%v", i, f, schema.Field(i))
+			}
+		}
+	}
+
+	mask := newBools(mem, "tftt")
+	defer mask.Release()
+
+	for _, tc := range []struct {
+		name string
+		fn   func(t *testing.T) array.Record
+	}{
+		{"record-builder", func(t *testing.T) array.Record {
+			b := array.NewRecordBuilder(mem, schema)
+			defer b.Release()
+			b.Field(0).(*array.Int64Builder).Append(1)
+			b.Field(1).AppendNull()
+			return b.NewRecord()
+		}},
+		{"slice", func(t *testing.T) array.Record {
+			return rec.NewSlice(1, 3)
+		}},
+		{"transfer", func(t *testing.T) array.Record {
+			return array.TransferRecord(mem, rec, array.WithCompaction(true))
+		}},
+		{"table-reader", func(t *testing.T) array.Record {
+			tbl := array.NewTableFromRecords(schema, []array.Record{rec})
+			defer tbl.Release()
+			tr := array.NewTableReader(tbl, 2)
+			defer tr.Release()
+			if !tr.Next() {
+				t.Fatalf("no record read from table")
+			}
+			got := tr.Record()
+			got.Retain()
+			return got
+		}},
+		{"batching-writer", func(t *testing.T) array.Record {
+			b := array.NewRecordBuilder(mem, schema)
+			defer b.Release()
+			var got array.Record
+			w := array.NewBatchingWriter(b, func(rec array.Record) error {
+				rec.Retain()
+				got = rec
+				return nil
+			})
+			if err := w.AppendRow(int64(1), "a"); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			return got
+		}},
+		{"filter-record", func(t *testing.T) array.Record {
+			got, err := compute.FilterRecord(mem, rec, mask)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return got
+		}},
+		{"expr-filter", func(t *testing.T) array.Record {
+			e, err := expr.Compile(schema, "id > 1 && name != null")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := e.Filter(mem, rec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return got
+		}},
+		{"aggregating-reader", func(t *testing.T) array.Record {
+			rr, err := array.NewRecordReader(schema, []array.Record{rec})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rr.Release()
+			ar, err := compute.NewAggregatingReader(rr, []compute.AggSpec{{Kind: compute.AggCount}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ar.Release()
+			if ar.Schema() != schema {
+				t.Fatalf("schema was not carried over by the reader")
+			}
+			if !ar.Next() {
+				t.Fatalf("no record read")
+			}
+			got := ar.Record()
+			got.Retain()
+			return got
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			check(t, tc.fn(t))
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// AddDuration returns an array of timestamps holding ts[i]+d[i].
+//
+// When ts and d do not have the same time unit, values are converted to the
+// finer of the two units, which is also the unit of the returned timestamps.
+// The returned timestamps keep the time zone of ts.
+// A row is null if either of its inputs is null.
+func AddDuration(mem memory.Allocator, ts, d array.Interface) (array.Interface, error) {
+	if err := checkSameLen(ts, d); err != nil {
+		return nil, err
+	}
+
+	tsArr, ok := ts.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", ts.DataType())
+	}
+	dArr, ok := d.(*array.Duration)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a duration)", d.DataType())
+	}
+
+	var (
+		tsType = tsArr.DataType().(*arrow.TimestampType)
+		dType  = dArr.DataType().(*arrow.DurationType)
+		unit   = finerUnit(tsType.Unit, dType.Unit)
+	)
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: unit, TimeZone: tsType.TimeZone})
+	defer bldr.Release()
+	bldr.Reserve(ts.Len())
+
+	for i := 0; i < ts.Len(); i++ {
+		if ts.IsNull(i) || d.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		v, err := convertUnit(int64(tsArr.Value(i)), tsType.Unit, unit)
+		if err != nil {
+			return nil, err
+		}
+		dv, err := convertUnit(int64(dArr.Value(i)), dType.Unit, unit)
+		if err != nil {
+			return nil, err
+		}
+		sum, err := addInt64(v, dv)
+		if err != nil {
+			return nil, err
+		}
+		bldr.UnsafeAppend(arrow.Timestamp(sum))
+	}
+
+	return bldr.NewArray(), nil
+}
+
+// TimestampDiff returns an array of durations holding a[i]-b[i].
+//
+// When a and b do not have the same time unit, values are converted to the
+// finer of the two units, which is also the unit of the returned durations.
+// A row is null if either of its inputs is null.
+func TimestampDiff(mem memory.Allocator, a, b array.Interface) (array.Interface, error) {
+	if err := checkSameLen(a, b); err != nil {
+		return nil, err
+	}
+
+	aArr, ok := a.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", a.DataType())
+	}
+	bArr, ok := b.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", b.DataType())
+	}
+
+	var (
+		aUnit = aArr.DataType().(*arrow.TimestampType).Unit
+		bUnit = bArr.DataType().(*arrow.TimestampType).Unit
+		unit  = finerUnit(aUnit, bUnit)
+	)
+
+	bldr := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: unit})
+	defer bldr.Release()
+	bldr.Reserve(a.Len())
+
+	for i := 0; i < a.Len(); i++ {
+		if a.IsNull(i) || b.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		av, err := convertUnit(int64(aArr.Value(i)), aUnit, unit)
+		if err != nil {
+			return nil, err
+		}
+		bv, err := convertUnit(int64(bArr.Value(i)), bUnit, unit)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := subInt64(av, bv)
+		if err != nil {
+			return nil, err
+		}
+		bldr.UnsafeAppend(arrow.Duration(diff))
+	}
+
+	return bldr.NewArray(), nil
+}
+
+// FloorTemporal returns an array of timestamps where each value of ts is
+// truncated down to a multiple of d, e.g. to the hour or to the day.
+//
+// Flooring operates on the wall clock of the column's time zone (UTC when
+// the time zone is empty): with d=24*time.Hour, values are floored to the
+// local midnight. Around daylight saving time transitions, each floored
+// wall clock reading is converted back with the UTC offset in effect at that
+// reading, so buckets start on the local boundary even when the offset of
+// the value differs.
+func FloorTemporal(mem memory.Allocator, ts array.Interface, d time.Duration) (array.Interface, error) {
+	arr, ok := ts.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", ts.DataType())
+	}
+
+	dtype := arr.DataType().(*arrow.TimestampType)
+	size, err := convertUnit(int64(d), arrow.Nanosecond, dtype.Unit)
+	if err != nil {
+		return nil, err
+	}
+	if dtype.Unit.Multiplier()*size != int64(d) || size <= 0 {
+		return nil, xerrors.Errorf("arrow/compute: invalid floor duration %v for unit %v", d, dtype.Unit)
+	}
+
+	loc, err := dtype.GetZone()
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/compute: could not load time zone: %w", err)
+	}
+
+	bldr := array.NewTimestampBuilder(mem, dtype)
+	defer bldr.Release()
+	bldr.Reserve(ts.Len())
+
+	for i := 0; i < ts.Len(); i++ {
+		if ts.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		v := int64(arr.Value(i))
+		if loc == time.UTC {
+			bldr.UnsafeAppend(arrow.Timestamp(floorInt64(v, size)))
+			continue
+		}
+		_, secs := toTime(v, dtype.Unit).In(loc).Zone()
+		floor := floorInt64(v+int64(secs)*int64(time.Second)/dtype.Unit.Multiplier(), size)
+		bldr.UnsafeAppend(arrow.Timestamp(floor - wallOffset(floor, dtype.Unit, loc)))
+	}
+
+	return bldr.NewArray(), nil
+}
+
+// AssumeTimezone returns an array of timestamps in the time zone tz, where
+// each value of the time zone neutral timestamps ts is interpreted as a wall
+// clock reading in tz.
+//
+// Wall clock readings that are skipped or repeated around daylight saving
+// time transitions are resolved as time.Date does.
+// AssumeTimezone returns an error if ts already has a time zone or if tz is
+// not a valid time zone.
+func AssumeTimezone(mem memory.Allocator, ts array.Interface, tz string) (array.Interface, error) {
+	arr, ok := ts.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", ts.DataType())
+	}
+
+	dtype := arr.DataType().(*arrow.TimestampType)
+	if dtype.TimeZone != "" {
+		return nil, xerrors.Errorf("arrow/compute: timestamps already have time zone %q (want time zone neutral timestamps)", dtype.TimeZone)
+	}
+
+	otype := &arrow.TimestampType{Unit: dtype.Unit, TimeZone: tz}
+	loc, err := otype.GetZone()
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/compute: could not load time zone: %w", err)
+	}
+
+	bldr := array.NewTimestampBuilder(mem, otype)
+	defer bldr.Release()
+	bldr.Reserve(ts.Len())
+
+	for i := 0; i < ts.Len(); i++ {
+		if ts.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		v := int64(arr.Value(i))
+		if loc != time.UTC {
+			v, err = subInt64(v, wallOffset(v, dtype.Unit, loc))
+			if err != nil {
+				return nil, err
+			}
+		}
+		bldr.UnsafeAppend(arrow.Timestamp(v))
+	}
+
+	return bldr.NewArray(), nil
+}
+
+// ConvertTimezone returns an array holding the same instants as ts, with
+// the time zone tz.
+//
+// Timestamps are stored relative to UTC, so only the data type changes: the
+// returned array shares the buffers, and the metadata, of ts.
+// ConvertTimezone returns an error if ts is time zone neutral (see
+// AssumeTimezone) or if tz is not a valid time zone.
+func ConvertTimezone(ts array.Interface, tz string) (array.Interface, error) {
+	arr, ok := ts.(*array.Timestamp)
+	if !ok {
+		return nil, xerrors.Errorf("arrow/compute: invalid data type %v (want a timestamp)", ts.DataType())
+	}
+
+	dtype := arr.DataType().(*arrow.TimestampType)
+	if dtype.TimeZone == "" {
+		return nil, xerrors.Errorf("arrow/compute: time zone neutral timestamps can not be converted (use AssumeTimezone)")
+	}
+
+	otype := &arrow.TimestampType{Unit: dtype.Unit, TimeZone: tz}
+	if _, err := otype.GetZone(); err != nil {
+		return nil, xerrors.Errorf("arrow/compute: could not load time zone: %w", err)
+	}
+
+	data := arr.Data()
+	odata := array.NewData(otype, data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+	defer odata.Release()
+	odata.SetMetadata(data.Metadata())
+
+	return array.MakeFromData(odata), nil
+}
+
+func checkSameLen(a, b array.Interface) error {
+	if a.Len() != b.Len() {
+		return xerrors.Errorf("arrow/compute: length mismatch: %d != %d", a.Len(), b.Len())
+	}
+	return nil
+}
+
+// finerUnit returns the finer of the two time units.
+func finerUnit(a, b arrow.TimeUnit) arrow.TimeUnit {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// convertUnit converts v from the time unit from to the time unit to,
+// returning an error if the result overflows.
+// Conversions to a coarser unit truncate towards zero.
+func convertUnit(v int64, from, to arrow.TimeUnit) (int64, error) {
+	o, err := arrow.ConvertDurationValue(arrow.Duration(v), from, to)
+	if err != nil {
+		return 0, xerrors.Errorf("arrow/compute: %w", err)
+	}
+	return int64(o), nil
+}
+
+func addInt64(a, b int64) (int64, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, xerrors.Errorf("arrow/compute: overflow computing %d + %d", a, b)
+	}
+	return sum, nil
+}
+
+func subInt64(a, b int64) (int64, error) {
+	diff := a - b
+	if (b > 0 && diff > a) || (b < 0 && diff < a) {
+		return 0, xerrors.Errorf("arrow/compute: overflow computing %d - %d", a, b)
+	}
+	return diff, nil
+}
+
+// floorInt64 returns the largest multiple of size that is not greater than v.
+func floorInt64(v, size int64) int64 {
+	floor := v - v%size
+	if v%size < 0 {
+		floor -= size
+	}
+	return floor
+}
+
+// wallOffset returns the UTC offset, in unit, in effect in loc at the wall
+// clock reading v. Readings that are skipped or repeated around daylight
+// saving time transitions are resolved as time.Date does.
+func wallOffset(v int64, unit arrow.TimeUnit, loc *time.Location) int64 {
+	wall := toTime(v, unit)
+	_, secs := time.Date(
+		wall.Year(), wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(),
+		loc,
+	).Zone()
+	return int64(secs) * int64(time.Second) / unit.Multiplier()
+}
+
+func toTime(v int64, unit arrow.TimeUnit) time.Time {
+	nanos := unit.Multiplier()
+	per := int64(time.Second) / nanos
+	return time.Unix(v/per, (v%per)*nanos).UTC()
+}
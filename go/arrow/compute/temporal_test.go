@@ -0,0 +1,348 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddDuration(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	tsb := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"})
+	defer tsb.Release()
+	tsb.AppendValues([]arrow.Timestamp{1, 2, 3, 4}, []bool{true, true, false, true})
+	ts := tsb.NewArray()
+	defer ts.Release()
+
+	db := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: arrow.Millisecond})
+	defer db.Release()
+	db.AppendValues([]arrow.Duration{500, -1000, 0, 0}, []bool{true, true, true, false})
+	d := db.NewArray()
+	defer d.Release()
+
+	got, err := compute.AddDuration(mem, ts, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}, got.DataType())
+	assert.Equal(t, 2, got.NullN())
+	vs := got.(*array.Timestamp)
+	assert.Equal(t, arrow.Timestamp(1500), vs.Value(0))
+	assert.Equal(t, arrow.Timestamp(1000), vs.Value(1))
+}
+
+func TestAddDurationOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	tsb := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer tsb.Release()
+	tsb.Append(math.MaxInt64 / 10)
+	ts := tsb.NewArray()
+	defer ts.Release()
+
+	db := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: arrow.Nanosecond})
+	defer db.Release()
+	db.Append(1)
+	d := db.NewArray()
+	defer d.Release()
+
+	_, err := compute.AddDuration(mem, ts, d)
+	assert.Error(t, err)
+}
+
+func TestTimestampDiff(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ab := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Millisecond})
+	defer ab.Release()
+	ab.AppendValues([]arrow.Timestamp{1000, 5000, 0}, []bool{true, true, false})
+	a := ab.NewArray()
+	defer a.Release()
+
+	bb := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Microsecond})
+	defer bb.Release()
+	bb.AppendValues([]arrow.Timestamp{500, 6000000, 0}, nil)
+	b := bb.NewArray()
+	defer b.Release()
+
+	got, err := compute.TimestampDiff(mem, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, &arrow.DurationType{Unit: arrow.Microsecond}, got.DataType())
+	vs := got.(*array.Duration)
+	assert.Equal(t, arrow.Duration(999500), vs.Value(0))
+	assert.Equal(t, arrow.Duration(-1000000), vs.Value(1))
+	assert.True(t, vs.IsNull(2))
+}
+
+func TestFloorTemporalTimeZone(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Etc/GMT-2"})
+	defer bldr.Release()
+
+	// 2020-01-01T23:30:00Z is 2020-01-02T01:30:00 in UTC+2.
+	v := time.Date(2020, 1, 1, 23, 30, 0, 0, time.UTC)
+	bldr.Append(arrow.Timestamp(v.Unix()))
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	got, err := compute.FloorTemporal(mem, ts, 24*time.Hour)
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	defer got.Release()
+
+	want := time.Date(2020, 1, 1, 22, 0, 0, 0, time.UTC) // local midnight
+	assert.Equal(t, arrow.Timestamp(want.Unix()), got.(*array.Timestamp).Value(0))
+}
+
+func TestFloorTemporalDST(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"})
+	defer bldr.Release()
+
+	// clocks go from 02:00 EST to 03:00 EDT on 2026-03-08.
+	for _, v := range []time.Time{
+		time.Date(2026, 3, 8, 1, 30, 0, 0, ny),  // EST
+		time.Date(2026, 3, 8, 3, 30, 0, 0, ny),  // EDT
+		time.Date(2026, 3, 8, 23, 59, 0, 0, ny), // EDT
+	} {
+		bldr.Append(arrow.Timestamp(v.Unix()))
+	}
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	for _, tc := range []struct {
+		d    time.Duration
+		want []time.Time
+	}{
+		{24 * time.Hour, []time.Time{
+			time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+			time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+			time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+		}},
+		{time.Hour, []time.Time{
+			time.Date(2026, 3, 8, 1, 0, 0, 0, ny),
+			time.Date(2026, 3, 8, 3, 0, 0, 0, ny),
+			time.Date(2026, 3, 8, 23, 0, 0, 0, ny),
+		}},
+	} {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			got, err := compute.FloorTemporal(mem, ts, tc.d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			vs := got.(*array.Timestamp)
+			for i, want := range tc.want {
+				assert.Equal(t, want.UTC().String(), time.Unix(int64(vs.Value(i)), 0).UTC().String(), "element %d", i)
+			}
+		})
+	}
+}
+
+// TestFloorTemporalHourlyBuckets buckets a day of per-second data into hourly groups.
+func TestFloorTemporalHourlyBuckets(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const N = 24 * 60 * 60
+	start := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer bldr.Release()
+	bldr.Reserve(N)
+	for i := 0; i < N; i++ {
+		bldr.UnsafeAppend(arrow.Timestamp(start + int64(i)))
+	}
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	buckets, err := compute.FloorTemporal(mem, ts, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buckets.Release()
+
+	groups := make(map[arrow.Timestamp]int)
+	for _, v := range buckets.(*array.Timestamp).TimestampValues() {
+		groups[v]++
+	}
+
+	if got, want := len(groups), 24; got != want {
+		t.Fatalf("invalid number of hourly groups: got=%d, want=%d", got, want)
+	}
+	for h := 0; h < 24; h++ {
+		key := arrow.Timestamp(start + int64(h)*3600)
+		if got, want := groups[key], 3600; got != want {
+			t.Fatalf("invalid number of rows in bucket %d: got=%d, want=%d", h, got, want)
+		}
+	}
+}
+
+func TestFloorTemporalInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer bldr.Release()
+	bldr.Append(1)
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	_, err := compute.FloorTemporal(mem, ts, time.Millisecond)
+	assert.Error(t, err)
+
+	_, err = compute.FloorTemporal(mem, ts, 0)
+	assert.Error(t, err)
+}
+
+func TestAssumeTimezone(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Millisecond})
+	defer bldr.Release()
+
+	// 2020-01-02T01:30:00 wall clock.
+	wall := time.Date(2020, 1, 2, 1, 30, 0, 0, time.UTC)
+	bldr.AppendValues([]arrow.Timestamp{arrow.Timestamp(wall.UnixNano() / 1e6), 0}, []bool{true, false})
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	got, err := compute.AssumeTimezone(mem, ts, "+05:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "+05:30"}, got.DataType())
+	want := time.Date(2020, 1, 1, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, arrow.Timestamp(want.UnixNano()/1e6), got.(*array.Timestamp).Value(0))
+	assert.True(t, got.IsNull(1))
+
+	_, err = compute.AssumeTimezone(mem, got, "UTC")
+	assert.Error(t, err)
+
+	_, err = compute.AssumeTimezone(mem, ts, "Nowhere/Special")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Nowhere/Special")
+	}
+}
+
+func TestAssumeTimezoneDST(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer bldr.Release()
+
+	winter := time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2020, 7, 15, 12, 0, 0, 0, time.UTC)
+	bldr.AppendValues([]arrow.Timestamp{arrow.Timestamp(winter.Unix()), arrow.Timestamp(summer.Unix())}, nil)
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	got, err := compute.AssumeTimezone(mem, ts, "America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	defer got.Release()
+
+	vs := got.(*array.Timestamp)
+	assert.Equal(t, arrow.Timestamp(winter.Add(5*time.Hour).Unix()), vs.Value(0))
+	assert.Equal(t, arrow.Timestamp(summer.Add(4*time.Hour).Unix()), vs.Value(1))
+}
+
+func TestConvertTimezone(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"}
+	bldr := array.NewTimestampBuilder(mem, dtype)
+	defer bldr.Release()
+	bldr.AppendValues([]arrow.Timestamp{1, 2, 3}, []bool{true, false, true})
+	ts := bldr.NewArray()
+	defer ts.Release()
+
+	slice := array.NewSlice(ts, 1, 3)
+	defer slice.Release()
+
+	got, err := compute.ConvertTimezone(slice, "-08:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+
+	assert.Equal(t, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "-08:00"}, got.DataType())
+	assert.Equal(t, "UTC", dtype.TimeZone, "input type must not be modified")
+	assert.Equal(t, 2, got.Len())
+	assert.Equal(t, 1, got.NullN())
+	assert.True(t, got.IsNull(0))
+	assert.Equal(t, arrow.Timestamp(3), got.(*array.Timestamp).Value(1))
+
+	md := arrow.NewMetadata([]string{"source"}, []string{"sensor-7"})
+	tagged := array.MakeFromDataWithMetadata(ts.Data(), &md)
+	defer tagged.Release()
+	conv, err := compute.ConvertTimezone(tagged, "-08:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conv.Release()
+	assert.True(t, conv.Data().Metadata() == &md, "metadata must be kept")
+
+	_, err = compute.ConvertTimezone(ts, "+25:00")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "+25:00")
+	}
+
+	naive := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer naive.Release()
+	naive.Append(1)
+	nts := naive.NewArray()
+	defer nts.Release()
+
+	_, err = compute.ConvertTimezone(nts, "UTC")
+	assert.Error(t, err)
+}
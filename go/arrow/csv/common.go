@@ -21,13 +21,17 @@ package csv
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 var (
 	ErrMismatchFields = errors.New("arrow/csv: number of records mismatch")
+
+	errInvalidDelim = errors.New("arrow/csv: invalid field delimiter")
 )
 
 // Option configures a CSV reader/writer.
@@ -41,7 +45,7 @@ func WithComma(c rune) Option {
 		case *Reader:
 			cfg.r.Comma = c
 		case *Writer:
-			cfg.w.Comma = c
+			cfg.comma = c
 		default:
 			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
 		}
@@ -90,6 +94,29 @@ func WithChunk(n int) Option {
 	}
 }
 
+// WithParseConcurrency specifies the number of goroutines used to parse
+// CSV rows into records.
+//
+// If n is greater than 1 and the reader is configured with chunks of more
+// than 1 row (see WithChunk), the input is split into blocks of rows at line
+// boundaries and the blocks are decoded concurrently, each worker using its own
+// record builders. Records are still delivered in input order by Next and
+// hold the same rows as with serial parsing.
+// Line numbers reported in *encoding/csv.ParseError errors refer to the
+// whole input.
+//
+// Otherwise, rows are parsed serially by the goroutine calling Next.
+func WithParseConcurrency(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.nworkers = n
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
 // WithCRLF specifies the line terminator used while writing CSV files.
 // If useCRLF is true, \r\n is used as the line terminator, otherwise \n is used.
 // The default value is false.
@@ -97,7 +124,22 @@ func WithCRLF(useCRLF bool) Option {
 	return func(cfg config) {
 		switch cfg := cfg.(type) {
 		case *Writer:
-			cfg.w.UseCRLF = useCRLF
+			cfg.crlf = useCRLF
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithBufferSize specifies the size of the buffer used while writing CSV
+// files. Rows are accumulated in the buffer and written to the underlying
+// io.Writer when it is full, or when the writer is flushed.
+// If n is not positive, a default size of 4096 bytes is used.
+func WithBufferSize(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.bufSize = n
 		default:
 			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
 		}
@@ -159,6 +201,65 @@ func WithNullWriter(null string) Option {
 	}
 }
 
+// WithBooleanParser specifies the function used by a CSV Reader to parse the
+// values of boolean columns. NULL values are detected before the parser is
+// called, as configured with WithNullReader.
+// The default parser is strconv.ParseBool. See ParseBoolLenient for a parser
+// accepting more spellings.
+func WithBooleanParser(parse func(string) (bool, error)) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.parseBool = parse
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithBooleanWriter sets the strings written for true and false values by a
+// CSV Writer. The default is "true" and "false".
+func WithBooleanWriter(trueValue, falseValue string) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.boolValues = [2]string{falseValue, trueValue}
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithFloatWriter sets the format and precision of the floating point values
+// written by a CSV Writer, as for strconv.FormatFloat: e.g. 'f' and 2 for
+// values with 2 decimals.
+// The default, 'g' and -1, writes the shortest representation parsing back
+// to the same value: other precisions do not round trip.
+func WithFloatWriter(format byte, prec int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.floatFmt = format
+			cfg.floatPrec = prec
+		default:
+			panic(fmt.Errorf("arrow/csv: unknown config type %T", cfg))
+		}
+	}
+}
+
+// ParseBoolLenient parses the common spellings of boolean values found in
+// CSV files: true/false, t/f, yes/no, y/n and 1/0, regardless of case and
+// surrounding spaces.
+func ParseBoolLenient(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "yes", "y", "1":
+		return true, nil
+	case "false", "f", "no", "n", "0":
+		return false, nil
+	}
+	return false, xerrors.Errorf("arrow/csv: invalid boolean value %q", s)
+}
+
 func validate(schema *arrow.Schema) {
 	for i, f := range schema.Fields() {
 		switch ft := f.Type.(type) {
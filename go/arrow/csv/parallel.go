@@ -0,0 +1,305 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"golang.org/x/xerrors"
+)
+
+// parser splits the input of a Reader into blocks of CSV rows and
+// converts these blocks into records, using a pool of workers.
+// Records are delivered in input order.
+type parser struct {
+	src *splitter
+	n   int // number of workers
+
+	once    sync.Once
+	started bool
+	quit    chan struct{}
+	stopped sync.Once
+	queue   chan *block
+	wg      sync.WaitGroup
+}
+
+func newParser(r io.Reader, comment rune, n int) *parser {
+	return &parser{
+		src:   &splitter{r: bufio.NewReader(r), comment: comment},
+		n:     n,
+		quit:  make(chan struct{}),
+		queue: make(chan *block, n),
+	}
+}
+
+// block is a range of complete CSV rows from the input.
+type block struct {
+	data []byte
+	line int // number of input lines preceding data.
+
+	rec  array.Record
+	err  error
+	done chan struct{}
+}
+
+// wrap rewrites the line numbers of CSV parse errors so they refer to
+// the whole input rather than to the block.
+func (blk *block) wrap(err error) error {
+	var perr *csv.ParseError
+	if xerrors.As(err, &perr) {
+		perr.StartLine += blk.line
+		perr.Line += blk.line
+	}
+	return err
+}
+
+// splitter cuts its input at line boundaries, so that each block holds a
+// given number of complete CSV rows.
+//
+// A line ending inside a quoted field does not terminate a row: the
+// splitter tracks the parity of the quotes seen since the start of the
+// row and keeps reading lines until all quoted fields are closed.
+// Empty lines and comments are not counted as rows, consistently with
+// encoding/csv.
+type splitter struct {
+	r       *bufio.Reader
+	comment rune
+	line    int
+	hint    int // size of the previous block, used to pre-size the next one.
+}
+
+// next returns a block holding at most n rows.
+// next returns io.EOF, together with the last block, once the input
+// is exhausted.
+func (s *splitter) next(n int) (*block, error) {
+	var (
+		blk    = &block{line: s.line, data: make([]byte, 0, s.hint), done: make(chan struct{})}
+		start  = true
+		quoted = false
+		rows   = 0
+	)
+
+	for {
+		line, err := s.readLine()
+		if len(line) > 0 {
+			s.line++
+			blk.data = append(blk.data, line...)
+			switch {
+			case start && s.skip(line):
+				// not a row.
+			default:
+				if start {
+					rows++
+				}
+				if bytes.Count(line, quote)%2 == 1 {
+					quoted = !quoted
+				}
+				start = !quoted
+			}
+		}
+		if err != nil {
+			return blk, err
+		}
+		if start && rows == n {
+			s.hint = len(blk.data) + len(blk.data)/8
+			return blk, nil
+		}
+	}
+}
+
+var quote = []byte{'"'}
+
+func (s *splitter) readLine() ([]byte, error) {
+	line, err := s.r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		buf := append([]byte(nil), line...)
+		for err == bufio.ErrBufferFull {
+			line, err = s.r.ReadSlice('\n')
+			buf = append(buf, line...)
+		}
+		line = buf
+	}
+	return line, err
+}
+
+// skip reports whether line is ignored by encoding/csv.
+func (s *splitter) skip(line []byte) bool {
+	if s.comment != 0 {
+		if c, _ := utf8.DecodeRune(line); c == s.comment {
+			return true
+		}
+	}
+	switch string(line) {
+	case "\n", "\r\n":
+		return true
+	}
+	return false
+}
+
+// readHeader consumes the header row from the input and prepares the
+// reader to decode it.
+func (p *parser) readHeader(r *Reader) error {
+	blk, err := p.src.next(1)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	r.r = r.newCSVReader(bytes.NewReader(blk.data))
+	return nil
+}
+
+// start launches the splitting goroutine and the workers.
+func (p *parser) start(r *Reader) {
+	p.started = true
+	jobs := make(chan *block, p.n)
+
+	for i := 0; i < p.n; i++ {
+		w := r.newWorker()
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			defer w.bld.Release()
+			for blk := range jobs {
+				w.parse(blk)
+			}
+		}()
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer close(jobs)
+		defer close(p.queue)
+
+		for {
+			blk, err := p.src.next(r.chunk)
+			switch {
+			case err == io.EOF && len(blk.data) == 0:
+				return
+			case err != nil && err != io.EOF:
+				blk.err = xerrors.Errorf("arrow/csv: could not read input: %w", err)
+				close(blk.done)
+				select {
+				case p.queue <- blk:
+				case <-p.quit:
+				}
+				return
+			}
+
+			select {
+			case p.queue <- blk:
+			case <-p.quit:
+				return
+			}
+
+			select {
+			case jobs <- blk:
+			case <-p.quit:
+				close(blk.done)
+				return
+			}
+
+			if err == io.EOF {
+				return
+			}
+		}
+	}()
+}
+
+// stop interrupts the parsing, releases the records that have not been
+// delivered yet and waits for all goroutines to return.
+func (p *parser) stop() {
+	if !p.started {
+		return
+	}
+	p.stopped.Do(func() { close(p.quit) })
+	for blk := range p.queue {
+		<-blk.done
+		if blk.rec != nil {
+			blk.rec.Release()
+		}
+	}
+	p.wg.Wait()
+}
+
+// nextParallel returns the next record decoded by the workers.
+func (r *Reader) nextParallel() bool {
+	p := r.par
+	p.once.Do(func() { p.start(r) })
+
+	for blk := range p.queue {
+		<-blk.done
+		if blk.err != nil {
+			r.err = blk.err
+			r.done = true
+			p.stop()
+			return false
+		}
+		if blk.rec == nil {
+			continue
+		}
+		r.cur = blk.rec
+		return true
+	}
+
+	r.done = true
+	return false
+}
+
+// newWorker returns a reader sharing the configuration of r, with its own
+// record builder and field converters.
+func (r *Reader) newWorker() *Reader {
+	w := &Reader{
+		r:                r.newCSVReader(nil),
+		schema:           r.schema,
+		refs:             1,
+		chunk:            r.chunk,
+		mem:              r.mem,
+		stringsCanBeNull: r.stringsCanBeNull,
+		nulls:            r.nulls,
+		parseBool:        r.parseBool,
+	}
+	w.r.FieldsPerRecord = len(r.schema.Fields())
+	w.bld = newRecordBuilder(w.mem, w.schema)
+	w.initFieldConverters()
+	return w
+}
+
+// parse decodes the rows of blk into a record.
+func (w *Reader) parse(blk *block) {
+	defer close(blk.done)
+
+	w.r = w.newCSVReader(bytes.NewReader(blk.data))
+	w.done, w.err = false, nil
+
+	ok := w.nextn()
+	switch {
+	case w.err != nil:
+		w.cur.Release()
+		blk.err = blk.wrap(w.err)
+	case ok:
+		blk.rec = w.cur
+	default:
+		w.cur.Release()
+	}
+	w.cur = nil
+}
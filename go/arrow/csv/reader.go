@@ -18,7 +18,6 @@ package csv
 
 import (
 	"encoding/csv"
-	"fmt"
 	"io"
 	"strconv"
 	"sync"
@@ -52,8 +51,18 @@ type Reader struct {
 
 	fieldConverter []func(field array.Builder, val string)
 
+	// rows and dataSizes hold the number of rows of the previous chunk and,
+	// for each string column, the number of data bytes it used. They are
+	// used to pre-size the next chunk.
+	rows      int
+	dataSizes []int
+
 	stringsCanBeNull bool
 	nulls            []string
+	parseBool        func(string) (bool, error)
+
+	nworkers int     // number of concurrent parsing workers
+	par      *parser // parallel parsing state, nil if rows are parsed serially
 }
 
 // NewReader returns a reader that reads from the CSV file and creates
@@ -70,6 +79,7 @@ func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
 		refs:             1,
 		chunk:            1,
 		stringsCanBeNull: false,
+		parseBool:        strconv.ParseBool,
 	}
 	rr.r.ReuseRecord = true
 	for _, opt := range opts {
@@ -80,29 +90,52 @@ func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
 		rr.mem = memory.DefaultAllocator
 	}
 
-	rr.bld = array.NewRecordBuilder(rr.mem, rr.schema)
+	rr.bld = newRecordBuilder(rr.mem, rr.schema)
 
 	switch {
 	case rr.chunk < 0:
 		rr.next = rr.nextall
+	case rr.chunk > 1 && rr.nworkers > 1:
+		rr.par = newParser(r, rr.r.Comment, rr.nworkers)
+		rr.next = rr.nextParallel
 	case rr.chunk > 1:
 		rr.next = rr.nextn
 	default:
 		rr.next = rr.next1
 	}
 
-	// Create a table of functions that will parse columns. This optimization
-	// allows us to specialize the implementation of each column's decoding
-	// and hoist type-based branches outside the inner loop.
-	rr.fieldConverter = make([]func(array.Builder, string), len(schema.Fields()))
-	for idx, field := range schema.Fields() {
-		rr.fieldConverter[idx] = rr.initFieldConverter(&field)
+	rr.initFieldConverters()
+
+	return rr
+}
+
+// initFieldConverters creates a table of functions that will parse columns.
+// This optimization allows us to specialize the implementation of each
+// column's decoding and hoist type-based branches outside the inner loop.
+func (r *Reader) initFieldConverters() {
+	r.fieldConverter = make([]func(array.Builder, string), len(r.schema.Fields()))
+	for idx, field := range r.schema.Fields() {
+		r.fieldConverter[idx] = r.initFieldConverter(idx, &field)
 	}
+}
 
+// newCSVReader returns a CSV reader over src, configured as r.r.
+func (r *Reader) newCSVReader(src io.Reader) *csv.Reader {
+	rr := csv.NewReader(src)
+	rr.Comma = r.r.Comma
+	rr.Comment = r.r.Comment
+	rr.FieldsPerRecord = r.r.FieldsPerRecord
+	rr.ReuseRecord = true
 	return rr
 }
 
 func (r *Reader) readHeader() error {
+	if r.par != nil {
+		if err := r.par.readHeader(r); err != nil {
+			return xerrors.Errorf("arrow/csv: could not read header from file: %w", err)
+		}
+	}
+
 	records, err := r.r.Read()
 	if err != nil {
 		return xerrors.Errorf("arrow/csv: could not read header from file: %w", err)
@@ -120,7 +153,7 @@ func (r *Reader) readHeader() error {
 
 	meta := r.schema.Metadata()
 	r.schema = arrow.NewSchema(fields, &meta)
-	r.bld = array.NewRecordBuilder(r.mem, r.schema)
+	r.bld = newRecordBuilder(r.mem, r.schema)
 	return nil
 }
 
@@ -173,7 +206,7 @@ func (r *Reader) next1() bool {
 
 	r.validate(recs)
 	r.read(recs)
-	r.cur = r.bld.NewRecord()
+	r.cur = r.newRecord()
 
 	return true
 }
@@ -185,20 +218,22 @@ func (r *Reader) nextall() bool {
 		r.done = true
 	}()
 
-	var (
-		recs [][]string
-	)
-
-	recs, r.err = r.r.ReadAll()
-	if r.err != nil {
-		return false
-	}
-
-	for _, rec := range recs {
+	// rows are read one at a time, rather than with ReadAll, so that the
+	// positions of invalid fields can be reported.
+	for {
+		rec, err := r.r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			r.err = err
+			r.bld.NewRecord().Release() // discard the rows read so far.
+			return false
+		}
 		r.validate(rec)
 		r.read(rec)
 	}
-	r.cur = r.bld.NewRecord()
+	r.cur = r.newRecord()
 
 	return true
 }
@@ -211,10 +246,16 @@ func (r *Reader) nextn() bool {
 		n    = 0
 	)
 
-	for i := 0; i < r.chunk && !r.done; i++ {
-		recs, r.err = r.r.Read()
-		if r.err != nil {
+	r.reserve()
+
+	for i := 0; i < r.chunk && !r.done && r.err == nil; i++ {
+		var err error
+		recs, err = r.r.Read()
+		if err != nil {
 			r.done = true
+			if err != io.EOF {
+				r.err = err
+			}
 			break
 		}
 
@@ -225,15 +266,63 @@ func (r *Reader) nextn() bool {
 
 	if r.err != nil {
 		r.done = true
-		if r.err == io.EOF {
-			r.err = nil
-		}
 	}
 
-	r.cur = r.bld.NewRecord()
+	r.recordDataSizes(n)
+	r.cur = r.newRecord()
 	return n > 0
 }
 
+// reserve pre-allocates the builders for the next chunk of rows, after the
+// number of rows and of string data bytes of the previous chunk, to limit
+// reallocations while appending.
+// The first chunk is not pre-allocated: the input may hold far fewer rows
+// than the chunk size.
+func (r *Reader) reserve() {
+	if r.rows == 0 {
+		return
+	}
+	r.bld.Reserve(r.rows)
+	for i, n := range r.dataSizes {
+		if n > 0 {
+			r.bld.Field(i).(*array.StringBuilder).ReserveData(n)
+		}
+	}
+}
+
+func (r *Reader) recordDataSizes(rows int) {
+	r.rows = rows
+	if r.dataSizes == nil {
+		r.dataSizes = make([]int, len(r.schema.Fields()))
+	}
+	for i, f := range r.bld.Fields() {
+		if sb, ok := f.(*array.StringBuilder); ok {
+			r.dataSizes[i] = sb.DataLen()
+		}
+	}
+}
+
+// newRecordBuilder returns a record builder for schema whose builders accept
+// nulls in every field: NULL and invalid values are read as nulls, even in
+// fields declared non-nullable.
+func newRecordBuilder(mem memory.Allocator, schema *arrow.Schema) *array.RecordBuilder {
+	fields := make([]arrow.Field, len(schema.Fields()))
+	for i, f := range schema.Fields() {
+		fields[i] = f
+		fields[i].Nullable = true
+	}
+	meta := schema.Metadata()
+	return array.NewRecordBuilder(mem, arrow.NewSchema(fields, &meta))
+}
+
+// newRecord returns the record of the rows read so far, with the schema of
+// r.
+func (r *Reader) newRecord() array.Record {
+	rec := r.bld.NewRecord()
+	defer rec.Release()
+	return array.NewRecord(r.schema, rec.Columns(), rec.NumRows())
+}
+
 func (r *Reader) validate(recs []string) {
 	if r.err != nil {
 		return
@@ -260,52 +349,13 @@ func (r *Reader) read(recs []string) {
 	}
 }
 
-func (r *Reader) initFieldConverter(field *arrow.Field) func(array.Builder, string) {
+func (r *Reader) initFieldConverter(idx int, field *arrow.Field) func(array.Builder, string) {
 	switch field.Type.(type) {
 	case *arrow.BooleanType:
 		return func(field array.Builder, str string) {
-			r.parseBool(field, str)
-		}
-	case *arrow.Int8Type:
-		return func(field array.Builder, str string) {
-			r.parseInt8(field, str)
-		}
-	case *arrow.Int16Type:
-		return func(field array.Builder, str string) {
-			r.parseInt16(field, str)
-		}
-	case *arrow.Int32Type:
-		return func(field array.Builder, str string) {
-			r.parseInt32(field, str)
-		}
-	case *arrow.Int64Type:
-		return func(field array.Builder, str string) {
-			r.parseInt64(field, str)
-		}
-	case *arrow.Uint8Type:
-		return func(field array.Builder, str string) {
-			r.parseUint8(field, str)
-		}
-	case *arrow.Uint16Type:
-		return func(field array.Builder, str string) {
-			r.parseUint16(field, str)
-		}
-	case *arrow.Uint32Type:
-		return func(field array.Builder, str string) {
-			r.parseUint32(field, str)
-		}
-	case *arrow.Uint64Type:
-		return func(field array.Builder, str string) {
-			r.parseUint64(field, str)
-		}
-	case *arrow.Float32Type:
-		return func(field array.Builder, str string) {
-			r.parseFloat32(field, str)
-		}
-	case *arrow.Float64Type:
-		return func(field array.Builder, str string) {
-			r.parseFloat64(field, str)
+			r.parseBoolField(idx, field.(*array.BooleanBuilder), str)
 		}
+
 	case *arrow.StringType:
 		// specialize the implementation when we know we cannot have nulls
 		if r.stringsCanBeNull {
@@ -323,188 +373,46 @@ func (r *Reader) initFieldConverter(field *arrow.Field) func(array.Builder, stri
 		}
 
 	default:
-		panic(fmt.Errorf("arrow/csv: unhandled field type %T", field.Type))
-	}
-}
-
-func (r *Reader) parseBool(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	var v bool
-	switch str {
-	case "false", "False", "0":
-		v = false
-	case "true", "True", "1":
-		v = true
-	default:
-		r.err = fmt.Errorf("Unrecognized boolean: %s", str)
-		field.AppendNull()
-		return
+		return r.parseField
 	}
-
-	field.(*array.BooleanBuilder).Append(v)
-}
-
-func (r *Reader) parseInt8(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseInt(str, 10, 8)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Int8Builder).Append(int8(v))
 }
 
-func (r *Reader) parseInt16(field array.Builder, str string) {
+// parseField appends the value parsed from str to field, or a null if str
+// is a NULL value or an invalid value. Only the first error is reported.
+func (r *Reader) parseField(field array.Builder, str string) {
 	if r.isNull(str) {
 		field.AppendNull()
 		return
 	}
 
-	v, err := strconv.ParseInt(str, 10, 16)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Int16Builder).Append(int16(v))
-}
-
-func (r *Reader) parseInt32(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseInt(str, 10, 32)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Int32Builder).Append(int32(v))
-}
-
-func (r *Reader) parseInt64(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseInt(str, 10, 64)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Int64Builder).Append(v)
-}
-
-func (r *Reader) parseUint8(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseUint(str, 10, 8)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Uint8Builder).Append(uint8(v))
-}
-
-func (r *Reader) parseUint16(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseUint(str, 10, 16)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Uint16Builder).Append(uint16(v))
-}
-
-func (r *Reader) parseUint32(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseUint(str, 10, 32)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Uint32Builder).Append(uint32(v))
-}
-
-func (r *Reader) parseUint64(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseUint(str, 10, 64)
-	if err != nil && r.err == nil {
-		r.err = err
-		field.AppendNull()
-		return
-	}
-
-	field.(*array.Uint64Builder).Append(v)
-}
-
-func (r *Reader) parseFloat32(field array.Builder, str string) {
-	if r.isNull(str) {
-		field.AppendNull()
-		return
-	}
-
-	v, err := strconv.ParseFloat(str, 32)
-	if err != nil && r.err == nil {
-		r.err = err
+	if err := field.AppendValueFromString(str); err != nil {
+		if r.err == nil {
+			r.err = err
+		}
 		field.AppendNull()
-		return
 	}
-	field.(*array.Float32Builder).Append(float32(v))
-
 }
 
-func (r *Reader) parseFloat64(field array.Builder, str string) {
+// parseBoolField appends the boolean value parsed from str, the idx-th field
+// of the current row, to field. Invalid values are reported as
+// *encoding/csv.ParseError errors locating the field in the input.
+func (r *Reader) parseBoolField(idx int, field *array.BooleanBuilder, str string) {
 	if r.isNull(str) {
 		field.AppendNull()
 		return
 	}
 
-	v, err := strconv.ParseFloat(str, 64)
-	if err != nil && r.err == nil {
-		r.err = err
+	v, err := r.parseBool(str)
+	if err != nil {
+		if r.err == nil {
+			start, _ := r.r.FieldPos(0)
+			line, col := r.r.FieldPos(idx)
+			r.err = &csv.ParseError{StartLine: start, Line: line, Column: col, Err: err}
+		}
 		field.AppendNull()
 		return
 	}
-	field.(*array.Float64Builder).Append(v)
+	field.Append(v)
 }
 
 // Retain increases the reference count by 1.
@@ -520,6 +428,9 @@ func (r *Reader) Release() {
 	debug.Assert(atomic.LoadInt64(&r.refs) > 0, "too many releases")
 
 	if atomic.AddInt64(&r.refs, -1) == 0 {
+		if r.par != nil {
+			r.par.stop()
+		}
 		if r.cur != nil {
 			r.cur.Release()
 		}
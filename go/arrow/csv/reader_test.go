@@ -18,11 +18,14 @@ package csv_test
 
 import (
 	"bytes"
+	stdcsv "encoding/csv"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/csv"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -537,6 +540,262 @@ rec[0]["str"]: ["str-0" "str-1" "str-2" "str-3" "str-4" "str-5" "str-6" "str-7"
 	}
 }
 
+func TestCSVReaderParseConcurrency(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := new(bytes.Buffer)
+	fmt.Fprintf(raw, "i64;f64;str\n")
+	for i := 0; i < 100; i++ {
+		switch {
+		case i%7 == 0:
+			fmt.Fprintf(raw, "%d;%d;\"multi\nline;\"\"%d\"\"\"\n", i, i, i)
+		case i%11 == 0:
+			fmt.Fprintf(raw, "# comment with a \" quote\n\n%d;%d;str-%d\r\n", i, i, i)
+		default:
+			fmt.Fprintf(raw, "%d;%d;str-%d\n", i, i, i)
+		}
+	}
+	fmt.Fprintf(raw, "100;100;no-newline")
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+			arrow.Field{Name: "str", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+
+	read := func(chunk, n int) string {
+		r := csv.NewReader(
+			bytes.NewReader(raw.Bytes()), schema,
+			csv.WithAllocator(mem), csv.WithComment('#'), csv.WithComma(';'),
+			csv.WithHeader(true), csv.WithChunk(chunk), csv.WithParseConcurrency(n),
+		)
+		defer r.Release()
+
+		out := new(strings.Builder)
+		rows := 0
+		for i := 0; r.Next(); i++ {
+			rec := r.Record()
+			rows += int(rec.NumRows())
+			for j, col := range rec.Columns() {
+				fmt.Fprintf(out, "rec[%d][%q]: %v\n", i, rec.ColumnName(j), col)
+			}
+		}
+		if err := r.Err(); err != nil {
+			t.Fatalf("chunk=%d, n=%d: unexpected error: %v", chunk, n, err)
+		}
+		if rows != 101 {
+			t.Fatalf("chunk=%d, n=%d: invalid number of rows: got=%d, want=%d", chunk, n, rows, 101)
+		}
+		return out.String()
+	}
+
+	for _, chunk := range []int{2, 3, 7, 1000} {
+		want := read(chunk, 1)
+		for _, n := range []int{2, 4, 8} {
+			t.Run(fmt.Sprintf("chunk=%d n=%d", chunk, n), func(t *testing.T) {
+				if got := read(chunk, n); got != want {
+					t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestCSVReaderParseConcurrencyError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	raw := new(bytes.Buffer)
+	for i := 0; i < 100; i++ {
+		if i == 42 {
+			fmt.Fprintf(raw, "%d;%d;bare\"quote\n", i, i)
+			continue
+		}
+		fmt.Fprintf(raw, "%d;%d;str-%d\n", i, i, i)
+	}
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+			arrow.Field{Name: "str", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+
+	r := csv.NewReader(
+		bytes.NewReader(raw.Bytes()), schema,
+		csv.WithAllocator(mem), csv.WithComma(';'),
+		csv.WithChunk(10), csv.WithParseConcurrency(4),
+	)
+	defer r.Release()
+
+	rows := 0
+	for r.Next() {
+		rows += int(r.Record().NumRows())
+	}
+
+	if rows != 40 {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", rows, 40)
+	}
+
+	perr, ok := r.Err().(*stdcsv.ParseError)
+	if !ok {
+		t.Fatalf("invalid error type: %T (%v)", r.Err(), r.Err())
+	}
+	if got, want := perr.Line, 43; got != want {
+		t.Fatalf("invalid error line: got=%d, want=%d", got, want)
+	}
+}
+
+func TestCSVReaderInvalidValue(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8},
+			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean},
+		},
+		nil,
+	)
+	r := csv.NewReader(strings.NewReader("1,true\n300,False\n"), schema, csv.WithAllocator(mem), csv.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	if got, want := r.Record().Column(0).NullN(), 1; got != want {
+		t.Fatalf("invalid number of nulls: got=%d, want=%d", got, want)
+	}
+	err := r.Err()
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if !strings.Contains(err.Error(), "int8") {
+		t.Fatalf("error does not mention the data type: %v", err)
+	}
+}
+
+func TestCSVReaderBooleanParser(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true}}, nil)
+	raw := "Yes\nno\n\"\"\nT\nf\n 1 \n0\nY\nN\nTRUE\nFalse\n"
+
+	r := csv.NewReader(strings.NewReader(raw), schema,
+		csv.WithAllocator(mem), csv.WithChunk(-1),
+		csv.WithNullReader(false, ""),
+		csv.WithBooleanParser(csv.ParseBoolLenient),
+	)
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	arr := r.Record().Column(0).(*array.Boolean)
+	if got, want := arr.String(), "[true false (null) true false true false true false true false]"; got != want {
+		t.Fatalf("invalid values:\ngot= %s\nwant=%s", got, want)
+	}
+}
+
+func TestCSVReaderInvalidBoolean(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "b", Type: arrow.FixedWidthTypes.Boolean},
+		},
+		nil,
+	)
+
+	var raw bytes.Buffer
+	for i := 0; i < 100; i++ {
+		v := "true"
+		if i == 70 {
+			v = "maybe"
+		}
+		fmt.Fprintf(&raw, "%d,%s\n", i, v)
+	}
+
+	for _, tc := range []struct {
+		name string
+		opts []csv.Option
+	}{
+		{"row", []csv.Option{csv.WithChunk(1)}},
+		{"chunk", []csv.Option{csv.WithChunk(16)}},
+		{"all", []csv.Option{csv.WithChunk(-1)}},
+		{"parallel", []csv.Option{csv.WithChunk(16), csv.WithParseConcurrency(4)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			opts := append([]csv.Option{csv.WithAllocator(mem)}, tc.opts...)
+			r := csv.NewReader(bytes.NewReader(raw.Bytes()), schema, opts...)
+			defer r.Release()
+
+			for r.Next() {
+			}
+
+			perr, ok := r.Err().(*stdcsv.ParseError)
+			if !ok {
+				t.Fatalf("invalid error type: %T (%v)", r.Err(), r.Err())
+			}
+			if got, want := perr.Line, 71; got != want {
+				t.Fatalf("invalid error line: got=%d, want=%d", got, want)
+			}
+			if got, want := perr.Column, 4; got != want {
+				t.Fatalf("invalid error column: got=%d, want=%d", got, want)
+			}
+			if !strings.Contains(perr.Error(), `"maybe"`) {
+				t.Fatalf("error does not mention the invalid value: %v", perr)
+			}
+		})
+	}
+}
+
+func TestCSVReaderChunkLargerThanInput(t *testing.T) {
+	mem := &peakAllocator{CheckedAllocator: memory.NewCheckedAllocator(memory.NewGoAllocator())}
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "s", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+
+	const chunk = 1 << 20
+	r := csv.NewReader(strings.NewReader("1,a\n2,b\n3,c\n"), schema, csv.WithAllocator(mem), csv.WithChunk(chunk))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("no record: %v", r.Err())
+	}
+	if got, want := r.Record().NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if r.Next() {
+		t.Fatalf("unexpected record: %v", r.Record())
+	}
+
+	// pre-allocating the builders for a whole chunk would take at least
+	// chunk*8 bytes for the int64 column alone.
+	if mem.peak > 4096 {
+		t.Fatalf("%d bytes allocated to read 3 rows with chunks of %d rows", mem.peak, chunk)
+	}
+}
+
 func BenchmarkRead(b *testing.B) {
 	gen := func(rows, cols int) []byte {
 		buf := new(bytes.Buffer)
@@ -564,7 +823,36 @@ func BenchmarkRead(b *testing.B) {
 	}
 }
 
-func benchRead(b *testing.B, raw []byte, rows, cols, chunks int) {
+// BenchmarkReadParallel measures the speedup brought by WithParseConcurrency.
+// Increase rows to benchmark against larger inputs.
+func BenchmarkReadParallel(b *testing.B) {
+	const (
+		rows  = 1e6
+		cols  = 4
+		chunk = 1e4
+	)
+
+	buf := new(bytes.Buffer)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if j > 0 {
+				fmt.Fprintf(buf, ";")
+			}
+			fmt.Fprintf(buf, "%d;%f;str-%d", i, float64(i), i)
+		}
+		fmt.Fprintf(buf, "\n")
+	}
+	raw := buf.Bytes()
+
+	for _, n := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(len(raw)))
+			benchRead(b, raw, rows, cols, rows/chunk, csv.WithParseConcurrency(n))
+		})
+	}
+}
+
+func benchRead(b *testing.B, raw []byte, rows, cols, chunks int, extra ...csv.Option) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(b, 0)
 
@@ -586,7 +874,9 @@ func benchRead(b *testing.B, raw []byte, rows, cols, chunks int) {
 		csv.WithAllocator(mem), csv.WithComment('#'), csv.WithComma(';'),
 		csv.WithChunk(chunk),
 	}
+	opts = append(opts, extra...)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		r := csv.NewReader(bytes.NewReader(raw), schema, opts...)
@@ -17,24 +17,45 @@
 package csv
 
 import (
-	"encoding/csv"
+	"bufio"
 	"io"
 	"strconv"
 	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 )
 
-// Writer wraps encoding/csv.Writer and writes array.Record based on a schema.
+// Writer writes array.Record based on a schema, following the same
+// quoting rules as encoding/csv.Writer.
+//
+// Rows are formatted field by field into a scratch buffer reused across
+// rows, so that writing does not allocate per row nor per field.
 type Writer struct {
-	w         *csv.Writer
+	w         *bufio.Writer
+	comma     rune
+	crlf      bool
 	schema    *arrow.Schema
 	header    bool
 	once      sync.Once
 	nullValue string
+	bufSize   int
+
+	boolValues [2]string // formatted false and true values.
+	floatFmt   byte      // format and precision of floating point values,
+	floatPrec  int       // as for strconv.FormatFloat.
+	err        error
+
+	line  []byte // scratch buffer for the current row.
+	field []byte // scratch buffer for the current field.
+	fmts  []fieldFormatter
 }
 
+// fieldFormatter appends the formatted i-th value of a column to dst.
+type fieldFormatter func(dst []byte, i int) []byte
+
 // NewWriter returns a writer that writes array.Records to the CSV file
 // with the given schema.
 //
@@ -44,13 +65,18 @@ func NewWriter(w io.Writer, schema *arrow.Schema, opts ...Option) *Writer {
 	validate(schema)
 
 	ww := &Writer{
-		w:         csv.NewWriter(w),
-		schema:    schema,
-		nullValue: "NULL", // override by passing WithNullWriter() as an option
+		comma:      ',',
+		schema:     schema,
+		nullValue:  "NULL", // override by passing WithNullWriter() as an option
+		boolValues: [2]string{"false", "true"},
+		floatFmt:   'g',
+		floatPrec:  -1,
+		fmts:       make([]fieldFormatter, len(schema.Fields())),
 	}
 	for _, opt := range opts {
 		opt(ww)
 	}
+	ww.w = bufio.NewWriterSize(w, ww.bufSize)
 
 	return ww
 }
@@ -73,146 +99,213 @@ func (w *Writer) Write(record array.Record) error {
 		}
 	}
 
-	recs := make([][]string, record.NumRows())
-	for i := range recs {
-		recs[i] = make([]string, record.NumCols())
+	for j, col := range record.Columns() {
+		w.fmts[j] = w.formatter(col)
 	}
 
-	for j, col := range record.Columns() {
-		switch w.schema.Field(j).Type.(type) {
-		case *arrow.BooleanType:
-			arr := col.(*array.Boolean)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatBool(arr.Value(i))
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int8Type:
-			arr := col.(*array.Int8)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int16Type:
-			arr := col.(*array.Int16)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Int32Type:
-			arr := col.(*array.Int32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
+	for i, n := 0, int(record.NumRows()); i < n; i++ {
+		w.line = w.line[:0]
+		for j, format := range w.fmts {
+			if j > 0 {
+				w.line = appendRune(w.line, w.comma)
 			}
-		case *arrow.Int64Type:
-			arr := col.(*array.Int64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatInt(int64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint8Type:
-			arr := col.(*array.Uint8)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint16Type:
-			arr := col.(*array.Uint16)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint32Type:
-			arr := col.(*array.Uint32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Uint64Type:
-			arr := col.(*array.Uint64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatUint(uint64(arr.Value(i)), 10)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Float32Type:
-			arr := col.(*array.Float32)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatFloat(float64(arr.Value(i)), 'g', -1, 32)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.Float64Type:
-			arr := col.(*array.Float64)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = strconv.FormatFloat(float64(arr.Value(i)), 'g', -1, 64)
-				} else {
-					recs[i][j] = w.nullValue
-				}
-			}
-		case *arrow.StringType:
-			arr := col.(*array.String)
-			for i := 0; i < arr.Len(); i++ {
-				if arr.IsValid(i) {
-					recs[i][j] = arr.Value(i)
-				} else {
-					recs[i][j] = w.nullValue
-				}
+			w.field = format(w.field[:0], i)
+			w.line = w.appendField(w.line, w.field)
+		}
+		if err := w.writeLine(); err != nil {
+			return err
+		}
+	}
+
+	for j := range w.fmts {
+		w.fmts[j] = nil // do not keep the columns alive.
+	}
+
+	return w.Flush()
+}
+
+// formatter returns the function formatting the values of col.
+func (w *Writer) formatter(col array.Interface) fieldFormatter {
+	var format fieldFormatter
+	switch arr := col.(type) {
+	case *array.Boolean:
+		format = func(dst []byte, i int) []byte {
+			if arr.Value(i) {
+				return append(dst, w.boolValues[1]...)
 			}
+			return append(dst, w.boolValues[0]...)
+		}
+	case *array.Int8:
+		format = func(dst []byte, i int) []byte { return strconv.AppendInt(dst, int64(arr.Value(i)), 10) }
+	case *array.Int16:
+		format = func(dst []byte, i int) []byte { return strconv.AppendInt(dst, int64(arr.Value(i)), 10) }
+	case *array.Int32:
+		format = func(dst []byte, i int) []byte { return strconv.AppendInt(dst, int64(arr.Value(i)), 10) }
+	case *array.Int64:
+		format = func(dst []byte, i int) []byte { return strconv.AppendInt(dst, arr.Value(i), 10) }
+	case *array.Uint8:
+		format = func(dst []byte, i int) []byte { return strconv.AppendUint(dst, uint64(arr.Value(i)), 10) }
+	case *array.Uint16:
+		format = func(dst []byte, i int) []byte { return strconv.AppendUint(dst, uint64(arr.Value(i)), 10) }
+	case *array.Uint32:
+		format = func(dst []byte, i int) []byte { return strconv.AppendUint(dst, uint64(arr.Value(i)), 10) }
+	case *array.Uint64:
+		format = func(dst []byte, i int) []byte { return strconv.AppendUint(dst, arr.Value(i), 10) }
+	case *array.Float32:
+		format = func(dst []byte, i int) []byte {
+			return strconv.AppendFloat(dst, float64(arr.Value(i)), w.floatFmt, w.floatPrec, 32)
 		}
+	case *array.Float64:
+		format = func(dst []byte, i int) []byte {
+			return strconv.AppendFloat(dst, arr.Value(i), w.floatFmt, w.floatPrec, 64)
+		}
+	case *array.String:
+		format = func(dst []byte, i int) []byte { return append(dst, arr.Value(i)...) }
 	}
 
-	return w.w.WriteAll(recs)
+	if col.NullN() == 0 {
+		return format
+	}
+	return func(dst []byte, i int) []byte {
+		if col.IsNull(i) {
+			return append(dst, w.nullValue...)
+		}
+		return format(dst, i)
+	}
 }
 
-// Flush writes any buffered data to the underlying csv Writer.
+// Flush writes any buffered data to the underlying io.Writer.
 // If an error occurred during the Flush, return it
 func (w *Writer) Flush() error {
-	w.w.Flush()
-	return w.w.Error()
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
 }
 
 // Error reports any error that has occurred during a previous Write or Flush.
 func (w *Writer) Error() error {
-	return w.w.Error()
+	return w.err
 }
 
 func (w *Writer) writeHeader() error {
-	headers := make([]string, len(w.schema.Fields()))
-	for i := range headers {
-		headers[i] = w.schema.Field(i).Name
+	w.line = w.line[:0]
+	for i, f := range w.schema.Fields() {
+		if i > 0 {
+			w.line = appendRune(w.line, w.comma)
+		}
+		w.line = w.appendField(w.line, append(w.field[:0], f.Name...))
 	}
-	if err := w.w.Write(headers); err != nil {
-		return err
+	return w.writeLine()
+}
+
+// writeLine terminates the current row and writes it to the buffered writer.
+func (w *Writer) writeLine() error {
+	if w.err != nil {
+		return w.err
+	}
+	if !validDelim(w.comma) {
+		return errInvalidDelim
+	}
+	if w.crlf {
+		w.line = append(w.line, '\r', '\n')
+	} else {
+		w.line = append(w.line, '\n')
+	}
+	_, w.err = w.w.Write(w.line)
+	return w.err
+}
+
+// appendField appends field to dst, quoted if needed.
+func (w *Writer) appendField(dst, field []byte) []byte {
+	if !w.fieldNeedsQuotes(field) {
+		return append(dst, field...)
+	}
+
+	dst = append(dst, '"')
+	for _, c := range field {
+		switch c {
+		case '"':
+			dst = append(dst, '"', '"')
+		case '\r':
+			if !w.crlf {
+				dst = append(dst, '\r')
+			}
+		case '\n':
+			if w.crlf {
+				dst = append(dst, '\r', '\n')
+			} else {
+				dst = append(dst, '\n')
+			}
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return append(dst, '"')
+}
+
+// fieldNeedsQuotes reports whether our field must be enclosed in quotes.
+// Fields with a comma, fields with a quote or newline, and fields which
+// start with a space must be enclosed in quotes, as with encoding/csv.
+func (w *Writer) fieldNeedsQuotes(field []byte) bool {
+	if len(field) == 0 {
+		return false
+	}
+	if len(field) == 2 && field[0] == '\\' && field[1] == '.' {
+		return true
+	}
+
+	for i := 0; i < len(field); {
+		r, size := rune(field[i]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(field[i:])
+		}
+		if r == '\n' || r == '\r' || r == '"' || r == w.comma {
+			return true
+		}
+		i += size
+	}
+
+	r, _ := utf8.DecodeRune(field)
+	return unicode.IsSpace(r)
+}
+
+func appendRune(dst []byte, r rune) []byte {
+	if r < utf8.RuneSelf {
+		return append(dst, byte(r))
+	}
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return append(dst, buf[:n]...)
+}
+
+func validDelim(r rune) bool {
+	return r != 0 && r != '"' && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+}
+
+// WriteTable writes the rows of tbl to w as CSV, chunkSize rows at a time,
+// and flushes w after each chunk.
+// Records are sliced out of the columns of tbl, so that the memory used while
+// writing does not depend on the number of rows of tbl.
+//
+// If chunkSize is not positive, each record holds a whole chunk of the
+// columns of tbl.
+func WriteTable(w io.Writer, tbl array.Table, chunkSize int64, opts ...Option) error {
+	tr := array.NewTableReader(tbl, chunkSize)
+	defer tr.Release()
+
+	return WriteReader(w, tr, opts...)
+}
+
+// WriteReader writes all the records of r to w as CSV, and flushes w after
+// each record.
+func WriteReader(w io.Writer, r array.RecordReader, opts ...Option) error {
+	ww := NewWriter(w, r.Schema(), opts...)
+	for r.Next() {
+		if err := ww.Write(r.Record()); err != nil {
+			return err
+		}
 	}
-	return nil
+	return ww.Flush()
 }
@@ -18,9 +18,13 @@ package csv_test
 
 import (
 	"bytes"
+	stdcsv "encoding/csv"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
+	"math/rand"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -139,18 +143,18 @@ func testCSVWriter(t *testing.T, writeHeader bool) {
 	defer pool.AssertSize(t, 0)
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean},
-			{Name: "i8", Type: arrow.PrimitiveTypes.Int8},
-			{Name: "i16", Type: arrow.PrimitiveTypes.Int16},
-			{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
-			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
-			{Name: "u8", Type: arrow.PrimitiveTypes.Uint8},
-			{Name: "u16", Type: arrow.PrimitiveTypes.Uint16},
-			{Name: "u32", Type: arrow.PrimitiveTypes.Uint32},
-			{Name: "u64", Type: arrow.PrimitiveTypes.Uint64},
-			{Name: "f32", Type: arrow.PrimitiveTypes.Float32},
-			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
-			{Name: "str", Type: arrow.BinaryTypes.String},
+			{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "i16", Type: arrow.PrimitiveTypes.Int16, Nullable: true},
+			{Name: "i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "u8", Type: arrow.PrimitiveTypes.Uint8, Nullable: true},
+			{Name: "u16", Type: arrow.PrimitiveTypes.Uint16, Nullable: true},
+			{Name: "u32", Type: arrow.PrimitiveTypes.Uint32, Nullable: true},
+			{Name: "u64", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
 		},
 		nil,
 	)
@@ -214,6 +218,357 @@ null;null;null;null;null;null;null;null;null;null;null;null
 	}
 }
 
+func TestCSVWriterQuoting(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+			{Name: "str", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+	var (
+		f64s = []float64{1.5, -2, 3, 0.25, 1e21, 6, 7, 8}
+		strs = []string{"a;b", `say "hi"`, " lead", `\.`, "line\nbreak", "cr\r\nlf", "", "é.ü"}
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+	b.Field(0).(*array.Float64Builder).AppendValues(f64s, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues(strs, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	for _, comma := range []rune{',', ';', '.', 'é'} {
+		for _, crlf := range []bool{false, true} {
+			t.Run(fmt.Sprintf("comma=%q crlf=%v", comma, crlf), func(t *testing.T) {
+				got := new(bytes.Buffer)
+				w := csv.NewWriter(got, schema, csv.WithComma(comma), csv.WithCRLF(crlf), csv.WithHeader(true))
+				if err := w.Write(rec); err != nil {
+					t.Fatal(err)
+				}
+
+				want := new(bytes.Buffer)
+				ref := stdcsv.NewWriter(want)
+				ref.Comma = comma
+				ref.UseCRLF = crlf
+				ref.Write([]string{"f64", "str"})
+				for i := range strs {
+					ref.Write([]string{fmt.Sprint(f64s[i]), strs[i]})
+				}
+				ref.Flush()
+
+				if got, want := got.String(), want.String(); got != want {
+					t.Fatalf("invalid output:\ngot=%q\nwant=%q", got, want)
+				}
+			})
+		}
+	}
+
+	w := csv.NewWriter(ioutil.Discard, schema, csv.WithComma('"'))
+	if err := w.Write(rec); err == nil {
+		t.Fatalf("expected an error for an invalid delimiter")
+	}
+}
+
+// peakAllocator records the peak of the memory allocated through it.
+type peakAllocator struct {
+	*memory.CheckedAllocator
+	cur, peak int
+}
+
+func (a *peakAllocator) Allocate(size int) []byte {
+	a.update(size)
+	return a.CheckedAllocator.Allocate(size)
+}
+
+func (a *peakAllocator) Reallocate(size int, b []byte) []byte {
+	a.update(size - len(b))
+	return a.CheckedAllocator.Reallocate(size, b)
+}
+
+func (a *peakAllocator) Free(b []byte) {
+	a.update(-len(b))
+	a.CheckedAllocator.Free(b)
+}
+
+func (a *peakAllocator) update(delta int) {
+	a.cur += delta
+	if a.cur > a.peak {
+		a.peak = a.cur
+	}
+}
+
+type countingWriter struct {
+	n, writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	w.writes++
+	return len(p), nil
+}
+
+func TestWriteTable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large table in short mode")
+	}
+
+	pool := &peakAllocator{CheckedAllocator: memory.NewCheckedAllocator(memory.NewGoAllocator())}
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+
+	const (
+		nrecs = 16
+		nrows = 1 << 16
+		chunk = 4096
+
+		bufSize = 1 << 16
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	recs := make([]array.Record, nrecs)
+	for i := range recs {
+		for j := 0; j < nrows; j++ {
+			v := i*nrows + j
+			b.Field(0).(*array.Int64Builder).Append(int64(v))
+			if v%7 == 0 {
+				b.Field(1).(*array.Float64Builder).AppendNull()
+			} else {
+				b.Field(1).(*array.Float64Builder).Append(float64(v) / 4)
+			}
+			b.Field(2).(*array.StringBuilder).Append("row")
+		}
+		recs[i] = b.NewRecord()
+		defer recs[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, recs)
+	defer tbl.Release()
+
+	var (
+		out    = new(countingWriter)
+		before runtime.MemStats
+		after  runtime.MemStats
+	)
+	base := pool.cur
+	pool.peak = base
+
+	runtime.ReadMemStats(&before)
+	err := csv.WriteTable(out, tbl, chunk, csv.WithHeader(true), csv.WithBufferSize(bufSize))
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := pool.peak, base; got != want {
+		t.Fatalf("invalid peak arrow memory: got=%d, want=%d", got, want)
+	}
+	// besides the output buffer, the Go heap used while writing grows with
+	// the number of chunks, not with the number of rows.
+	if got, max := after.TotalAlloc-before.TotalAlloc, uint64(bufSize+nrecs*nrows/chunk*2048); got > max {
+		t.Fatalf("too much memory allocated: got=%d bytes, want<=%d", got, max)
+	}
+	if got, min := out.writes, nrecs*nrows/chunk; got < min {
+		t.Fatalf("invalid number of writes: got=%d, want>=%d", got, min)
+	}
+
+	want := new(bytes.Buffer)
+	w := csv.NewWriter(want, schema, csv.WithHeader(true))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := out.n, want.Len(); got != want {
+		t.Fatalf("invalid output size: got=%d, want=%d", got, want)
+	}
+}
+
+func TestCSVBooleanRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		},
+		nil,
+	)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	bld.Field(0).(*array.Int64Builder).AppendValues([]int64{0, 1, 2, 3, 4}, nil)
+	bld.Field(1).(*array.BooleanBuilder).AppendValues(
+		[]bool{true, false, false, true, false},
+		[]bool{true, true, false, true, true},
+	)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		name   string
+		values [2]string // written true and false values.
+		null   string
+		parse  func(string) (bool, error)
+		want   string
+	}{
+		{"default", [2]string{"true", "false"}, "", nil, "0,true\n1,false\n2,\n3,true\n4,false\n"},
+		{"digits", [2]string{"1", "0"}, "", nil, "0,1\n1,0\n2,\n3,1\n4,0\n"},
+		{"digits-lenient", [2]string{"1", "0"}, "", csv.ParseBoolLenient, "0,1\n1,0\n2,\n3,1\n4,0\n"},
+		{"yes-no", [2]string{"yes", "no"}, "NULL", csv.ParseBoolLenient, "0,yes\n1,no\n2,NULL\n3,yes\n4,no\n"},
+		{"letters", [2]string{"T", "F"}, "N/A", csv.ParseBoolLenient, "0,T\n1,F\n2,N/A\n3,T\n4,F\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w := csv.NewWriter(buf, schema,
+				csv.WithNullWriter(tc.null),
+				csv.WithBooleanWriter(tc.values[0], tc.values[1]),
+			)
+			if err := w.Write(rec); err != nil {
+				t.Fatalf("could not write record: %v", err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Fatalf("invalid output:\ngot= %q\nwant=%q", got, tc.want)
+			}
+
+			opts := []csv.Option{csv.WithAllocator(mem), csv.WithChunk(-1), csv.WithNullReader(false, tc.null)}
+			if tc.parse != nil {
+				opts = append(opts, csv.WithBooleanParser(tc.parse))
+			}
+			r := csv.NewReader(buf, schema, opts...)
+			defer r.Release()
+
+			if !r.Next() {
+				t.Fatalf("could not read record: %v", r.Err())
+			}
+			if err := r.Err(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !array.RecordEqual(r.Record(), rec) {
+				t.Fatalf("invalid record:\ngot= %v\nwant=%v", r.Record().Column(1), rec.Column(1))
+			}
+		})
+	}
+}
+
+func TestCSVFloatRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		},
+		nil,
+	)
+
+	f64s := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1.0 / 3, 1e21, 1e-7,
+		math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.Float64frombits(0x000fffffffffffff), // largest subnormal.
+		math.Inf(+1), math.Inf(-1), math.NaN(),
+	}
+	f32s := []float32{
+		0, float32(math.Copysign(0, -1)), 1, -1, 0.1, 1.0 / 3, 1e21, 1e-7,
+		math.MaxFloat32, -math.MaxFloat32, math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32,
+		math.Float32frombits(0x007fffff), // largest subnormal.
+		float32(math.Inf(+1)), float32(math.Inf(-1)), float32(math.NaN()),
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for len(f64s) < 1000 {
+		f64s = append(f64s, math.Float64frombits(rnd.Uint64()))
+		f32s = append(f32s, math.Float32frombits(rnd.Uint32()))
+	}
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	bld.Field(0).(*array.Float32Builder).AppendValues(f32s, nil)
+	bld.Field(1).(*array.Float64Builder).AppendValues(f64s, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf, schema)
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %v", err)
+	}
+
+	r := csv.NewReader(buf, schema, csv.WithAllocator(mem), csv.WithChunk(-1))
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read record: %v", r.Err())
+	}
+	var (
+		g32 = r.Record().Column(0).(*array.Float32).Float32Values()
+		g64 = r.Record().Column(1).(*array.Float64).Float64Values()
+	)
+	for i := range f64s {
+		if got, want := g32[i], f32s[i]; math.Float32bits(got) != math.Float32bits(want) && !(math.IsNaN(float64(got)) && math.IsNaN(float64(want))) {
+			t.Errorf("invalid float32 value %d: got=%v, want=%v", i, got, want)
+		}
+		if got, want := g64[i], f64s[i]; math.Float64bits(got) != math.Float64bits(want) && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("invalid float64 value %d: got=%v, want=%v", i, got, want)
+		}
+	}
+}
+
+func TestCSVWriterFloatFormat(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		},
+		nil,
+	)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	bld.Field(0).(*array.Float32Builder).AppendValues([]float32{0.1, 1e21, 2.5}, nil)
+	bld.Field(1).(*array.Float64Builder).AppendValues([]float64{0.1, 1e21, 1.0 / 3}, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		name string
+		opts []csv.Option
+		want string
+	}{
+		{"default", nil, "0.1,0.1\n1e+21,1e+21\n2.5,0.3333333333333333\n"},
+		{"fixed", []csv.Option{csv.WithFloatWriter('f', 2)}, "0.10,0.10\n1000000020040877342720.00,1000000000000000000000.00\n2.50,0.33\n"},
+		{"exponent", []csv.Option{csv.WithFloatWriter('e', 3)}, "1.000e-01,1.000e-01\n1.000e+21,1.000e+21\n2.500e+00,3.333e-01\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w := csv.NewWriter(buf, schema, tc.opts...)
+			if err := w.Write(rec); err != nil {
+				t.Fatalf("could not write record: %v", err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Fatalf("invalid output:\ngot= %q\nwant=%q", got, tc.want)
+			}
+		})
+	}
+}
+
 func BenchmarkWrite(b *testing.B) {
 	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer pool.AssertSize(b, 0)
@@ -120,6 +120,13 @@ const (
 	// Measure of elapsed time in either seconds, milliseconds, microseconds
 	// or nanoseconds.
 	DURATION
+
+	// DECIMAL256 is a precision- and scale-based decimal type, stored
+	// as a 256-bit integer.
+	DECIMAL256
+
+	// LARGE_LIST is a list of some logical data type, with 64-bit offsets
+	LARGE_LIST
 )
 
 // DataType is the representation of an Arrow type.
@@ -141,3 +148,43 @@ type BinaryDataType interface {
 	DataType
 	binary()
 }
+
+// IsFixedWidth reports whether values of type t occupy a fixed number of
+// bits in memory.
+func IsFixedWidth(t Type) bool {
+	switch t {
+	case BOOL, FIXED_SIZE_BINARY, DECIMAL, DECIMAL256, INTERVAL,
+		DATE32, DATE64, TIMESTAMP, TIME32, TIME64, DURATION:
+		return true
+	}
+	return IsPrimitive(t)
+}
+
+// IsPrimitive reports whether t is a boolean, integer or floating point type.
+func IsPrimitive(t Type) bool {
+	switch t {
+	case BOOL,
+		UINT8, INT8, UINT16, INT16, UINT32, INT32, UINT64, INT64,
+		FLOAT16, FLOAT32, FLOAT64:
+		return true
+	}
+	return false
+}
+
+// IsNested reports whether t is a type made of child types.
+func IsNested(t Type) bool {
+	switch t {
+	case LIST, LARGE_LIST, FIXED_SIZE_LIST, STRUCT, UNION, MAP:
+		return true
+	}
+	return false
+}
+
+// IsBinaryLike reports whether t is a variable-length binary or string type.
+func IsBinaryLike(t Type) bool {
+	switch t {
+	case BINARY, STRING:
+		return true
+	}
+	return false
+}
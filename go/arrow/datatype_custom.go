@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import "fmt"
+
+// StorageProvider is the interface implemented by custom data types: logical
+// data types defined outside of this package, whose values are stored as
+// the values of one of the data types of this package.
+//
+// A custom data type must:
+//   - return EXTENSION from ID,
+//   - return a name identifying the custom type from Name, such as
+//     "example.geo-point". Names are written to and matched against the
+//     field metadata of IPC streams and files,
+//   - describe itself, including its parameters, with String, used when
+//     printing schemas,
+//   - return its storage type from StorageType, which must not change over
+//     the lifetime of the value.
+//
+// Data types are compared with TypeEqual, which compares the values of
+// custom types with reflect.DeepEqual: two values describing the same type
+// must hold the same fields.
+//
+// Builders and arrays of custom types are respectively array.CustomBuilder
+// and array.Custom: values are appended to, and read from, their storage.
+// IPC writers write custom types as their storage type, with the name of the
+// custom type in the field metadata.
+type StorageProvider interface {
+	DataType
+	fmt.Stringer
+
+	// StorageType returns the data type of the values of the custom type.
+	StorageType() DataType
+}
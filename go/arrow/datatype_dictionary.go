@@ -0,0 +1,29 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+// DictionaryType describes dictionary-encoded values: each array slot holds
+// an index into a dictionary array, holding the distinct values.
+type DictionaryType struct {
+	IndexType DataType // signed or unsigned integer data type of the indices
+	ValueType DataType // data type of the dictionary values
+	Ordered   bool     // whether the order of the dictionary values is meaningful
+}
+
+func (*DictionaryType) ID() Type         { return DICTIONARY }
+func (*DictionaryType) Name() string     { return "dictionary" }
+func (t *DictionaryType) String() string { return typeString(t) }
@@ -0,0 +1,61 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestDictionaryType(t *testing.T) {
+	dt := &arrow.DictionaryType{
+		IndexType: arrow.PrimitiveTypes.Int16,
+		ValueType: arrow.ListOf(arrow.BinaryTypes.String),
+		Ordered:   true,
+	}
+
+	if got, want := dt.ID(), arrow.DICTIONARY; got != want {
+		t.Fatalf("invalid type ID: got=%v, want=%v", got, want)
+	}
+	if got, want := dt.String(), "dictionary<values=list<item: utf8>, indices=int16, ordered=true>"; got != want {
+		t.Fatalf("invalid string: got=%q, want=%q", got, want)
+	}
+
+	same := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.ListOf(arrow.BinaryTypes.String), Ordered: true}
+	if !arrow.TypeEqual(dt, same) {
+		t.Fatalf("dictionary types should be equal")
+	}
+	for _, other := range []*arrow.DictionaryType{
+		{IndexType: arrow.PrimitiveTypes.Int32, ValueType: dt.ValueType, Ordered: true},
+		{IndexType: dt.IndexType, ValueType: arrow.BinaryTypes.String, Ordered: true},
+		{IndexType: dt.IndexType, ValueType: dt.ValueType},
+	} {
+		if arrow.TypeEqual(dt, other) {
+			t.Fatalf("%v and %v should differ", dt, other)
+		}
+	}
+
+	// the dictionary does not add a nesting level to its values.
+	f := arrow.Field{Name: "f", Type: dt}
+	if err := arrow.ValidateNestingDepth(f, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := arrow.ValidateNestingDepth(f, 0); err == nil {
+		t.Fatalf("expected a nesting error")
+	}
+}
@@ -19,6 +19,10 @@ package arrow
 import (
 	"fmt"
 	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
 )
 
 type BooleanType struct{}
@@ -61,6 +65,9 @@ const (
 
 func (u TimeUnit) String() string { return [...]string{"ns", "us", "ms", "s"}[uint(u)&3] }
 
+// Multiplier returns the number of nanoseconds in one unit.
+func (u TimeUnit) Multiplier() int64 { return [...]int64{1, 1e3, 1e6, 1e9}[uint(u)&3] }
+
 // TimestampType is encoded as a 64-bit signed integer since the UNIX epoch (2017-01-01T00:00:00Z).
 // The zero-value is a nanosecond and time zone neutral. Time zone neutral can be
 // considered UTC without having "UTC" as a time zone.
@@ -83,6 +90,81 @@ func (t *TimestampType) String() string {
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (*TimestampType) BitWidth() int { return 64 }
 
+// GetZone returns the location of the time zone of the timestamps.
+//
+// Time zone neutral timestamps (and the "UTC" time zone) are reported as time.UTC.
+// Time zones may either be an IANA time zone name (e.g. "America/New_York")
+// or an absolute UTC offset of the form "+HH:MM", "-HH:MM", "+HHMM" or "+HH".
+// Locations are loaded once and cached.
+func (t *TimestampType) GetZone() (*time.Location, error) {
+	return loadZone(t.TimeZone)
+}
+
+var zones sync.Map // map[string]*time.Location
+
+func loadZone(tz string) (*time.Location, error) {
+	switch tz {
+	case "", "UTC":
+		return time.UTC, nil
+	}
+
+	if loc, ok := zones.Load(tz); ok {
+		return loc.(*time.Location), nil
+	}
+
+	var (
+		loc *time.Location
+		err error
+	)
+	switch tz[0] {
+	case '+', '-':
+		loc, err = parseZoneOffset(tz)
+	default:
+		loc, err = time.LoadLocation(tz)
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("arrow: invalid time zone %q: %w", tz, err)
+	}
+
+	v, _ := zones.LoadOrStore(tz, loc)
+	return v.(*time.Location), nil
+}
+
+// parseZoneOffset parses a UTC offset of the form [+-]HH[[:]MM].
+func parseZoneOffset(tz string) (*time.Location, error) {
+	var (
+		sign = 1
+		hh   = tz[1:]
+		mm   = "00"
+	)
+	if tz[0] == '-' {
+		sign = -1
+	}
+	switch len(hh) {
+	case 2:
+	case 4:
+		hh, mm = hh[:2], hh[2:]
+	case 5:
+		if hh[2] != ':' {
+			return nil, xerrors.Errorf("invalid UTC offset")
+		}
+		hh, mm = hh[:2], hh[3:]
+	default:
+		return nil, xerrors.Errorf("invalid UTC offset")
+	}
+
+	h, err := strconv.ParseUint(hh, 10, 8)
+	if err != nil || h > 23 {
+		return nil, xerrors.Errorf("invalid UTC offset hours")
+	}
+	m, err := strconv.ParseUint(mm, 10, 8)
+	if err != nil || m > 59 {
+		return nil, xerrors.Errorf("invalid UTC offset minutes")
+	}
+
+	return time.FixedZone(tz, sign*int(h*3600+m*60)), nil
+}
+
 // Time32Type is encoded as a 32-bit signed integer, representing either seconds or milliseconds since midnight.
 type Time32Type struct {
 	Unit TimeUnit
@@ -132,11 +214,24 @@ type Decimal128Type struct {
 
 func (*Decimal128Type) ID() Type      { return DECIMAL }
 func (*Decimal128Type) Name() string  { return "decimal" }
-func (*Decimal128Type) BitWidth() int { return 16 }
+func (*Decimal128Type) BitWidth() int { return 128 }
 func (t *Decimal128Type) String() string {
 	return fmt.Sprintf("%s(%d, %d)", t.Name(), t.Precision, t.Scale)
 }
 
+// Decimal256Type represents a fixed-size 256-bit decimal type.
+type Decimal256Type struct {
+	Precision int32
+	Scale     int32
+}
+
+func (*Decimal256Type) ID() Type      { return DECIMAL256 }
+func (*Decimal256Type) Name() string  { return "decimal256" }
+func (*Decimal256Type) BitWidth() int { return 256 }
+func (t *Decimal256Type) String() string {
+	return fmt.Sprintf("%s(%d, %d)", t.Name(), t.Precision, t.Scale)
+}
+
 // MonthInterval represents a number of months.
 type MonthInterval int32
 
@@ -17,7 +17,9 @@
 package arrow_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +43,20 @@ func TestTimeUnit_String(t *testing.T) {
 	}
 }
 
+func TestTimeUnit_Multiplier(t *testing.T) {
+	for _, test := range []struct {
+		u   arrow.TimeUnit
+		exp time.Duration
+	}{
+		{arrow.Nanosecond, time.Nanosecond},
+		{arrow.Microsecond, time.Microsecond},
+		{arrow.Millisecond, time.Millisecond},
+		{arrow.Second, time.Second},
+	} {
+		assert.Equal(t, int64(test.exp), test.u.Multiplier(), "unit %v", test.u)
+	}
+}
+
 func TestDecimal128Type(t *testing.T) {
 	for _, tc := range []struct {
 		precision int32
@@ -53,7 +69,7 @@ func TestDecimal128Type(t *testing.T) {
 	} {
 		t.Run(tc.want, func(t *testing.T) {
 			dt := arrow.Decimal128Type{Precision: tc.precision, Scale: tc.scale}
-			if got, want := dt.BitWidth(), 16; got != want {
+			if got, want := dt.BitWidth(), 128; got != want {
 				t.Fatalf("invalid bitwidth: got=%d, want=%d", got, want)
 			}
 
@@ -68,6 +84,33 @@ func TestDecimal128Type(t *testing.T) {
 	}
 }
 
+func TestDecimal256Type(t *testing.T) {
+	for _, tc := range []struct {
+		precision int32
+		scale     int32
+		want      string
+	}{
+		{1, 10, "decimal256(1, 10)"},
+		{76, 10, "decimal256(76, 10)"},
+		{40, -2, "decimal256(40, -2)"},
+	} {
+		t.Run(tc.want, func(t *testing.T) {
+			dt := arrow.Decimal256Type{Precision: tc.precision, Scale: tc.scale}
+			if got, want := dt.BitWidth(), 256; got != want {
+				t.Fatalf("invalid bitwidth: got=%d, want=%d", got, want)
+			}
+
+			if got, want := dt.ID(), arrow.DECIMAL256; got != want {
+				t.Fatalf("invalid type ID: got=%v, want=%v", got, want)
+			}
+
+			if got, want := dt.String(), tc.want; got != want {
+				t.Fatalf("invalid stringer: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
+
 func TestFixedSizeBinaryType(t *testing.T) {
 	for _, tc := range []struct {
 		byteWidth int
@@ -131,6 +174,58 @@ func TestTimestampType(t *testing.T) {
 	}
 }
 
+func TestTimestampTypeGetZone(t *testing.T) {
+	ref := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		tz     string
+		name   string
+		offset int
+	}{
+		{"", "UTC", 0},
+		{"UTC", "UTC", 0},
+		{"America/New_York", "EDT", -4 * 3600},
+		{"Asia/Kolkata", "IST", 5*3600 + 30*60},
+		{"+05:30", "+05:30", 5*3600 + 30*60},
+		{"-0800", "-0800", -8 * 3600},
+		{"+01", "+01", 3600},
+		{"-00:00", "-00:00", 0},
+	} {
+		t.Run(tc.tz, func(t *testing.T) {
+			dt := &arrow.TimestampType{Unit: arrow.Second, TimeZone: tc.tz}
+			loc, err := dt.GetZone()
+			if err != nil {
+				t.Fatalf("could not load zone: %+v", err)
+			}
+
+			name, offset := ref.In(loc).Zone()
+			if name != tc.name || offset != tc.offset {
+				t.Fatalf("invalid zone: got=(%q, %d), want=(%q, %d)", name, offset, tc.name, tc.offset)
+			}
+
+			again, err := dt.GetZone()
+			if err != nil {
+				t.Fatalf("could not reload zone: %+v", err)
+			}
+			if again != loc {
+				t.Fatalf("zone %q was not cached", tc.tz)
+			}
+		})
+	}
+
+	for _, tz := range []string{"Mars/Olympus_Mons", "+5:30", "+24:00", "-05:60", "+05-30", "+"} {
+		t.Run(tz, func(t *testing.T) {
+			dt := &arrow.TimestampType{Unit: arrow.Second, TimeZone: tz}
+			_, err := dt.GetZone()
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !strings.Contains(err.Error(), tz) {
+				t.Fatalf("error %q does not mention time zone %q", err, tz)
+			}
+		})
+	}
+}
+
 func TestTime32Type(t *testing.T) {
 	for _, tc := range []struct {
 		unit arrow.TimeUnit
@@ -25,26 +25,66 @@ import (
 // ListType describes a nested type in which each array slot contains
 // a variable-size sequence of values, all having the same relative type.
 type ListType struct {
-	elem DataType // DataType of the list's elements
+	elem Field // field of the list's elements
 }
 
 // ListOf returns the list type with element type t.
 // For example, if t represents int32, ListOf(t) represents []int32.
+// The elements of the list are described by a nullable field named "item".
 //
 // ListOf panics if t is nil or invalid.
 func ListOf(t DataType) *ListType {
-	if t == nil {
+	return ListOfField(Field{Name: "item", Type: t, Nullable: true})
+}
+
+// ListOfField returns the list type whose elements are described by f,
+// e.g. to declare non-nullable elements, or elements carrying metadata.
+//
+// ListOfField panics if the data type of f is nil or invalid.
+func ListOfField(f Field) *ListType {
+	if f.Type == nil {
 		panic("arrow: nil DataType")
 	}
-	return &ListType{elem: t}
+	if f.Metadata.Len() == 0 {
+		f.Metadata = Metadata{}
+	}
+	return &ListType{elem: f}
 }
 
 func (*ListType) ID() Type         { return LIST }
 func (*ListType) Name() string     { return "list" }
-func (t *ListType) String() string { return fmt.Sprintf("list<item: %v>", t.elem) }
+func (t *ListType) String() string { return typeString(t) }
 
 // Elem returns the ListType's element type.
-func (t *ListType) Elem() DataType { return t.elem }
+func (t *ListType) Elem() DataType { return t.elem.Type }
+
+// ElemField returns the field describing the ListType's elements.
+func (t *ListType) ElemField() Field { return t.elem }
+
+// LargeListType describes a nested type in which each array slot contains
+// a variable-size sequence of values, all having the same relative type.
+// Unlike ListType, its offsets are 64-bit integers, allowing more than 2^31
+// elements in the flattened values.
+type LargeListType struct {
+	elem DataType // DataType of the list's elements
+}
+
+// LargeListOf returns the large list type with element type t.
+//
+// LargeListOf panics if t is nil or invalid.
+func LargeListOf(t DataType) *LargeListType {
+	if t == nil {
+		panic("arrow: nil DataType")
+	}
+	return &LargeListType{elem: t}
+}
+
+func (*LargeListType) ID() Type         { return LARGE_LIST }
+func (*LargeListType) Name() string     { return "large_list" }
+func (t *LargeListType) String() string { return typeString(t) }
+
+// Elem returns the LargeListType's element type.
+func (t *LargeListType) Elem() DataType { return t.elem }
 
 // FixedSizeListType describes a nested type in which each array slot contains
 // a fixed-size sequence of values, all having the same relative type.
@@ -68,11 +108,9 @@ func FixedSizeListOf(n int32, t DataType) *FixedSizeListType {
 	return &FixedSizeListType{elem: t, n: n}
 }
 
-func (*FixedSizeListType) ID() Type     { return FIXED_SIZE_LIST }
-func (*FixedSizeListType) Name() string { return "fixed_size_list" }
-func (t *FixedSizeListType) String() string {
-	return fmt.Sprintf("fixed_size_list<item: %v>[%d]", t.elem, t.n)
-}
+func (*FixedSizeListType) ID() Type         { return FIXED_SIZE_LIST }
+func (*FixedSizeListType) Name() string     { return "fixed_size_list" }
+func (t *FixedSizeListType) String() string { return typeString(t) }
 
 // Elem returns the FixedSizeListType's element type.
 func (t *FixedSizeListType) Elem() DataType { return t.elem }
@@ -80,17 +118,61 @@ func (t *FixedSizeListType) Elem() DataType { return t.elem }
 // Len returns the FixedSizeListType's size.
 func (t *FixedSizeListType) Len() int32 { return t.n }
 
+// MapType describes a nested type in which each array slot contains a
+// variable-size sequence of key-item pairs.
+//
+// A map is laid out as a list of non-nullable "entries" structs, each made of
+// a non-nullable "key" field and a nullable "value" field holding the item.
+type MapType struct {
+	value *ListType // list of the map's entries.
+
+	// KeysSorted tells whether the keys of each map are sorted.
+	KeysSorted bool
+}
+
+// MapOf returns the map type with keys of type key and items of type item.
+// For example, if key represents utf8 and item represents int32, MapOf(key, item)
+// represents map[string]int32.
+//
+// MapOf panics if key or item is nil.
+func MapOf(key, item DataType) *MapType {
+	if key == nil || item == nil {
+		panic("arrow: nil DataType")
+	}
+	return &MapType{value: ListOf(StructOf(
+		Field{Name: "key", Type: key},
+		Field{Name: "value", Type: item, Nullable: true},
+	))}
+}
+
+func (*MapType) ID() Type         { return MAP }
+func (*MapType) Name() string     { return "map" }
+func (t *MapType) String() string { return typeString(t) }
+
+// KeyType returns the MapType's key type.
+func (t *MapType) KeyType() DataType { return t.Elem().Field(0).Type }
+
+// ItemType returns the MapType's item type.
+func (t *MapType) ItemType() DataType { return t.Elem().Field(1).Type }
+
+// Elem returns the MapType's entry type: a struct of a key and an item.
+func (t *MapType) Elem() *StructType { return t.value.Elem().(*StructType) }
+
+// ValueType returns the list type the MapType is laid out as.
+func (t *MapType) ValueType() *ListType { return t.value }
+
 // StructType describes a nested type parameterized by an ordered sequence
 // of relative types, called its fields.
 type StructType struct {
 	fields []Field
-	index  map[string]int
+	index  map[string][]int
 	meta   Metadata
 }
 
 // StructOf returns the struct type with fields fs.
+// As in schemas, several fields may share a name: the fields are kept in
+// the order of fs.
 //
-// StructOf panics if there are duplicated fields.
 // StructOf panics if there is a field with an invalid DataType.
 func StructOf(fs ...Field) *StructType {
 	n := len(fs)
@@ -100,7 +182,7 @@ func StructOf(fs ...Field) *StructType {
 
 	t := &StructType{
 		fields: make([]Field, n),
-		index:  make(map[string]int, n),
+		index:  make(map[string][]int, n),
 	}
 	for i, f := range fs {
 		if f.Type == nil {
@@ -112,10 +194,7 @@ func StructOf(fs ...Field) *StructType {
 			Nullable: f.Nullable,
 			Metadata: f.Metadata.clone(),
 		}
-		if _, dup := t.index[f.Name]; dup {
-			panic(fmt.Errorf("arrow: duplicate field with name %q", f.Name))
-		}
-		t.index[f.Name] = i
+		t.index[f.Name] = append(t.index[f.Name], i)
 	}
 
 	return t
@@ -124,28 +203,26 @@ func StructOf(fs ...Field) *StructType {
 func (*StructType) ID() Type     { return STRUCT }
 func (*StructType) Name() string { return "struct" }
 
-func (t *StructType) String() string {
-	o := new(strings.Builder)
-	o.WriteString("struct<")
-	for i, f := range t.fields {
-		if i > 0 {
-			o.WriteString(", ")
-		}
-		o.WriteString(fmt.Sprintf("%s: %v", f.Name, f.Type))
-	}
-	o.WriteString(">")
-	return o.String()
-}
+func (t *StructType) String() string { return typeString(t) }
 
 func (t *StructType) Fields() []Field   { return t.fields }
 func (t *StructType) Field(i int) Field { return t.fields[i] }
 
+// FieldByName returns the first field with the given name, in the order of
+// the fields of the struct. Use FieldIndices to retrieve all the fields
+// sharing a name.
 func (t *StructType) FieldByName(name string) (Field, bool) {
-	i, ok := t.index[name]
-	if !ok {
+	idx := t.index[name]
+	if len(idx) == 0 {
 		return Field{}, false
 	}
-	return t.fields[i], true
+	return t.fields[idx[0]], true
+}
+
+// FieldIndices returns the indices of the fields with the given name, in
+// increasing order, or nil.
+func (t *StructType) FieldIndices(name string) []int {
+	return t.index[name]
 }
 
 type Field struct {
@@ -176,5 +253,7 @@ func (f Field) String() string {
 
 var (
 	_ DataType = (*ListType)(nil)
+	_ DataType = (*LargeListType)(nil)
+	_ DataType = (*MapType)(nil)
 	_ DataType = (*StructType)(nil)
 )
@@ -40,7 +40,7 @@ func TestListOf(t *testing.T) {
 	} {
 		t.Run(tc.Name(), func(t *testing.T) {
 			got := ListOf(tc)
-			want := &ListType{elem: tc}
+			want := &ListType{elem: Field{Name: "item", Type: tc, Nullable: true}}
 			if !reflect.DeepEqual(got, want) {
 				t.Fatalf("got=%#v, want=%#v", got, want)
 			}
@@ -78,6 +78,49 @@ func TestListOf(t *testing.T) {
 	}
 }
 
+func TestListOfField(t *testing.T) {
+	md := NewMetadata([]string{"k"}, []string{"v"})
+	for _, tc := range []struct {
+		field Field
+		str   string
+	}{
+		{Field{Name: "item", Type: PrimitiveTypes.Int32, Nullable: true}, "list<item: int32>"},
+		{Field{Name: "item", Type: PrimitiveTypes.Int32}, "list<item: int32>"},
+		{Field{Name: "element", Type: ListOf(PrimitiveTypes.Int8)}, "list<element: list<item: int8>>"},
+		{Field{Name: "item", Type: PrimitiveTypes.Int32, Metadata: md}, "list<item: int32>"},
+	} {
+		t.Run(tc.str, func(t *testing.T) {
+			got := ListOfField(tc.field)
+			if got, want := got.ElemField(), tc.field; !got.Equal(want) {
+				t.Fatalf("invalid element field: got=%v, want=%v", got, want)
+			}
+			if got, want := got.Elem(), tc.field.Type; got != want {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+			if got, want := got.String(), tc.str; got != want {
+				t.Fatalf("got=%q, want=%q", got, want)
+			}
+		})
+	}
+
+	// ListOf elements are nullable and named "item".
+	want := Field{Name: "item", Type: PrimitiveTypes.Int32, Nullable: true}
+	if got := ListOf(PrimitiveTypes.Int32).ElemField(); !got.Equal(want) {
+		t.Fatalf("invalid element field: got=%v, want=%v", got, want)
+	}
+	got := ListOfField(Field{Name: "item", Type: PrimitiveTypes.Int32, Nullable: true, Metadata: MetadataFrom(nil)})
+	if !reflect.DeepEqual(got, ListOf(PrimitiveTypes.Int32)) {
+		t.Fatalf("got=%#v, want=%#v", got, ListOf(PrimitiveTypes.Int32))
+	}
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("test should have panicked but did not")
+		}
+	}()
+	_ = ListOfField(Field{Name: "item"})
+}
+
 func TestStructOf(t *testing.T) {
 	for _, tc := range []struct {
 		fields []Field
@@ -91,14 +134,14 @@ func TestStructOf(t *testing.T) {
 			fields: []Field{{Name: "f1", Type: PrimitiveTypes.Int32}},
 			want: &StructType{
 				fields: []Field{{Name: "f1", Type: PrimitiveTypes.Int32}},
-				index:  map[string]int{"f1": 0},
+				index:  map[string][]int{"f1": {0}},
 			},
 		},
 		{
 			fields: []Field{{Name: "f1", Type: PrimitiveTypes.Int32, Nullable: true}},
 			want: &StructType{
 				fields: []Field{{Name: "f1", Type: PrimitiveTypes.Int32, Nullable: true}},
-				index:  map[string]int{"f1": 0},
+				index:  map[string][]int{"f1": {0}},
 			},
 		},
 		{
@@ -111,7 +154,7 @@ func TestStructOf(t *testing.T) {
 					{Name: "f1", Type: PrimitiveTypes.Int32},
 					{Name: "", Type: PrimitiveTypes.Int64},
 				},
-				index: map[string]int{"f1": 0, "": 1},
+				index: map[string][]int{"f1": {0}, "": {1}},
 			},
 		},
 		{
@@ -124,7 +167,7 @@ func TestStructOf(t *testing.T) {
 					{Name: "f1", Type: PrimitiveTypes.Int32},
 					{Name: "f2", Type: PrimitiveTypes.Int64},
 				},
-				index: map[string]int{"f1": 0, "f2": 1},
+				index: map[string][]int{"f1": {0}, "f2": {1}},
 			},
 		},
 		{
@@ -139,7 +182,7 @@ func TestStructOf(t *testing.T) {
 					{Name: "f2", Type: PrimitiveTypes.Int64},
 					{Name: "f3", Type: ListOf(PrimitiveTypes.Float64)},
 				},
-				index: map[string]int{"f1": 0, "f2": 1, "f3": 2},
+				index: map[string][]int{"f1": {0}, "f2": {1}, "f3": {2}},
 			},
 		},
 		{
@@ -154,7 +197,7 @@ func TestStructOf(t *testing.T) {
 					{Name: "f2", Type: PrimitiveTypes.Int64},
 					{Name: "f3", Type: ListOf(ListOf(PrimitiveTypes.Float64))},
 				},
-				index: map[string]int{"f1": 0, "f2": 1, "f3": 2},
+				index: map[string][]int{"f1": {0}, "f2": {1}, "f3": {2}},
 			},
 		},
 		{
@@ -169,7 +212,7 @@ func TestStructOf(t *testing.T) {
 					{Name: "f2", Type: PrimitiveTypes.Int64},
 					{Name: "f3", Type: ListOf(ListOf(StructOf(Field{Name: "f1", Type: PrimitiveTypes.Float64})))},
 				},
-				index: map[string]int{"f1": 0, "f2": 1, "f3": 2},
+				index: map[string][]int{"f1": {0}, "f2": {1}, "f3": {2}},
 			},
 		},
 	} {
@@ -218,16 +261,10 @@ func TestStructOf(t *testing.T) {
 	for _, tc := range []struct {
 		fields []Field
 	}{
-		{
-			fields: []Field{
-				{Name: "", Type: PrimitiveTypes.Int32},
-				{Name: "", Type: PrimitiveTypes.Int32},
-			},
-		},
 		{
 			fields: []Field{
 				{Name: "x", Type: PrimitiveTypes.Int32},
-				{Name: "x", Type: PrimitiveTypes.Int32},
+				{Name: "y", Type: nil},
 			},
 		},
 	} {
@@ -243,6 +280,46 @@ func TestStructOf(t *testing.T) {
 	}
 }
 
+func TestStructOfDuplicates(t *testing.T) {
+	fields := []Field{
+		{Name: "x", Type: PrimitiveTypes.Int32},
+		{Name: "", Type: PrimitiveTypes.Int32},
+		{Name: "x", Type: BinaryTypes.String},
+		{Name: "", Type: PrimitiveTypes.Int32},
+		{Name: "x", Type: FixedWidthTypes.Boolean},
+	}
+	got := StructOf(fields...)
+
+	if !reflect.DeepEqual(got.Fields(), fields) {
+		t.Fatalf("invalid fields:\ngot= %v\nwant=%v", got.Fields(), fields)
+	}
+	if got, want := got.FieldIndices("x"), []int{0, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid indices: got=%v, want=%v", got, want)
+	}
+	if got, want := got.FieldIndices(""), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid indices: got=%v, want=%v", got, want)
+	}
+	if got := got.FieldIndices("y"); got != nil {
+		t.Fatalf("invalid indices: got=%v, want=nil", got)
+	}
+
+	f, ok := got.FieldByName("x")
+	if !ok || !f.Equal(fields[0]) {
+		t.Fatalf("FieldByName should return the first field named x: got=%v", f)
+	}
+	if _, ok := got.FieldByName("y"); ok {
+		t.Fatalf("FieldByName should not find field y")
+	}
+
+	other := StructOf(fields[0], fields[2], fields[1], fields[3], fields[4])
+	if TypeEqual(got, other) {
+		t.Fatalf("struct types with different field orders should differ")
+	}
+	if !TypeEqual(got, StructOf(fields...)) {
+		t.Fatalf("struct types with duplicate fields should be equal")
+	}
+}
+
 func TestFieldEqual(t *testing.T) {
 	for _, tc := range []struct {
 		a, b Field
@@ -354,3 +431,104 @@ func TestFixedSizeListOf(t *testing.T) {
 		})
 	}
 }
+
+func TestLargeListOf(t *testing.T) {
+	for _, tc := range []DataType{
+		PrimitiveTypes.Int32,
+		ListOf(PrimitiveTypes.Int32),
+		LargeListOf(PrimitiveTypes.Int32),
+		StructOf(Field{Name: "f1", Type: PrimitiveTypes.Float64}),
+	} {
+		t.Run(tc.Name(), func(t *testing.T) {
+			got := LargeListOf(tc)
+			want := &LargeListType{elem: tc}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got=%#v, want=%#v", got, want)
+			}
+
+			if got, want := got.Name(), "large_list"; got != want {
+				t.Fatalf("got=%q, want=%q", got, want)
+			}
+
+			if got, want := got.ID(), LARGE_LIST; got != want {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+
+			if got, want := got.Elem(), tc; got != want {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+
+			if TypeEqual(got, ListOf(tc)) {
+				t.Fatalf("large_list and list types should differ")
+			}
+		})
+	}
+
+	if got, want := ListOf(LargeListOf(PrimitiveTypes.Int8)).String(), "list<item: large_list<item: int8>>"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("test should have panicked but did not")
+		}
+	}()
+	_ = LargeListOf(nil)
+}
+
+func TestMapOf(t *testing.T) {
+	got := MapOf(BinaryTypes.String, PrimitiveTypes.Int32)
+	want := &MapType{value: ListOf(StructOf(
+		Field{Name: "key", Type: BinaryTypes.String},
+		Field{Name: "value", Type: PrimitiveTypes.Int32, Nullable: true},
+	))}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%#v, want=%#v", got, want)
+	}
+
+	if got, want := got.Name(), "map"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := got.ID(), MAP; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := got.KeyType(), DataType(BinaryTypes.String); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := got.ItemType(), DataType(PrimitiveTypes.Int32); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := got.ValueType().Elem(), DataType(got.Elem()); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := got.String(), "map<utf8, int32>"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	sorted := MapOf(BinaryTypes.String, PrimitiveTypes.Int32)
+	sorted.KeysSorted = true
+	if got, want := sorted.String(), "map<utf8, int32, keys_sorted>"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if TypeEqual(got, sorted) {
+		t.Fatalf("maps with sorted and unsorted keys should differ")
+	}
+	if !TypeEqual(got, MapOf(BinaryTypes.String, PrimitiveTypes.Int32)) {
+		t.Fatalf("identical map types should be equal")
+	}
+
+	for _, tc := range []struct{ key, item DataType }{
+		{nil, PrimitiveTypes.Int32},
+		{BinaryTypes.String, nil},
+	} {
+		t.Run("invalid", func(t *testing.T) {
+			defer func() {
+				if e := recover(); e == nil {
+					t.Fatalf("test should have panicked but did not")
+				}
+			}()
+
+			_ = MapOf(tc.key, tc.item)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestBitWidth(t *testing.T) {
+	for _, tc := range []struct {
+		dt   arrow.DataType
+		want int
+	}{
+		{arrow.FixedWidthTypes.Boolean, 1},
+		{arrow.PrimitiveTypes.Uint8, 8},
+		{arrow.PrimitiveTypes.Int8, 8},
+		{arrow.PrimitiveTypes.Uint16, 16},
+		{arrow.PrimitiveTypes.Int16, 16},
+		{arrow.PrimitiveTypes.Uint32, 32},
+		{arrow.PrimitiveTypes.Int32, 32},
+		{arrow.PrimitiveTypes.Uint64, 64},
+		{arrow.PrimitiveTypes.Int64, 64},
+		{arrow.FixedWidthTypes.Float16, 16},
+		{arrow.PrimitiveTypes.Float32, 32},
+		{arrow.PrimitiveTypes.Float64, 64},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 3}, 24},
+		{arrow.PrimitiveTypes.Date32, 32},
+		{arrow.PrimitiveTypes.Date64, 64},
+		{arrow.FixedWidthTypes.Timestamp_s, 64},
+		{arrow.FixedWidthTypes.Time32s, 32},
+		{arrow.FixedWidthTypes.Time64us, 64},
+		{arrow.FixedWidthTypes.MonthInterval, 32},
+		{arrow.FixedWidthTypes.DayTimeInterval, 64},
+		{&arrow.Decimal128Type{Precision: 10, Scale: 2}, 128},
+		{&arrow.Decimal256Type{Precision: 50, Scale: 2}, 256},
+		{arrow.FixedWidthTypes.Duration_ns, 64},
+	} {
+		t.Run(tc.dt.Name(), func(t *testing.T) {
+			dt, ok := tc.dt.(arrow.FixedWidthDataType)
+			if !ok {
+				t.Fatalf("%v does not implement arrow.FixedWidthDataType", tc.dt)
+			}
+			if got, want := dt.BitWidth(), tc.want; got != want {
+				t.Fatalf("invalid bit width: got=%d, want=%d", got, want)
+			}
+			if !arrow.IsFixedWidth(tc.dt.ID()) {
+				t.Fatalf("%v should be fixed-width", tc.dt)
+			}
+		})
+	}
+}
+
+func TestTypePredicates(t *testing.T) {
+	type preds struct {
+		fixed, primitive, nested, binary bool
+	}
+	for _, tc := range []struct {
+		id   arrow.Type
+		want preds
+	}{
+		{arrow.NULL, preds{}},
+		{arrow.BOOL, preds{fixed: true, primitive: true}},
+		{arrow.UINT8, preds{fixed: true, primitive: true}},
+		{arrow.INT8, preds{fixed: true, primitive: true}},
+		{arrow.UINT16, preds{fixed: true, primitive: true}},
+		{arrow.INT16, preds{fixed: true, primitive: true}},
+		{arrow.UINT32, preds{fixed: true, primitive: true}},
+		{arrow.INT32, preds{fixed: true, primitive: true}},
+		{arrow.UINT64, preds{fixed: true, primitive: true}},
+		{arrow.INT64, preds{fixed: true, primitive: true}},
+		{arrow.FLOAT16, preds{fixed: true, primitive: true}},
+		{arrow.FLOAT32, preds{fixed: true, primitive: true}},
+		{arrow.FLOAT64, preds{fixed: true, primitive: true}},
+		{arrow.STRING, preds{binary: true}},
+		{arrow.BINARY, preds{binary: true}},
+		{arrow.FIXED_SIZE_BINARY, preds{fixed: true}},
+		{arrow.DATE32, preds{fixed: true}},
+		{arrow.DATE64, preds{fixed: true}},
+		{arrow.TIMESTAMP, preds{fixed: true}},
+		{arrow.TIME32, preds{fixed: true}},
+		{arrow.TIME64, preds{fixed: true}},
+		{arrow.INTERVAL, preds{fixed: true}},
+		{arrow.DECIMAL, preds{fixed: true}},
+		{arrow.LIST, preds{nested: true}},
+		{arrow.STRUCT, preds{nested: true}},
+		{arrow.UNION, preds{nested: true}},
+		{arrow.DICTIONARY, preds{}},
+		{arrow.MAP, preds{nested: true}},
+		{arrow.EXTENSION, preds{}},
+		{arrow.FIXED_SIZE_LIST, preds{nested: true}},
+		{arrow.DURATION, preds{fixed: true}},
+	} {
+		t.Run(tc.id.String(), func(t *testing.T) {
+			got := preds{
+				fixed:     arrow.IsFixedWidth(tc.id),
+				primitive: arrow.IsPrimitive(tc.id),
+				nested:    arrow.IsNested(tc.id),
+				binary:    arrow.IsBinaryLike(tc.id),
+			}
+			if got != tc.want {
+				t.Fatalf("invalid predicates: got=%+v, want=%+v", got, tc.want)
+			}
+		})
+	}
+}
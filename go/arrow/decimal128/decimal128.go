@@ -16,6 +16,12 @@
 
 package decimal128 // import "github.com/apache/arrow/go/arrow/decimal128"
 
+import "math/big"
+
+// MaxPrecision is the maximum number of decimal digits a 128-bit decimal
+// can hold.
+const MaxPrecision = 38
+
 var (
 	MaxDecimal128 = New(542101086242752217, 687399551400673280-1)
 )
@@ -71,3 +77,18 @@ func (n Num) Sign() int {
 	}
 	return int(1 | (n.hi >> 63))
 }
+
+// FitsInPrecision reports whether n holds at most prec decimal digits.
+func (n Num) FitsInPrecision(prec int32) bool {
+	if prec <= 0 || prec > MaxPrecision {
+		return false
+	}
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+	return new(big.Int).Abs(n.toBig()).Cmp(limit) < 0
+}
+
+func (n Num) toBig() *big.Int {
+	b := big.NewInt(n.hi)
+	b.Lsh(b, 64)
+	return b.Add(b, new(big.Int).SetUint64(n.lo))
+}
@@ -92,3 +92,32 @@ func TestFromI64(t *testing.T) {
 }
 
 func u64Cnv(i int64) uint64 { return uint64(i) }
+
+func TestFitsInPrecision(t *testing.T) {
+	for _, tc := range []struct {
+		n    Num
+		prec int32
+		want bool
+	}{
+		{FromI64(0), 1, true},
+		{FromI64(9), 1, true},
+		{FromI64(-9), 1, true},
+		{FromI64(10), 1, false},
+		{FromI64(-10), 1, false},
+		{FromI64(99999), 5, true},
+		{FromI64(-100000), 5, false},
+		{FromI64(1), 0, false},
+		{MaxDecimal128, MaxPrecision, true},
+		{MaxDecimal128, MaxPrecision - 1, false},
+		{MaxDecimal128, MaxPrecision + 1, false},
+		{New(-542101086242752218, 18446744073709551615-687399551400673280+2), MaxPrecision, true}, // -MaxDecimal128
+		{New(math.MaxInt64, math.MaxUint64), MaxPrecision, false},
+		{New(math.MinInt64, 0), MaxPrecision, false},
+	} {
+		t.Run(fmt.Sprintf("%v/%d", tc.n, tc.prec), func(t *testing.T) {
+			if got := tc.n.FitsInPrecision(tc.prec); got != tc.want {
+				t.Fatalf("invalid result: got=%v, want=%v", got, tc.want)
+			}
+		})
+	}
+}
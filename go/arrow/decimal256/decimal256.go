@@ -0,0 +1,280 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package decimal256 provides a signed 256-bit integer type, used as the
+// storage of 256-bit decimal values.
+package decimal256 // import "github.com/apache/arrow/go/arrow/decimal256"
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"golang.org/x/xerrors"
+)
+
+// MaxPrecision is the maximum number of decimal digits a 256-bit decimal
+// value can hold.
+const MaxPrecision = 76
+
+var (
+	MaxDecimal256 = fromBigUnchecked(new(big.Int).Sub(pow10(MaxPrecision), big.NewInt(1)))
+
+	two255    = new(big.Int).Lsh(big.NewInt(1), 255)
+	negTwo255 = new(big.Int).Neg(two255)
+	two256    = new(big.Int).Lsh(big.NewInt(1), 256)
+	mask64    = new(big.Int).SetUint64(^uint64(0))
+)
+
+// Num represents a signed 256-bit integer in two's complement.
+// Calculations wrap around and overflow is ignored.
+//
+// The words are stored in little-endian order, which matches the memory
+// layout of 256-bit decimal values in Arrow arrays.
+type Num struct {
+	arr [4]uint64
+}
+
+// New returns a new signed 256-bit integer value from its four 64-bit words,
+// from the most significant (x1) to the least significant (x4).
+func New(x1, x2, x3, x4 uint64) Num {
+	return Num{[4]uint64{x4, x3, x2, x1}}
+}
+
+// FromU64 returns a new signed 256-bit integer value from the provided uint64 one.
+func FromU64(v uint64) Num {
+	return Num{[4]uint64{v, 0, 0, 0}}
+}
+
+// FromI64 returns a new signed 256-bit integer value from the provided int64 one.
+func FromI64(v int64) Num {
+	ext := uint64(v >> 63)
+	return Num{[4]uint64{uint64(v), ext, ext, ext}}
+}
+
+// FromDecimal128 returns a new signed 256-bit integer value from the
+// provided 128-bit one.
+func FromDecimal128(n decimal128.Num) Num {
+	ext := uint64(n.HighBits() >> 63)
+	return Num{[4]uint64{n.LowBits(), uint64(n.HighBits()), ext, ext}}
+}
+
+// FromBigInt returns the signed 256-bit integer value of b, or an error if
+// b does not fit in 256 bits.
+func FromBigInt(b *big.Int) (Num, error) {
+	if b.Cmp(two255) >= 0 || b.Cmp(negTwo255) < 0 {
+		return Num{}, xerrors.Errorf("arrow/decimal256: value %s overflows a 256-bit integer", b)
+	}
+	return fromBigUnchecked(b), nil
+}
+
+// ToBigInt returns n as a new big.Int.
+func (n Num) ToBigInt() *big.Int { return n.toBig() }
+
+// Array returns the four 64-bit words of the two's complement representation
+// of the number, from the least significant to the most significant.
+func (n Num) Array() [4]uint64 { return n.arr }
+
+// Sign returns:
+//
+// -1 if x <  0
+//
+//	0 if x == 0
+//
+// +1 if x >  0
+func (n Num) Sign() int {
+	if n == (Num{}) {
+		return 0
+	}
+	return int(1 | (int64(n.arr[3]) >> 63))
+}
+
+// Negate returns -n, wrapping around for the minimum value.
+func (n Num) Negate() Num {
+	var carry uint64 = 1
+	for i, w := range n.arr {
+		w = ^w + carry
+		if w != 0 {
+			carry = 0
+		}
+		n.arr[i] = w
+	}
+	return n
+}
+
+// ToDecimal128 returns n as a signed 128-bit integer value, or an error if
+// n does not fit in 128 bits.
+func (n Num) ToDecimal128() (decimal128.Num, error) {
+	ext := uint64(int64(n.arr[1]) >> 63)
+	if n.arr[2] != ext || n.arr[3] != ext {
+		return decimal128.Num{}, xerrors.Errorf("arrow/decimal256: value %s overflows a 128-bit decimal", n.toBig())
+	}
+	return decimal128.New(int64(n.arr[1]), n.arr[0]), nil
+}
+
+// FromString parses s, a decimal number such as "-123.45" or "1.2e-3", into
+// a value of the given precision and scale, ie: s multiplied by 10^scale.
+//
+// FromString returns an error if s is malformed, if it has more fractional
+// digits than allowed by scale or if it needs more than precision digits.
+func FromString(s string, prec, scale int32) (Num, error) {
+	if prec <= 0 || prec > MaxPrecision {
+		return Num{}, xerrors.Errorf("arrow/decimal256: invalid precision %d", prec)
+	}
+
+	v, err := parse(s)
+	if err != nil {
+		return Num{}, xerrors.Errorf("arrow/decimal256: could not parse %q: %w", s, err)
+	}
+
+	coef, exp := v.coef, v.exp+int(scale)
+	switch {
+	case coef.Sign() == 0:
+		return Num{}, nil
+	case exp > MaxPrecision:
+		return Num{}, xerrors.Errorf("arrow/decimal256: value %q exceeds precision %d", s, prec)
+	case exp < -MaxPrecision-len(coef.String()):
+		return Num{}, xerrors.Errorf("arrow/decimal256: value %q cannot be represented with scale %d", s, scale)
+	case exp > 0:
+		coef.Mul(coef, pow10(exp))
+	case exp < 0:
+		var rem big.Int
+		coef.QuoRem(coef, pow10(-exp), &rem)
+		if rem.Sign() != 0 {
+			return Num{}, xerrors.Errorf("arrow/decimal256: value %q cannot be represented with scale %d", s, scale)
+		}
+	}
+
+	if ndigits := len(coef.String()); ndigits > int(prec) {
+		return Num{}, xerrors.Errorf("arrow/decimal256: value %q has %d digits, exceeding precision %d", s, ndigits, prec)
+	}
+	if v.neg {
+		coef.Neg(coef)
+	}
+	return fromBigUnchecked(coef), nil
+}
+
+type number struct {
+	neg  bool
+	coef *big.Int
+	exp  int
+}
+
+// parse parses a decimal number as coef*10^exp.
+func parse(s string) (number, error) {
+	var v number
+	switch {
+	case strings.HasPrefix(s, "-"):
+		v.neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return v, xerrors.Errorf("invalid exponent: %w", err)
+		}
+		v.exp = exp
+		s = s[:i]
+	}
+
+	digits := s
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits = s[:i] + s[i+1:]
+		v.exp -= len(s) - i - 1
+	}
+	if digits == "" {
+		return v, xerrors.New("no digits")
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return v, xerrors.Errorf("invalid character %q", c)
+		}
+	}
+
+	v.coef, _ = new(big.Int).SetString(digits, 10)
+	return v, nil
+}
+
+// ToString returns the string representation of n as a decimal value with
+// the given scale.
+func (n Num) ToString(scale int32) string {
+	b := n.toBig()
+	digits := new(big.Int).Abs(b).String()
+
+	var o strings.Builder
+	if b.Sign() < 0 {
+		o.WriteString("-")
+	}
+	switch {
+	case scale <= 0:
+		o.WriteString(digits)
+		if b.Sign() != 0 {
+			o.WriteString(strings.Repeat("0", int(-scale)))
+		}
+	default:
+		if len(digits) <= int(scale) {
+			digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+		}
+		o.WriteString(digits[:len(digits)-int(scale)])
+		o.WriteString(".")
+		o.WriteString(digits[len(digits)-int(scale):])
+	}
+	return o.String()
+}
+
+// FitsInPrecision reports whether n holds at most prec decimal digits.
+func (n Num) FitsInPrecision(prec int32) bool {
+	if prec <= 0 || prec > MaxPrecision {
+		return false
+	}
+	return new(big.Int).Abs(n.toBig()).Cmp(pow10(int(prec))) < 0
+}
+
+func (n Num) toBig() *big.Int {
+	b := new(big.Int)
+	for i := len(n.arr) - 1; i >= 0; i-- {
+		b.Lsh(b, 64)
+		b.Or(b, new(big.Int).SetUint64(n.arr[i]))
+	}
+	if b.Cmp(two255) >= 0 {
+		b.Sub(b, two256)
+	}
+	return b
+}
+
+// fromBigUnchecked returns the two's complement representation of the 256
+// least significant bits of b.
+func fromBigUnchecked(b *big.Int) Num {
+	v := new(big.Int).Set(b)
+	if v.Sign() < 0 {
+		v.Add(v, two256)
+	}
+
+	var n Num
+	for i := range n.arr {
+		n.arr[i] = new(big.Int).And(v, mask64).Uint64()
+		v.Rsh(v, 64)
+	}
+	return n
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,237 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal256 // import "github.com/apache/arrow/go/arrow/decimal256"
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/decimal128"
+)
+
+var (
+	// maxNum is 2^255-1, the largest signed 256-bit integer.
+	maxNum = New(math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64)
+	// minNum is -2^255, the smallest signed 256-bit integer.
+	minNum = New(1<<63, 0, 0, 0)
+)
+
+func TestFromI64(t *testing.T) {
+	for _, tc := range []struct {
+		v    int64
+		want Num
+		sign int
+	}{
+		{0, Num{}, 0},
+		{1, New(0, 0, 0, 1), +1},
+		{-1, New(math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64), -1},
+		{math.MaxInt64, New(0, 0, 0, math.MaxInt64), +1},
+		{math.MinInt64, New(math.MaxUint64, math.MaxUint64, math.MaxUint64, 1<<63), -1},
+	} {
+		v := FromI64(tc.v)
+		if v != tc.want {
+			t.Fatalf("invalid value for %d: got=%v, want=%v", tc.v, v, tc.want)
+		}
+		if got, want := v.Sign(), tc.sign; got != want {
+			t.Fatalf("invalid sign for %d: got=%v, want=%v", tc.v, got, want)
+		}
+		if got, want := v.toBig(), big.NewInt(tc.v); got.Cmp(want) != 0 {
+			t.Fatalf("invalid big-int for %d: got=%v, want=%v", tc.v, got, want)
+		}
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	two255 := new(big.Int).Lsh(big.NewInt(1), 255)
+
+	if got, want := maxNum.toBig(), new(big.Int).Sub(two255, big.NewInt(1)); got.Cmp(want) != 0 {
+		t.Fatalf("invalid max: got=%v, want=%v", got, want)
+	}
+	if got, want := minNum.toBig(), new(big.Int).Neg(two255); got.Cmp(want) != 0 {
+		t.Fatalf("invalid min: got=%v, want=%v", got, want)
+	}
+	if got, want := maxNum.Negate(), New(1<<63, 0, 0, 1); got != want {
+		t.Fatalf("invalid -max: got=%v, want=%v", got, want)
+	}
+	if got, want := maxNum.Negate().Negate(), maxNum; got != want {
+		t.Fatalf("invalid -(-max): got=%v, want=%v", got, want)
+	}
+	// -min wraps around.
+	if got, want := minNum.Negate(), minNum; got != want {
+		t.Fatalf("invalid -min: got=%v, want=%v", got, want)
+	}
+	if maxNum.Sign() != +1 || minNum.Sign() != -1 || maxNum.Negate().Sign() != -1 {
+		t.Fatalf("invalid signs")
+	}
+
+	const maxDigits = "57896044618658097711785492504343953926634992332820282019728792003956564819967"
+	if got, want := maxNum.ToString(0), maxDigits; got != want {
+		t.Fatalf("invalid max string:\ngot= %s\nwant=%s", got, want)
+	}
+	if got, want := maxNum.Negate().ToString(10), "-"+maxDigits[:len(maxDigits)-10]+"."+maxDigits[len(maxDigits)-10:]; got != want {
+		t.Fatalf("invalid -max string:\ngot= %s\nwant=%s", got, want)
+	}
+	if got, want := minNum.ToString(0), "-57896044618658097711785492504343953926634992332820282019728792003956564819968"; got != want {
+		t.Fatalf("invalid min string:\ngot= %s\nwant=%s", got, want)
+	}
+
+	// 2^255-1 has 77 digits: it does not fit in any decimal256 precision.
+	if _, err := FromString(maxDigits, MaxPrecision, 0); err == nil {
+		t.Fatalf("expected an error parsing 2^255-1")
+	}
+	if maxNum.FitsInPrecision(MaxPrecision) || minNum.FitsInPrecision(MaxPrecision) {
+		t.Fatalf("extremes should not fit in precision %d", MaxPrecision)
+	}
+	if !MaxDecimal256.FitsInPrecision(MaxPrecision) || MaxDecimal256.FitsInPrecision(MaxPrecision-1) {
+		t.Fatalf("invalid precision for MaxDecimal256")
+	}
+}
+
+func TestFromString(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		prec  int32
+		scale int32
+		want  string // string representation at scale, or error substring.
+		err   bool
+	}{
+		{s: "0", prec: 1, scale: 0, want: "0"},
+		{s: "-0.00", prec: 1, scale: 2, want: "0.00"},
+		{s: "123.45", prec: 5, scale: 2, want: "123.45"},
+		{s: "-123.45", prec: 10, scale: 4, want: "-123.4500"},
+		{s: "+7", prec: 3, scale: 2, want: "7.00"},
+		{s: ".5", prec: 3, scale: 1, want: "0.5"},
+		{s: "5.", prec: 3, scale: 0, want: "5"},
+		{s: "-0.001", prec: 3, scale: 3, want: "-0.001"},
+		{s: "1.2e-3", prec: 3, scale: 4, want: "0.0012"},
+		{s: "-1.5E2", prec: 5, scale: 1, want: "-150.0"},
+		{s: "12300", prec: 3, scale: -2, want: "12300"},
+		{s: strings.Repeat("9", 76), prec: 76, scale: 0, want: strings.Repeat("9", 76)},
+		{s: "-" + strings.Repeat("9", 70) + "." + strings.Repeat("9", 6), prec: 76, scale: 6, want: "-" + strings.Repeat("9", 70) + "." + strings.Repeat("9", 6)},
+
+		{s: "123.45", prec: 4, scale: 2, want: "exceeding precision", err: true},
+		{s: "1.234", prec: 10, scale: 2, want: "cannot be represented", err: true},
+		{s: "1" + strings.Repeat("0", 76), prec: 76, scale: 0, want: "exceeding precision", err: true},
+		{s: "1e100", prec: 76, scale: 0, want: "exceeds precision", err: true},
+		{s: "1e-100", prec: 76, scale: 0, want: "cannot be represented", err: true},
+		{s: "0e1000000", prec: 1, scale: 0, want: "0"},
+		{s: "", prec: 10, scale: 0, want: "no digits", err: true},
+		{s: "-", prec: 10, scale: 0, want: "no digits", err: true},
+		{s: "1.2.3", prec: 10, scale: 0, want: "invalid character", err: true},
+		{s: "12a", prec: 10, scale: 0, want: "invalid character", err: true},
+		{s: "1e", prec: 10, scale: 0, want: "invalid exponent", err: true},
+		{s: "1", prec: 0, scale: 0, want: "invalid precision", err: true},
+		{s: "1", prec: 77, scale: 0, want: "invalid precision", err: true},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			v, err := FromString(tc.s, tc.prec, tc.scale)
+			switch {
+			case tc.err:
+				if err == nil || !strings.Contains(err.Error(), tc.want) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.want)
+				}
+			case err != nil:
+				t.Fatal(err)
+			default:
+				if got := v.ToString(tc.scale); got != tc.want {
+					t.Fatalf("invalid value: got=%q, want=%q", got, tc.want)
+				}
+				if !v.FitsInPrecision(tc.prec) {
+					t.Fatalf("value %q does not fit in precision %d", tc.want, tc.prec)
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal128(t *testing.T) {
+	for _, v := range []decimal128.Num{
+		decimal128.FromI64(0),
+		decimal128.FromI64(-1),
+		decimal128.FromU64(math.MaxUint64),
+		decimal128.New(math.MaxInt64, math.MaxUint64),
+		decimal128.New(math.MinInt64, 0),
+		decimal128.MaxDecimal128,
+	} {
+		n := FromDecimal128(v)
+		got, err := n.ToDecimal128()
+		if err != nil {
+			t.Fatalf("could not convert %v back: %v", v, err)
+		}
+		if got != v {
+			t.Fatalf("invalid round-trip: got=%v, want=%v", got, v)
+		}
+		if got, want := n.Sign(), v.Sign(); got != want {
+			t.Fatalf("invalid sign for %v: got=%d, want=%d", v, got, want)
+		}
+	}
+
+	for _, n := range []Num{
+		maxNum,
+		minNum,
+		New(0, 1, 0, 0),
+		New(0, 0, 1<<63, 0), // 2^127 does not fit in a signed 128-bit integer.
+		New(math.MaxUint64, math.MaxUint64, math.MaxInt64, 0),
+	} {
+		if _, err := n.ToDecimal128(); err == nil {
+			t.Fatalf("expected an overflow error converting %s", n.ToString(0))
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	two255 := new(big.Int).Lsh(big.NewInt(1), 255)
+	max76, _ := new(big.Int).SetString(strings.Repeat("9", 76), 10)
+
+	for _, tc := range []struct {
+		v    *big.Int
+		want Num
+	}{
+		{big.NewInt(0), Num{}},
+		{big.NewInt(-1), FromI64(-1)},
+		{big.NewInt(math.MaxInt64), FromI64(math.MaxInt64)},
+		{new(big.Int).Lsh(big.NewInt(1), 64), New(0, 0, 1, 0)},
+		{new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 64)), New(math.MaxUint64, math.MaxUint64, math.MaxUint64, 0)},
+		{max76, MaxDecimal256},
+		{new(big.Int).Neg(max76), MaxDecimal256.Negate()},
+		{new(big.Int).Sub(two255, big.NewInt(1)), maxNum},
+		{new(big.Int).Neg(two255), minNum},
+	} {
+		got, err := FromBigInt(tc.v)
+		if err != nil {
+			t.Fatalf("could not convert %v: %v", tc.v, err)
+		}
+		if got != tc.want {
+			t.Fatalf("invalid value for %v: got=%v, want=%v", tc.v, got, tc.want)
+		}
+		if got := got.ToBigInt(); got.Cmp(tc.v) != 0 {
+			t.Fatalf("invalid round trip: got=%v, want=%v", got, tc.v)
+		}
+	}
+
+	for _, v := range []*big.Int{
+		two255,
+		new(big.Int).Sub(new(big.Int).Neg(two255), big.NewInt(1)),
+		new(big.Int).Lsh(big.NewInt(1), 300),
+	} {
+		if _, err := FromBigInt(v); err == nil {
+			t.Fatalf("expected an overflow error for %v", v)
+		}
+	}
+}
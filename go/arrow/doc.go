@@ -31,8 +31,9 @@ array is valid (not null). If the array has no null entries, it is possible to o
 */
 package arrow
 
-//go:generate go run _tools/tmpl/main.go -i -data=numeric.tmpldata type_traits_numeric.gen.go.tmpl type_traits_numeric.gen_test.go.tmpl array/numeric.gen.go.tmpl array/numericbuilder.gen.go.tmpl array/bufferbuilder_numeric.gen.go.tmpl
+//go:generate go run _tools/tmpl/main.go -i -data=numeric.tmpldata type_traits_numeric.gen.go.tmpl type_traits_numeric.gen_test.go.tmpl array/numeric.gen.go.tmpl array/numericbuilder.gen.go.tmpl array/numericbuilder.gen_test.go.tmpl array/bufferbuilder_numeric.gen.go.tmpl array/drain.gen.go.tmpl array/dictionary.gen.go.tmpl array/minmax.gen.go.tmpl
 //go:generate go run _tools/tmpl/main.go -i -data=datatype_numeric.gen.go.tmpldata datatype_numeric.gen.go.tmpl tensor/numeric.gen.go.tmpl tensor/numeric.gen_test.go.tmpl
+//go:generate go run _tools/tmpl/main.go -i -data=array/record_column.gen.go.tmpldata array/record_column.gen.go.tmpl
 //go:generate go run ./gen-flatbuffers.go
 
 // stringer
@@ -467,7 +467,7 @@ func Example_record() {
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
 			arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64},
 		},
 		nil,
@@ -497,7 +497,7 @@ func Example_recordReader() {
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
 			arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64},
 		},
 		nil,
@@ -546,7 +546,7 @@ func Example_table() {
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
 			arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64},
 		},
 		nil,
@@ -31,39 +31,73 @@ type Num struct {
 
 // New creates a new half-precision floating point value from the provided
 // float32 value.
+//
+// f is rounded to the nearest half-precision value, ties to even.
+// Values too large for a float16 become infinities, values too small become
+// zeros, and NaNs stay NaNs.
 func New(f float32) Num {
-	b := math.Float32bits(f)
-	sn := uint16((b >> 31) & 0x1)
-	exp := (b >> 23) & 0xff
-	res := int16(exp) - 127 + 15
-	fc := uint16(b>>13) & 0x3ff
-	switch {
-	case exp == 0:
-		res = 0
-	case exp == 0xff:
-		res = 0x1f
-	case res > 0x1e:
-		res = 0x1f
-		fc = 0
-	case res < 0x01:
-		res = 0
-		fc = 0
+	var (
+		b    = math.Float32bits(f)
+		sign = uint16(b>>16) & 0x8000
+		exp  = int((b >> 23) & 0xff)
+		mant = b & 0x7fffff
+	)
+
+	switch e := exp - 127 + 15; {
+	case exp == 0xff && mant != 0:
+		// NaN: keep it quiet, along with the high bits of its payload.
+		return Num{bits: sign | 0x7e00 | uint16(mant>>13)}
+	case e >= 0x1f:
+		// infinities, and overflows.
+		return Num{bits: sign | 0x7c00}
+	case e > 0:
+		// normal values. A carry out of the mantissa increments the
+		// exponent, up to the infinity.
+		return Num{bits: sign | uint16(roundShift(uint32(e)<<23|mant, 13))}
+	case e >= -10:
+		// subnormal values, in units of 2^-24.
+		return Num{bits: sign | uint16(roundShift(mant|0x800000, uint(14-e)))}
+	default:
+		// zeros, and underflows.
+		return Num{bits: sign}
+	}
+}
+
+// roundShift returns v >> s, rounded to the nearest integer, ties to even.
+func roundShift(v uint32, s uint) uint32 {
+	var (
+		q    = v >> s
+		r    = v & (1<<s - 1)
+		half = uint32(1) << (s - 1)
+	)
+	if r > half || (r == half && q&1 == 1) {
+		q++
 	}
-	return Num{bits: (sn << 15) | uint16(res<<10) | fc}
+	return q
 }
 
+// Float32 returns the float32 value of f. The conversion is exact.
 func (f Num) Float32() float32 {
-	sn := uint32((f.bits >> 15) & 0x1)
-	exp := (f.bits >> 10) & 0x1f
-	res := uint32(exp) + 127 - 15
-	fc := uint32(f.bits & 0x3ff)
-	switch {
-	case exp == 0:
-		res = 0
-	case exp == 0x1f:
-		res = 0xff
+	var (
+		sign = uint32(f.bits&0x8000) << 16
+		exp  = uint32(f.bits>>10) & 0x1f
+		mant = uint32(f.bits & 0x3ff)
+	)
+
+	switch exp {
+	case 0x1f:
+		// infinities and NaNs.
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		// zeros and subnormal values, in units of 2^-24.
+		v := float32(mant) / (1 << 24)
+		if sign != 0 {
+			v = -v
+		}
+		return v
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
 	}
-	return math.Float32frombits((sn << 31) | (res << 23) | (fc << 13))
 }
 
 func (f Num) Uint16() uint16 { return f.bits }
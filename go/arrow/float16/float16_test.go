@@ -18,6 +18,7 @@ package float16
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,3 +44,75 @@ func TestFloat16(t *testing.T) {
 		assert.Equal(t, k.String(), fmt.Sprintf("%v", v), "string representation differ")
 	}
 }
+
+func TestNewRounding(t *testing.T) {
+	pow2 := func(e int) float32 { return float32(math.Ldexp(1, e)) }
+
+	for _, tc := range []struct {
+		name string
+		v    float32
+		want uint16
+	}{
+		{"one", 1, 0x3c00},
+		{"tie-down-to-even", 1 + pow2(-11), 0x3c00},
+		{"above-tie", 1 + pow2(-11) + pow2(-20), 0x3c01},
+		{"tie-up-to-even", 1 + 3*pow2(-11), 0x3c02},
+		{"negative-tie", -(1 + 3*pow2(-11)), 0xbc02},
+		{"carry-to-exponent", 2 - pow2(-12), 0x4000},
+		{"max", 65504, 0x7bff},
+		{"below-overflow-tie", 65519, 0x7bff},
+		{"overflow-tie", 65520, 0x7c00},
+		{"overflow", 1e6, 0x7c00},
+		{"negative-overflow", -1e6, 0xfc00},
+		{"inf", float32(math.Inf(+1)), 0x7c00},
+		{"negative-inf", float32(math.Inf(-1)), 0xfc00},
+		{"zero", 0, 0x0000},
+		{"negative-zero", float32(math.Copysign(0, -1)), 0x8000},
+		{"min-normal", pow2(-14), 0x0400},
+		{"subnormal-to-normal-tie", pow2(-14) - pow2(-25), 0x0400},
+		{"min-subnormal", pow2(-24), 0x0001},
+		{"subnormal", 3 * pow2(-24), 0x0003},
+		{"subnormal-tie", 3*pow2(-24) + pow2(-25), 0x0004},
+		{"underflow-above-tie", 1.5 * pow2(-25), 0x0001},
+		{"underflow-tie", pow2(-25), 0x0000},
+		{"underflow", 1e-10, 0x0000},
+		{"negative-underflow", -1e-10, 0x8000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, New(tc.v).Uint16(), "New(%v)", tc.v)
+		})
+	}
+}
+
+func TestNewNaN(t *testing.T) {
+	for _, b := range []uint32{0x7fc00000, 0xffc00000, 0x7f800001, 0x7fa00000, 0x7fffffff} {
+		f := math.Float32frombits(b)
+		got := New(f)
+		assert.True(t, got.Uint16()&0x7c00 == 0x7c00 && got.Uint16()&0x3ff != 0, "New(%#x) = %#x, want a NaN", b, got.Uint16())
+		assert.Equal(t, uint16(b>>16)&0x8000, got.Uint16()&0x8000, "sign of New(%#x)", b)
+		assert.True(t, math.IsNaN(float64(got.Float32())))
+	}
+}
+
+func TestFloat32Special(t *testing.T) {
+	assert.Equal(t, float32(math.Ldexp(1, -24)), Num{bits: 0x0001}.Float32())
+	assert.Equal(t, float32(math.Ldexp(1023, -24)), Num{bits: 0x03ff}.Float32())
+	assert.Equal(t, float32(-math.Ldexp(1, -24)), Num{bits: 0x8001}.Float32())
+	assert.True(t, math.Signbit(float64(Num{bits: 0x8000}.Float32())))
+	assert.Equal(t, float32(math.Inf(+1)), Num{bits: 0x7c00}.Float32())
+	assert.Equal(t, float32(math.Inf(-1)), Num{bits: 0xfc00}.Float32())
+	assert.True(t, math.IsNaN(float64(Num{bits: 0x7c01}.Float32())))
+}
+
+func TestRoundTrip(t *testing.T) {
+	for b := 0; b <= math.MaxUint16; b++ {
+		f := Num{bits: uint16(b)}
+		if f.bits&0x7c00 == 0x7c00 && f.bits&0x3ff != 0 {
+			// NaNs are quieted.
+			continue
+		}
+		if got := New(f.Float32()); got != f {
+			t.Fatalf("round trip of %#04x: got=%#04x (%v)", b, got.bits, f.Float32())
+		}
+	}
+}
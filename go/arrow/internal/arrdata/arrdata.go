@@ -24,6 +24,7 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -38,6 +39,8 @@ func init() {
 	Records["primitives"] = makePrimitiveRecords()
 	Records["structs"] = makeStructsRecords()
 	Records["lists"] = makeListsRecords()
+	Records["maps"] = makeMapsRecords()
+	Records["large_lists"] = makeLargeListsRecords()
 	Records["strings"] = makeStringsRecords()
 	Records["fixed_size_lists"] = makeFixedSizeListsRecords()
 	Records["fixed_width_types"] = makeFixedWidthTypesRecords()
@@ -45,6 +48,7 @@ func init() {
 	Records["intervals"] = makeIntervalsRecords()
 	Records["durations"] = makeDurationsRecords()
 	Records["decimal128"] = makeDecimal128sRecords()
+	Records["decimal256"] = makeDecimal256sRecords()
 
 	for k := range Records {
 		RecordNames = append(RecordNames, k)
@@ -313,6 +317,160 @@ func makeListsRecords() []array.Record {
 	return recs
 }
 
+func makeMapsRecords() []array.Record {
+	mem := memory.NewGoAllocator()
+	dtype := arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "map_nullable", Type: dtype, Nullable: true},
+	}, nil)
+
+	chunks := [][]array.Interface{
+		[]array.Interface{
+			mapOf(mem,
+				[][]string{{"a", "b", "c"}, {}, {"d"}, {"e", "f"}},
+				[][]int32{{1, 2, 3}, {}, {4}, {5, 6}},
+				[][]bool{{true, false, true}, {}, {true}, {false, true}},
+				nil,
+			),
+		},
+		[]array.Interface{
+			mapOf(mem,
+				[][]string{{"a"}, {}, {"b", "c"}, {}},
+				[][]int32{{-1}, {}, {-2, -3}, {}},
+				[][]bool{{true}, {}, {true, true}, {}},
+				[]bool{true, false, true, true},
+			),
+		},
+		[]array.Interface{
+			func() array.Interface {
+				bldr := array.NewMapBuilder(mem, dtype.KeyType(), dtype.ItemType(), false)
+				defer bldr.Release()
+
+				return bldr.NewMapArray()
+			}(),
+		},
+	}
+
+	defer func() {
+		for _, chunk := range chunks {
+			for _, col := range chunk {
+				col.Release()
+			}
+		}
+	}()
+
+	recs := make([]array.Record, len(chunks))
+	for i, chunk := range chunks {
+		recs[i] = array.NewRecord(schema, chunk, -1)
+	}
+
+	return recs
+}
+
+func makeLargeListsRecords() []array.Record {
+	mem := memory.NewGoAllocator()
+	stype := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		arrow.Field{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "large_list_nullable", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		{Name: "list_of_large_lists", Type: arrow.ListOf(arrow.LargeListOf(arrow.PrimitiveTypes.Int32)), Nullable: true},
+		{Name: "large_list_of_structs", Type: arrow.LargeListOf(stype), Nullable: true},
+	}, nil)
+
+	mask := []bool{true, false, true}
+
+	chunks := [][]array.Interface{
+		[]array.Interface{
+			largeListOf(mem, []array.Interface{
+				arrayOf(mem, []int32{1, 2, 3}, mask),
+				arrayOf(mem, []int32{}, nil),
+				arrayOf(mem, []int32{11, 12, 13}, mask),
+			}, []bool{true, false, true}),
+			listOf(mem, []array.Interface{
+				largeListOf(mem, []array.Interface{
+					arrayOf(mem, []int32{1, 2}, nil),
+					arrayOf(mem, []int32{3}, nil),
+				}, nil),
+				largeListOf(mem, []array.Interface{
+					arrayOf(mem, []int32{4, 5, 6}, mask),
+				}, []bool{false}),
+				largeListOf(mem, []array.Interface{
+					arrayOf(mem, []int32{7}, nil),
+					arrayOf(mem, []int32{8, 9}, nil),
+					arrayOf(mem, []int32{}, nil),
+				}, nil),
+			}, []bool{true, true, false}),
+			largeListOf(mem, []array.Interface{
+				structOf(mem, stype, [][]array.Interface{{
+					arrayOf(mem, []int32{1, 2}, nil),
+					arrayOf(mem, []string{"a", "bb"}, nil),
+				}}, nil),
+				structOf(mem, stype, [][]array.Interface{{
+					arrayOf(mem, []int32{3}, []bool{false}),
+					arrayOf(mem, []string{"ccc"}, nil),
+				}}, nil),
+				structOf(mem, stype, [][]array.Interface{{
+					arrayOf(mem, []int32{4, 5, 6}, mask),
+					arrayOf(mem, []string{"d", "", "f"}, mask),
+				}}, nil),
+			}, nil),
+		},
+		[]array.Interface{
+			largeListOf(mem, []array.Interface{
+				arrayOf(mem, []int32{-1, -2, -3}, mask),
+			}, nil),
+			listOf(mem, []array.Interface{
+				largeListOf(mem, []array.Interface{
+					arrayOf(mem, []int32{-1, -2, -3}, nil),
+				}, nil),
+			}, nil),
+			largeListOf(mem, []array.Interface{
+				structOf(mem, stype, [][]array.Interface{{
+					arrayOf(mem, []int32{-1}, nil),
+					arrayOf(mem, []string{"z"}, nil),
+				}}, nil),
+			}, []bool{false}),
+		},
+		[]array.Interface{
+			func() array.Interface {
+				bldr := array.NewLargeListBuilder(mem, arrow.PrimitiveTypes.Int32)
+				defer bldr.Release()
+
+				return bldr.NewLargeListArray()
+			}(),
+			func() array.Interface {
+				bldr := array.NewListBuilder(mem, arrow.LargeListOf(arrow.PrimitiveTypes.Int32))
+				defer bldr.Release()
+
+				return bldr.NewListArray()
+			}(),
+			func() array.Interface {
+				bldr := array.NewLargeListBuilder(mem, stype)
+				defer bldr.Release()
+
+				return bldr.NewLargeListArray()
+			}(),
+		},
+	}
+
+	defer func() {
+		for _, chunk := range chunks {
+			for _, col := range chunk {
+				col.Release()
+			}
+		}
+	}()
+
+	recs := make([]array.Record, len(chunks))
+	for i, chunk := range chunks {
+		recs[i] = array.NewRecord(schema, chunk, -1)
+	}
+
+	return recs
+}
+
 func makeFixedSizeListsRecords() []array.Record {
 	mem := memory.NewGoAllocator()
 	const N = 3
@@ -366,8 +524,8 @@ func makeFixedSizeListsRecords() []array.Record {
 func makeStringsRecords() []array.Record {
 	mem := memory.NewGoAllocator()
 	schema := arrow.NewSchema([]arrow.Field{
-		{Name: "strings", Type: arrow.BinaryTypes.String},
-		{Name: "bytes", Type: arrow.BinaryTypes.Binary},
+		{Name: "strings", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "bytes", Type: arrow.BinaryTypes.Binary, Nullable: true},
 	}, nil)
 
 	mask := []bool{true, false, false, true, true}
@@ -690,6 +848,65 @@ func makeDecimal128sRecords() []array.Record {
 	return recs
 }
 
+var (
+	decimal256Type = &arrow.Decimal256Type{Precision: 76, Scale: 3}
+)
+
+func makeDecimal256sRecords() []array.Record {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			arrow.Field{Name: "dec256s", Type: decimal256Type, Nullable: true},
+		}, nil,
+	)
+
+	dec256s := func(vs []string) []decimal256.Num {
+		o := make([]decimal256.Num, len(vs))
+		for i, v := range vs {
+			n, err := decimal256.FromString(v, decimal256Type.Precision, decimal256Type.Scale)
+			if err != nil {
+				panic(err)
+			}
+			o[i] = n
+		}
+		return o
+	}
+
+	mask := []bool{true, false, false, true, true}
+	chunks := [][]array.Interface{
+		[]array.Interface{
+			arrayOf(mem, dec256s([]string{"3.1", "3.2", "3.3", "-3.4", "3.5"}), mask),
+		},
+		[]array.Interface{
+			arrayOf(mem, dec256s([]string{"0", "4.2", "4.3", "-0.001", "123456789012345678901234567890.123"}), mask),
+		},
+		[]array.Interface{
+			arrayOf(mem, []decimal256.Num{
+				decimal256.MaxDecimal256,
+				decimal256.FromI64(-52),
+				decimal256.FromI64(53),
+				decimal256.MaxDecimal256.Negate(),
+				decimal256.FromI64(-1),
+			}, mask),
+		},
+	}
+
+	defer func() {
+		for _, chunk := range chunks {
+			for _, col := range chunk {
+				col.Release()
+			}
+		}
+	}()
+
+	recs := make([]array.Record, len(chunks))
+	for i, chunk := range chunks {
+		recs[i] = array.NewRecord(schema, chunk, -1)
+	}
+
+	return recs
+}
+
 func arrayOf(mem memory.Allocator, a interface{}, valids []bool) array.Interface {
 	if mem == nil {
 		mem = memory.NewGoAllocator()
@@ -791,6 +1008,13 @@ func arrayOf(mem memory.Allocator, a interface{}, valids []bool) array.Interface
 		aa := bldr.NewDecimal128Array()
 		return aa
 
+	case []decimal256.Num:
+		bldr := array.NewDecimal256Builder(mem, decimal256Type)
+		defer bldr.Release()
+
+		bldr.AppendValues(a, valids)
+		return bldr.NewDecimal256Array()
+
 	case []string:
 		bldr := array.NewStringBuilder(mem)
 		defer bldr.Release()
@@ -1000,6 +1224,53 @@ func listOf(mem memory.Allocator, values []array.Interface, valids []bool) *arra
 	return bldr.NewListArray()
 }
 
+func largeListOf(mem memory.Allocator, values []array.Interface, valids []bool) *array.LargeList {
+	if mem == nil {
+		mem = memory.NewGoAllocator()
+	}
+
+	bldr := array.NewLargeListBuilder(mem, values[0].DataType())
+	defer bldr.Release()
+
+	valid := func(i int) bool {
+		return valids[i]
+	}
+
+	if valids == nil {
+		valid = func(i int) bool { return true }
+	}
+
+	for i, value := range values {
+		bldr.Append(valid(i))
+		buildArray(bldr.ValueBuilder(), value)
+	}
+
+	return bldr.NewLargeListArray()
+}
+
+func mapOf(mem memory.Allocator, keys [][]string, items [][]int32, masks [][]bool, valids []bool) *array.Map {
+	if mem == nil {
+		mem = memory.NewGoAllocator()
+	}
+
+	bldr := array.NewMapBuilder(mem, arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32, false)
+	defer bldr.Release()
+
+	kb := bldr.KeyBuilder().(*array.StringBuilder)
+	ib := bldr.ItemBuilder().(*array.Int32Builder)
+	for i := range keys {
+		if valids != nil && !valids[i] {
+			bldr.AppendNull()
+			continue
+		}
+		bldr.Append(true)
+		kb.AppendValues(keys[i], nil)
+		ib.AppendValues(items[i], masks[i])
+	}
+
+	return bldr.NewMapArray()
+}
+
 func fixedSizeListOf(mem memory.Allocator, n int32, values []array.Interface, valids []bool) *array.FixedSizeList {
 	if mem == nil {
 		mem = memory.NewGoAllocator()
@@ -1054,6 +1325,9 @@ func buildArray(bldr array.Builder, data array.Interface) {
 	defer data.Release()
 
 	switch bldr := bldr.(type) {
+	default:
+		bldr.AppendArray(data, 0, data.Len())
+
 	case *array.BooleanBuilder:
 		data := data.(*array.Boolean)
 		for i := 0; i < data.Len(); i++ {
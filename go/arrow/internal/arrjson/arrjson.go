@@ -19,6 +19,7 @@
 package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"strconv"
@@ -52,15 +53,16 @@ type Field struct {
 }
 
 type dataType struct {
-	Name      string `json:"name"`
-	Signed    bool   `json:"isSigned,omitempty"`
-	BitWidth  int    `json:"bitWidth,omitempty"`
-	Precision string `json:"precision,omitempty"`
-	ByteWidth int    `json:"byteWidth,omitempty"`
-	ListSize  int32  `json:"listSize,omitempty"`
-	Unit      string `json:"unit,omitempty"`
-	TimeZone  string `json:"timezone,omitempty"`
-	Scale     int    `json:"scale,omitempty"` // for Decimal128
+	Name       string `json:"name"`
+	Signed     bool   `json:"isSigned,omitempty"`
+	BitWidth   int    `json:"bitWidth,omitempty"`
+	Precision  string `json:"precision,omitempty"`
+	ByteWidth  int    `json:"byteWidth,omitempty"`
+	ListSize   int32  `json:"listSize,omitempty"`
+	Unit       string `json:"unit,omitempty"`
+	TimeZone   string `json:"timezone,omitempty"`
+	KeysSorted bool   `json:"keysSorted,omitempty"`
+	Scale      int    `json:"scale,omitempty"` // for Decimal128
 }
 
 func dtypeToJSON(dt arrow.DataType) dataType {
@@ -142,6 +144,10 @@ func dtypeToJSON(dt arrow.DataType) dataType {
 
 	case *arrow.ListType:
 		return dataType{Name: "list"}
+	case *arrow.LargeListType:
+		return dataType{Name: "largelist"}
+	case *arrow.MapType:
+		return dataType{Name: "map", KeysSorted: dt.KeysSorted}
 	case *arrow.StructType:
 		return dataType{Name: "struct"}
 	case *arrow.FixedSizeListType:
@@ -235,9 +241,20 @@ func dtypeFromJSON(dt dataType, children []Field) arrow.DataType {
 			return &arrow.TimestampType{TimeZone: dt.TimeZone, Unit: arrow.Nanosecond}
 		}
 	case "list":
-		return arrow.ListOf(dtypeFromJSON(children[0].Type, nil))
+		return arrow.ListOfField(arrow.Field{
+			Name:     children[0].Name,
+			Type:     dtypeFromJSON(children[0].Type, children[0].Children),
+			Nullable: children[0].Nullable,
+		})
+	case "largelist":
+		return arrow.LargeListOf(dtypeFromJSON(children[0].Type, children[0].Children))
 	case "struct":
 		return arrow.StructOf(fieldsFromJSON(children)...)
+	case "map":
+		entries := fieldsFromJSON(children[0].Children)
+		mt := arrow.MapOf(entries[0].Type, entries[1].Type)
+		mt.KeysSorted = dt.KeysSorted
+		return mt
 	case "fixedsizebinary":
 		return &arrow.FixedSizeBinaryType{ByteWidth: dt.ByteWidth}
 	case "fixedsizelist":
@@ -285,11 +302,15 @@ func fieldsToJSON(fields []arrow.Field) []Field {
 		}
 		switch dt := f.Type.(type) {
 		case *arrow.ListType:
+			o[i].Children = fieldsToJSON([]arrow.Field{dt.ElemField()})
+		case *arrow.LargeListType:
 			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: f.Nullable}})
 		case *arrow.FixedSizeListType:
 			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: f.Nullable}})
 		case *arrow.StructType:
 			o[i].Children = fieldsToJSON(dt.Fields())
+		case *arrow.MapType:
+			o[i].Children = fieldsToJSON([]arrow.Field{{Name: "entries", Type: dt.Elem()}})
 		}
 	}
 	return o
@@ -348,6 +369,82 @@ type Array struct {
 	Data     []interface{} `json:"DATA,omitempty"`
 	Offset   []int32       `json:"OFFSET,omitempty"`
 	Children []Array       `json:"children,omitempty"`
+
+	// LargeOffset holds the 64-bit offsets of LargeList arrays.
+	// They are written to the OFFSET member as strings, as JSON numbers
+	// cannot represent all 64-bit integers.
+	LargeOffset []int64 `json:"-"`
+}
+
+// jsonArray is the JSON layout of Array.
+type jsonArray struct {
+	Name     string        `json:"name"`
+	Count    int           `json:"count"`
+	Valids   []int         `json:"VALIDITY,omitempty"`
+	Data     []interface{} `json:"DATA,omitempty"`
+	Offset   interface{}   `json:"OFFSET,omitempty"`
+	Children []Array       `json:"children,omitempty"`
+}
+
+func (a Array) MarshalJSON() ([]byte, error) {
+	o := jsonArray{
+		Name:     a.Name,
+		Count:    a.Count,
+		Valids:   a.Valids,
+		Data:     a.Data,
+		Children: a.Children,
+	}
+	switch {
+	case len(a.LargeOffset) > 0:
+		offsets := make([]string, len(a.LargeOffset))
+		for i, v := range a.LargeOffset {
+			offsets[i] = strconv.FormatInt(v, 10)
+		}
+		o.Offset = offsets
+	case len(a.Offset) > 0:
+		o.Offset = a.Offset
+	}
+	return json.Marshal(o)
+}
+
+func (a *Array) UnmarshalJSON(data []byte) error {
+	var o struct {
+		jsonArray
+		Offset []json.RawMessage `json:"OFFSET,omitempty"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&o); err != nil {
+		return err
+	}
+
+	*a = Array{
+		Name:     o.Name,
+		Count:    o.Count,
+		Valids:   o.Valids,
+		Data:     o.Data,
+		Children: o.Children,
+	}
+	for _, raw := range o.Offset {
+		if len(raw) > 0 && raw[0] == '"' {
+			var v string
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return err
+			}
+			off, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return xerrors.Errorf("arrjson: invalid offset %q: %w", v, err)
+			}
+			a.LargeOffset = append(a.LargeOffset, off)
+			continue
+		}
+		var off int32
+		if err := json.Unmarshal(raw, &off); err != nil {
+			return err
+		}
+		a.Offset = append(a.Offset, off)
+	}
+	return nil
 }
 
 func arraysFromJSON(mem memory.Allocator, schema *arrow.Schema, arrs []Array) []array.Interface {
@@ -499,6 +596,36 @@ func arrayFromJSON(mem memory.Allocator, dt arrow.DataType, arr Array) array.Int
 		}
 		return bldr.NewArray()
 
+	case *arrow.LargeListType:
+		bldr := array.NewLargeListBuilder(mem, dt.Elem())
+		defer bldr.Release()
+		valids := validsFromJSON(arr.Valids)
+		elems := arrayFromJSON(mem, dt.Elem(), arr.Children[0])
+		defer elems.Release()
+		for i, v := range valids {
+			bldr.Append(v)
+			beg := int(arr.LargeOffset[i])
+			end := int(arr.LargeOffset[i+1])
+			bldr.ValueBuilder().AppendArray(elems, beg, end)
+		}
+		return bldr.NewArray()
+
+	case *arrow.MapType:
+		bldr := array.NewMapBuilder(mem, dt.KeyType(), dt.ItemType(), dt.KeysSorted)
+		defer bldr.Release()
+		valids := validsFromJSON(arr.Valids)
+		elems := arrayFromJSON(mem, dt.Elem(), arr.Children[0])
+		defer elems.Release()
+		entries := elems.(*array.Struct)
+		for i, v := range valids {
+			bldr.Append(v)
+			beg := int64(arr.Offset[i])
+			end := int64(arr.Offset[i+1])
+			buildArray(bldr.KeyBuilder(), array.NewSlice(entries.Field(0), beg, end))
+			buildArray(bldr.ItemBuilder(), array.NewSlice(entries.Field(1), beg, end))
+		}
+		return bldr.NewArray()
+
 	case *arrow.FixedSizeListType:
 		bldr := array.NewFixedSizeListBuilder(mem, dt.Len(), dt.Elem())
 		defer bldr.Release()
@@ -631,127 +758,62 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 			Count: arr.Len(),
 		}
 
-	case *array.Boolean:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   boolsToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Int8:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   i8ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Int16:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   i16ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Int32:
+	case *array.Boolean,
+		*array.Int8, *array.Int16, *array.Int32, *array.Int64,
+		*array.Uint8, *array.Uint16, *array.Uint32, *array.Uint64,
+		*array.Float16, *array.Float32, *array.Float64,
+		*array.String, *array.FixedSizeBinary,
+		*array.Date32, *array.Date64, *array.Time32, *array.Time64,
+		*array.Timestamp, *array.MonthInterval, *array.DayTimeInterval,
+		*array.Duration:
 		return Array{
 			Name:   field.Name,
 			Count:  arr.Len(),
-			Data:   i32ToJSON(arr),
+			Data:   dataToJSON(arr),
 			Valids: validsToJSON(arr),
 		}
 
-	case *array.Int64:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   i64ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Uint8:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   u8ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Uint16:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   u16ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Uint32:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   u32ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Uint64:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   u64ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Float16:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   f16ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Float32:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   f32ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-
-	case *array.Float64:
+	case *array.Binary:
 		return Array{
 			Name:   field.Name,
 			Count:  arr.Len(),
-			Data:   f64ToJSON(arr),
+			Data:   dataToJSON(arr),
 			Valids: validsToJSON(arr),
+			Offset: arr.ValueOffsets(),
 		}
 
-	case *array.String:
-		return Array{
+	case *array.List:
+		o := Array{
 			Name:   field.Name,
 			Count:  arr.Len(),
-			Data:   strToJSON(arr),
 			Valids: validsToJSON(arr),
+			Offset: arr.Offsets(),
+			Children: []Array{
+				arrayToJSON(arr.DataType().(*arrow.ListType).ElemField(), arr.ListValues()),
+			},
 		}
+		return o
 
-	case *array.Binary:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   bytesToJSON(arr),
-			Valids: validsToJSON(arr),
-			Offset: arr.ValueOffsets(),
+	case *array.LargeList:
+		o := Array{
+			Name:        field.Name,
+			Count:       arr.Len(),
+			Valids:      validsToJSON(arr),
+			LargeOffset: arr.Offsets(),
+			Children: []Array{
+				arrayToJSON(arrow.Field{Name: "item", Type: arr.DataType().(*arrow.LargeListType).Elem()}, arr.ListValues()),
+			},
 		}
+		return o
 
-	case *array.List:
+	case *array.Map:
 		o := Array{
 			Name:   field.Name,
 			Count:  arr.Len(),
 			Valids: validsToJSON(arr),
 			Offset: arr.Offsets(),
 			Children: []Array{
-				arrayToJSON(arrow.Field{Name: "item", Type: arr.DataType().(*arrow.ListType).Elem()}, arr.ListValues()),
+				arrayToJSON(arrow.Field{Name: "entries", Type: arr.DataType().(*arrow.MapType).Elem()}, arr.ListValues()),
 			},
 		}
 		return o
@@ -780,88 +842,110 @@ func arrayToJSON(field arrow.Field, arr array.Interface) Array {
 		}
 		return o
 
-	case *array.FixedSizeBinary:
-		dt := arr.DataType().(*arrow.FixedSizeBinaryType)
-		o := Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Valids: validsToJSON(arr),
-			Data:   make([]interface{}, arr.Len()),
-		}
-		for i := range o.Data {
-			v := []byte(strings.ToUpper(hex.EncodeToString(arr.Value(i))))
-			if len(v) != 2*dt.ByteWidth {
-				panic(xerrors.Errorf("arrjson: invalid hex-string length (got=%d, want=%d)", len(v), 2*dt.ByteWidth))
-			}
-			o.Data[i] = string(v) // re-convert as string to prevent json.Marshal from base64-encoding it.
-		}
-		return o
+	default:
+		panic(xerrors.Errorf("unknown array type %T", arr))
+	}
+	panic("impossible")
+}
 
-	case *array.Date32:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   date32ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
+// dataToJSON returns the JSON values of the value slots of arr, including
+// the slots of null elements.
+func dataToJSON(arr array.Interface) []interface{} {
+	// visit arr as if it had no nulls, to get all of its value slots.
+	var (
+		data  = arr.Data()
+		bufs  = append([]*memory.Buffer{nil}, data.Buffers()[1:]...)
+		slots = array.NewData(data.DataType(), data.Len(), bufs, nil, 0, data.Offset())
+	)
+	defer slots.Release()
+	all := array.MakeFromData(slots)
+	defer all.Release()
+
+	v := &dataVisitor{arr: arr, data: make([]interface{}, 0, arr.Len())}
+	if err := array.Accept(all, v); err != nil {
+		panic(err)
+	}
+	return v.data
+}
 
-	case *array.Date64:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   date64ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
+// dataVisitor collects the JSON values of the value slots of an array.
+// 64-bit integers are encoded as strings, with null slots set to "0".
+type dataVisitor struct {
+	array.BaseVisitor
+	arr  array.Interface // visited array, with its validity.
+	data []interface{}
+}
 
-	case *array.Time32:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   time32ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
+func (v *dataVisitor) add(x interface{}) error {
+	v.data = append(v.data, x)
+	return nil
+}
 
-	case *array.Time64:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   time64ToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
+// addInt64 adds the string encoding of the 64-bit integer x at pos.
+func (v *dataVisitor) addInt64(pos array.Position, x int64) error {
+	if v.arr.IsNull(pos.Index) {
+		return v.add("0")
+	}
+	return v.add(strconv.FormatInt(x, 10))
+}
+
+func (v *dataVisitor) VisitBoolean(_ array.Position, x bool) error        { return v.add(x) }
+func (v *dataVisitor) VisitInt8(_ array.Position, x int8) error           { return v.add(x) }
+func (v *dataVisitor) VisitInt16(_ array.Position, x int16) error         { return v.add(x) }
+func (v *dataVisitor) VisitInt32(_ array.Position, x int32) error         { return v.add(x) }
+func (v *dataVisitor) VisitInt64(pos array.Position, x int64) error       { return v.addInt64(pos, x) }
+func (v *dataVisitor) VisitUint8(_ array.Position, x uint8) error         { return v.add(x) }
+func (v *dataVisitor) VisitUint16(_ array.Position, x uint16) error       { return v.add(x) }
+func (v *dataVisitor) VisitUint32(_ array.Position, x uint32) error       { return v.add(x) }
+func (v *dataVisitor) VisitFloat16(_ array.Position, x float16.Num) error { return v.add(x.Float32()) }
+func (v *dataVisitor) VisitFloat32(_ array.Position, x float32) error     { return v.add(x) }
+func (v *dataVisitor) VisitFloat64(_ array.Position, x float64) error     { return v.add(x) }
+func (v *dataVisitor) VisitString(_ array.Position, x string) error       { return v.add(x) }
+func (v *dataVisitor) VisitDate32(_ array.Position, x arrow.Date32) error { return v.add(int32(x)) }
+func (v *dataVisitor) VisitTime32(_ array.Position, x arrow.Time32) error { return v.add(int32(x)) }
+
+func (v *dataVisitor) VisitUint64(pos array.Position, x uint64) error {
+	if v.arr.IsNull(pos.Index) {
+		return v.add("0")
+	}
+	return v.add(strconv.FormatUint(x, 10))
+}
 
-	case *array.Timestamp:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   timestampToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-	case *array.MonthInterval:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   monthintervalToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-	case *array.DayTimeInterval:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   daytimeintervalToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
-	case *array.Duration:
-		return Array{
-			Name:   field.Name,
-			Count:  arr.Len(),
-			Data:   durationToJSON(arr),
-			Valids: validsToJSON(arr),
-		}
+func (v *dataVisitor) VisitDate64(pos array.Position, x arrow.Date64) error {
+	return v.addInt64(pos, int64(x))
+}
 
-	default:
-		panic(xerrors.Errorf("unknown array type %T", arr))
+func (v *dataVisitor) VisitTime64(pos array.Position, x arrow.Time64) error {
+	return v.addInt64(pos, int64(x))
+}
+
+func (v *dataVisitor) VisitTimestamp(pos array.Position, x arrow.Timestamp) error {
+	return v.addInt64(pos, int64(x))
+}
+
+func (v *dataVisitor) VisitDuration(pos array.Position, x arrow.Duration) error {
+	return v.addInt64(pos, int64(x))
+}
+
+func (v *dataVisitor) VisitMonthInterval(_ array.Position, x arrow.MonthInterval) error {
+	return v.add(int32(x))
+}
+
+func (v *dataVisitor) VisitDayTimeInterval(_ array.Position, x arrow.DayTimeInterval) error {
+	return v.add(x)
+}
+
+func (v *dataVisitor) VisitBinary(_ array.Position, x []byte) error {
+	return v.add(strings.ToUpper(hex.EncodeToString(x)))
+}
+
+func (v *dataVisitor) VisitFixedSizeBinary(pos array.Position, x []byte) error {
+	dt := pos.Array.DataType().(*arrow.FixedSizeBinaryType)
+	str := strings.ToUpper(hex.EncodeToString(x))
+	if len(str) != 2*dt.ByteWidth {
+		return xerrors.Errorf("arrjson: invalid hex-string length (got=%d, want=%d)", len(str), 2*dt.ByteWidth)
 	}
-	panic("impossible")
+	return v.add(str) // add as a string to prevent json.Marshal from base64-encoding it.
 }
 
 func validsFromJSON(vs []int) []bool {
@@ -892,14 +976,6 @@ func boolsFromJSON(vs []interface{}) []bool {
 	return o
 }
 
-func boolsToJSON(arr *array.Boolean) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func i8FromJSON(vs []interface{}) []int8 {
 	o := make([]int8, len(vs))
 	for i, v := range vs {
@@ -912,14 +988,6 @@ func i8FromJSON(vs []interface{}) []int8 {
 	return o
 }
 
-func i8ToJSON(arr *array.Int8) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func i16FromJSON(vs []interface{}) []int16 {
 	o := make([]int16, len(vs))
 	for i, v := range vs {
@@ -932,14 +1000,6 @@ func i16FromJSON(vs []interface{}) []int16 {
 	return o
 }
 
-func i16ToJSON(arr *array.Int16) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func i32FromJSON(vs []interface{}) []int32 {
 	o := make([]int32, len(vs))
 	for i, v := range vs {
@@ -952,14 +1012,6 @@ func i32FromJSON(vs []interface{}) []int32 {
 	return o
 }
 
-func i32ToJSON(arr *array.Int32) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func i64FromJSON(vs []interface{}) []int64 {
 	o := make([]int64, len(vs))
 	for i, v := range vs {
@@ -972,18 +1024,6 @@ func i64FromJSON(vs []interface{}) []int64 {
 	return o
 }
 
-func i64ToJSON(arr *array.Int64) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatInt(arr.Value(i), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
 func u8FromJSON(vs []interface{}) []uint8 {
 	o := make([]uint8, len(vs))
 	for i, v := range vs {
@@ -996,14 +1036,6 @@ func u8FromJSON(vs []interface{}) []uint8 {
 	return o
 }
 
-func u8ToJSON(arr *array.Uint8) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func u16FromJSON(vs []interface{}) []uint16 {
 	o := make([]uint16, len(vs))
 	for i, v := range vs {
@@ -1016,14 +1048,6 @@ func u16FromJSON(vs []interface{}) []uint16 {
 	return o
 }
 
-func u16ToJSON(arr *array.Uint16) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func u32FromJSON(vs []interface{}) []uint32 {
 	o := make([]uint32, len(vs))
 	for i, v := range vs {
@@ -1036,14 +1060,6 @@ func u32FromJSON(vs []interface{}) []uint32 {
 	return o
 }
 
-func u32ToJSON(arr *array.Uint32) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func u64FromJSON(vs []interface{}) []uint64 {
 	o := make([]uint64, len(vs))
 	for i, v := range vs {
@@ -1056,22 +1072,12 @@ func u64FromJSON(vs []interface{}) []uint64 {
 	return o
 }
 
-func u64ToJSON(arr *array.Uint64) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatUint(arr.Value(i), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
+// f16FromJSON and f32FromJSON parse values with the precision of their type:
+// parsing them as float64 first could round them twice.
 func f16FromJSON(vs []interface{}) []float16.Num {
 	o := make([]float16.Num, len(vs))
 	for i, v := range vs {
-		vv, err := v.(json.Number).Float64()
+		vv, err := strconv.ParseFloat(string(v.(json.Number)), 32)
 		if err != nil {
 			panic(err)
 		}
@@ -1080,18 +1086,10 @@ func f16FromJSON(vs []interface{}) []float16.Num {
 	return o
 }
 
-func f16ToJSON(arr *array.Float16) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i).Float32()
-	}
-	return o
-}
-
 func f32FromJSON(vs []interface{}) []float32 {
 	o := make([]float32, len(vs))
 	for i, v := range vs {
-		vv, err := v.(json.Number).Float64()
+		vv, err := strconv.ParseFloat(string(v.(json.Number)), 32)
 		if err != nil {
 			panic(err)
 		}
@@ -1100,14 +1098,6 @@ func f32FromJSON(vs []interface{}) []float32 {
 	return o
 }
 
-func f32ToJSON(arr *array.Float32) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func f64FromJSON(vs []interface{}) []float64 {
 	o := make([]float64, len(vs))
 	for i, v := range vs {
@@ -1120,14 +1110,6 @@ func f64FromJSON(vs []interface{}) []float64 {
 	return o
 }
 
-func f64ToJSON(arr *array.Float64) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func strFromJSON(vs []interface{}) []string {
 	o := make([]string, len(vs))
 	for i, v := range vs {
@@ -1143,14 +1125,6 @@ func strFromJSON(vs []interface{}) []string {
 	return o
 }
 
-func strToJSON(arr *array.String) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func bytesFromJSON(vs []interface{}) [][]byte {
 	o := make([][]byte, len(vs))
 	for i, v := range vs {
@@ -1170,14 +1144,6 @@ func bytesFromJSON(vs []interface{}) [][]byte {
 	return o
 }
 
-func bytesToJSON(arr *array.Binary) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = strings.ToUpper(hex.EncodeToString(arr.Value(i)))
-	}
-	return o
-}
-
 func date32FromJSON(vs []interface{}) []arrow.Date32 {
 	o := make([]arrow.Date32, len(vs))
 	for i, v := range vs {
@@ -1190,14 +1156,6 @@ func date32FromJSON(vs []interface{}) []arrow.Date32 {
 	return o
 }
 
-func date32ToJSON(arr *array.Date32) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = int32(arr.Value(i))
-	}
-	return o
-}
-
 func date64FromJSON(vs []interface{}) []arrow.Date64 {
 	o := make([]arrow.Date64, len(vs))
 	for i, v := range vs {
@@ -1210,18 +1168,6 @@ func date64FromJSON(vs []interface{}) []arrow.Date64 {
 	return o
 }
 
-func date64ToJSON(arr *array.Date64) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatInt(int64(arr.Value(i)), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
 func time32FromJSON(vs []interface{}) []arrow.Time32 {
 	o := make([]arrow.Time32, len(vs))
 	for i, v := range vs {
@@ -1234,14 +1180,6 @@ func time32FromJSON(vs []interface{}) []arrow.Time32 {
 	return o
 }
 
-func time32ToJSON(arr *array.Time32) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = int32(arr.Value(i))
-	}
-	return o
-}
-
 func time64FromJSON(vs []interface{}) []arrow.Time64 {
 	o := make([]arrow.Time64, len(vs))
 	for i, v := range vs {
@@ -1254,18 +1192,6 @@ func time64FromJSON(vs []interface{}) []arrow.Time64 {
 	return o
 }
 
-func time64ToJSON(arr *array.Time64) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatInt(int64(arr.Value(i)), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
 func timestampFromJSON(vs []interface{}) []arrow.Timestamp {
 	o := make([]arrow.Timestamp, len(vs))
 	for i, v := range vs {
@@ -1278,18 +1204,6 @@ func timestampFromJSON(vs []interface{}) []arrow.Timestamp {
 	return o
 }
 
-func timestampToJSON(arr *array.Timestamp) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatInt(int64(arr.Value(i)), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
 func monthintervalFromJSON(vs []interface{}) []arrow.MonthInterval {
 	o := make([]arrow.MonthInterval, len(vs))
 	for i, v := range vs {
@@ -1302,14 +1216,6 @@ func monthintervalFromJSON(vs []interface{}) []arrow.MonthInterval {
 	return o
 }
 
-func monthintervalToJSON(arr *array.MonthInterval) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = int32(arr.Value(i))
-	}
-	return o
-}
-
 func daytimeintervalFromJSON(vs []interface{}) []arrow.DayTimeInterval {
 	o := make([]arrow.DayTimeInterval, len(vs))
 	for i, vv := range vs {
@@ -1327,14 +1233,6 @@ func daytimeintervalFromJSON(vs []interface{}) []arrow.DayTimeInterval {
 	return o
 }
 
-func daytimeintervalToJSON(arr *array.DayTimeInterval) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		o[i] = arr.Value(i)
-	}
-	return o
-}
-
 func durationFromJSON(vs []interface{}) []arrow.Duration {
 	o := make([]arrow.Duration, len(vs))
 	for i, v := range vs {
@@ -1347,24 +1245,12 @@ func durationFromJSON(vs []interface{}) []arrow.Duration {
 	return o
 }
 
-func durationToJSON(arr *array.Duration) []interface{} {
-	o := make([]interface{}, arr.Len())
-	for i := range o {
-		if arr.IsValid(i) {
-			o[i] = strconv.FormatInt(int64(arr.Value(i)), 10)
-		} else {
-			o[i] = "0"
-		}
-	}
-	return o
-}
-
 func buildArray(bldr array.Builder, data array.Interface) {
 	defer data.Release()
 
 	switch bldr := bldr.(type) {
 	default:
-		panic(xerrors.Errorf("unknown builder %T", bldr))
+		bldr.AppendArray(data, 0, data.Len())
 
 	case *array.BooleanBuilder:
 		data := data.(*array.Boolean)
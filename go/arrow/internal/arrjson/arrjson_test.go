@@ -17,11 +17,15 @@
 package arrjson // import "github.com/apache/arrow/go/arrow/internal/arrjson"
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"os"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
 	"github.com/apache/arrow/go/arrow/memory"
@@ -33,6 +37,8 @@ func TestReadWrite(t *testing.T) {
 	wantJSONs["primitives"] = makePrimitiveWantJSONs()
 	wantJSONs["structs"] = makeStructsWantJSONs()
 	wantJSONs["lists"] = makeListsWantJSONs()
+	wantJSONs["maps"] = makeMapsWantJSONs()
+	wantJSONs["large_lists"] = makeLargeListsWantJSONs()
 	wantJSONs["strings"] = makeStringsWantJSONs()
 	wantJSONs["fixed_size_lists"] = makeFixedSizeListsWantJSONs()
 	wantJSONs["fixed_width_types"] = makeFixedWidthTypesWantJSONs()
@@ -49,8 +55,9 @@ func TestReadWrite(t *testing.T) {
 
 	for name, recs := range arrdata.Records {
 		t.Run(name, func(t *testing.T) {
-			if name == "decimal128" {
-				t.Skip() // FIXME(sbinet): implement full decimal128 support
+			switch name {
+			case "decimal128", "decimal256":
+				t.Skip() // FIXME(sbinet): implement full decimal128/decimal256 support
 			}
 			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer mem.AssertSize(t, 0)
@@ -135,6 +142,82 @@ func TestReadWrite(t *testing.T) {
 	}
 }
 
+func TestFloatRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		},
+		nil,
+	)
+
+	// JSON cannot represent infinities and NaNs.
+	f32s := []float32{
+		0, float32(math.Copysign(0, -1)), 0.1, 1.0 / 3, math.MaxFloat32, -math.MaxFloat32,
+		math.SmallestNonzeroFloat32, math.Float32frombits(0x007fffff),
+	}
+	f64s := []float64{
+		0, math.Copysign(0, -1), 0.1, 1.0 / 3, math.MaxFloat64, -math.MaxFloat64,
+		math.SmallestNonzeroFloat64, math.Float64frombits(0x000fffffffffffff),
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for len(f32s) < 1000 {
+		f32 := math.Float32frombits(rnd.Uint32())
+		f64 := math.Float64frombits(rnd.Uint64())
+		if math.IsNaN(float64(f32)) || math.IsInf(float64(f32), 0) || math.IsNaN(f64) || math.IsInf(f64, 0) {
+			continue
+		}
+		f32s = append(f32s, f32)
+		f64s = append(f64s, f64)
+	}
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	bld.Field(0).(*array.Float32Builder).AppendValues(f32s, nil)
+	bld.Field(1).(*array.Float64Builder).AppendValues(f64s, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("could not close JSON writer: %v", err)
+	}
+
+	r, err := NewReader(buf, WithAllocator(mem), WithSchema(schema))
+	if err != nil {
+		t.Fatalf("could not read JSON: %v", err)
+	}
+	defer r.Release()
+
+	got, err := r.Read()
+	if err != nil {
+		t.Fatalf("could not read record: %v", err)
+	}
+
+	var (
+		g32 = got.Column(0).(*array.Float32).Float32Values()
+		g64 = got.Column(1).(*array.Float64).Float64Values()
+	)
+	for i := range f32s {
+		if math.Float32bits(g32[i]) != math.Float32bits(f32s[i]) {
+			t.Errorf("invalid float32 value %d: got=%v, want=%v", i, g32[i], f32s[i])
+		}
+		if math.Float64bits(g64[i]) != math.Float64bits(f64s[i]) {
+			t.Errorf("invalid float64 value %d: got=%v, want=%v", i, g64[i], f64s[i])
+		}
+	}
+}
+
 func makeNullWantJSONs() string {
 	return `{
   "schema": {
@@ -1477,6 +1560,672 @@ func makeListsWantJSONs() string {
 }`
 }
 
+func makeMapsWantJSONs() string {
+	return `{
+  "schema": {
+    "fields": [
+      {
+        "name": "map_nullable",
+        "type": {
+          "name": "map"
+        },
+        "nullable": true,
+        "children": [
+          {
+            "name": "entries",
+            "type": {
+              "name": "struct"
+            },
+            "nullable": false,
+            "children": [
+              {
+                "name": "key",
+                "type": {
+                  "name": "utf8"
+                },
+                "nullable": false,
+                "children": []
+              },
+              {
+                "name": "value",
+                "type": {
+                  "name": "int",
+                  "isSigned": true,
+                  "bitWidth": 32
+                },
+                "nullable": true,
+                "children": []
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  "batches": [
+    {
+      "count": 4,
+      "columns": [
+        {
+          "name": "map_nullable",
+          "count": 4,
+          "VALIDITY": [
+            1,
+            1,
+            1,
+            1
+          ],
+          "OFFSET": [
+            0,
+            3,
+            3,
+            4,
+            6
+          ],
+          "children": [
+            {
+              "name": "entries",
+              "count": 6,
+              "VALIDITY": [
+                1,
+                1,
+                1,
+                1,
+                1,
+                1
+              ],
+              "children": [
+                {
+                  "name": "key",
+                  "count": 6,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1,
+                    1,
+                    1,
+                    1
+                  ],
+                  "DATA": [
+                    "a",
+                    "b",
+                    "c",
+                    "d",
+                    "e",
+                    "f"
+                  ]
+                },
+                {
+                  "name": "value",
+                  "count": 6,
+                  "VALIDITY": [
+                    1,
+                    0,
+                    1,
+                    1,
+                    0,
+                    1
+                  ],
+                  "DATA": [
+                    1,
+                    2,
+                    3,
+                    4,
+                    5,
+                    6
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "count": 4,
+      "columns": [
+        {
+          "name": "map_nullable",
+          "count": 4,
+          "VALIDITY": [
+            1,
+            0,
+            1,
+            1
+          ],
+          "OFFSET": [
+            0,
+            1,
+            1,
+            3,
+            3
+          ],
+          "children": [
+            {
+              "name": "entries",
+              "count": 3,
+              "VALIDITY": [
+                1,
+                1,
+                1
+              ],
+              "children": [
+                {
+                  "name": "key",
+                  "count": 3,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1
+                  ],
+                  "DATA": [
+                    "a",
+                    "b",
+                    "c"
+                  ]
+                },
+                {
+                  "name": "value",
+                  "count": 3,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1
+                  ],
+                  "DATA": [
+                    -1,
+                    -2,
+                    -3
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "count": 0,
+      "columns": [
+        {
+          "name": "map_nullable",
+          "count": 0,
+          "OFFSET": [
+            0
+          ],
+          "children": [
+            {
+              "name": "entries",
+              "count": 0,
+              "children": [
+                {
+                  "name": "key",
+                  "count": 0
+                },
+                {
+                  "name": "value",
+                  "count": 0
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+}
+
+func makeLargeListsWantJSONs() string {
+	return `{
+  "schema": {
+    "fields": [
+      {
+        "name": "large_list_nullable",
+        "type": {
+          "name": "largelist"
+        },
+        "nullable": true,
+        "children": [
+          {
+            "name": "item",
+            "type": {
+              "name": "int",
+              "isSigned": true,
+              "bitWidth": 32
+            },
+            "nullable": true,
+            "children": []
+          }
+        ]
+      },
+      {
+        "name": "list_of_large_lists",
+        "type": {
+          "name": "list"
+        },
+        "nullable": true,
+        "children": [
+          {
+            "name": "item",
+            "type": {
+              "name": "largelist"
+            },
+            "nullable": true,
+            "children": [
+              {
+                "name": "item",
+                "type": {
+                  "name": "int",
+                  "isSigned": true,
+                  "bitWidth": 32
+                },
+                "nullable": true,
+                "children": []
+              }
+            ]
+          }
+        ]
+      },
+      {
+        "name": "large_list_of_structs",
+        "type": {
+          "name": "largelist"
+        },
+        "nullable": true,
+        "children": [
+          {
+            "name": "item",
+            "type": {
+              "name": "struct"
+            },
+            "nullable": true,
+            "children": [
+              {
+                "name": "a",
+                "type": {
+                  "name": "int",
+                  "isSigned": true,
+                  "bitWidth": 32
+                },
+                "nullable": true,
+                "children": []
+              },
+              {
+                "name": "b",
+                "type": {
+                  "name": "utf8"
+                },
+                "nullable": true,
+                "children": []
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  "batches": [
+    {
+      "count": 3,
+      "columns": [
+        {
+          "name": "large_list_nullable",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            0,
+            1
+          ],
+          "OFFSET": [
+            "0",
+            "3",
+            "3",
+            "6"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 6,
+              "VALIDITY": [
+                1,
+                0,
+                1,
+                1,
+                0,
+                1
+              ],
+              "DATA": [
+                1,
+                0,
+                3,
+                11,
+                0,
+                13
+              ]
+            }
+          ]
+        },
+        {
+          "name": "list_of_large_lists",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            1,
+            0
+          ],
+          "OFFSET": [
+            0,
+            2,
+            3,
+            6
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 6,
+              "VALIDITY": [
+                1,
+                1,
+                0,
+                1,
+                1,
+                1
+              ],
+              "OFFSET": [
+                "0",
+                "2",
+                "3",
+                "6",
+                "7",
+                "9",
+                "9"
+              ],
+              "children": [
+                {
+                  "name": "item",
+                  "count": 9,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1,
+                    1,
+                    0,
+                    1,
+                    1,
+                    1,
+                    1
+                  ],
+                  "DATA": [
+                    1,
+                    2,
+                    3,
+                    4,
+                    0,
+                    6,
+                    7,
+                    8,
+                    9
+                  ]
+                }
+              ]
+            }
+          ]
+        },
+        {
+          "name": "large_list_of_structs",
+          "count": 3,
+          "VALIDITY": [
+            1,
+            1,
+            1
+          ],
+          "OFFSET": [
+            "0",
+            "2",
+            "3",
+            "6"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 6,
+              "VALIDITY": [
+                1,
+                1,
+                1,
+                1,
+                1,
+                1
+              ],
+              "children": [
+                {
+                  "name": "a",
+                  "count": 6,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    0,
+                    1,
+                    0,
+                    1
+                  ],
+                  "DATA": [
+                    1,
+                    2,
+                    0,
+                    4,
+                    0,
+                    6
+                  ]
+                },
+                {
+                  "name": "b",
+                  "count": 6,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1,
+                    1,
+                    0,
+                    1
+                  ],
+                  "DATA": [
+                    "a",
+                    "bb",
+                    "ccc",
+                    "d",
+                    "",
+                    "f"
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "count": 1,
+      "columns": [
+        {
+          "name": "large_list_nullable",
+          "count": 1,
+          "VALIDITY": [
+            1
+          ],
+          "OFFSET": [
+            "0",
+            "3"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 3,
+              "VALIDITY": [
+                1,
+                0,
+                1
+              ],
+              "DATA": [
+                -1,
+                0,
+                -3
+              ]
+            }
+          ]
+        },
+        {
+          "name": "list_of_large_lists",
+          "count": 1,
+          "VALIDITY": [
+            1
+          ],
+          "OFFSET": [
+            0,
+            1
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 1,
+              "VALIDITY": [
+                1
+              ],
+              "OFFSET": [
+                "0",
+                "3"
+              ],
+              "children": [
+                {
+                  "name": "item",
+                  "count": 3,
+                  "VALIDITY": [
+                    1,
+                    1,
+                    1
+                  ],
+                  "DATA": [
+                    -1,
+                    -2,
+                    -3
+                  ]
+                }
+              ]
+            }
+          ]
+        },
+        {
+          "name": "large_list_of_structs",
+          "count": 1,
+          "VALIDITY": [
+            0
+          ],
+          "OFFSET": [
+            "0",
+            "1"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 1,
+              "VALIDITY": [
+                1
+              ],
+              "children": [
+                {
+                  "name": "a",
+                  "count": 1,
+                  "VALIDITY": [
+                    1
+                  ],
+                  "DATA": [
+                    -1
+                  ]
+                },
+                {
+                  "name": "b",
+                  "count": 1,
+                  "VALIDITY": [
+                    1
+                  ],
+                  "DATA": [
+                    "z"
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "count": 0,
+      "columns": [
+        {
+          "name": "large_list_nullable",
+          "count": 0,
+          "OFFSET": [
+            "0"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 0
+            }
+          ]
+        },
+        {
+          "name": "list_of_large_lists",
+          "count": 0,
+          "OFFSET": [
+            0
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 0,
+              "OFFSET": [
+                "0"
+              ],
+              "children": [
+                {
+                  "name": "item",
+                  "count": 0
+                }
+              ]
+            }
+          ]
+        },
+        {
+          "name": "large_list_of_structs",
+          "count": 0,
+          "OFFSET": [
+            "0"
+          ],
+          "children": [
+            {
+              "name": "item",
+              "count": 0,
+              "children": [
+                {
+                  "name": "a",
+                  "count": 0
+                },
+                {
+                  "name": "b",
+                  "count": 0
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+}
+
 func makeFixedSizeListsWantJSONs() string {
 	return `{
   "schema": {
@@ -1643,7 +2392,7 @@ func makeStringsWantJSONs() string {
         "type": {
           "name": "utf8"
         },
-        "nullable": false,
+        "nullable": true,
         "children": []
       },
       {
@@ -1651,7 +2400,7 @@ func makeStringsWantJSONs() string {
         "type": {
           "name": "binary"
         },
-        "nullable": false,
+        "nullable": true,
         "children": []
       }
     ]
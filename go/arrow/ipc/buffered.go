@@ -0,0 +1,266 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"golang.org/x/xerrors"
+)
+
+const (
+	kDefaultQueueBytes    = 64 << 20 // default bound, in bytes, of the queue of a BufferedWriter
+	kDefaultQueueMessages = 16       // default bound, in messages, of the queue of a BufferedWriter
+)
+
+// BufferedWriter is an Arrow stream writer decoupling the serialization of
+// records from the writes to the underlying io.Writer.
+//
+// Write serializes its record into a queue of encapsulated messages, written
+// out by a background goroutine: once Write returns, the record is no longer
+// referenced and may be released.
+// The queue is bounded, by default to 64MiB and 16 messages (see
+// WithQueueLimits): Write blocks while the queue is full, so that a slow
+// writer holds back its producers instead of letting memory grow.
+//
+// The first error of the underlying io.Writer is returned by Err, and by
+// the calls to Write and Close that follow it. The messages queued at that
+// point are discarded.
+//
+// A BufferedWriter must not be used concurrently, nor after Close.
+type BufferedWriter struct {
+	w *Writer
+	q *bqueue
+
+	abort bool // whether Close discards the queued messages
+}
+
+// NewBufferedWriter returns a stream writer, writing to w from a background
+// goroutine. The options are those of NewWriter, along with WithQueueLimits
+// and WithAbortOnClose.
+// The BufferedWriter must be closed to stop its goroutine.
+func NewBufferedWriter(w io.Writer, opts ...Option) *BufferedWriter {
+	cfg := newConfig(opts...)
+	q := &bqueue{
+		w:        w,
+		maxBytes: cfg.queue.bytes,
+		maxMsgs:  cfg.queue.msgs,
+		done:     make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	go q.flush()
+
+	bw := &BufferedWriter{
+		w:     NewWriter(w, opts...),
+		q:     q,
+		abort: cfg.queue.abort,
+	}
+	bw.w.pw = q
+	return bw
+}
+
+// Schema returns the schema of the records written to the stream.
+func (bw *BufferedWriter) Schema() *arrow.Schema { return bw.w.Schema() }
+
+// Write serializes rec and queues its messages, blocking while the queue is
+// full. Write returns the first error of the underlying writer, if any.
+func (bw *BufferedWriter) Write(rec array.Record) error {
+	if err := bw.q.Err(); err != nil {
+		return err
+	}
+	return bw.w.Write(rec)
+}
+
+// Err returns the first error of the underlying writer, if any.
+func (bw *BufferedWriter) Err() error { return bw.q.Err() }
+
+// Buffered returns the number of bytes queued and not written out yet,
+// including those of the message being written.
+func (bw *BufferedWriter) Buffered() int {
+	bw.q.mu.Lock()
+	defer bw.q.mu.Unlock()
+	return bw.q.size
+}
+
+// Close ends the stream and waits for the background goroutine to stop.
+//
+// By default, Close writes out the queued messages and the end of stream
+// marker. With WithAbortOnClose(true), Close discards the queued messages
+// instead, and only waits for the write in progress, if any.
+// Close does not close the underlying writer.
+func (bw *BufferedWriter) Close() error {
+	if bw.abort {
+		bw.q.discard()
+	}
+	err := bw.w.Close()
+
+	// stops the goroutine if the stream could not be ended.
+	bw.q.discard()
+	<-bw.q.done
+
+	if err != nil {
+		return err
+	}
+	return bw.q.Err()
+}
+
+// bqueue is the payload writer of a BufferedWriter: it serializes payloads
+// into a bounded queue, written out to w by flush.
+type bqueue struct {
+	w io.Writer
+
+	mu       sync.Mutex
+	cond     *sync.Cond // signals changes of msgs, closed, aborted and err
+	msgs     [][]byte
+	busy     int // size of the message being written, in bytes
+	size     int // total size of msgs and of the message being written, in bytes
+	maxBytes int
+	maxMsgs  int
+	closed   bool  // whether no message is queued anymore
+	aborted  bool  // whether queued messages are discarded
+	err      error // first error of w
+	done     chan struct{}
+
+	scratch [4]byte // staging space for message prefixes
+}
+
+func (q *bqueue) start() error { return nil }
+
+func (q *bqueue) write(p payload) error {
+	var buf bytes.Buffer
+	if _, err := writeIPCPayload(&buf, p, q.scratch[:]); err != nil {
+		return err
+	}
+	return q.push(buf.Bytes())
+}
+
+// Close queues the end of stream marker and waits for the queue to be
+// written out, unless it is discarded.
+func (q *bqueue) Close() error {
+	err := q.push(kEOS[:])
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+
+	<-q.done
+	return q.Err()
+}
+
+// push queues msg, waiting for the queue to have room for it.
+// A message is always accepted by an empty queue, whatever its size.
+func (q *bqueue) push(msg []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.err == nil && !q.aborted && q.size > 0 && q.full(len(msg)) {
+		q.cond.Wait()
+	}
+	switch {
+	case q.err != nil:
+		return q.err
+	case q.aborted:
+		return nil
+	}
+
+	q.msgs = append(q.msgs, msg)
+	q.size += len(msg)
+	q.cond.Broadcast()
+	return nil
+}
+
+// full reports whether the queue has no room for a message of n bytes.
+// The message being written counts towards the limits.
+func (q *bqueue) full(n int) bool {
+	msgs := len(q.msgs)
+	if q.busy > 0 {
+		msgs++
+	}
+	return (q.maxMsgs > 0 && msgs >= q.maxMsgs) ||
+		(q.maxBytes > 0 && q.size+n > q.maxBytes)
+}
+
+// discard drops the queued messages and stops flush, once done with the
+// message being written.
+func (q *bqueue) discard() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.aborted = true
+	q.drop()
+	q.cond.Broadcast()
+}
+
+// drop removes the queued messages.
+func (q *bqueue) drop() {
+	for i := range q.msgs {
+		q.msgs[i] = nil
+	}
+	q.msgs = nil
+	q.size = q.busy
+}
+
+func (q *bqueue) Err() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.err
+}
+
+// flush writes out the queued messages, in order, until the queue is closed
+// and empty, discarded, or an error occurs.
+func (q *bqueue) flush() {
+	defer close(q.done)
+
+	for {
+		q.mu.Lock()
+		for len(q.msgs) == 0 && !q.closed && !q.aborted {
+			q.cond.Wait()
+		}
+		if len(q.msgs) == 0 || q.aborted {
+			q.mu.Unlock()
+			return
+		}
+		msg := q.msgs[0]
+		q.msgs[0] = nil
+		q.msgs = q.msgs[1:]
+		q.busy = len(msg)
+		q.mu.Unlock()
+
+		_, err := q.w.Write(msg)
+
+		q.mu.Lock()
+		q.size -= q.busy
+		q.busy = 0
+		if err != nil {
+			q.err = xerrors.Errorf("arrow/ipc: could not write message: %w", err)
+			q.drop()
+		}
+		q.cond.Broadcast()
+		q.mu.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// slowWriter is an io.Writer waiting for a token of gate before each write.
+type slowWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	calls   int
+	gate    chan struct{}
+	waiting chan struct{} // signaled before waiting for gate, if not nil
+	fail    error         // error returned by the writes, if any
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	if w.waiting != nil {
+		w.waiting <- struct{}{}
+	}
+	<-w.gate
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.calls++
+	if w.fail != nil {
+		return 0, w.fail
+	}
+	return w.buf.Write(p)
+}
+
+func (w *slowWriter) Calls() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.calls
+}
+
+func newInt64Record(mem memory.Allocator, n int) array.Record {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "v", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+	for i := 0; i < n; i++ {
+		b.Append(int64(i))
+	}
+	arr := b.NewArray()
+	defer arr.Release()
+	return array.NewRecord(schema, []array.Interface{arr}, int64(n))
+}
+
+func TestBufferedWriter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		recs = arrdata.Records["primitives"]
+		out  = &slowWriter{gate: make(chan struct{})}
+	)
+	close(out.gate)
+
+	w := ipc.NewBufferedWriter(out, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem), ipc.WithQueueLimits(0, 1))
+	for i, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record %d: %+v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Buffered(); got != 0 {
+		t.Fatalf("invalid buffered size after close: got=%d, want=0", got)
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(out.buf.Bytes()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		if !array.RecordEqual(r.Record(), recs[n]) {
+			t.Fatalf("records %d differ", n)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(recs) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+	}
+}
+
+func TestBufferedWriterBackPressure(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const nrecs = 20
+
+	// records are allocated apart from the buffers of the writer.
+	recMem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	var (
+		out     = &slowWriter{gate: make(chan struct{})}
+		written = make(chan int)
+		errc    = make(chan error, 1)
+	)
+
+	w := ipc.NewBufferedWriter(out, ipc.WithAllocator(mem), ipc.WithQueueLimits(0, 3))
+	go func() {
+		defer close(written)
+		for i := 0; i < nrecs; i++ {
+			rec := newInt64Record(recMem, 1024)
+			err := w.Write(rec)
+			rec.Release()
+			if err != nil {
+				errc <- err
+				return
+			}
+			// the queue holds serialized messages only.
+			recMem.AssertSize(t, 0)
+			written <- i
+		}
+		errc <- nil
+	}()
+
+	// the schema is being written: 2 records fit in the queue.
+	for i := 0; i < 2; i++ {
+		<-written
+	}
+	select {
+	case i := <-written:
+		t.Fatalf("record %d written past the queue limits", i)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// every write lets one more record in.
+	queued := 0
+	for i := 2; i < nrecs; i++ {
+		out.gate <- struct{}{}
+		if got := <-written; got != i {
+			t.Fatalf("invalid record: got=%d, want=%d", got, i)
+		}
+		if queued == 0 {
+			// 3 record batch messages.
+			queued = w.Buffered()
+		}
+		if got := w.Buffered(); got > queued {
+			t.Fatalf("queue grew past its bound: got=%d bytes, want<=%d", got, queued)
+		}
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	close(out.gate)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(out.buf.Bytes()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		n++
+	}
+	if n != nrecs {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, nrecs)
+	}
+}
+
+func TestBufferedWriterQueueBytes(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := newInt64Record(mem, 1024)
+	defer rec.Release()
+
+	var (
+		out   = &slowWriter{gate: make(chan struct{})}
+		limit = 3 * 8 * 1024
+	)
+
+	w := ipc.NewBufferedWriter(out, ipc.WithAllocator(mem), ipc.WithQueueLimits(limit, 0))
+	done := make(chan error, 1)
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := w.Write(rec); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+			close(out.gate)
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := out.Calls(), 12; got != want {
+				t.Fatalf("invalid number of messages: got=%d, want=%d", got, want)
+			}
+			return
+		case out.gate <- struct{}{}:
+			if got := w.Buffered(); got > limit {
+				t.Fatalf("queue grew past its bound: got=%d bytes, want<=%d", got, limit)
+			}
+		}
+	}
+}
+
+func TestBufferedWriterError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := newInt64Record(mem, 16)
+	defer rec.Release()
+
+	var (
+		boom = xerrors.New("boom")
+		out  = &slowWriter{gate: make(chan struct{}), fail: boom}
+	)
+
+	w := ipc.NewBufferedWriter(out, ipc.WithAllocator(mem))
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("the first write should not wait for the underlying writer: %+v", err)
+	}
+	if err := w.Err(); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	out.gate <- struct{}{}
+	deadline := time.Now().Add(time.Second)
+	for w.Err() == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := w.Err(); !xerrors.Is(err, boom) {
+		t.Fatalf("invalid error: got=%+v, want=%v", err, boom)
+	}
+	if got := w.Buffered(); got != 0 {
+		t.Fatalf("queue not discarded: got=%d bytes", got)
+	}
+
+	if err := w.Write(rec); !xerrors.Is(err, boom) {
+		t.Fatalf("invalid write error: got=%+v, want=%v", err, boom)
+	}
+	if err := w.Close(); !xerrors.Is(err, boom) {
+		t.Fatalf("invalid close error: got=%+v, want=%v", err, boom)
+	}
+	if got, want := out.Calls(), 1; got != want {
+		t.Fatalf("invalid number of writes: got=%d, want=%d", got, want)
+	}
+}
+
+func TestBufferedWriterAbort(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := newInt64Record(mem, 16)
+	defer rec.Release()
+
+	out := &slowWriter{gate: make(chan struct{}), waiting: make(chan struct{}, 1)}
+	w := ipc.NewBufferedWriter(out, ipc.WithAllocator(mem), ipc.WithQueueLimits(0, 0), ipc.WithAbortOnClose(true))
+	for i := 0; i < 5; i++ {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	<-out.waiting
+	errc := make(chan error, 1)
+	go func() { errc <- w.Close() }()
+
+	// Close waits for the message being written, the schema.
+	select {
+	case err := <-errc:
+		t.Fatalf("close returned before the end of the write in progress: %+v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(out.gate)
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := out.Calls(), 1; got != want {
+		t.Fatalf("invalid number of writes: got=%d, want=%d", got, want)
+	}
+
+	_, err := ipc.NewReader(bytes.NewReader(out.buf.Bytes()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatalf("could not read schema: %+v", err)
+	}
+}
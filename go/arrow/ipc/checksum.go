@@ -0,0 +1,178 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// Record batch checksums
+//
+// The checksum of a record batch is the CRC-32C (Castagnoli polynomial,
+// as computed by hash/crc32 with crc32.Castagnoli) of the body of its
+// message: the BodyLength bytes following the message metadata, padding
+// included. It is formatted as 8 lower-case hexadecimal digits.
+//
+// A file stores the checksums of all its record batches, in the order of
+// the record batch blocks of its footer, as a comma-separated list under
+// the kBatchChecksumKey ("arrow-go:batch_crc32c") key of the footer schema
+// metadata.
+// A stream stores the checksum of each record batch under the same key, in
+// the custom metadata of the record batch message.
+//
+// Dictionary batches are not checksummed.
+const kBatchChecksumKey = "arrow-go:batch_crc32c"
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// checksumMode specifies whether, and where, a recordEncoder stores the
+// checksums of the bodies it encodes.
+type checksumMode int
+
+const (
+	noChecksum      checksumMode = iota
+	footerChecksum               // the checksum is only computed, for the file footer.
+	messageChecksum              // the checksum is stored in the message metadata.
+)
+
+// WithChecksums specifies that writers compute a CRC-32C checksum of the
+// body of each record batch: a FileWriter stores them in the file footer,
+// a stream Writer in the custom metadata of each record batch message.
+func WithChecksums() Option {
+	return func(cfg *config) {
+		cfg.checksum = true
+	}
+}
+
+// WithVerifyChecksums specifies that readers verify the checksum of each
+// record batch they load, as stored by a writer created with WithChecksums.
+// Reading a record batch whose body does not match its checksum, or which
+// has no checksum, fails with an error holding the index of the batch.
+func WithVerifyChecksums() Option {
+	return func(cfg *config) {
+		cfg.verify = true
+	}
+}
+
+// bodyChecksum returns the checksum of the body made of bufs, each padded
+// to a multiple of 8 bytes as done by writeIPCPayload.
+func bodyChecksum(bufs []*memory.Buffer) uint32 {
+	var crc uint32
+	for _, buf := range bufs {
+		if buf == nil || buf.Len() == 0 {
+			continue
+		}
+		size := int64(buf.Len())
+		crc = crc32.Update(crc, castagnoli, buf.Bytes())
+		crc = crc32.Update(crc, castagnoli, paddingBytes[:bitutil.CeilByte64(size)-size])
+	}
+	return crc
+}
+
+func formatChecksum(crc uint32) string {
+	return fmt.Sprintf("%08x", crc)
+}
+
+func parseChecksum(s string) (uint32, error) {
+	if len(s) != 8 {
+		return 0, xerrors.Errorf("arrow/ipc: invalid checksum %q", s)
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, xerrors.Errorf("arrow/ipc: invalid checksum %q", s)
+	}
+	return uint32(v), nil
+}
+
+// verifyChecksum checks body against the checksum want of the i-th record
+// batch.
+func verifyChecksum(i int, body *memory.Buffer, want uint32) error {
+	var got uint32
+	if body != nil {
+		got = crc32.Checksum(body.Bytes(), castagnoli)
+	}
+	if got != want {
+		return xerrors.Errorf("arrow/ipc: checksum mismatch for record batch %d (got=%s, want=%s)", i, formatChecksum(got), formatChecksum(want))
+	}
+	return nil
+}
+
+// batchChecksum returns the checksum held by md, the custom metadata of the
+// i-th record batch message of a stream.
+func batchChecksum(i int, md arrow.Metadata) (uint32, error) {
+	idx := md.FindKey(kBatchChecksumKey)
+	if idx < 0 {
+		return 0, xerrors.Errorf("arrow/ipc: no checksum for record batch %d", i)
+	}
+	return parseChecksum(md.Values()[idx])
+}
+
+// appendMetadata returns a copy of md with the (key, val) pair appended.
+func appendMetadata(md arrow.Metadata, key, val string) arrow.Metadata {
+	var (
+		keys = append(append([]string{}, md.Keys()...), key)
+		vals = append(append([]string{}, md.Values()...), val)
+	)
+	return arrow.NewMetadata(keys, vals)
+}
+
+// withChecksums returns a copy of schema, whose metadata holds the
+// provided checksums.
+func withChecksums(schema *arrow.Schema, crcs []uint32) *arrow.Schema {
+	strs := make([]string, len(crcs))
+	for i, crc := range crcs {
+		strs[i] = formatChecksum(crc)
+	}
+	meta := appendMetadata(schema.Metadata(), kBatchChecksumKey, strings.Join(strs, ","))
+	return arrow.NewSchema(schema.Fields(), &meta)
+}
+
+// stripChecksums extracts the checksums held in the metadata of schema,
+// returning schema without them.
+func stripChecksums(schema *arrow.Schema) (*arrow.Schema, []uint32, error) {
+	md := schema.Metadata()
+	idx := md.FindKey(kBatchChecksumKey)
+	if idx < 0 {
+		return schema, nil, nil
+	}
+
+	crcs := []uint32{}
+	if raw := md.Values()[idx]; raw != "" {
+		for _, s := range strings.Split(raw, ",") {
+			crc, err := parseChecksum(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			crcs = append(crcs, crc)
+		}
+	}
+
+	var (
+		keys = append(append([]string{}, md.Keys()[:idx]...), md.Keys()[idx+1:]...)
+		vals = append(append([]string{}, md.Values()[:idx]...), md.Values()[idx+1:]...)
+		meta = arrow.NewMetadata(keys, vals)
+	)
+	return arrow.NewSchema(schema.Fields(), &meta), crcs, nil
+}
@@ -0,0 +1,192 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestChecksums(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			opts := []ipc.Option{ipc.WithAllocator(mem), ipc.WithVerifyChecksums()}
+
+			raw := writeFileBytes(t, mem, recs, ipc.WithChecksums())
+			f, err := ipc.NewFileReader(bytes.NewReader(raw), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			if !f.Schema().Equal(recs[0].Schema()) {
+				t.Fatalf("invalid schema:\ngot:\n%v\nwant:\n%v", f.Schema(), recs[0].Schema())
+			}
+			for i, want := range recs {
+				rec, err := f.Record(i)
+				if err != nil {
+					t.Fatalf("could not read record %d: %+v", i, err)
+				}
+				if !array.RecordEqual(rec, want) {
+					t.Fatalf("records %d differ", i)
+				}
+			}
+
+			s, err := ipc.NewReader(bytes.NewReader(writeStreamBytes(t, mem, recs, ipc.WithChecksums())), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Release()
+
+			n := 0
+			for s.Next() {
+				if !array.RecordEqual(s.Record(), recs[n]) {
+					t.Fatalf("records %d differ", n)
+				}
+				n++
+			}
+			if err := s.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if n != len(recs) {
+				t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+			}
+		})
+	}
+}
+
+func TestChecksumMismatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const marker = 0x0123456789abcdef
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "v", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	recs := make([]array.Record, 3)
+	for i := range recs {
+		bldr.Field(0).(*array.Int64Builder).AppendValues([]int64{int64(i), marker + int64(i)}, nil)
+		recs[i] = bldr.NewRecord()
+		defer recs[i].Release()
+	}
+
+	// corrupt flips a bit of the body of the second record batch.
+	corrupt := func(t *testing.T, raw []byte) []byte {
+		var key [8]byte
+		binary.LittleEndian.PutUint64(key[:], marker+1)
+		i := bytes.Index(raw, key[:])
+		if i < 0 {
+			t.Fatalf("could not find marker")
+		}
+		out := append([]byte{}, raw...)
+		out[i] ^= 0x10
+		return out
+	}
+
+	const want = "checksum mismatch for record batch 1"
+	opts := []ipc.Option{ipc.WithAllocator(mem), ipc.WithVerifyChecksums()}
+
+	t.Run("file", func(t *testing.T) {
+		raw := corrupt(t, writeFileBytes(t, mem, recs, ipc.WithChecksums()))
+		f, err := ipc.NewFileReader(bytes.NewReader(raw), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		if _, err := f.Record(0); err != nil {
+			t.Fatal(err)
+		}
+		_, err = f.Record(1)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid error: got=%v, want=%q", err, want)
+		}
+
+		sc := f.Scan(context.Background())
+		defer sc.Release()
+		n := 0
+		for sc.Next() {
+			n++
+		}
+		if err := sc.Err(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid scan error: got=%v, want=%q", err, want)
+		}
+		if n != 1 {
+			t.Fatalf("invalid number of scanned records: got=%d, want=1", n)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		raw := corrupt(t, writeStreamBytes(t, mem, recs, ipc.WithChecksums()))
+		r, err := ipc.NewReader(bytes.NewReader(raw), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+
+		n := 0
+		for r.Next() {
+			n++
+		}
+		if err := r.Err(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid error: got=%v, want=%q", err, want)
+		}
+		if n != 1 {
+			t.Fatalf("invalid number of records: got=%d, want=1", n)
+		}
+	})
+
+	t.Run("no-checksums", func(t *testing.T) {
+		const want = "no checksum for record batch 0"
+
+		f, err := ipc.NewFileReader(bytes.NewReader(writeFileBytes(t, mem, recs)), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if _, err := f.Record(0); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid error: got=%v, want=%q", err, want)
+		}
+
+		r, err := ipc.NewReader(bytes.NewReader(writeStreamBytes(t, mem, recs)), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+		if r.Next() {
+			t.Fatalf("unexpected record")
+		}
+		if err := r.Err(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid error: got=%v, want=%q", err, want)
+		}
+	})
+}
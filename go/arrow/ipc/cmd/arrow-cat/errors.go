@@ -0,0 +1,113 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"golang.org/x/xerrors"
+)
+
+// Classes of the errors of the command, reported with -errors=json.
+const (
+	classError    = "error"     // any other error, exit code 1.
+	classNotFound = "not-found" // the input cannot be opened, exit code 2.
+	classNotArrow = "not-arrow" // the input is not an Arrow file or stream, exit code 2.
+	classCorrupt  = "corrupt"   // the input holds invalid Arrow data, exit code 3.
+)
+
+// streamPrefix is the continuation marker starting the messages of Arrow
+// streams.
+var streamPrefix = []byte{0xff, 0xff, 0xff, 0xff}
+
+// cmdError is an error of the command, with the input and the record batch
+// where it happened.
+type cmdError struct {
+	class string
+	file  string // name of the input file, empty for the standard input.
+	batch int    // index of the record batch, from 0, or -1.
+	err   error
+}
+
+// newError returns an error of the given class, which happened while
+// reading the record batch of index batch, or -1.
+func newError(class string, batch int, err error) error {
+	return &cmdError{class: class, batch: batch, err: err}
+}
+
+func (e *cmdError) Error() string { return e.err.Error() }
+func (e *cmdError) Unwrap() error { return e.err }
+
+func (e *cmdError) exitCode() int {
+	switch e.class {
+	case classNotFound, classNotArrow:
+		return 2
+	case classCorrupt:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// inFile returns err, recording that it happened while processing the file
+// fname.
+func inFile(err error, fname string) error {
+	var cerr *cmdError
+	if !xerrors.As(err, &cerr) {
+		return &cmdError{class: classError, file: fname, batch: -1, err: err}
+	}
+	if cerr.file == "" {
+		cerr.file = fname
+	}
+	return err
+}
+
+// report displays err on w, as text or as a single JSON object depending on
+// format, and returns the exit code of its class.
+func report(w io.Writer, err error, format string) int {
+	var cerr *cmdError
+	if !xerrors.As(err, &cerr) {
+		cerr = &cmdError{class: classError, batch: -1, err: err}
+	}
+
+	if format != "json" {
+		fmt.Fprintf(w, "%s%v\n", log.Prefix(), err)
+		return cerr.exitCode()
+	}
+
+	out := struct {
+		File    string `json:"file"`
+		Batch   *int   `json:"batch,omitempty"`
+		Class   string `json:"class"`
+		Message string `json:"message"`
+	}{
+		File:    cerr.file,
+		Class:   cerr.class,
+		Message: err.Error(),
+	}
+	if out.File == "" {
+		out.File = "-"
+	}
+	if cerr.batch >= 0 {
+		out.Batch = &cerr.batch
+	}
+	json.NewEncoder(w).Encode(out)
+	return cerr.exitCode()
+}
@@ -0,0 +1,242 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-cat"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
+)
+
+// formatOpts configures the display of string and binary values.
+var formatOpts []array.FormatOption
+
+// formatColumn returns the string representation of col.
+// Values are displayed as by the String methods of arrays, except for
+// timestamps, which are displayed in the time zone of their data type, and
+// for string and binary values, which are displayed as configured by
+// formatOpts.
+func formatColumn(col array.Interface) (string, error) {
+	f := &formatter{
+		stack:  []frame{newFrame(col.DataType())},
+		values: array.NewValueFormatter(formatOpts...),
+	}
+	if err := array.Accept(col, f); err != nil {
+		return "", err
+	}
+	return f.stack[0].String(), nil
+}
+
+// formatter formats the elements of an array into frames.
+// The top of the stack is the frame of the array being visited.
+type formatter struct {
+	array.BaseVisitor
+	stack  []frame
+	values *array.ValueFormatter
+
+	// time zone of the last timestamp data type seen.
+	tz  string
+	loc *time.Location
+}
+
+// frame accumulates the formatted elements of an array.
+type frame interface {
+	add(s string)
+	addNull()
+	String() string
+}
+
+func newFrame(dtype arrow.DataType) frame {
+	dt, ok := dtype.(*arrow.StructType)
+	if !ok {
+		return new(listFrame)
+	}
+	f := &structFrame{fields: make([]frame, len(dt.Fields()))}
+	for i, field := range dt.Fields() {
+		f.fields[i] = newFrame(field.Type)
+	}
+	return f
+}
+
+// listFrame formats arrays as a bracketed list of their elements.
+type listFrame struct {
+	o strings.Builder
+	n int
+}
+
+func (f *listFrame) add(s string) {
+	if f.n > 0 {
+		f.o.WriteString(" ")
+	}
+	f.o.WriteString(s)
+	f.n++
+}
+
+func (f *listFrame) addNull()       { f.add("(null)") }
+func (f *listFrame) String() string { return "[" + f.o.String() + "]" }
+
+// structFrame formats struct arrays field by field: the elements of a
+// struct are added to the frames of its fields.
+type structFrame struct {
+	fields []frame
+}
+
+func (f *structFrame) add(s string) { panic("arrow-cat: invalid value for struct frame") }
+
+func (f *structFrame) addNull() {
+	for _, field := range f.fields {
+		field.addNull()
+	}
+}
+
+func (f *structFrame) String() string {
+	strs := make([]string, len(f.fields))
+	for i, field := range f.fields {
+		strs[i] = field.String()
+	}
+	return "{" + strings.Join(strs, " ") + "}"
+}
+
+func (f *formatter) top() frame { return f.stack[len(f.stack)-1] }
+
+func (f *formatter) add(v interface{}) error {
+	f.top().add(fmt.Sprintf("%v", v))
+	return nil
+}
+
+func (f *formatter) VisitNull(array.Position) error {
+	f.top().addNull()
+	return nil
+}
+
+func (f *formatter) VisitBoolean(_ array.Position, v bool) error              { return f.add(v) }
+func (f *formatter) VisitInt8(_ array.Position, v int8) error                 { return f.add(v) }
+func (f *formatter) VisitInt16(_ array.Position, v int16) error               { return f.add(v) }
+func (f *formatter) VisitInt32(_ array.Position, v int32) error               { return f.add(v) }
+func (f *formatter) VisitInt64(_ array.Position, v int64) error               { return f.add(v) }
+func (f *formatter) VisitUint8(_ array.Position, v uint8) error               { return f.add(v) }
+func (f *formatter) VisitUint16(_ array.Position, v uint16) error             { return f.add(v) }
+func (f *formatter) VisitUint32(_ array.Position, v uint32) error             { return f.add(v) }
+func (f *formatter) VisitUint64(_ array.Position, v uint64) error             { return f.add(v) }
+func (f *formatter) VisitFloat16(_ array.Position, v float16.Num) error       { return f.add(v.Float32()) }
+func (f *formatter) VisitFloat32(_ array.Position, v float32) error           { return f.add(v) }
+func (f *formatter) VisitFloat64(_ array.Position, v float64) error           { return f.add(v) }
+func (f *formatter) VisitDecimal128(_ array.Position, v decimal128.Num) error { return f.add(v) }
+func (f *formatter) VisitDate32(_ array.Position, v arrow.Date32) error       { return f.add(v) }
+func (f *formatter) VisitDate64(_ array.Position, v arrow.Date64) error       { return f.add(v) }
+func (f *formatter) VisitTime32(_ array.Position, v arrow.Time32) error       { return f.add(v) }
+func (f *formatter) VisitTime64(_ array.Position, v arrow.Time64) error       { return f.add(v) }
+func (f *formatter) VisitDuration(_ array.Position, v arrow.Duration) error   { return f.add(v) }
+func (f *formatter) VisitMonthInterval(_ array.Position, v arrow.MonthInterval) error {
+	return f.add(v)
+}
+func (f *formatter) VisitDayTimeInterval(_ array.Position, v arrow.DayTimeInterval) error {
+	return f.add(v)
+}
+
+func (f *formatter) VisitDecimal256(pos array.Position, v decimal256.Num) error {
+	f.top().add(v.ToString(pos.Array.DataType().(*arrow.Decimal256Type).Scale))
+	return nil
+}
+
+func (f *formatter) VisitTimestamp(pos array.Position, v arrow.Timestamp) error {
+	dtype := pos.Array.DataType().(*arrow.TimestampType)
+	if f.loc == nil || f.tz != dtype.TimeZone {
+		loc, err := dtype.GetZone()
+		if err != nil {
+			return err
+		}
+		f.tz, f.loc = dtype.TimeZone, loc
+	}
+
+	nanos := int64(1)
+	switch dtype.Unit {
+	case arrow.Second:
+		nanos = int64(time.Second)
+	case arrow.Millisecond:
+		nanos = int64(time.Millisecond)
+	case arrow.Microsecond:
+		nanos = int64(time.Microsecond)
+	}
+	per := int64(time.Second) / nanos
+
+	t := time.Unix(int64(v)/per, (int64(v)%per)*nanos).In(f.loc)
+	f.top().add(t.Format(time.RFC3339Nano))
+	return nil
+}
+
+func (f *formatter) VisitBinary(_ array.Position, v []byte) error {
+	f.top().add(f.values.FormatBinary(v))
+	return nil
+}
+
+func (f *formatter) VisitString(_ array.Position, v string) error {
+	f.top().add(f.values.FormatString(v))
+	return nil
+}
+
+func (f *formatter) VisitFixedSizeBinary(_ array.Position, v []byte) error {
+	f.top().add(f.values.FormatBinary(v))
+	return nil
+}
+
+func (f *formatter) EnterList(pos array.Position, n int) error {
+	var elem arrow.DataType
+	switch dt := pos.Array.DataType().(type) {
+	case *arrow.ListType:
+		elem = dt.Elem()
+	case *arrow.LargeListType:
+		elem = dt.Elem()
+	case *arrow.FixedSizeListType:
+		elem = dt.Elem()
+	case *arrow.MapType:
+		elem = dt.Elem()
+	}
+	f.stack = append(f.stack, newFrame(elem))
+	return nil
+}
+
+func (f *formatter) ExitList(array.Position) error {
+	sub := f.top()
+	f.stack = f.stack[:len(f.stack)-1]
+	f.top().add(sub.String())
+	return nil
+}
+
+// EnterStruct pushes a placeholder for the frames of the fields of the
+// struct, set by VisitField.
+func (f *formatter) EnterStruct(array.Position) error {
+	f.stack = append(f.stack, nil)
+	return nil
+}
+
+func (f *formatter) VisitField(_ array.Position, i int) error {
+	n := len(f.stack)
+	f.stack[n-1] = f.stack[n-2].(*structFrame).fields[i]
+	return nil
+}
+
+func (f *formatter) ExitStruct(array.Position) error {
+	f.stack = f.stack[:len(f.stack)-1]
+	return nil
+}
@@ -52,9 +52,39 @@
 //  record 2...
 //    col[0] "bools": [true (null) (null) false true]
 //  [...]
+//
+//  $> arrow-cat -where 'int32s < -3 && bools == true' ./testdata/primitives.data
+//  version: V4
+//  record 1/3...
+//    col[0] "bools": [true]
+//    col[1] "int8s": [-5]
+//  [...]
+//
+// Streams missing their schema message can be displayed with the schema
+// of another Arrow stream or file, given with -schema-file:
+//
+//  $> arrow-cat -schema-file ./testdata/schema.data < headless.data
+//
+// Long string and binary values can be truncated with -max-cell-width, and
+// binary values displayed as hexadecimal, base64 or by their length only
+// with -binary:
+//
+//  $> arrow-cat -max-cell-width 8 -binary hex ./testdata/blobs.data
+//  version: V5
+//  record 1/1...
+//    col[0] "json": ["{\"id\": 1"…(10240 bytes) "{\"id\": 2"…(10185 bytes)]
+//    col[1] "blob": [89504e470d0a1a0a…(1048576 bytes) (null)]
+//
+// arrow-cat exits with code 2 when an input cannot be opened or is not an
+// Arrow file or stream, with code 3 when it holds invalid Arrow data, and
+// with code 1 on any other error. With -errors=json, the error is reported
+// on the standard error as a JSON object, with the input file ("-" for the
+// standard input), the index of the record batch, if any, the error class
+// (not-found, not-arrow, corrupt or error) and the message.
 package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-cat"
 
 import (
+	"bufio"
 	"bytes"
 	"flag"
 	"fmt"
@@ -62,112 +92,248 @@ import (
 	"log"
 	"os"
 
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute/expr"
 	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 	"golang.org/x/xerrors"
 )
 
+// where is the expression selecting the rows to display.
+// All rows are displayed when it is empty.
+var where string
+
+// fallback is the schema used to decode streams without a schema message,
+// if any.
+var fallback *arrow.Schema
+
 func main() {
 	log.SetPrefix("arrow-cat: ")
 	log.SetFlags(0)
 
+	flag.StringVar(&where, "where", "", "only display the rows matching the given expression (e.g. 'int32s > 10 && strings != null')")
+	schemaFile := flag.String("schema-file", "", "Arrow stream or file holding the schema of streams missing their schema message")
+	maxWidth := flag.Int("max-cell-width", 0, "maximum number of characters of string values, and bytes of binary values, to display (0 means no limit)")
+	binary := flag.String("binary", "quoted", "display of binary values: quoted, hex, base64 or len")
+	errorsFmt := flag.String("errors", "text", "format of the error message: text or json")
 	flag.Parse()
 
-	var err error
-	switch flag.NArg() {
-	case 0:
-		err = processStream(os.Stdout, os.Stdin)
-	default:
-		err = processFiles(os.Stdout, flag.Args())
+	if *errorsFmt != "text" && *errorsFmt != "json" {
+		log.Fatalf("invalid -errors format %q (want text or json)", *errorsFmt)
 	}
+
+	err := run(*schemaFile, *maxWidth, *binary)
 	if err != nil {
-		log.Fatal(err)
+		os.Exit(report(os.Stderr, err, *errorsFmt))
 	}
 }
 
-func processStream(w io.Writer, rin io.Reader) error {
+func run(schemaFile string, maxWidth int, binary string) error {
+	bf, err := array.ParseBinaryFormat(binary)
+	if err != nil {
+		return err
+	}
+	formatOpts = []array.FormatOption{array.WithMaxCellWidth(maxWidth), array.WithBinaryFormat(bf)}
+
 	mem := memory.NewGoAllocator()
-	for {
-		r, err := ipc.NewReader(rin, ipc.WithAllocator(mem))
+	if schemaFile != "" {
+		fallback, err = loadSchema(schemaFile, mem)
+		if err != nil {
+			return inFile(err, schemaFile)
+		}
+	}
+	switch flag.NArg() {
+	case 0:
+		return processStream(os.Stdout, os.Stdin, mem)
+	default:
+		return processFiles(os.Stdout, flag.Args(), mem)
+	}
+}
+
+func processStream(w io.Writer, rin io.Reader, mem memory.Allocator) error {
+	opts := []ipc.Option{ipc.WithAllocator(mem)}
+	if fallback != nil {
+		opts = append(opts, ipc.WithFallbackSchema(fallback))
+	}
+	br := bufio.NewReader(rin)
+	for i := 0; ; i++ {
+		// an input starting like an Arrow stream is corrupt if it cannot
+		// be read, so are the streams following a valid one.
+		class := classCorrupt
+		if p, _ := br.Peek(len(streamPrefix)); i == 0 && !bytes.Equal(p, streamPrefix) {
+			class = classNotArrow
+		}
+
+		r, err := ipc.NewReader(br, opts...)
 		if err != nil {
 			if xerrors.Is(err, io.EOF) {
 				return nil
 			}
+			return newError(class, -1, err)
+		}
+
+		err = printStream(w, r, mem)
+		if err != nil {
 			return err
 		}
+	}
+}
 
-		n := 0
-		for r.Next() {
-			n++
-			fmt.Fprintf(w, "record %d...\n", n)
-			rec := r.Record()
-			for i, col := range rec.Columns() {
-				fmt.Fprintf(w, "  col[%d] %q: %v\n", i, rec.ColumnName(i), col)
-			}
+// printStream displays the records of r and releases r.
+func printStream(w io.Writer, r *ipc.Reader, mem memory.Allocator) error {
+	defer r.Release()
+
+	filter, err := compileWhere(r.Schema())
+	if err != nil {
+		return newError(classError, -1, err)
+	}
+
+	n := 0
+	for r.Next() {
+		n++
+		fmt.Fprintf(w, "record %d...\n", n)
+		err := printRecord(w, r.Record(), filter, mem)
+		if err != nil {
+			return newError(classError, n-1, err)
 		}
-		r.Release()
+	}
+	if err := r.Err(); err != nil {
+		return newError(classCorrupt, n, err)
 	}
 	return nil
 }
 
-func processFiles(w io.Writer, names []string) error {
+func processFiles(w io.Writer, names []string, mem memory.Allocator) error {
 	for _, name := range names {
-		err := processFile(w, name)
+		err := processFile(w, name, mem)
 		if err != nil {
-			return err
+			return inFile(err, name)
 		}
 	}
 	return nil
 }
 
-func processFile(w io.Writer, fname string) error {
+func processFile(w io.Writer, fname string, mem memory.Allocator) error {
 
 	f, err := os.Open(fname)
 	if err != nil {
-		return err
+		return newError(classNotFound, -1, err)
 	}
 	defer f.Close()
 
 	hdr := make([]byte, len(ipc.Magic))
 	_, err = io.ReadFull(f, hdr)
 	if err != nil {
-		return xerrors.Errorf("could not read file header: %w", err)
+		return newError(classNotArrow, -1, xerrors.Errorf("could not read file header: %w", err))
 	}
 	f.Seek(0, io.SeekStart)
 
 	if !bytes.Equal(hdr, ipc.Magic) {
 		// try as a stream.
-		return processStream(w, f)
+		return processStream(w, f, mem)
 	}
 
-	mem := memory.NewGoAllocator()
-
 	r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
 	if err != nil {
-		if xerrors.Is(err, io.EOF) {
-			return nil
-		}
-		return err
+		return newError(classCorrupt, -1, err)
 	}
 	defer r.Close()
 
+	filter, err := compileWhere(r.Schema())
+	if err != nil {
+		return newError(classError, -1, err)
+	}
+
 	fmt.Fprintf(w, "version: %v\n", r.Version())
 	for i := 0; i < r.NumRecords(); i++ {
 		fmt.Fprintf(w, "record %d/%d...\n", i+1, r.NumRecords())
+		// rec is owned by r: it is released by the next call to Record or by Close.
 		rec, err := r.Record(i)
 		if err != nil {
-			return err
+			return newError(classCorrupt, i, err)
 		}
 
-		for i, col := range rec.Columns() {
-			fmt.Fprintf(w, "  col[%d] %q: %v\n", i, rec.ColumnName(i), col)
+		err = printRecord(w, rec, filter, mem)
+		if err != nil {
+			return newError(classError, i, err)
 		}
-		rec.Release()
 	}
 
 	return nil
 }
 
+// loadSchema returns the schema of the Arrow stream or file fname.
+func loadSchema(fname string, mem memory.Allocator) (*arrow.Schema, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, newError(classNotFound, -1, err)
+	}
+	defer f.Close()
+
+	hdr := make([]byte, len(ipc.Magic))
+	_, err = io.ReadFull(f, hdr)
+	if err != nil {
+		return nil, newError(classNotArrow, -1, xerrors.Errorf("could not read schema file header: %w", err))
+	}
+	f.Seek(0, io.SeekStart)
+
+	if !bytes.Equal(hdr, ipc.Magic) {
+		class := classCorrupt
+		if !bytes.Equal(hdr[:len(streamPrefix)], streamPrefix) {
+			class = classNotArrow
+		}
+		r, err := ipc.NewReader(f, ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, newError(class, -1, xerrors.Errorf("could not read schema from %q: %w", fname, err))
+		}
+		defer r.Release()
+		return r.Schema(), nil
+	}
+
+	r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+	if err != nil {
+		return nil, newError(classCorrupt, -1, xerrors.Errorf("could not read schema from %q: %w", fname, err))
+	}
+	defer r.Close()
+	return r.Schema(), nil
+}
+
+// compileWhere compiles the -where expression against schema.
+// It returns a nil expression when no expression was given.
+func compileWhere(schema *arrow.Schema) (*expr.Expr, error) {
+	if where == "" {
+		return nil, nil
+	}
+	filter, err := expr.Compile(schema, where)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid -where expression: %w", err)
+	}
+	return filter, nil
+}
+
+// printRecord displays the rows of rec matching filter, or all of them when
+// filter is nil.
+func printRecord(w io.Writer, rec array.Record, filter *expr.Expr, mem memory.Allocator) error {
+	if filter != nil {
+		sel, err := filter.Filter(mem, rec)
+		if err != nil {
+			return err
+		}
+		defer sel.Release()
+		rec = sel
+	}
+
+	for i, col := range rec.Columns() {
+		str, err := formatColumn(col)
+		if err != nil {
+			return xerrors.Errorf("could not format column %q: %w", rec.ColumnName(i), err)
+		}
+		fmt.Fprintf(w, "  col[%d] %q: %s\n", i, rec.ColumnName(i), str)
+	}
+	return nil
+}
+
 func init() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Command arrow-cat displays the content of an Arrow stream or file.
@@ -210,7 +376,18 @@ Examples:
  record 2...
    col[0] "bools": [true (null) (null) false true]
  [...]
+
+ $> arrow-cat -where 'int32s < -3 && bools == true' ./testdata/primitives.data
+ version: V4
+ record 1/3...
+   col[0] "bools": [true]
+   col[1] "int8s": [-5]
+ [...]
+
+Options:
+
 `)
+		flag.PrintDefaults()
 		os.Exit(0)
 	}
 }
@@ -18,12 +18,19 @@ package main
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
 	"github.com/apache/arrow/go/arrow/ipc"
@@ -132,10 +139,10 @@ record 3...
   col[2] "time32s": [-2 (null) (null) 1 2]
   col[3] "time64ns": [-2 (null) (null) 1 2]
   col[4] "time64us": [-2 (null) (null) 1 2]
-  col[5] "timestamp_s": [0 (null) (null) 3 4]
-  col[6] "timestamp_ms": [0 (null) (null) 3 4]
-  col[7] "timestamp_us": [0 (null) (null) 3 4]
-  col[8] "timestamp_ns": [0 (null) (null) 3 4]
+  col[5] "timestamp_s": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:03Z 1970-01-01T00:00:04Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.003Z 1970-01-01T00:00:00.004Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000003Z 1970-01-01T00:00:00.000004Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000000003Z 1970-01-01T00:00:00.000000004Z]
   col[9] "date32s": [-2 (null) (null) 1 2]
   col[10] "date64s": [-2 (null) (null) 1 2]
 record 2...
@@ -144,10 +151,10 @@ record 2...
   col[2] "time32s": [-12 (null) (null) 11 12]
   col[3] "time64ns": [-12 (null) (null) 11 12]
   col[4] "time64us": [-12 (null) (null) 11 12]
-  col[5] "timestamp_s": [10 (null) (null) 13 14]
-  col[6] "timestamp_ms": [10 (null) (null) 13 14]
-  col[7] "timestamp_us": [10 (null) (null) 13 14]
-  col[8] "timestamp_ns": [10 (null) (null) 13 14]
+  col[5] "timestamp_s": [1970-01-01T00:00:10Z (null) (null) 1970-01-01T00:00:13Z 1970-01-01T00:00:14Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.01Z (null) (null) 1970-01-01T00:00:00.013Z 1970-01-01T00:00:00.014Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00001Z (null) (null) 1970-01-01T00:00:00.000013Z 1970-01-01T00:00:00.000014Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000001Z (null) (null) 1970-01-01T00:00:00.000000013Z 1970-01-01T00:00:00.000000014Z]
   col[9] "date32s": [-12 (null) (null) 11 12]
   col[10] "date64s": [-12 (null) (null) 11 12]
 record 3...
@@ -156,10 +163,10 @@ record 3...
   col[2] "time32s": [-22 (null) (null) 21 22]
   col[3] "time64ns": [-22 (null) (null) 21 22]
   col[4] "time64us": [-22 (null) (null) 21 22]
-  col[5] "timestamp_s": [20 (null) (null) 23 24]
-  col[6] "timestamp_ms": [20 (null) (null) 23 24]
-  col[7] "timestamp_us": [20 (null) (null) 23 24]
-  col[8] "timestamp_ns": [20 (null) (null) 23 24]
+  col[5] "timestamp_s": [1970-01-01T00:00:20Z (null) (null) 1970-01-01T00:00:23Z 1970-01-01T00:00:24Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.02Z (null) (null) 1970-01-01T00:00:00.023Z 1970-01-01T00:00:00.024Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00002Z (null) (null) 1970-01-01T00:00:00.000023Z 1970-01-01T00:00:00.000024Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000002Z (null) (null) 1970-01-01T00:00:00.000000023Z 1970-01-01T00:00:00.000000024Z]
   col[9] "date32s": [-22 (null) (null) 21 22]
   col[10] "date64s": [-22 (null) (null) 21 22]
 `,
@@ -172,6 +179,48 @@ record 2...
   col[0] "fixed_size_binary_3": ["011" (null) (null) "014" "015"]
 record 3...
   col[0] "fixed_size_binary_3": ["021" (null) (null) "024" "025"]
+`,
+		},
+		{
+			name: "intervals",
+			want: `record 1...
+  col[0] "months": [1 (null) (null) 4 5]
+  col[1] "days": [{1 1} (null) (null) {4 4} {5 5}]
+record 2...
+  col[0] "months": [11 (null) (null) 14 15]
+  col[1] "days": [{11 11} (null) (null) {14 14} {15 15}]
+record 3...
+  col[0] "months": [21 (null) (null) 24 25]
+  col[1] "days": [{21 21} (null) (null) {24 24} {25 25}]
+`,
+		},
+		{
+			name: "nulls",
+			want: `record 1...
+  col[0] "nulls": [(null) (null) (null) (null) (null)]
+record 2...
+  col[0] "nulls": [(null) (null) (null) (null) (null)]
+record 3...
+  col[0] "nulls": [(null) (null) (null) (null) (null)]
+`,
+		},
+		{
+			name: "durations",
+			want: `record 1...
+  col[0] "durations-s": [1 (null) (null) 4 5]
+  col[1] "durations-ms": [1 (null) (null) 4 5]
+  col[2] "durations-us": [1 (null) (null) 4 5]
+  col[3] "durations-ns": [1 (null) (null) 4 5]
+record 2...
+  col[0] "durations-s": [11 (null) (null) 14 15]
+  col[1] "durations-ms": [11 (null) (null) 14 15]
+  col[2] "durations-us": [11 (null) (null) 14 15]
+  col[3] "durations-ns": [11 (null) (null) 14 15]
+record 3...
+  col[0] "durations-s": [21 (null) (null) 24 25]
+  col[1] "durations-ms": [21 (null) (null) 24 25]
+  col[2] "durations-us": [21 (null) (null) 24 25]
+  col[3] "durations-ns": [21 (null) (null) 24 25]
 `,
 		},
 	} {
@@ -216,7 +265,7 @@ record 3...
 			defer f.Close()
 
 			w := new(bytes.Buffer)
-			err = processStream(w, f)
+			err = processStream(w, f, mem)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -425,10 +474,10 @@ record 3/3...
   col[2] "time32s": [-2 (null) (null) 1 2]
   col[3] "time64ns": [-2 (null) (null) 1 2]
   col[4] "time64us": [-2 (null) (null) 1 2]
-  col[5] "timestamp_s": [0 (null) (null) 3 4]
-  col[6] "timestamp_ms": [0 (null) (null) 3 4]
-  col[7] "timestamp_us": [0 (null) (null) 3 4]
-  col[8] "timestamp_ns": [0 (null) (null) 3 4]
+  col[5] "timestamp_s": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:03Z 1970-01-01T00:00:04Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.003Z 1970-01-01T00:00:00.004Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000003Z 1970-01-01T00:00:00.000004Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000000003Z 1970-01-01T00:00:00.000000004Z]
   col[9] "date32s": [-2 (null) (null) 1 2]
   col[10] "date64s": [-2 (null) (null) 1 2]
 record 2...
@@ -437,10 +486,10 @@ record 2...
   col[2] "time32s": [-12 (null) (null) 11 12]
   col[3] "time64ns": [-12 (null) (null) 11 12]
   col[4] "time64us": [-12 (null) (null) 11 12]
-  col[5] "timestamp_s": [10 (null) (null) 13 14]
-  col[6] "timestamp_ms": [10 (null) (null) 13 14]
-  col[7] "timestamp_us": [10 (null) (null) 13 14]
-  col[8] "timestamp_ns": [10 (null) (null) 13 14]
+  col[5] "timestamp_s": [1970-01-01T00:00:10Z (null) (null) 1970-01-01T00:00:13Z 1970-01-01T00:00:14Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.01Z (null) (null) 1970-01-01T00:00:00.013Z 1970-01-01T00:00:00.014Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00001Z (null) (null) 1970-01-01T00:00:00.000013Z 1970-01-01T00:00:00.000014Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000001Z (null) (null) 1970-01-01T00:00:00.000000013Z 1970-01-01T00:00:00.000000014Z]
   col[9] "date32s": [-12 (null) (null) 11 12]
   col[10] "date64s": [-12 (null) (null) 11 12]
 record 3...
@@ -449,10 +498,10 @@ record 3...
   col[2] "time32s": [-22 (null) (null) 21 22]
   col[3] "time64ns": [-22 (null) (null) 21 22]
   col[4] "time64us": [-22 (null) (null) 21 22]
-  col[5] "timestamp_s": [20 (null) (null) 23 24]
-  col[6] "timestamp_ms": [20 (null) (null) 23 24]
-  col[7] "timestamp_us": [20 (null) (null) 23 24]
-  col[8] "timestamp_ns": [20 (null) (null) 23 24]
+  col[5] "timestamp_s": [1970-01-01T00:00:20Z (null) (null) 1970-01-01T00:00:23Z 1970-01-01T00:00:24Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.02Z (null) (null) 1970-01-01T00:00:00.023Z 1970-01-01T00:00:00.024Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00002Z (null) (null) 1970-01-01T00:00:00.000023Z 1970-01-01T00:00:00.000024Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000002Z (null) (null) 1970-01-01T00:00:00.000000023Z 1970-01-01T00:00:00.000000024Z]
   col[9] "date32s": [-22 (null) (null) 21 22]
   col[10] "date64s": [-22 (null) (null) 21 22]
 `,
@@ -466,10 +515,10 @@ record 1/3...
   col[2] "time32s": [-2 (null) (null) 1 2]
   col[3] "time64ns": [-2 (null) (null) 1 2]
   col[4] "time64us": [-2 (null) (null) 1 2]
-  col[5] "timestamp_s": [0 (null) (null) 3 4]
-  col[6] "timestamp_ms": [0 (null) (null) 3 4]
-  col[7] "timestamp_us": [0 (null) (null) 3 4]
-  col[8] "timestamp_ns": [0 (null) (null) 3 4]
+  col[5] "timestamp_s": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:03Z 1970-01-01T00:00:04Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.003Z 1970-01-01T00:00:00.004Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000003Z 1970-01-01T00:00:00.000004Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00Z (null) (null) 1970-01-01T00:00:00.000000003Z 1970-01-01T00:00:00.000000004Z]
   col[9] "date32s": [-2 (null) (null) 1 2]
   col[10] "date64s": [-2 (null) (null) 1 2]
 record 2/3...
@@ -478,10 +527,10 @@ record 2/3...
   col[2] "time32s": [-12 (null) (null) 11 12]
   col[3] "time64ns": [-12 (null) (null) 11 12]
   col[4] "time64us": [-12 (null) (null) 11 12]
-  col[5] "timestamp_s": [10 (null) (null) 13 14]
-  col[6] "timestamp_ms": [10 (null) (null) 13 14]
-  col[7] "timestamp_us": [10 (null) (null) 13 14]
-  col[8] "timestamp_ns": [10 (null) (null) 13 14]
+  col[5] "timestamp_s": [1970-01-01T00:00:10Z (null) (null) 1970-01-01T00:00:13Z 1970-01-01T00:00:14Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.01Z (null) (null) 1970-01-01T00:00:00.013Z 1970-01-01T00:00:00.014Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00001Z (null) (null) 1970-01-01T00:00:00.000013Z 1970-01-01T00:00:00.000014Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000001Z (null) (null) 1970-01-01T00:00:00.000000013Z 1970-01-01T00:00:00.000000014Z]
   col[9] "date32s": [-12 (null) (null) 11 12]
   col[10] "date64s": [-12 (null) (null) 11 12]
 record 3/3...
@@ -490,10 +539,10 @@ record 3/3...
   col[2] "time32s": [-22 (null) (null) 21 22]
   col[3] "time64ns": [-22 (null) (null) 21 22]
   col[4] "time64us": [-22 (null) (null) 21 22]
-  col[5] "timestamp_s": [20 (null) (null) 23 24]
-  col[6] "timestamp_ms": [20 (null) (null) 23 24]
-  col[7] "timestamp_us": [20 (null) (null) 23 24]
-  col[8] "timestamp_ns": [20 (null) (null) 23 24]
+  col[5] "timestamp_s": [1970-01-01T00:00:20Z (null) (null) 1970-01-01T00:00:23Z 1970-01-01T00:00:24Z]
+  col[6] "timestamp_ms": [1970-01-01T00:00:00.02Z (null) (null) 1970-01-01T00:00:00.023Z 1970-01-01T00:00:00.024Z]
+  col[7] "timestamp_us": [1970-01-01T00:00:00.00002Z (null) (null) 1970-01-01T00:00:00.000023Z 1970-01-01T00:00:00.000024Z]
+  col[8] "timestamp_ns": [1970-01-01T00:00:00.00000002Z (null) (null) 1970-01-01T00:00:00.000000023Z 1970-01-01T00:00:00.000000024Z]
   col[9] "date32s": [-22 (null) (null) 21 22]
   col[10] "date64s": [-22 (null) (null) 21 22]
 `,
@@ -518,6 +567,31 @@ record 2/3...
   col[0] "fixed_size_binary_3": ["011" (null) (null) "014" "015"]
 record 3/3...
   col[0] "fixed_size_binary_3": ["021" (null) (null) "024" "025"]
+`,
+		},
+		{
+			name: "intervals",
+			want: `version: V4
+record 1/3...
+  col[0] "months": [1 (null) (null) 4 5]
+  col[1] "days": [{1 1} (null) (null) {4 4} {5 5}]
+record 2/3...
+  col[0] "months": [11 (null) (null) 14 15]
+  col[1] "days": [{11 11} (null) (null) {14 14} {15 15}]
+record 3/3...
+  col[0] "months": [21 (null) (null) 24 25]
+  col[1] "days": [{21 21} (null) (null) {24 24} {25 25}]
+`,
+		},
+		{
+			name: "decimal256",
+			want: `version: V4
+record 1/3...
+  col[0] "dec256s": [3.100 (null) (null) -3.400 3.500]
+record 2/3...
+  col[0] "dec256s": [0.000 (null) (null) -0.001 123456789012345678901234567890.123]
+record 3/3...
+  col[0] "dec256s": [9999999999999999999999999999999999999999999999999999999999999999999999999.999 (null) (null) -9999999999999999999999999999999999999999999999999999999999999999999999999.999 -0.001]
 `,
 		},
 	} {
@@ -569,7 +643,7 @@ record 3/3...
 			}()
 
 			w := new(bytes.Buffer)
-			err := processFile(w, fname)
+			err := processFile(w, fname, mem)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -580,3 +654,651 @@ record 3/3...
 		})
 	}
 }
+
+func TestFormatTimestampZone(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		tz   string
+		want string
+		err  bool
+	}{
+		{tz: "", want: "[2020-01-01T10:00:00.5Z (null)]"},
+		{tz: "+05:30", want: "[2020-01-01T15:30:00.5+05:30 (null)]"},
+		{tz: "-0800", want: "[2020-01-01T02:00:00.5-08:00 (null)]"},
+		{tz: "Not/A_Zone", err: true},
+	} {
+		t.Run(tc.tz, func(t *testing.T) {
+			bldr := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: tc.tz})
+			defer bldr.Release()
+			bldr.AppendValues([]arrow.Timestamp{1577872800500, 0}, []bool{true, false})
+			arr := bldr.NewArray()
+			defer arr.Release()
+
+			got, err := formatColumn(arr)
+			switch {
+			case tc.err:
+				if err == nil || !strings.Contains(err.Error(), tc.tz) {
+					t.Fatalf("invalid error: %v", err)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case got != tc.want:
+				t.Fatalf("invalid output: got=%q, want=%q", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestFormatColumn checks that columns are formatted as by the String
+// methods of arrays, timestamps aside.
+func TestFormatColumn(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, rec := range recs {
+				for i, col := range rec.Columns() {
+					if col.DataType().ID() == arrow.TIMESTAMP {
+						continue
+					}
+					arrs := []array.Interface{col}
+					// the String method of sliced struct arrays misaligns the
+					// validity of the parent and of the fields: skip them.
+					if col.Len() > 1 && col.DataType().ID() != arrow.STRUCT {
+						slice := array.NewSlice(col, 1, int64(col.Len()))
+						defer slice.Release()
+						arrs = append(arrs, slice)
+					}
+					for _, arr := range arrs {
+						got, err := formatColumn(arr)
+						if err != nil {
+							t.Fatalf("could not format column %q: %v", rec.ColumnName(i), err)
+						}
+						if want := fmt.Sprintf("%v", arr); got != want {
+							t.Fatalf("invalid output for column %q:\ngot= %s\nwant=%s", rec.ColumnName(i), got, want)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCatCorrupted(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-corrupted-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	recs := arrdata.Records["primitives"]
+
+	for _, stream := range []bool{false, true} {
+		raw := func() []byte {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile(tempDir, "go-arrow-cat-corrupted-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			var w interface {
+				io.Closer
+				Write(array.Record) error
+			}
+			switch {
+			case stream:
+				w = ipc.NewWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+			default:
+				w, err = ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, rec := range recs {
+				err = w.Write(rec)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raw, err := ioutil.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return raw
+		}()
+
+		// truncate the input in the footer, in the middle of a record batch
+		// and in the middle of the schema.
+		for _, n := range []int{len(raw) - 1, len(raw) - 8, len(raw)/2 + 1, 12} {
+			t.Run(fmt.Sprintf("stream=%v-size=%d", stream, n), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				fname := filepath.Join(tempDir, fmt.Sprintf("truncated-%v-%d.data", stream, n))
+				err := ioutil.WriteFile(fname, raw[:n], 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = processFile(ioutil.Discard, fname, mem)
+				if err == nil {
+					t.Fatalf("expected an error on a truncated input")
+				}
+				if code := report(ioutil.Discard, err, "text"); code != 3 {
+					t.Fatalf("invalid exit code: got=%d, want=3 (err=%+v)", code, err)
+				}
+			})
+		}
+	}
+}
+
+func TestCatWhere(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-where-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	recs := arrdata.Records["primitives"]
+	fname := func() string {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		f, err := ioutil.TempFile(tempDir, "go-arrow-cat-where-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		w, err := ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, rec := range recs {
+			err = w.Write(rec)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = w.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f.Name()
+	}()
+
+	defer func(v string) { where = v }(where)
+
+	for _, tc := range []struct {
+		where string
+		want  string
+		err   string
+	}{
+		{
+			where: "int32s < -3 && bools == true && -20 < int8s",
+			want: `version: V4
+record 1/3...
+  col[0] "bools": [true]
+  col[1] "int8s": [-5]
+  col[2] "int16s": [-5]
+  col[3] "int32s": [-5]
+  col[4] "int64s": [-5]
+  col[5] "uint8s": [5]
+  col[6] "uint16s": [5]
+  col[7] "uint32s": [5]
+  col[8] "uint64s": [5]
+  col[9] "float32s": [5]
+  col[10] "float64s": [5]
+record 2/3...
+  col[0] "bools": [true true]
+  col[1] "int8s": [-11 -15]
+  col[2] "int16s": [-11 -15]
+  col[3] "int32s": [-11 -15]
+  col[4] "int64s": [-11 -15]
+  col[5] "uint8s": [11 15]
+  col[6] "uint16s": [11 15]
+  col[7] "uint32s": [11 15]
+  col[8] "uint64s": [11 15]
+  col[9] "float32s": [11 15]
+  col[10] "float64s": [11 15]
+record 3/3...
+  col[0] "bools": []
+  col[1] "int8s": []
+  col[2] "int16s": []
+  col[3] "int32s": []
+  col[4] "int64s": []
+  col[5] "uint8s": []
+  col[6] "uint16s": []
+  col[7] "uint32s": []
+  col[8] "uint64s": []
+  col[9] "float32s": []
+  col[10] "float64s": []
+`,
+		},
+		{
+			where: "int32s == 1.5",
+			err:   `column "int32s"`,
+		},
+		{
+			where: `uint8s == "1"`,
+			err:   `column "uint8s"`,
+		},
+		{
+			where: "missing != null",
+			err:   `unknown column "missing"`,
+		},
+	} {
+		t.Run(tc.where, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			where = tc.where
+			w := new(bytes.Buffer)
+			err := processFile(w, fname, mem)
+			switch {
+			case tc.err != "":
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case w.String() != tc.want:
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", w.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestCatSchemaFile(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-schema-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		recs   = arrdata.Records["primitives"]
+		schema = recs[0].Schema()
+	)
+	writeStream := func(recs []array.Record) []byte {
+		buf := new(bytes.Buffer)
+		w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		for _, rec := range recs {
+			if err := w.Write(rec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	// a stream holding only the schema, and a stream without it.
+	schemaFile := filepath.Join(tempDir, "schema.data")
+	if err := ioutil.WriteFile(schemaFile, writeStream(nil), 0644); err != nil {
+		t.Fatal(err)
+	}
+	full := writeStream(recs)
+	headless := full[8+binary.LittleEndian.Uint32(full[4:]):]
+
+	want := new(bytes.Buffer)
+	if err := processStream(want, bytes.NewReader(full), mem); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := processStream(ioutil.Discard, bytes.NewReader(headless), mem); err == nil {
+		t.Fatalf("expected an error without fallback schema")
+	}
+
+	defer func(s *arrow.Schema) { fallback = s }(fallback)
+	fallback, err = loadSchema(schemaFile, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := new(bytes.Buffer)
+	if err := processStream(got, bytes.NewReader(headless), mem); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != want.String() {
+		t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+	}
+}
+
+// TestCatEmpty checks that zero-row records of every type are displayed,
+// whether they come from a stream or from a file.
+func TestCatEmpty(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-empty-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for _, name := range arrdata.RecordNames {
+		for _, stream := range []bool{true, false} {
+			t.Run(fmt.Sprintf("%s/stream=%v", name, stream), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				var (
+					rec    = arrdata.Records[name][0]
+					schema = rec.Schema()
+					recs   = []array.Record{
+						rec.NewSlice(0, 0),
+						rec.NewSlice(rec.NumRows(), rec.NumRows()),
+					}
+				)
+				defer func() {
+					for _, rec := range recs {
+						rec.Release()
+					}
+				}()
+
+				f, err := ioutil.TempFile(tempDir, "go-arrow-cat-empty-")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer f.Close()
+
+				var w interface {
+					Write(array.Record) error
+					Close() error
+				}
+				want := new(strings.Builder)
+				switch {
+				case stream:
+					w = ipc.NewWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+				default:
+					w, err = ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				for i, rec := range recs {
+					if err := w.Write(rec); err != nil {
+						t.Fatal(err)
+					}
+					if stream {
+						fmt.Fprintf(want, "record %d...\n", i+1)
+					} else {
+						fmt.Fprintf(want, "record %d/%d...\n", i+1, len(recs))
+					}
+					for j, field := range schema.Fields() {
+						empty := array.MakeEmpty(mem, field.Type)
+						fmt.Fprintf(want, "  col[%d] %q: %v\n", j, field.Name, empty)
+						empty.Release()
+					}
+				}
+				if err := w.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if err := f.Close(); err != nil {
+					t.Fatal(err)
+				}
+
+				out := new(bytes.Buffer)
+				if err := processFile(out, f.Name(), mem); err != nil {
+					t.Fatal(err)
+				}
+				got := out.String()
+				if !stream {
+					// skip the version of the file format.
+					got = got[strings.Index(got, "\n")+1:]
+				}
+				if got != want.String() {
+					t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestCatFormatOptions(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["strings"]
+	buf := new(bytes.Buffer)
+	w := ipc.NewWriter(buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(opts []array.FormatOption) { formatOpts = opts }(formatOpts)
+
+	for _, tc := range []struct {
+		name string
+		opts []array.FormatOption
+		want string
+	}{
+		{
+			name: "width=1",
+			opts: []array.FormatOption{array.WithMaxCellWidth(1)},
+			want: `record 1...
+  col[0] "strings": ["1"…(3 bytes) (null) (null) "4" "5"]
+  col[1] "bytes": ["1"…(3 bytes) (null) (null) "4" "5"]
+record 2...
+  col[0] "strings": ["1"…(2 bytes) (null) (null) "4"…(2 bytes) "5"…(2 bytes)]
+  col[1] "bytes": ["1"…(2 bytes) (null) (null) "4"…(2 bytes) "5"…(2 bytes)]
+record 3...
+  col[0] "strings": ["1"…(3 bytes) (null) (null) "4"…(3 bytes) "5"…(3 bytes)]
+  col[1] "bytes": ["1"…(3 bytes) (null) (null) "4"…(3 bytes) "5"…(3 bytes)]
+`,
+		},
+		{
+			name: "width=2,hex",
+			opts: []array.FormatOption{array.WithMaxCellWidth(2), array.WithBinaryFormat(array.BinaryHex)},
+			want: `record 1...
+  col[0] "strings": ["1é" (null) (null) "4" "5"]
+  col[1] "bytes": [31c3…(3 bytes) (null) (null) 34 35]
+record 2...
+  col[0] "strings": ["11" (null) (null) "44" "55"]
+  col[1] "bytes": [3131 (null) (null) 3434 3535]
+record 3...
+  col[0] "strings": ["11"…(3 bytes) (null) (null) "44"…(3 bytes) "55"…(3 bytes)]
+  col[1] "bytes": [3131…(3 bytes) (null) (null) 3434…(3 bytes) 3535…(3 bytes)]
+`,
+		},
+		{
+			name: "len",
+			opts: []array.FormatOption{array.WithBinaryFormat(array.BinaryLen)},
+			want: `record 1...
+  col[0] "strings": ["1é" (null) (null) "4" "5"]
+  col[1] "bytes": [<3 bytes> (null) (null) <1 bytes> <1 bytes>]
+record 2...
+  col[0] "strings": ["11" (null) (null) "44" "55"]
+  col[1] "bytes": [<2 bytes> (null) (null) <2 bytes> <2 bytes>]
+record 3...
+  col[0] "strings": ["111" (null) (null) "444" "555"]
+  col[1] "bytes": [<3 bytes> (null) (null) <3 bytes> <3 bytes>]
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			formatOpts = tc.opts
+			got := new(bytes.Buffer)
+			if err := processStream(got, bytes.NewReader(buf.Bytes()), mem); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tc.want {
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s\n", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCatErrors(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-cat-errors-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	recs := arrdata.Records["primitives"]
+	stream := new(bytes.Buffer)
+	w := ipc.NewWriter(stream, ipc.WithSchema(recs[0].Schema()))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// corrupt the body length of the second record batch message.
+	corrupted := append([]byte(nil), stream.Bytes()...)
+	{
+		r := ipc.NewMessageReader(bytes.NewReader(corrupted))
+		pos := 0
+		for i := 0; i < 2; i++ {
+			msg, err := r.Message()
+			if err != nil {
+				t.Fatal(err)
+			}
+			pos += 8 + len(msg.RawMetadata()) + int(msg.BodyLen())
+		}
+		r.Release()
+		binary.LittleEndian.PutUint32(corrupted[pos+4:], 1<<30)
+	}
+
+	write := func(name string, raw []byte) string {
+		fname := filepath.Join(tempDir, name)
+		err := ioutil.WriteFile(fname, raw, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fname
+	}
+
+	for _, tc := range []struct {
+		name  string
+		fname string
+		class string
+		batch *int
+		code  int
+	}{
+		{
+			name:  "missing",
+			fname: filepath.Join(tempDir, "missing.data"),
+			class: classNotFound,
+			code:  2,
+		},
+		{
+			name:  "text",
+			fname: write("text.txt", []byte("hello, world\n")),
+			class: classNotArrow,
+			code:  2,
+		},
+		{
+			name:  "empty",
+			fname: write("empty.txt", nil),
+			class: classNotArrow,
+			code:  2,
+		},
+		{
+			name:  "truncated-stream",
+			fname: write("truncated.stream", stream.Bytes()[:stream.Len()-16]),
+			class: classCorrupt,
+			batch: intPtr(len(recs) - 1),
+			code:  3,
+		},
+		{
+			name:  "corrupted-stream",
+			fname: write("corrupted.stream", corrupted),
+			class: classCorrupt,
+			batch: intPtr(1),
+			code:  3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			err := processFiles(ioutil.Discard, []string{tc.fname}, mem)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+
+			out := new(bytes.Buffer)
+			if code := report(out, err, "json"); code != tc.code {
+				t.Fatalf("invalid exit code: got=%d, want=%d", code, tc.code)
+			}
+
+			var got struct {
+				File    string `json:"file"`
+				Batch   *int   `json:"batch"`
+				Class   string `json:"class"`
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+				t.Fatalf("invalid JSON output %q: %+v", out.String(), err)
+			}
+			if got.File != tc.fname {
+				t.Fatalf("invalid file: got=%q, want=%q", got.File, tc.fname)
+			}
+			if got.Class != tc.class {
+				t.Fatalf("invalid class: got=%q, want=%q (err=%+v)", got.Class, tc.class, err)
+			}
+			if !reflect.DeepEqual(got.Batch, tc.batch) {
+				t.Fatalf("invalid batch: got=%v, want=%v", got.Batch, tc.batch)
+			}
+			if got.Message != err.Error() {
+				t.Fatalf("invalid message: got=%q, want=%q", got.Message, err.Error())
+			}
+
+			out.Reset()
+			if code := report(out, err, "text"); code != tc.code {
+				t.Fatalf("invalid exit code: got=%d, want=%d", code, tc.code)
+			}
+			if got, want := out.String(), log.Prefix()+err.Error()+"\n"; got != want {
+				t.Fatalf("invalid text output: got=%q, want=%q", got, want)
+			}
+		})
+	}
+
+	t.Run("schema-file", func(t *testing.T) {
+		mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer mem.AssertSize(t, 0)
+
+		for _, tc := range []struct {
+			fname string
+			class string
+		}{
+			{filepath.Join(tempDir, "missing.schema"), classNotFound},
+			{write("text.schema", []byte("hello, world\n")), classNotArrow},
+		} {
+			_, err := loadSchema(tc.fname, mem)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			out := new(bytes.Buffer)
+			if code := report(out, inFile(err, tc.fname), "json"); code != 2 {
+				t.Fatalf("invalid exit code: got=%d, want=2", code)
+			}
+			if want := fmt.Sprintf(`"class":%q`, tc.class); !strings.Contains(out.String(), want) {
+				t.Fatalf("invalid JSON output: got=%q, want %s", out.String(), want)
+			}
+		}
+	})
+}
+
+func intPtr(v int) *int { return &v }
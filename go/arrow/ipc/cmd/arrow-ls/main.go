@@ -50,9 +50,17 @@
 //      - float32s: type=float32, nullable
 //      - float64s: type=float64, nullable
 //  records: 3
+//
+// arrow-ls exits with code 2 when an input cannot be opened or is not an
+// Arrow file or stream, with code 3 when it holds invalid Arrow data, and
+// with code 1 on any other error. With -errors=json, the error is reported
+// on the standard error as a JSON object, with the input file ("-" for the
+// standard input), the index of the record batch, if any, the error class
+// (not-found, not-arrow, corrupt or error) and the message.
 package main // import "github.com/apache/arrow/go/arrow/ipc/cmd/arrow-ls"
 
 import (
+	"bufio"
 	"bytes"
 	"flag"
 	"fmt"
@@ -69,8 +77,13 @@ func main() {
 	log.SetPrefix("arrow-ls: ")
 	log.SetFlags(0)
 
+	errorsFmt := flag.String("errors", "text", "format of the error message: text or json")
 	flag.Parse()
 
+	if *errorsFmt != "text" && *errorsFmt != "json" {
+		log.Fatalf("invalid -errors format %q (want text or json)", *errorsFmt)
+	}
+
 	var err error
 	switch flag.NArg() {
 	case 0:
@@ -79,20 +92,28 @@ func main() {
 		err = processFiles(os.Stdout, flag.Args())
 	}
 	if err != nil {
-		log.Fatal(err)
+		os.Exit(report(os.Stderr, err, *errorsFmt))
 	}
 }
 
 func processStream(w io.Writer, rin io.Reader) error {
 	mem := memory.NewGoAllocator()
 
-	for {
-		r, err := ipc.NewReader(rin, ipc.WithAllocator(mem))
+	br := bufio.NewReader(rin)
+	for i := 0; ; i++ {
+		// an input starting like an Arrow stream is corrupt if it cannot
+		// be read, so are the streams following a valid one.
+		class := classCorrupt
+		if p, _ := br.Peek(len(streamPrefix)); i == 0 && !bytes.Equal(p, streamPrefix) {
+			class = classNotArrow
+		}
+
+		r, err := ipc.NewReader(br, ipc.WithAllocator(mem))
 		if err != nil {
 			if xerrors.Is(err, io.EOF) {
 				return nil
 			}
-			return err
+			return newError(class, -1, err)
 		}
 
 		fmt.Fprintf(w, "%v\n", r.Schema())
@@ -101,17 +122,20 @@ func processStream(w io.Writer, rin io.Reader) error {
 		for r.Next() {
 			nrecs++
 		}
-		fmt.Fprintf(w, "records: %d\n", nrecs)
+		err = r.Err()
 		r.Release()
+		if err != nil {
+			return newError(classCorrupt, nrecs, err)
+		}
+		fmt.Fprintf(w, "records: %d\n", nrecs)
 	}
-	return nil
 }
 
 func processFiles(w io.Writer, names []string) error {
 	for _, name := range names {
 		err := processFile(w, name)
 		if err != nil {
-			return err
+			return inFile(err, name)
 		}
 	}
 	return nil
@@ -121,14 +145,14 @@ func processFile(w io.Writer, fname string) error {
 
 	f, err := os.Open(fname)
 	if err != nil {
-		return err
+		return newError(classNotFound, -1, err)
 	}
 	defer f.Close()
 
 	hdr := make([]byte, len(ipc.Magic))
 	_, err = io.ReadFull(f, hdr)
 	if err != nil {
-		return xerrors.Errorf("could not read file header: %w", err)
+		return newError(classNotArrow, -1, xerrors.Errorf("could not read file header: %w", err))
 	}
 	f.Seek(0, io.SeekStart)
 
@@ -141,10 +165,7 @@ func processFile(w io.Writer, fname string) error {
 
 	r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
 	if err != nil {
-		if xerrors.Is(err, io.EOF) {
-			return nil
-		}
-		return err
+		return newError(classCorrupt, -1, err)
 	}
 	defer r.Close()
 
@@ -195,7 +216,11 @@ Examples:
      - float32s: type=float32, nullable
      - float64s: type=float64, nullable
  records: 3
+
+Options:
+
 `)
+		flag.PrintDefaults()
 		os.Exit(0)
 	}
 }
@@ -18,10 +18,15 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow/array"
@@ -80,8 +85,8 @@ records: 4
 			name: "strings",
 			want: `schema:
   fields: 2
-    - strings: type=utf8
-    - bytes: type=binary
+    - strings: type=utf8, nullable
+    - bytes: type=binary, nullable
 records: 3
 `,
 		},
@@ -339,3 +344,153 @@ records: 3
 		})
 	}
 }
+
+func TestLsErrors(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "go-arrow-ls-errors-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	write := func(name string, raw []byte) string {
+		fname := filepath.Join(tempDir, name)
+		err := ioutil.WriteFile(fname, raw, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fname
+	}
+
+	recs := arrdata.Records["primitives"]
+	stream := new(bytes.Buffer)
+	w := ipc.NewWriter(stream, ipc.WithSchema(recs[0].Schema()))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Create(filepath.Join(tempDir, "primitives.data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw, err := ipc.NewFileWriter(file, ipc.WithSchema(recs[0].Schema()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range recs {
+		if err := fw.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+	raw, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		fname string
+		class string
+		batch *int
+		code  int
+	}{
+		{
+			name:  "missing",
+			fname: filepath.Join(tempDir, "missing.data"),
+			class: classNotFound,
+			code:  2,
+		},
+		{
+			name:  "text",
+			fname: write("text.txt", []byte("hello, world\n")),
+			class: classNotArrow,
+			code:  2,
+		},
+		{
+			name:  "short",
+			fname: write("short.txt", []byte("abc")),
+			class: classNotArrow,
+			code:  2,
+		},
+		{
+			name:  "truncated-file",
+			fname: write("truncated.data", raw[:len(raw)-8]),
+			class: classCorrupt,
+			code:  3,
+		},
+		{
+			name:  "truncated-stream",
+			fname: write("truncated.stream", stream.Bytes()[:stream.Len()-16]),
+			class: classCorrupt,
+			batch: intPtr(len(recs) - 1),
+			code:  3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := processFiles(ioutil.Discard, []string{tc.fname})
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+
+			out := new(bytes.Buffer)
+			if code := report(out, err, "json"); code != tc.code {
+				t.Fatalf("invalid exit code: got=%d, want=%d", code, tc.code)
+			}
+
+			var got struct {
+				File    string `json:"file"`
+				Batch   *int   `json:"batch"`
+				Class   string `json:"class"`
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+				t.Fatalf("invalid JSON output %q: %+v", out.String(), err)
+			}
+			if got.File != tc.fname {
+				t.Fatalf("invalid file: got=%q, want=%q", got.File, tc.fname)
+			}
+			if got.Class != tc.class {
+				t.Fatalf("invalid class: got=%q, want=%q (err=%+v)", got.Class, tc.class, err)
+			}
+			if !reflect.DeepEqual(got.Batch, tc.batch) {
+				t.Fatalf("invalid batch: got=%v, want=%v", got.Batch, tc.batch)
+			}
+			if got.Message != err.Error() {
+				t.Fatalf("invalid message: got=%q, want=%q", got.Message, err.Error())
+			}
+
+			out.Reset()
+			if code := report(out, err, "text"); code != tc.code {
+				t.Fatalf("invalid exit code: got=%d, want=%d", code, tc.code)
+			}
+			if got, want := out.String(), log.Prefix()+err.Error()+"\n"; got != want {
+				t.Fatalf("invalid text output: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
+
+func TestLsStdinErrors(t *testing.T) {
+	err := processStream(ioutil.Discard, strings.NewReader("hello, world\n"))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	out := new(bytes.Buffer)
+	if code := report(out, err, "json"); code != 2 {
+		t.Fatalf("invalid exit code: got=%d, want=2", code)
+	}
+	if got, want := out.String(), `{"file":"-","class":"not-arrow","message":`; !strings.HasPrefix(got, want) {
+		t.Fatalf("invalid JSON output: got=%q, want prefix %q", got, want)
+	}
+}
+
+func intPtr(v int) *int { return &v }
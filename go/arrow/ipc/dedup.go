@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+const (
+	// DefaultDeduplicationThreshold is the repetition ratio above which
+	// WithStringDeduplication encodes a string column, by default.
+	DefaultDeduplicationThreshold = 0.5
+
+	// DefaultDeduplicationSampleSize is the number of values sampled by
+	// WithStringDeduplication to estimate the repetition ratio of a string
+	// column, by default.
+	DefaultDeduplicationSampleSize = 1024
+)
+
+// WithStringDeduplication specifies that readers dictionary-encode the
+// string columns of the record batches they load, when their values are
+// repetitive. Encoding costs CPU time when loading a record batch, and
+// saves the memory of the repeated values.
+//
+// A column is encoded when, among sampleSize values evenly spaced in the
+// column, the ratio of repeated values (1 - distinct/sampled) is at least
+// threshold. A zero threshold or sample size selects the default one.
+//
+// Encoded columns have a dictionary data type, with int32 indices and
+// string values, exposed by the schema of their record. The schema of the
+// reader is not affected: records may have different schemas, depending on
+// their values.
+func WithStringDeduplication(threshold float64, sampleSize int) Option {
+	return func(cfg *config) {
+		if threshold == 0 {
+			threshold = DefaultDeduplicationThreshold
+		}
+		if sampleSize <= 0 {
+			sampleSize = DefaultDeduplicationSampleSize
+		}
+		cfg.dedup.threshold = threshold
+		cfg.dedup.sample = sampleSize
+	}
+}
+
+// deduplicator dictionary-encodes the repetitive string columns of the
+// records loaded from an IPC source.
+type deduplicator struct {
+	mem       memory.Allocator
+	threshold float64
+	sample    int
+}
+
+// newDeduplicator returns the deduplicator configured by cfg, or nil if
+// string deduplication is not enabled.
+func newDeduplicator(cfg *config) *deduplicator {
+	if cfg.dedup.sample == 0 {
+		return nil
+	}
+	return &deduplicator{
+		mem:       cfg.alloc,
+		threshold: cfg.dedup.threshold,
+		sample:    cfg.dedup.sample,
+	}
+}
+
+// apply encodes the repetitive string columns of rec.
+// apply releases rec, unless it is returned as is.
+func (d *deduplicator) apply(rec array.Record) array.Record {
+	var (
+		cols   []array.Interface
+		fields []arrow.Field
+	)
+	for i, col := range rec.Columns() {
+		str, ok := col.(*array.String)
+		if !ok || !d.repetitive(str) {
+			continue
+		}
+		if cols == nil {
+			cols = append([]array.Interface{}, rec.Columns()...)
+			fields = append([]arrow.Field{}, rec.Schema().Fields()...)
+		}
+		cols[i] = array.DictionaryEncode(d.mem, str)
+		defer cols[i].Release()
+		fields[i].Type = cols[i].DataType()
+	}
+	if cols == nil {
+		return rec
+	}
+	defer rec.Release()
+
+	meta := rec.Schema().Metadata()
+	return array.NewRecord(arrow.NewSchema(fields, &meta), cols, rec.NumRows())
+}
+
+// repetitive reports whether the ratio of repeated values among a sample
+// of the values of arr is at least the threshold of d.
+func (d *deduplicator) repetitive(arr *array.String) bool {
+	step := arr.Len() / d.sample
+	if step == 0 {
+		step = 1
+	}
+
+	var (
+		seen    = make(map[string]struct{})
+		sampled = 0
+	)
+	for i := 0; i < arr.Len() && sampled < d.sample; i += step {
+		if arr.IsNull(i) {
+			continue
+		}
+		seen[arr.Value(i)] = struct{}{}
+		sampled++
+	}
+	if sampled == 0 {
+		return false
+	}
+	return 1-float64(len(seen))/float64(sampled) >= d.threshold
+}
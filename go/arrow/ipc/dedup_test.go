@@ -0,0 +1,204 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// makeDedupRecords returns records whose "rep" column is repetitive, except
+// in the last record, and whose "uniq" column is not.
+func makeDedupRecords(mem memory.Allocator) []array.Record {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "rep", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "uniq", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	const nrows = 10000
+	var recs []array.Record
+	for i := 0; i < 3; i++ {
+		for j := 0; j < nrows; j++ {
+			bld.Field(0).(*array.Int64Builder).Append(int64(j))
+			switch {
+			case j%7 == 3:
+				bld.Field(1).(*array.StringBuilder).AppendNull()
+			case i == 2:
+				bld.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("a rather long unique value %06d", j))
+			default:
+				bld.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("a rather long repeated value %d", j%10))
+			}
+			bld.Field(2).(*array.StringBuilder).Append(fmt.Sprintf("%06d", j))
+		}
+		recs = append(recs, bld.NewRecord())
+	}
+	return recs
+}
+
+// decodeRecord returns a copy of rec whose dictionary-encoded columns are
+// decoded.
+func decodeRecord(t *testing.T, mem memory.Allocator, rec array.Record, schema *arrow.Schema) array.Record {
+	cols := make([]array.Interface, rec.NumCols())
+	for i, col := range rec.Columns() {
+		dict, ok := col.(*array.Dictionary)
+		if !ok {
+			col.Retain()
+			cols[i] = col
+			continue
+		}
+		b := array.NewBuilder(mem, dict.Dictionary().DataType())
+		for j := 0; j < dict.Len(); j++ {
+			if dict.IsNull(j) {
+				b.AppendNull()
+				continue
+			}
+			k := dict.GetValueIndex(j)
+			b.AppendArray(dict.Dictionary(), k, k+1)
+		}
+		cols[i] = b.NewArray()
+		b.Release()
+	}
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	return array.NewRecord(schema, cols, rec.NumRows())
+}
+
+// arraySize returns the number of bytes of the buffers of arr, a string or
+// dictionary array.
+func arraySize(arr array.Interface) int {
+	if dict, ok := arr.(*array.Dictionary); ok {
+		return arraySize(dict.Indices()) + arraySize(dict.Dictionary())
+	}
+	n := 0
+	for _, buf := range arr.Data().Buffers() {
+		if buf != nil {
+			n += buf.Len()
+		}
+	}
+	return n
+}
+
+func TestStringDeduplication(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeDedupRecords(mem)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+	schema := recs[0].Schema()
+
+	check := func(t *testing.T, got []array.Record, encoded []bool) {
+		if len(got) != len(recs) {
+			t.Fatalf("invalid number of records: got=%d, want=%d", len(got), len(recs))
+		}
+		for i, rec := range got {
+			_, isDict := rec.Column(1).(*array.Dictionary)
+			if isDict != encoded[i] {
+				t.Fatalf("record %d: invalid encoding of column %q: got=%v", i, "rep", rec.Schema().Field(1).Type)
+			}
+			if _, ok := rec.Column(2).(*array.String); !ok {
+				t.Fatalf("record %d: column %q should not be encoded", i, "uniq")
+			}
+			if got, want := rec.Schema().Field(1).Type.ID(), rec.Column(1).DataType().ID(); got != want {
+				t.Fatalf("record %d: schema and column disagree: %v, %v", i, got, want)
+			}
+
+			dec := decodeRecord(t, mem, rec, schema)
+			defer dec.Release()
+			if !array.RecordEqual(dec, recs[i]) {
+				t.Fatalf("record %d differs after decoding", i)
+			}
+
+			plain, dedup := arraySize(recs[i].Column(1)), arraySize(rec.Column(1))
+			if encoded[i] && dedup*5 > plain {
+				t.Fatalf("record %d: deduplicated column is too large: %d bytes, for %d bytes", i, dedup, plain)
+			}
+		}
+	}
+
+	file := writeFileBytes(t, mem, recs)
+	stream := writeStreamBytes(t, mem, recs)
+
+	for _, tc := range []struct {
+		name    string
+		opt     ipc.Option
+		encoded []bool
+	}{
+		{"default", ipc.WithStringDeduplication(0, 0), []bool{true, true, false}},
+		{"small sample", ipc.WithStringDeduplication(0, 10), []bool{true, true, false}},
+		{"high threshold", ipc.WithStringDeduplication(0.999, 0), []bool{false, false, false}},
+	} {
+		t.Run(tc.name+"/file", func(t *testing.T) {
+			r, err := ipc.NewFileReader(bytes.NewReader(file), ipc.WithAllocator(mem), tc.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			var got []array.Record
+			for i := 0; i < r.NumRecords(); i++ {
+				rec, err := r.Record(i)
+				if err != nil {
+					t.Fatal(err)
+				}
+				rec.Retain()
+				defer rec.Release()
+				got = append(got, rec)
+			}
+			if !r.Schema().Equal(schema) {
+				t.Fatalf("the schema of the reader should not change")
+			}
+			check(t, got, tc.encoded)
+		})
+
+		t.Run(tc.name+"/stream", func(t *testing.T) {
+			r, err := ipc.NewReader(bytes.NewReader(stream), ipc.WithAllocator(mem), tc.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			var got []array.Record
+			for r.Next() {
+				rec := r.Record()
+				rec.Retain()
+				defer rec.Release()
+				got = append(got, rec)
+			}
+			if err := r.Err(); err != nil {
+				t.Fatal(err)
+			}
+			check(t, got, tc.encoded)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// EncodingKey is the key of the field metadata declaring the encoding a
+// field must be written with, by writers created with an EncodingPolicy.
+//
+// The supported values are:
+//   - "float16", "float32" and "float64": the floating-point field is
+//     written with that data type.
+//   - "timestamp[s]", "timestamp[ms]", "timestamp[us]" and "timestamp[ns]":
+//     the timestamp field is written with that time unit, in its time zone.
+//   - "duration[s]", "duration[ms]", "duration[us]" and "duration[ns]":
+//     the duration field is written with that time unit.
+//   - "dictionary": the field is dictionary-encoded.
+//     Dictionary encoding is not supported yet: writers reject schemas
+//     declaring it.
+const EncodingKey = "ARROW:go:encoding"
+
+// EncodingPolicy specifies how writers handle the fields of their schema
+// declaring an encoding under EncodingKey.
+//
+// With EncodingConvert or EncodingReject, the written schema holds the
+// declared data types, and the columns of declared fields may have any data
+// type in the records passed to Write.
+type EncodingPolicy int
+
+const (
+	// EncodingIgnore ignores encoding declarations. This is the default.
+	EncodingIgnore EncodingPolicy = iota
+
+	// EncodingConvert converts the columns not in their declared encoding,
+	// with compute.Cast.
+	EncodingConvert
+
+	// EncodingReject rejects the records holding columns not in their
+	// declared encoding.
+	EncodingReject
+)
+
+// WithEncodingPolicy specifies how writers handle the encodings declared
+// in the metadata of the fields of their schema. See EncodingKey.
+func WithEncodingPolicy(policy EncodingPolicy) Option {
+	return func(cfg *config) {
+		cfg.encoding = policy
+	}
+}
+
+// declaredType returns the data type field must be written with, or false
+// if field declares no encoding.
+func declaredType(field arrow.Field) (arrow.DataType, bool, error) {
+	i := field.Metadata.FindKey(EncodingKey)
+	if i < 0 {
+		return nil, false, nil
+	}
+	enc := field.Metadata.Values()[i]
+
+	invalid := xerrors.Errorf("arrow/ipc: invalid encoding %q for field %q of type %v", enc, field.Name, field.Type)
+	switch {
+	case enc == "dictionary":
+		return nil, false, xerrors.Errorf("arrow/ipc: dictionary encoding of field %q is not supported", field.Name)
+
+	case enc == "float16" || enc == "float32" || enc == "float64":
+		switch field.Type.ID() {
+		case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		default:
+			return nil, false, invalid
+		}
+		switch enc {
+		case "float16":
+			return arrow.FixedWidthTypes.Float16, true, nil
+		case "float32":
+			return arrow.PrimitiveTypes.Float32, true, nil
+		default:
+			return arrow.PrimitiveTypes.Float64, true, nil
+		}
+
+	case strings.HasPrefix(enc, "timestamp["):
+		dt, ok := field.Type.(*arrow.TimestampType)
+		unit, valid := parseUnit(enc, "timestamp")
+		if !ok || !valid {
+			return nil, false, invalid
+		}
+		return &arrow.TimestampType{Unit: unit, TimeZone: dt.TimeZone}, true, nil
+
+	case strings.HasPrefix(enc, "duration["):
+		_, ok := field.Type.(*arrow.DurationType)
+		unit, valid := parseUnit(enc, "duration")
+		if !ok || !valid {
+			return nil, false, invalid
+		}
+		return &arrow.DurationType{Unit: unit}, true, nil
+	}
+
+	return nil, false, invalid
+}
+
+// parseUnit parses the time unit of an encoding of the form "name[unit]".
+func parseUnit(enc, name string) (arrow.TimeUnit, bool) {
+	for _, unit := range []arrow.TimeUnit{arrow.Second, arrow.Millisecond, arrow.Microsecond, arrow.Nanosecond} {
+		if enc == name+"["+unit.String()+"]" {
+			return unit, true
+		}
+	}
+	return 0, false
+}
+
+// conforms reports whether schema is the schema want bound to a writer.
+// Unless policy is EncodingIgnore, the data types of the fields declaring
+// an encoding in want are not compared.
+func conforms(schema, want *arrow.Schema, policy EncodingPolicy) bool {
+	switch {
+	case schema == nil:
+		return false
+	case policy == EncodingIgnore:
+		return schema.Equal(want)
+	}
+	if len(schema.Fields()) != len(want.Fields()) {
+		return false
+	}
+	for i, field := range schema.Fields() {
+		ref := want.Field(i)
+		if ref.Metadata.FindKey(EncodingKey) >= 0 {
+			field.Type, ref.Type = nil, nil
+		}
+		if !field.Equal(ref) {
+			return false
+		}
+	}
+	return true
+}
+
+// enforcer applies the encodings declared by the fields of a schema to the
+// records written to an IPC sink.
+type enforcer struct {
+	mem    memory.Allocator
+	policy EncodingPolicy
+	schema *arrow.Schema    // written schema
+	types  []arrow.DataType // declared data types, nil for other fields
+}
+
+// newEnforcer returns the enforcer of the encodings declared by schema, or
+// nil if policy is EncodingIgnore or no field declares an encoding.
+func newEnforcer(mem memory.Allocator, schema *arrow.Schema, policy EncodingPolicy) (*enforcer, error) {
+	if policy == EncodingIgnore {
+		return nil, nil
+	}
+
+	var (
+		fields = make([]arrow.Field, len(schema.Fields()))
+		e      = &enforcer{
+			mem:    mem,
+			policy: policy,
+			types:  make([]arrow.DataType, len(fields)),
+		}
+		n = 0
+	)
+
+	for i, field := range schema.Fields() {
+		fields[i] = field
+		dt, ok, err := declaredType(field)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		fields[i].Type = dt
+		e.types[i] = dt
+		n++
+	}
+
+	if n == 0 {
+		return nil, nil
+	}
+
+	meta := schema.Metadata()
+	e.schema = arrow.NewSchema(fields, &meta)
+	return e, nil
+}
+
+// apply returns a record holding the columns of rec in their declared
+// encodings. The returned record must be released by the caller.
+func (e *enforcer) apply(rec array.Record) (array.Record, error) {
+	cols := make([]array.Interface, 0, len(e.types))
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	for i, dt := range e.types {
+		col := rec.Column(i)
+		if dt == nil || arrow.TypeEqual(col.DataType(), dt) {
+			col.Retain()
+			cols = append(cols, col)
+			continue
+		}
+
+		name := e.schema.Field(i).Name
+		if e.policy == EncodingReject {
+			return nil, xerrors.Errorf(
+				"arrow/ipc: field %q (index %d) is not in its declared encoding (got=%v, want=%v)",
+				name, i, col.DataType(), dt,
+			)
+		}
+
+		out, err := compute.Cast(e.mem, col, dt)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: could not convert field %q (index %d) to %v: %w", name, i, dt, err)
+		}
+		cols = append(cols, out)
+	}
+
+	return array.NewRecord(e.schema, cols, rec.NumRows()), nil
+}
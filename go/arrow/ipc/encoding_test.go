@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// encodingSchema returns a schema whose float field declares the encoding
+// enc, and has the data type dt.
+func encodingSchema(enc string, dt arrow.DataType) *arrow.Schema {
+	meta := arrow.NewMetadata([]string{ipc.EncodingKey}, []string{enc})
+	return arrow.NewSchema([]arrow.Field{
+		{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "f", Type: dt, Nullable: true, Metadata: meta},
+	}, nil)
+}
+
+func makeEncodingRecord(mem memory.Allocator, schema *arrow.Schema) array.Record {
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	bld.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	switch b := bld.Field(1).(type) {
+	case *array.Float64Builder:
+		b.AppendValues([]float64{1.5, 0, -2}, []bool{true, false, true})
+	case *array.Float32Builder:
+		b.AppendValues([]float32{1.5, 0, -2}, []bool{true, false, true})
+	}
+	return bld.NewRecord()
+}
+
+func TestWriterEncodingPolicy(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		schema  = encodingSchema("float32", arrow.PrimitiveTypes.Float64)
+		encoded = encodingSchema("float32", arrow.PrimitiveTypes.Float32)
+	)
+
+	// records may hold declared fields either as is or in their encoding.
+	recs := []array.Record{
+		makeEncodingRecord(mem, schema),
+		makeEncodingRecord(mem, encoded),
+	}
+	defer recs[0].Release()
+	defer recs[1].Release()
+
+	want := makeEncodingRecord(mem, encoded)
+	defer want.Release()
+
+	t.Run("stream", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem), ipc.WithEncodingPolicy(ipc.EncodingConvert))
+		for _, rec := range recs {
+			if err := w.Write(rec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := ipc.NewReader(buf, ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+
+		if !r.Schema().Equal(encoded) {
+			t.Fatalf("invalid schema:\ngot= %v\nwant=%v", r.Schema(), encoded)
+		}
+		n := 0
+		for r.Next() {
+			if !array.RecordEqual(r.Record(), want) {
+				t.Fatalf("records[%d] differ:\ngot= %v\nwant=%v", n, r.Record(), want)
+			}
+			n++
+		}
+		if n != len(recs) {
+			t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		raw := writeFileBytes(t, mem, recs, ipc.WithEncodingPolicy(ipc.EncodingConvert))
+		r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		if !r.Schema().Equal(encoded) {
+			t.Fatalf("invalid schema:\ngot= %v\nwant=%v", r.Schema(), encoded)
+		}
+		for i := 0; i < r.NumRecords(); i++ {
+			rec, err := r.Record(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !array.RecordEqual(rec, want) {
+				t.Fatalf("records[%d] differ:\ngot= %v\nwant=%v", i, rec, want)
+			}
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		w := ipc.NewWriter(new(bytes.Buffer), ipc.WithSchema(schema), ipc.WithAllocator(mem), ipc.WithEncodingPolicy(ipc.EncodingReject))
+		defer w.Close()
+
+		if err := w.Write(recs[1]); err != nil {
+			t.Fatal(err)
+		}
+		err := w.Write(recs[0])
+		if err == nil || !strings.Contains(err.Error(), `field "f"`) {
+			t.Fatalf("invalid error: %v", err)
+		}
+	})
+
+	t.Run("ignore", func(t *testing.T) {
+		w := ipc.NewWriter(new(bytes.Buffer), ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		defer w.Close()
+
+		if err := w.Write(recs[1]); err == nil {
+			t.Fatalf("expected an error writing a record with a different schema")
+		}
+	})
+}
+
+func TestWriterEncodingInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		schema *arrow.Schema
+		err    string
+	}{
+		{
+			name:   "dictionary",
+			schema: encodingSchema("dictionary", arrow.PrimitiveTypes.Float64),
+			err:    `dictionary encoding of field "f" is not supported`,
+		},
+		{
+			name:   "type",
+			schema: encodingSchema("timestamp[ms]", arrow.PrimitiveTypes.Float64),
+			err:    `invalid encoding "timestamp[ms]" for field "f" of type float64`,
+		},
+		{
+			name:   "unit",
+			schema: encodingSchema("duration[h]", arrow.FixedWidthTypes.Duration_s),
+			err:    `invalid encoding "duration[h]"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := ipc.NewWriter(new(bytes.Buffer), ipc.WithSchema(tc.schema), ipc.WithEncodingPolicy(ipc.EncodingConvert))
+			err := w.Close()
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error:\ngot= %v\nwant=%s", err, tc.err)
+			}
+		})
+	}
+}
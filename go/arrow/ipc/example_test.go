@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func ExampleWriteFile() {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "", "c"}, []bool{true, false, true})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	dir, err := ioutil.TempDir("", "go-arrow-example-")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fname := filepath.Join(dir, "data.arrow")
+	if err := ipc.WriteFile(fname, []array.Record{rec}, ipc.WithAllocator(mem)); err != nil {
+		log.Fatal(err)
+	}
+
+	recs, got, err := ipc.ReadFile(fname, ipc.WithAllocator(mem))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, rec := range recs {
+		defer rec.Release()
+	}
+
+	fmt.Println(got)
+	for _, rec := range recs {
+		for i, col := range rec.Columns() {
+			fmt.Printf("%s: %v\n", rec.ColumnName(i), col)
+		}
+	}
+
+	// Output:
+	// schema:
+	//   fields: 2
+	//     - id: type=int64
+	//     - name: type=utf8, nullable
+	// id: [1 2 3]
+	// name: ["a" (null) "c"]
+}
+
+func ExampleWriteStreamBytes() {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{{Name: "x", Type: arrow.PrimitiveTypes.Float64}}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Float64Builder).AppendValues([]float64{1.5, 2.5}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	raw, err := ipc.WriteStreamBytes([]array.Record{rec}, ipc.WithAllocator(mem))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	recs, _, err := ipc.ReadStreamBytes(raw, ipc.WithAllocator(mem))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, rec := range recs {
+		defer rec.Release()
+		fmt.Println(rec.NumRows(), rec.Column(0))
+	}
+
+	// Output:
+	// 2 [1.5 2.5]
+}
+
+func ExampleNewWriter() {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{{Name: "n", Type: arrow.PrimitiveTypes.Int32}}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	for i := int32(0); i < 3; i++ {
+		b.Field(0).(*array.Int32Builder).AppendValues([]int32{i, i * 10}, nil)
+		rec := b.NewRecord()
+		err := w.Write(rec)
+		rec.Release()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Release()
+
+	for r.Next() {
+		fmt.Println(r.Record().Column(0))
+	}
+	if err := r.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// [0 0]
+	// [1 10]
+	// [2 20]
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"sort"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
@@ -43,9 +44,26 @@ type FileReader struct {
 	memo   dictMemo
 
 	schema *arrow.Schema
+	layout *sourceLayout // layout of the fields, if some are unsupported
+	subst  *substitutor
+	dedup  *deduplicator
 	record array.Record
 
+	unsupported UnsupportedFieldHandling
+
+	stats  []BatchStats // statistics of the record batches, if any.
+	filter BatchFilter
+
+	crcs   []uint32 // checksums of the record batches, if any.
+	verify bool     // whether to verify the checksums of the record batches
+
+	maxDepth int  // maximum nesting depth of the schema
+	validate bool // whether to check the layout of record batch buffers
+
+	metrics MetricsHandler
+
 	irec int   // current record index. used for the arrio.Reader interface
+	nrev int   // number of records read by ReadReverse
 	err  error // last error
 }
 
@@ -59,6 +77,14 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 			r:      r,
 			fields: make(dictTypeMap),
 			memo:   newMemo(),
+			filter: cfg.filter,
+			verify: cfg.verify,
+
+			unsupported: cfg.unsupported,
+
+			maxDepth: cfg.maxDepth,
+			validate: cfg.validate,
+			metrics:  cfg.metrics,
 		}
 	)
 
@@ -72,21 +98,42 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 
 	err = f.readFooter()
 	if err != nil {
+		f.Close()
 		return nil, xerrors.Errorf("arrow/ipc: could not decode footer: %w", err)
 	}
 
 	err = f.readSchema()
 	if err != nil {
+		f.Close()
 		return nil, xerrors.Errorf("arrow/ipc: could not decode schema: %w", err)
 	}
 
 	if cfg.schema != nil && !cfg.schema.Equal(f.schema) {
+		f.Close()
 		return nil, xerrors.Errorf("arrow/ipc: inconsistent schema for reading (got: %v, want: %v)", f.schema, cfg.schema)
 	}
+	f.subst = newSubstitutor(cfg.alloc, f.schema, cfg.subst, cfg.custom)
+	f.dedup = newDeduplicator(cfg)
+
+	if err := cfg.ensureSchema(f.Schema()); err != nil {
+		f.Close()
+		return nil, err
+	}
 
 	return &f, err
 }
 
+// NewFileReaderAt opens an Arrow file embedded in r, starting at offset and
+// spanning size bytes.
+// All positions, including the one given by WithFooterOffset, are relative
+// to the start of the embedded Arrow file.
+//
+// NewFileReaderAt is equivalent to calling NewFileReader with an
+// io.SectionReader over r.
+func NewFileReaderAt(r io.ReaderAt, offset, size int64, opts ...Option) (*FileReader, error) {
+	return NewFileReader(io.NewSectionReader(r, offset, size), opts...)
+}
+
 func (f *FileReader) readFooter() error {
 	var err error
 
@@ -128,7 +175,11 @@ func (f *FileReader) readFooter() error {
 }
 
 func (f *FileReader) readSchema() error {
-	var err error
+	err := checkNestingFB(f.footer.data.Schema(nil), f.maxDepth)
+	if err != nil {
+		return xerrors.Errorf("arrow/ipc: invalid schema: %w", err)
+	}
+
 	f.fields, err = dictTypesFromFB(f.footer.data.Schema(nil))
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not load dictionary types from file: %w", err)
@@ -166,11 +217,27 @@ func (f *FileReader) readSchema() error {
 	if schema == nil {
 		return xerrors.Errorf("arrow/ipc: could not load schema from flatbuffer data")
 	}
-	f.schema, err = schemaFromFB(schema, &f.memo)
+	f.schema, f.layout, err = schemaFromFBWith(schema, &f.memo, f.unsupported)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not read schema: %w", err)
 	}
 
+	f.schema, f.stats, err = stripBatchStats(f.schema)
+	if err != nil {
+		return err
+	}
+	if f.stats != nil && len(f.stats) != f.NumRecords() {
+		return xerrors.Errorf("arrow/ipc: inconsistent batch statistics (got=%d, want=%d batches)", len(f.stats), f.NumRecords())
+	}
+
+	f.schema, f.crcs, err = stripChecksums(f.schema)
+	if err != nil {
+		return err
+	}
+	if f.crcs != nil && len(f.crcs) != f.NumRecords() {
+		return xerrors.Errorf("arrow/ipc: inconsistent batch checksums (got=%d, want=%d batches)", len(f.crcs), f.NumRecords())
+	}
+
 	return err
 }
 
@@ -188,6 +255,23 @@ func (f *FileReader) block(i int) (fileBlock, error) {
 	}, nil
 }
 
+// recordBlock returns the i-th record block, after checking its alignment.
+func (f *FileReader) recordBlock(i int) (fileBlock, error) {
+	blk, err := f.block(i)
+	if err != nil {
+		return blk, err
+	}
+	switch {
+	case !bitutil.IsMultipleOf8(blk.Offset):
+		return blk, xerrors.Errorf("arrow/ipc: invalid file offset=%d for record %d", blk.Offset, i)
+	case !bitutil.IsMultipleOf8(int64(blk.Meta)):
+		return blk, xerrors.Errorf("arrow/ipc: invalid file metadata=%d position for record %d", blk.Meta, i)
+	case !bitutil.IsMultipleOf8(blk.Body):
+		return blk, xerrors.Errorf("arrow/ipc: invalid file body=%d position for record %d", blk.Body, i)
+	}
+	return blk, nil
+}
+
 func (f *FileReader) dict(i int) (fileBlock, error) {
 	var blk flatbuf.Block
 	if !f.footer.data.Dictionaries(&blk, i) {
@@ -203,6 +287,9 @@ func (f *FileReader) dict(i int) (fileBlock, error) {
 }
 
 func (f *FileReader) Schema() *arrow.Schema {
+	if f.subst != nil {
+		return f.subst.schema
+	}
 	return f.schema
 }
 
@@ -214,15 +301,49 @@ func (f *FileReader) NumDictionaries() int {
 }
 
 func (f *FileReader) NumRecords() int {
+	if f.footer.data == nil {
+		return 0
+	}
 	return f.footer.data.RecordBatchesLength()
 }
 
+// BatchStats returns the statistics of the i-th record batch, or nil if the
+// file holds no statistics.
+func (f *FileReader) BatchStats(i int) BatchStats {
+	if f.stats == nil {
+		return nil
+	}
+	return f.stats[i]
+}
+
+// skip reports whether the i-th record batch is rejected by filter.
+func (f *FileReader) skip(i int, filter BatchFilter) bool {
+	return filter != nil && f.stats != nil && !filter(i, f.stats[i])
+}
+
+// verifyBatch checks the body of the i-th record batch against its checksum,
+// when the reader verifies checksums.
+func (f *FileReader) verifyBatch(i int, msg *Message) error {
+	if !f.verify {
+		return nil
+	}
+	if f.crcs == nil {
+		return xerrors.Errorf("arrow/ipc: no checksum for record batch %d", i)
+	}
+	return verifyChecksum(i, msg.body, f.crcs[i])
+}
+
 func (f *FileReader) Version() MetadataVersion {
+	if f.footer.data == nil {
+		return 0
+	}
 	return MetadataVersion(f.footer.data.Version())
 }
 
 // Close cleans up resources used by the File.
 // Close does not close the underlying reader.
+// Close may be called multiple times: once closed, the FileReader holds no
+// record and Record returns an error.
 func (f *FileReader) Close() error {
 	if f.footer.data != nil {
 		f.footer.data = nil
@@ -237,6 +358,8 @@ func (f *FileReader) Close() error {
 		f.record.Release()
 		f.record = nil
 	}
+
+	f.memo.delete()
 	return nil
 }
 
@@ -244,22 +367,18 @@ func (f *FileReader) Close() error {
 // The returned value is valid until the next call to Record.
 // Users need to call Retain on that Record to keep it valid for longer.
 func (f *FileReader) Record(i int) (array.Record, error) {
+	if f.footer.data == nil {
+		return nil, errClosedFile
+	}
 	if i < 0 || i > f.NumRecords() {
 		panic("arrow/ipc: record index out of bounds")
 	}
 
-	blk, err := f.block(i)
+	start := startTimer(f.metrics)
+	blk, err := f.recordBlock(i)
 	if err != nil {
 		return nil, err
 	}
-	switch {
-	case !bitutil.IsMultipleOf8(blk.Offset):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file offset=%d for record %d", blk.Offset, i)
-	case !bitutil.IsMultipleOf8(int64(blk.Meta)):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file metadata=%d position for record %d", blk.Meta, i)
-	case !bitutil.IsMultipleOf8(blk.Body):
-		return nil, xerrors.Errorf("arrow/ipc: invalid file body=%d position for record %d", blk.Body, i)
-	}
 
 	msg, err := blk.NewMessage()
 	if err != nil {
@@ -267,24 +386,60 @@ func (f *FileReader) Record(i int) (array.Record, error) {
 	}
 	defer msg.Release()
 
-	if msg.Type() != MessageRecordBatch {
-		return nil, xerrors.Errorf("arrow/ipc: message %d is not a Record", i)
-	}
-
 	if f.record != nil {
 		f.record.Release()
+		f.record = nil
+	}
+
+	rec, err := f.loadRecord(i, msg)
+	if err != nil {
+		return nil, err
 	}
 
-	f.record = newRecord(f.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
+	messageRead(f.metrics, msg, start)
+	f.record = rec
 	return f.record, nil
 }
 
+// loadRecord decodes the i-th record batch from msg, after verifying its
+// checksum, and applies the type substitutions and dictionary deduplication
+// of the reader.
+// Record and Scanner load record batches through loadRecord, so that both
+// return the same records.
+func (f *FileReader) loadRecord(i int, msg *Message) (array.Record, error) {
+	if msg.Type() != MessageRecordBatch {
+		return nil, xerrors.Errorf("arrow/ipc: message %d is not a Record", i)
+	}
+	if err := f.verifyBatch(i, msg); err != nil {
+		return nil, err
+	}
+
+	rec, err := newRecord(f.schema, f.layout, msg.meta, msg.body, f.maxDepth, f.validate)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not read record %d: %w", i, err)
+	}
+	if f.subst != nil {
+		rec, err = f.subst.apply(rec, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if f.dedup != nil {
+		rec = f.dedup.apply(rec)
+	}
+	return rec, nil
+}
+
 // Read reads the current record from the underlying stream and an error, if any.
 // When the Reader reaches the end of the underlying stream, it returns (nil, io.EOF).
+// Record batches rejected by the filter given to WithBatchFilter are skipped.
 //
 // The returned record value is valid until the next call to Read.
 // Users need to call Retain on that Record to keep it valid for longer.
 func (f *FileReader) Read() (rec array.Record, err error) {
+	for f.irec < f.NumRecords() && f.skip(f.irec, f.filter) {
+		f.irec++
+	}
 	if f.irec == f.NumRecords() {
 		return nil, io.EOF
 	}
@@ -293,12 +448,52 @@ func (f *FileReader) Read() (rec array.Record, err error) {
 	return rec, f.err
 }
 
+// ReadReverse reads the records of the file from last to first, and an error,
+// if any. Only the block of the returned record batch is read from the
+// underlying reader: dictionaries are loaded when opening the file.
+// When the first record has been read, ReadReverse returns (nil, io.EOF).
+// Record batches rejected by the filter given to WithBatchFilter are skipped.
+//
+// ReadReverse and Read iterate independently over the file.
+// The returned record value is valid until the next call to ReadReverse.
+// Users need to call Retain on that Record to keep it valid for longer.
+func (f *FileReader) ReadReverse() (rec array.Record, err error) {
+	i := f.NumRecords() - 1 - f.nrev
+	for i >= 0 && f.skip(i, f.filter) {
+		f.nrev++
+		i--
+	}
+	if i < 0 {
+		return nil, io.EOF
+	}
+	rec, f.err = f.Record(i)
+	f.nrev++
+	return rec, f.err
+}
+
 // ReadAt reads the i-th record from the underlying stream and an error, if any.
 func (f *FileReader) ReadAt(i int64) (array.Record, error) {
 	return f.Record(int(i))
 }
 
-func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) array.Record {
+// newRecord loads the record batch described by meta from body.
+//
+// Buffers are sliced from body at their declared offset and length,
+// independently of each other: they may appear in any order in the body.
+// When validate is set, buffers sharing bytes of the body are rejected.
+// The fields of the batch are located with layout, if the source schema
+// holds unsupported fields.
+func newRecord(schema *arrow.Schema, layout *sourceLayout, meta, body *memory.Buffer, maxDepth int, validate bool) (rec array.Record, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			lerr, ok := e.(loadError)
+			if !ok {
+				panic(e)
+			}
+			rec, err = nil, lerr.err
+		}
+	}()
+
 	var (
 		msg = flatbuf.GetRootAsMessage(meta.Bytes(), 0)
 		md  flatbuf.RecordBatch
@@ -306,49 +501,118 @@ func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) arr
 	initFB(&md, msg.Header)
 	rows := md.Length()
 
+	if err := checkBuffers(&md, int64(body.Len()), validate); err != nil {
+		return nil, err
+	}
+
 	ctx := &arrayLoaderContext{
 		src: ipcSource{
 			meta: &md,
-			r:    body,
+			body: body.Bytes(),
 		},
-		max: kMaxNestingDepth,
+		max: maxDepth,
+	}
+
+	cols := make([]array.Interface, 0, len(schema.Fields()))
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	switch layout {
+	case nil:
+		for _, field := range schema.Fields() {
+			cols = append(cols, ctx.loadArray(field.Type))
+		}
+	default:
+		cols, err = ctx.loadLayout(layout, cols)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// writers may emit buffers not used by the reader, e.g. for null arrays,
+	// but every field node must belong to a field of the schema.
+	if ctx.ifield != md.NodesLength() {
+		return nil, xerrors.Errorf("arrow/ipc: record batch has %d field nodes, schema requires %d", md.NodesLength(), ctx.ifield)
 	}
 
-	cols := make([]array.Interface, len(schema.Fields()))
-	for i, field := range schema.Fields() {
-		cols[i] = ctx.loadArray(field.Type)
+	if validate {
+		for i, col := range cols {
+			if err := array.ValidateFull(col); err != nil {
+				return nil, xerrors.Errorf("arrow/ipc: invalid field %q: %w", schema.Field(i).Name, err)
+			}
+		}
 	}
 
-	return array.NewRecord(schema, cols, rows)
+	return array.NewRecord(schema, cols, rows), nil
+}
+
+// loadError reports invalid array data found while loading the arrays of a
+// record batch. It is raised as a panic by the array loader, and recovered
+// by newRecord.
+type loadError struct {
+	err error
+}
+
+// checkBuffers checks that the buffers of md lie within a body of size
+// bytes and, if overlaps is set, that they do not share any byte.
+func checkBuffers(md *flatbuf.RecordBatch, size int64, overlaps bool) error {
+	var (
+		buf  flatbuf.Buffer
+		rngs = make([][2]int64, 0, md.BuffersLength())
+	)
+	for i := 0; i < md.BuffersLength(); i++ {
+		md.Buffers(&buf, i)
+		off, n := buf.Offset(), buf.Length()
+		if off < 0 || n < 0 || off > size || n > size-off {
+			return xerrors.Errorf("arrow/ipc: buffer %d [%d, %d+%d) out of body of size %d", i, off, off, n, size)
+		}
+		if n > 0 {
+			rngs = append(rngs, [2]int64{off, off + n})
+		}
+	}
+	if !overlaps {
+		return nil
+	}
+
+	idx := make([]int, len(rngs))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return rngs[idx[i]][0] < rngs[idx[j]][0] })
+	for k := 1; k < len(idx); k++ {
+		prev, cur := rngs[idx[k-1]], rngs[idx[k]]
+		if cur[0] < prev[1] {
+			return xerrors.Errorf("arrow/ipc: buffers [%d, %d) and [%d, %d) overlap", prev[0], prev[1], cur[0], cur[1])
+		}
+	}
+	return nil
 }
 
 type ipcSource struct {
 	meta *flatbuf.RecordBatch
-	r    ReadAtSeeker
+	body []byte
 }
 
+// buffer returns a copy of the i-th buffer of the body.
 func (src *ipcSource) buffer(i int) *memory.Buffer {
 	var buf flatbuf.Buffer
-	if !src.meta.Buffers(&buf, i) {
-		panic("buffer index out of bound")
+	if i >= src.meta.BuffersLength() || !src.meta.Buffers(&buf, i) {
+		panic(loadError{xerrors.Errorf("arrow/ipc: record batch has %d buffers, schema requires more", src.meta.BuffersLength())})
 	}
 	if buf.Length() == 0 {
 		return memory.NewBufferBytes(nil)
 	}
 
 	raw := make([]byte, buf.Length())
-	_, err := src.r.ReadAt(raw, buf.Offset())
-	if err != nil {
-		panic(err)
-	}
-
+	copy(raw, src.body[buf.Offset():buf.Offset()+buf.Length()])
 	return memory.NewBufferBytes(raw)
 }
 
 func (src *ipcSource) fieldMetadata(i int) *flatbuf.FieldNode {
 	var node flatbuf.FieldNode
-	if !src.meta.Nodes(&node, i) {
-		panic("field metadata out of bound")
+	if i >= src.meta.NodesLength() || !src.meta.Nodes(&node, i) {
+		panic(loadError{xerrors.Errorf("arrow/ipc: record batch has %d field nodes, schema requires more", src.meta.NodesLength())})
 	}
 	return &node
 }
@@ -372,32 +636,30 @@ func (ctx *arrayLoaderContext) buffer() *memory.Buffer {
 	return buf
 }
 
+// check checks the layout of data, before arrays are instantiated from it.
+func (ctx *arrayLoaderContext) check(data *array.Data) {
+	if err := array.ValidateData(data); err != nil {
+		panic(loadError{err})
+	}
+}
+
 func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
 	switch dt := dt.(type) {
 	case *arrow.NullType:
 		return ctx.loadNull()
 
-	case *arrow.BooleanType,
-		*arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
-		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type,
-		*arrow.Float16Type, *arrow.Float32Type, *arrow.Float64Type,
-		*arrow.Decimal128Type,
-		*arrow.Time32Type, *arrow.Time64Type,
-		*arrow.TimestampType,
-		*arrow.Date32Type, *arrow.Date64Type,
-		*arrow.MonthIntervalType, *arrow.DayTimeIntervalType,
-		*arrow.DurationType:
-		return ctx.loadPrimitive(dt)
-
-	case *arrow.BinaryType, *arrow.StringType:
-		return ctx.loadBinary(dt)
-
 	case *arrow.FixedSizeBinaryType:
 		return ctx.loadFixedSizeBinary(dt)
 
 	case *arrow.ListType:
 		return ctx.loadList(dt)
 
+	case *arrow.MapType:
+		return ctx.loadMap(dt)
+
+	case *arrow.LargeListType:
+		return ctx.loadLargeList(dt)
+
 	case *arrow.FixedSizeListType:
 		return ctx.loadFixedSizeList(dt)
 
@@ -405,10 +667,52 @@ func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
 		return ctx.loadStruct(dt)
 
 	default:
+		switch {
+		case arrow.IsFixedWidth(dt.ID()):
+			return ctx.loadPrimitive(dt)
+		case arrow.IsBinaryLike(dt.ID()):
+			return ctx.loadBinary(dt)
+		}
 		panic(xerrors.Errorf("array type %T not handled yet", dt))
 	}
 }
 
+// loadLayout loads the supported and opaque fields of layout, appending
+// their arrays to cols, and skips the other fields.
+// The buffers of the unsupported field are the ones not consumed by the
+// supported fields.
+func (ctx *arrayLoaderContext) loadLayout(layout *sourceLayout, cols []array.Interface) ([]array.Interface, error) {
+	unknown := 0
+	for _, f := range layout.fields {
+		if f.dt == nil {
+			unknown++
+		}
+	}
+	if unknown > 1 {
+		return cols, xerrors.Errorf("arrow/ipc: could not locate the buffers of %d unsupported fields", unknown)
+	}
+	nbufs := ctx.src.meta.BuffersLength() - layout.known
+	if nbufs < 0 {
+		return cols, xerrors.Errorf("arrow/ipc: record batch has %d buffers, supported fields require %d", ctx.src.meta.BuffersLength(), layout.known)
+	}
+
+	for _, f := range layout.fields {
+		switch {
+		case f.dt != nil:
+			cols = append(cols, ctx.loadArray(f.dt))
+		case f.opaque:
+			if nbufs != 3 {
+				return cols, xerrors.Errorf("arrow/ipc: could not read field %q as opaque: got %d buffers, want 3: %w", f.name, nbufs, ErrUnsupportedType)
+			}
+			cols = append(cols, ctx.loadBinary(arrow.BinaryTypes.Binary))
+		default:
+			ctx.ifield += f.nodes
+			ctx.ibuffer += nbufs
+		}
+	}
+	return cols, nil
+}
+
 func (ctx *arrayLoaderContext) loadCommon(nbufs int) (*flatbuf.FieldNode, []*memory.Buffer) {
 	buffers := make([]*memory.Buffer, 0, nbufs)
 	field := ctx.field()
@@ -439,6 +743,7 @@ func (ctx *arrayLoaderContext) loadNull() array.Interface {
 	field := ctx.field()
 	data := array.NewData(arrow.Null, int(field.Length()), nil, nil, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.MakeFromData(data)
 }
@@ -456,6 +761,7 @@ func (ctx *arrayLoaderContext) loadPrimitive(dt arrow.DataType) array.Interface
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.MakeFromData(data)
 }
@@ -466,6 +772,7 @@ func (ctx *arrayLoaderContext) loadBinary(dt arrow.DataType) array.Interface {
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.MakeFromData(data)
 }
@@ -476,6 +783,7 @@ func (ctx *arrayLoaderContext) loadFixedSizeBinary(dt *arrow.FixedSizeBinaryType
 
 	data := array.NewData(dt, int(field.Length()), buffers, nil, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.MakeFromData(data)
 }
@@ -489,10 +797,39 @@ func (ctx *arrayLoaderContext) loadList(dt *arrow.ListType) array.Interface {
 
 	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.NewListData(data)
 }
 
+func (ctx *arrayLoaderContext) loadMap(dt *arrow.MapType) array.Interface {
+	field, buffers := ctx.loadCommon(2)
+	buffers = append(buffers, ctx.buffer())
+
+	sub := ctx.loadChild(dt.Elem())
+	defer sub.Release()
+
+	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
+	defer data.Release()
+	ctx.check(data)
+
+	return array.NewMapData(data)
+}
+
+func (ctx *arrayLoaderContext) loadLargeList(dt *arrow.LargeListType) array.Interface {
+	field, buffers := ctx.loadCommon(2)
+	buffers = append(buffers, ctx.buffer())
+
+	sub := ctx.loadChild(dt.Elem())
+	defer sub.Release()
+
+	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
+	defer data.Release()
+	ctx.check(data)
+
+	return array.NewLargeListData(data)
+}
+
 func (ctx *arrayLoaderContext) loadFixedSizeList(dt *arrow.FixedSizeListType) array.Interface {
 	field, buffers := ctx.loadCommon(1)
 
@@ -501,6 +838,7 @@ func (ctx *arrayLoaderContext) loadFixedSizeList(dt *arrow.FixedSizeListType) ar
 
 	data := array.NewData(dt, int(field.Length()), buffers, []*array.Data{sub.Data()}, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.NewFixedSizeListData(data)
 }
@@ -522,6 +860,7 @@ func (ctx *arrayLoaderContext) loadStruct(dt *arrow.StructType) array.Interface
 
 	data := array.NewData(dt, int(field.Length()), buffers, subs, int(field.NullCount()), 0)
 	defer data.Release()
+	ctx.check(data)
 
 	return array.NewStructData(data)
 }
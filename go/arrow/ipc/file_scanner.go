@@ -0,0 +1,286 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"golang.org/x/xerrors"
+)
+
+// Scanner iterates sequentially over the records of an Arrow file.
+//
+// While a record is being decoded or consumed, the Scanner reads the bytes
+// of the following record batches in the background, so that I/O and
+// decoding overlap.
+type Scanner struct {
+	refCount int64
+
+	f      *FileReader
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	filter  BatchFilter
+	beg     int // index of the first record batch of the range.
+	end     int // index past the last record batch of the range.
+	reverse bool
+
+	metrics MetricsHandler
+
+	blocks chan *prefetched
+	pool   sync.Pool
+	wg     sync.WaitGroup
+
+	rec  array.Record
+	err  error
+	done bool
+}
+
+// prefetched holds the bytes of a record batch read ahead of time.
+type prefetched struct {
+	i   int // record index
+	blk fileBlock
+	buf *[]byte
+	err error
+}
+
+// Scan returns a Scanner over the records of the file.
+//
+// The number of record batches read ahead may be configured with WithPrefetch.
+// Record batches rejected by the filter given to WithBatchFilter, either
+// when opening the file or to Scan, are skipped without being read.
+// With WithReverse(true), record batches are yielded from last to first.
+// Scanning stops when ctx is cancelled. The file reader must not be closed
+// before the Scanner is released.
+func (f *FileReader) Scan(ctx context.Context, opts ...Option) *Scanner {
+	return f.scan(ctx, 0, f.NumRecords(), opts...)
+}
+
+// RecordRange returns a Scanner over the records of the file with indices in
+// [from, to). It accepts the same options as Scan.
+//
+// Using the block index of the footer, only the record batches of the range
+// are read from the underlying reader.
+// RecordRange panics if the range is out of bounds.
+func (f *FileReader) RecordRange(from, to int, opts ...Option) *Scanner {
+	if from < 0 || from > to || to > f.NumRecords() {
+		panic("arrow/ipc: record range out of bounds")
+	}
+	return f.scan(context.Background(), from, to, opts...)
+}
+
+func (f *FileReader) scan(ctx context.Context, beg, end int, opts ...Option) *Scanner {
+	cfg := newConfig(opts...)
+	if cfg.prefetch < 1 {
+		cfg.prefetch = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Scanner{
+		refCount: 1,
+		f:        f,
+		ctx:      ctx,
+		cancel:   cancel,
+		filter:   f.filter,
+		beg:      beg,
+		end:      end,
+		reverse:  cfg.reverse,
+		metrics:  f.metrics,
+		// the prefetching goroutine holds one more batch while blocked on send.
+		blocks: make(chan *prefetched, cfg.prefetch-1),
+	}
+	if cfg.filter != nil {
+		s.filter = cfg.filter
+	}
+	if cfg.metrics != nil {
+		s.metrics = cfg.metrics
+	}
+
+	s.wg.Add(1)
+	go s.prefetch()
+
+	return s
+}
+
+func (s *Scanner) prefetch() {
+	defer s.wg.Done()
+	defer close(s.blocks)
+
+	for k := 0; k < s.end-s.beg; k++ {
+		i := s.beg + k
+		if s.reverse {
+			i = s.end - 1 - k
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+		if s.f.skip(i, s.filter) {
+			continue
+		}
+
+		p := s.fetch(i)
+		select {
+		case s.blocks <- p:
+		case <-s.ctx.Done():
+			s.put(p.buf)
+			return
+		}
+
+		if p.err != nil {
+			return
+		}
+	}
+}
+
+// fetch reads the metadata and body bytes of the i-th record batch.
+func (s *Scanner) fetch(i int) *prefetched {
+	blk, err := s.f.recordBlock(i)
+	if err != nil {
+		return &prefetched{i: i, err: err}
+	}
+
+	buf := s.get(int(int64(blk.Meta) + blk.Body))
+	n, err := blk.r.ReadAt(*buf, blk.Offset)
+	if err != nil && n < len(*buf) {
+		s.put(buf)
+		return &prefetched{i: i, err: xerrors.Errorf("arrow/ipc: could not read record %d: %w", i, err)}
+	}
+
+	return &prefetched{i: i, blk: blk, buf: buf}
+}
+
+func (s *Scanner) get(n int) *[]byte {
+	if v := s.pool.Get(); v != nil {
+		buf := v.(*[]byte)
+		if cap(*buf) >= n {
+			*buf = (*buf)[:n]
+			return buf
+		}
+	}
+	buf := make([]byte, n)
+	return &buf
+}
+
+func (s *Scanner) put(buf *[]byte) {
+	if buf != nil {
+		s.pool.Put(buf)
+	}
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (s *Scanner) Retain() {
+	atomic.AddInt64(&s.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the background reads are
+// cancelled and the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (s *Scanner) Release() {
+	debug.Assert(atomic.LoadInt64(&s.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&s.refCount, -1) == 0 {
+		s.stop()
+		if s.rec != nil {
+			s.rec.Release()
+			s.rec = nil
+		}
+	}
+}
+
+// stop cancels the background reads and waits for them to complete.
+func (s *Scanner) stop() {
+	s.cancel()
+	for p := range s.blocks {
+		s.put(p.buf)
+	}
+	s.wg.Wait()
+}
+
+// Schema returns the schema of the underlying file.
+func (s *Scanner) Schema() *arrow.Schema { return s.f.Schema() }
+
+// Err returns the last error encountered during the iteration.
+func (s *Scanner) Err() error { return s.err }
+
+// Record returns the current record.
+// It is valid until the next call to Next.
+func (s *Scanner) Record() array.Record { return s.rec }
+
+// Next returns whether a record could be extracted from the file.
+func (s *Scanner) Next() bool {
+	if s.rec != nil {
+		s.rec.Release()
+		s.rec = nil
+	}
+
+	if s.err != nil || s.done {
+		return false
+	}
+
+	var (
+		p  *prefetched
+		ok bool
+	)
+	select {
+	case p, ok = <-s.blocks:
+	case <-s.ctx.Done():
+	}
+
+	switch {
+	case p == nil && s.ctx.Err() != nil:
+		s.err = s.ctx.Err()
+		s.done = true
+		s.stop()
+		return false
+	case !ok:
+		s.done = true
+		return false
+	case p.err != nil:
+		s.err = p.err
+		s.done = true
+		return false
+	}
+	defer s.put(p.buf)
+
+	start := startTimer(s.metrics)
+	msg := p.blk.messageFromBytes(*p.buf)
+	defer msg.Release()
+
+	// the buffers of the record are copied from the body of msg, so the
+	// pooled bytes may be reused once the record is loaded.
+	rec, err := s.f.loadRecord(p.i, msg)
+	if err != nil {
+		s.err = err
+		s.done = true
+		return false
+	}
+
+	messageRead(s.metrics, msg, start)
+	s.rec = rec
+	return true
+}
+
+var (
+	_ array.RecordReader = (*Scanner)(nil)
+)
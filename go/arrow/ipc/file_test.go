@@ -17,11 +17,21 @@
 package ipc_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -48,3 +58,714 @@ func TestFile(t *testing.T) {
 		})
 	}
 }
+
+func TestFileLateSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	f, err := ioutil.TempFile("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	recs := arrdata.Records["structs"]
+
+	w, err := ipc.NewFileWriter(f, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Schema() != nil {
+		t.Fatalf("unexpected schema before first write: %v", w.Schema())
+	}
+
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %v", err)
+		}
+	}
+	if !w.Schema().Equal(recs[0].Schema()) {
+		t.Fatalf("invalid bound schema:\ngot= %v\nwant=%v", w.Schema(), recs[0].Schema())
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	arrdata.CheckArrowFile(t, f, mem, recs[0].Schema(), recs)
+}
+
+func TestFileCloseWithoutSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	f, err := ioutil.TempFile("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	w, err := ipc.NewFileWriter(f, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err == nil {
+		t.Fatalf("expected an error closing a writer without a schema")
+	}
+}
+
+func TestFileReaderDoubleClose(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	raw := writeFileBytes(t, mem, recs)
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Record(0); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := r.Close(); err != nil {
+			t.Fatalf("could not close file reader (#%d): %v", i, err)
+		}
+	}
+
+	if got := r.NumRecords(); got != 0 {
+		t.Fatalf("invalid number of records after close: got=%d, want=0", got)
+	}
+	if _, err := r.Record(0); err == nil {
+		t.Fatalf("expected an error reading a record from a closed file")
+	}
+}
+
+func TestReaderReleaseOnError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	o := new(bytes.Buffer)
+	w := ipc.NewWriter(o, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// truncate the stream in the middle of the second record batch.
+	raw := o.Bytes()
+	r, err := ipc.NewReader(bytes.NewReader(raw[:len(raw)*2/3]), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	for r.Next() {
+	}
+	if r.Err() == nil {
+		t.Fatalf("expected an error reading a truncated stream")
+	}
+}
+
+func TestFileReaderAt(t *testing.T) {
+	var (
+		prefix = bytes.Repeat([]byte("garbage-prefix"), 3)
+		suffix = []byte("garbage-suffix")
+	)
+
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile("", "go-arrow-file-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			if _, err := f.Write(prefix); err != nil {
+				t.Fatal(err)
+			}
+
+			w, err := ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, rec := range recs {
+				if err := w.Write(rec); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			end, err := f.Seek(0, io.SeekCurrent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Write(suffix); err != nil {
+				t.Fatal(err)
+			}
+
+			offset := int64(len(prefix))
+			r, err := ipc.NewFileReaderAt(f, offset, end-offset, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			if got, want := r.NumRecords(), len(recs); got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+			for i := range recs {
+				rec, err := r.Record(i)
+				if err != nil {
+					t.Fatalf("could not read record %d: %v", i, err)
+				}
+				if !array.RecordEqual(rec, recs[i]) {
+					t.Fatalf("records[%d] differ", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFileScan(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		for _, prefetch := range []int{0, 1, 3} {
+			t.Run(fmt.Sprintf("%s/prefetch=%d", name, prefetch), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				raw := writeFileBytes(t, mem, recs)
+				r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Close()
+
+				s := r.Scan(context.Background(), ipc.WithPrefetch(prefetch))
+				defer s.Release()
+
+				n := 0
+				for s.Next() {
+					if !array.RecordEqual(s.Record(), recs[n]) {
+						t.Fatalf("records[%d] differ", n)
+					}
+					n++
+				}
+				if err := s.Err(); err != nil {
+					t.Fatal(err)
+				}
+				if got, want := n, len(recs); got != want {
+					t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestFileScanCancel(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	raw := writeFileBytes(t, mem, recs)
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := r.Scan(ctx, ipc.WithPrefetch(2))
+	defer s.Release()
+
+	if !s.Next() {
+		t.Fatalf("could not read first record: %v", s.Err())
+	}
+	cancel()
+
+	for s.Next() {
+	}
+	if got, want := s.Err(), context.Canceled; got != want {
+		t.Fatalf("invalid error: got=%v, want=%v", got, want)
+	}
+}
+
+func TestFileReadReverse(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			raw := writeFileBytes(t, mem, recs)
+			rr := &recordingReaderAt{r: bytes.NewReader(raw)}
+			r, err := ipc.NewFileReaderAt(rr, 0, int64(len(raw)), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			blocks := recordBlocks(t, r, rr)
+
+			for i := len(recs) - 1; i >= 0; i-- {
+				rr.reads = nil
+				rec, err := r.ReadReverse()
+				if err != nil {
+					t.Fatalf("could not read record %d: %v", i, err)
+				}
+				if !array.RecordEqual(rec, recs[i]) {
+					t.Fatalf("records[%d] differ", i)
+				}
+				rr.checkWithin(t, blocks[i:i+1])
+			}
+
+			rr.reads = nil
+			if _, err := r.ReadReverse(); err != io.EOF {
+				t.Fatalf("invalid error: got=%v, want=%v", err, io.EOF)
+			}
+			if len(rr.reads) != 0 {
+				t.Fatalf("unexpected reads past the first record: %v", rr.reads)
+			}
+		})
+	}
+}
+
+func TestFileRecordRange(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		for _, reverse := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/reverse=%v", name, reverse), func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				raw := writeFileBytes(t, mem, recs)
+				rr := &recordingReaderAt{r: bytes.NewReader(raw)}
+				r, err := ipc.NewFileReaderAt(rr, 0, int64(len(raw)), ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Close()
+				blocks := recordBlocks(t, r, rr)
+
+				for from := 0; from <= len(recs); from++ {
+					for to := from; to <= len(recs); to++ {
+						want := make([]int, 0, to-from)
+						for i := from; i < to; i++ {
+							want = append(want, i)
+						}
+						if reverse {
+							for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+								want[i], want[j] = want[j], want[i]
+							}
+						}
+
+						rr.reads = nil
+						s := r.RecordRange(from, to, ipc.WithReverse(reverse))
+						n := 0
+						for s.Next() {
+							if n == len(want) {
+								t.Fatalf("range [%d, %d): too many records", from, to)
+							}
+							if !array.RecordEqual(s.Record(), recs[want[n]]) {
+								t.Fatalf("range [%d, %d): records[%d] differ", from, to, want[n])
+							}
+							n++
+						}
+						err := s.Err()
+						s.Release()
+						if err != nil {
+							t.Fatal(err)
+						}
+						if got, want := n, len(want); got != want {
+							t.Fatalf("range [%d, %d): invalid number of records: got=%d, want=%d", from, to, got, want)
+						}
+						rr.checkWithin(t, blocks[from:to])
+					}
+				}
+			})
+		}
+	}
+}
+
+// recordBlocks returns the byte range read from rr for each record of r.
+func TestFileWriteTable(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true}}, nil)
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+
+	var (
+		recs []array.Record
+		want []int64
+	)
+	for _, n := range []int{1, 1, 1, 10, 2, 2} {
+		for i := 0; i < n; i++ {
+			bld.Append(int64(len(want)))
+			want = append(want, int64(len(want)))
+		}
+		col := bld.NewArray()
+		recs = append(recs, array.NewRecord(schema, []array.Interface{col}, int64(n)))
+		col.Release()
+	}
+	tbl := array.NewTableFromRecords(schema, recs)
+	defer tbl.Release()
+	for _, rec := range recs {
+		rec.Release()
+	}
+
+	f, err := ioutil.TempFile("", "go-arrow-table-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	w, err := ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteTable(tbl, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, err := ipc.ReadFile(f.Name(), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		for _, rec := range got {
+			rec.Release()
+		}
+	}()
+
+	var (
+		rows   []int64
+		values []int64
+	)
+	for _, rec := range got {
+		rows = append(rows, rec.NumRows())
+		values = append(values, rec.Column(0).(*array.Int64).Int64Values()...)
+	}
+	if want := []int64{3, 4, 4, 4, 2}; !reflect.DeepEqual(rows, want) {
+		t.Fatalf("invalid records: got=%v, want=%v", rows, want)
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("invalid values:\ngot= %v\nwant=%v", values, want)
+	}
+}
+
+func recordBlocks(t *testing.T, r *ipc.FileReader, rr *recordingReaderAt) [][2]int64 {
+	t.Helper()
+
+	blocks := make([][2]int64, r.NumRecords())
+	for i := range blocks {
+		rr.reads = nil
+		if _, err := r.Record(i); err != nil {
+			t.Fatalf("could not read record %d: %v", i, err)
+		}
+		if len(rr.reads) == 0 {
+			t.Fatalf("no read for record %d", i)
+		}
+		blocks[i] = rr.reads[0]
+		for _, rng := range rr.reads[1:] {
+			if rng[0] < blocks[i][0] {
+				blocks[i][0] = rng[0]
+			}
+			if rng[1] > blocks[i][1] {
+				blocks[i][1] = rng[1]
+			}
+		}
+	}
+	return blocks
+}
+
+// recordingReaderAt records the byte ranges read from r.
+type recordingReaderAt struct {
+	r     io.ReaderAt
+	reads [][2]int64
+}
+
+func (r *recordingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	r.reads = append(r.reads, [2]int64{off, off + int64(len(p))})
+	return r.r.ReadAt(p, off)
+}
+
+// checkWithin checks that all the recorded reads fall within blocks.
+func (r *recordingReaderAt) checkWithin(t *testing.T, blocks [][2]int64) {
+	t.Helper()
+
+loop:
+	for _, rng := range r.reads {
+		for _, blk := range blocks {
+			if blk[0] <= rng[0] && rng[1] <= blk[1] {
+				continue loop
+			}
+		}
+		t.Fatalf("read of [%d, %d) outside of the requested blocks %v", rng[0], rng[1], blocks)
+	}
+}
+
+func writeFileBytes(t testing.TB, mem memory.Allocator, recs []array.Record, opts ...ipc.Option) []byte {
+	dir, err := ioutil.TempDir("", "go-arrow-file-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fname := filepath.Join(dir, "recs.arrow")
+	opts = append([]ipc.Option{ipc.WithAllocator(mem)}, opts...)
+	if err := ipc.WriteFile(fname, recs, opts...); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := ioutil.ReadFile(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+// slowReaderAt simulates a storage with a fixed latency per read.
+type slowReaderAt struct {
+	r       io.ReaderAt
+	latency time.Duration
+}
+
+func (r slowReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	time.Sleep(r.latency)
+	return r.r.ReadAt(p, off)
+}
+
+// BenchmarkFileScan compares sequential reads of records with Record and
+// with a prefetching Scanner, over a storage with 1ms of latency per read
+// and a consumer spending 1ms per record.
+// With prefetching, the time per record should get close to max(IO, CPU)
+// rather than IO+CPU.
+func BenchmarkFileScan(b *testing.B) {
+	const (
+		latency = time.Millisecond
+		work    = time.Millisecond
+	)
+
+	mem := memory.NewGoAllocator()
+	var recs []array.Record
+	for i := 0; i < 16; i++ {
+		recs = append(recs, arrdata.Records["primitives"]...)
+	}
+	raw := writeFileBytes(b, mem, recs)
+
+	open := func(b *testing.B) *ipc.FileReader {
+		r, err := ipc.NewFileReaderAt(slowReaderAt{bytes.NewReader(raw), latency}, 0, int64(len(raw)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		return r
+	}
+
+	b.Run("Record", func(b *testing.B) {
+		r := open(b)
+		defer r.Close()
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < r.NumRecords(); j++ {
+				if _, err := r.Record(j); err != nil {
+					b.Fatal(err)
+				}
+				time.Sleep(work)
+			}
+		}
+	})
+
+	for _, prefetch := range []int{1, 4} {
+		b.Run(fmt.Sprintf("Scan-prefetch=%d", prefetch), func(b *testing.B) {
+			r := open(b)
+			defer r.Close()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s := r.Scan(context.Background(), ipc.WithPrefetch(prefetch))
+				for s.Next() {
+					time.Sleep(work)
+				}
+				if err := s.Err(); err != nil {
+					b.Fatal(err)
+				}
+				s.Release()
+			}
+		})
+	}
+}
+
+func TestFileListElemField(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// the elements of the "ints" lists are not nullable.
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "ints", Type: arrow.ListOfField(arrow.Field{Name: "item", Type: arrow.PrimitiveTypes.Int32})},
+		{Name: "strs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	}, nil)
+
+	f, err := os.Open("testdata/list-not-null-items.arrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	r, err := ipc.NewFileReader(f, ipc.WithSchema(want), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	rec, err := r.Record(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprintf("%v", rec.Column(0)), "[[1 2 3] [] [4]]"; got != want {
+		t.Fatalf("invalid column: got=%s, want=%s", got, want)
+	}
+
+	// the element fields are written back as is.
+	raw := writeFileBytes(t, mem, []array.Record{rec}, ipc.WithSchema(want))
+	rr, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithSchema(want), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rr.Close()
+
+	got, err := rr.Record(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !array.RecordEqual(got, rec) {
+		t.Fatalf("invalid round trip:\ngot= %v\nwant=%v", got, rec)
+	}
+}
+
+func TestDuplicateFieldNames(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	inner := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		arrow.Field{Name: "a", Type: arrow.BinaryTypes.String, Nullable: true},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "x", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "y", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "x", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "s", Type: inner, Nullable: true},
+		{Name: "x", Type: arrow.ListOf(inner), Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	b.Field(1).(*array.BooleanBuilder).AppendValues([]bool{true, false}, []bool{true, false})
+	b.Field(2).(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+	appendInner := func(sb *array.StructBuilder, i int32, s string) {
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.Int32Builder).Append(i)
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(s)
+	}
+	sb := b.Field(3).(*array.StructBuilder)
+	appendInner(sb, 1, "one")
+	appendInner(sb, 2, "two")
+	lb := b.Field(4).(*array.ListBuilder)
+	lb.Append(true)
+	appendInner(lb.ValueBuilder().(*array.StructBuilder), 3, "three")
+	appendInner(lb.ValueBuilder().(*array.StructBuilder), 4, "four")
+	lb.AppendNull()
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	check := func(t *testing.T, got *arrow.Schema, recs []array.Record) {
+		t.Helper()
+		if !got.Equal(schema) {
+			t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, schema)
+		}
+		if got, want := got.FieldIndices("x"), []int{0, 2, 4}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid indices: got=%v, want=%v", got, want)
+		}
+		for _, i := range []int{3, 4} {
+			dt := got.Field(i).Type
+			if i == 4 {
+				dt = dt.(*arrow.ListType).Elem()
+			}
+			if got, want := dt.(*arrow.StructType).FieldIndices("a"), []int{0, 1}; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid indices of field %d: got=%v, want=%v", i, got, want)
+			}
+		}
+		if len(recs) != 1 {
+			t.Fatalf("invalid number of records: got=%d, want=1", len(recs))
+		}
+		if !array.RecordEqual(recs[0], rec) {
+			t.Fatalf("invalid record:\ngot= %v\nwant=%v", recs[0], rec)
+		}
+	}
+
+	t.Run("file", func(t *testing.T) {
+		raw := writeFileBytes(t, mem, []array.Record{rec})
+		r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		got, err := r.Record(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, r.Schema(), []array.Record{got})
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := ipc.NewReader(buf, ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+
+		var recs []array.Record
+		for r.Next() {
+			rec := r.Record()
+			rec.Retain()
+			defer rec.Release()
+			recs = append(recs, rec)
+		}
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+		check(t, r.Schema(), recs)
+	})
+}
@@ -35,12 +35,15 @@ type payloadWriter interface {
 }
 
 type pwriter struct {
-	w   io.WriteSeeker
-	pos int64
+	w    io.WriteSeeker
+	pos  int64 // current position, relative to base
+	base int64 // position of the start of the Arrow file in w
 
 	schema *arrow.Schema
 	dicts  []fileBlock
 	recs   []fileBlock
+
+	scratch [4]byte // staging space for message prefixes
 }
 
 func (w *pwriter) start() error {
@@ -67,7 +70,7 @@ func (w *pwriter) start() error {
 
 func (w *pwriter) write(p payload) error {
 	blk := fileBlock{Offset: w.pos, Meta: 0, Body: p.size}
-	n, err := writeIPCPayload(w, p)
+	n, err := writeIPCPayload(w, p, w.scratch[:])
 	if err != nil {
 		return err
 	}
@@ -133,6 +136,7 @@ func (w *pwriter) Close() error {
 func (w *pwriter) updatePos() error {
 	var err error
 	w.pos, err = w.w.Seek(0, io.SeekCurrent)
+	w.pos -= w.base
 	return err
 }
 
@@ -152,8 +156,8 @@ func (w *pwriter) Write(p []byte) (int, error) {
 	return n, err
 }
 
-func writeIPCPayload(w io.Writer, p payload) (int, error) {
-	n, err := writeMessage(p.meta, kArrowIPCAlignment, w)
+func writeIPCPayload(w io.Writer, p payload, scratch []byte) (int, error) {
+	n, err := writeMessage(p.meta, kArrowIPCAlignment, w, scratch)
 	if err != nil {
 		return n, err
 	}
@@ -233,36 +237,77 @@ type FileWriter struct {
 		written bool
 	}
 
-	pw payloadWriter
+	pw  payloadWriter
+	enc *recordEncoder // reused across calls to Write
 
-	schema *arrow.Schema
+	schema   *arrow.Schema
+	prepared *PreparedSchema
+
+	policy EncodingPolicy
+	enf    *enforcer
+
+	maxDepth int // maximum nesting depth of the schema
+	metrics  MetricsHandler
+
+	collect bool         // whether to collect batch statistics
+	arrMeta bool         // whether the schema holds the metadata of the first record
+	stats   []BatchStats // statistics of the record batches written so far
+	crcs    []uint32     // checksums of the record batches written so far, if any
 }
 
 // NewFileWriter opens an Arrow file using the provided writer w.
+// The Arrow file starts at the current position of w: offsets recorded in
+// the file footer are relative to that position.
+//
+// If no schema is provided via WithSchema, the writer binds to the schema of
+// the first record written to it.
 func NewFileWriter(w io.WriteSeeker, opts ...Option) (*FileWriter, error) {
-	var (
-		cfg = newConfig(opts...)
-		err error
-	)
-
-	f := FileWriter{
-		w:      w,
-		pw:     &pwriter{w: w, schema: cfg.schema, pos: -1},
-		mem:    cfg.alloc,
-		schema: cfg.schema,
-	}
+	cfg := newConfig(opts...)
 
-	pos, err := f.w.Seek(0, io.SeekCurrent)
+	pos, err := w.Seek(0, io.SeekCurrent)
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not seek current position: %w", err)
 	}
+
+	f := FileWriter{
+		w:       w,
+		pw:      &pwriter{w: w, schema: cfg.schema, pos: -1, base: pos},
+		enc:     newWriterEncoder(cfg),
+		mem:     cfg.alloc,
+		schema:  cfg.schema,
+		policy:  cfg.encoding,
+		collect: cfg.stats,
+		arrMeta: cfg.arrMeta,
+
+		maxDepth: cfg.maxDepth,
+		metrics:  cfg.metrics,
+		prepared: cfg.prepared,
+	}
 	f.header.offset = pos
+	if cfg.checksum {
+		f.enc.checksum = footerChecksum
+		f.crcs = []uint32{}
+	}
 
-	return &f, err
+	return &f, nil
 }
 
+// Schema returns the schema of the records written to the file.
+// Schema returns nil if the writer was created without a schema and no
+// record has been written yet.
+func (f *FileWriter) Schema() *arrow.Schema { return f.schema }
+
 func (f *FileWriter) Close() error {
-	err := f.checkStarted()
+	// no record is encoded once the writer is closed: the scratch space of
+	// the encoder is released first, whether or not the file can be
+	// completed.
+	f.enc.Release()
+
+	if f.schema == nil {
+		return errNoSchema
+	}
+
+	err := f.checkStarted(nil)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not write empty file: %w", err)
 	}
@@ -271,6 +316,17 @@ func (f *FileWriter) Close() error {
 		return nil
 	}
 
+	if f.collect {
+		schema, err := withBatchStats(f.pw.(*pwriter).schema, f.stats)
+		if err != nil {
+			return err
+		}
+		f.pw.(*pwriter).schema = schema
+	}
+	if f.crcs != nil {
+		f.pw.(*pwriter).schema = withChecksums(f.pw.(*pwriter).schema, f.crcs)
+	}
+
 	err = f.pw.Close()
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not close payload writer: %w", err)
@@ -281,45 +337,181 @@ func (f *FileWriter) Close() error {
 }
 
 func (f *FileWriter) Write(rec array.Record) error {
+	if err := checkRetained(rec); err != nil {
+		return err
+	}
+	start := startTimer(f.metrics)
 	schema := rec.Schema()
-	if schema == nil || !schema.Equal(f.schema) {
+	if f.schema == nil {
+		f.schema = schema
+		f.pw.(*pwriter).schema = schema
+	}
+
+	if !conforms(schema, f.schema, f.policy) {
 		return errInconsistentSchema
 	}
 
-	if err := f.checkStarted(); err != nil {
+	if err := f.checkStarted(rec); err != nil {
 		return xerrors.Errorf("arrow/ipc: could not write header: %w", err)
 	}
 
-	const allow64b = true
+	if f.enf != nil {
+		var err error
+		rec, err = f.enf.apply(rec)
+		if err != nil {
+			return err
+		}
+		defer rec.Release()
+	}
+
+	data := f.enc.payload()
+	defer f.enc.release(&data)
+
+	if err := f.enc.Encode(&data, rec); err != nil {
+		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
+	}
+
+	if err := f.pw.write(data); err != nil {
+		return err
+	}
+	recordWritten(f.metrics, rec.NumRows(), data.size, start)
+
+	if f.collect {
+		f.stats = append(f.stats, newBatchStats(rec))
+	}
+	if f.crcs != nil {
+		f.crcs = append(f.crcs, f.enc.crc)
+	}
+	return nil
+}
+
+// WriteTable writes the rows of tbl as record batches of at most chunkSize
+// rows, aligned on the chunk boundaries of the columns of tbl.
+// Adjacent chunks of less than chunkSize rows are merged, as with
+// array.RechunkTable, while they are written: only the merged chunks are
+// copied. If chunkSize is <= 0, chunks are written as is.
+func (f *FileWriter) WriteTable(tbl array.Table, chunkSize int64) error {
+	tr := array.NewTableReader(tbl, chunkSize)
+	defer tr.Release()
+
 	var (
-		data = payload{msg: MessageRecordBatch}
-		enc  = newRecordEncoder(f.mem, 0, kMaxNestingDepth, allow64b)
+		pending []array.Record
+		rows    int64
 	)
-	defer data.Release()
+	defer func() { releaseRecords(pending) }()
 
-	if err := enc.Encode(&data, rec); err != nil {
-		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		rec, err := concatRecords(f.mem, pending)
+		if err != nil {
+			return err
+		}
+		defer rec.Release()
+		releaseRecords(pending)
+		pending, rows = pending[:0], 0
+		return f.Write(rec)
 	}
 
-	return f.pw.write(data)
+	for tr.Next() {
+		rec := tr.Record()
+		if chunkSize <= 0 || rec.NumRows() >= chunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+			if err := f.Write(rec); err != nil {
+				return err
+			}
+			continue
+		}
+		if rows+rec.NumRows() > chunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		rec.Retain()
+		pending = append(pending, rec)
+		rows += rec.NumRows()
+	}
+	return flush()
 }
 
-func (f *FileWriter) checkStarted() error {
+// concatRecords returns a record holding the rows of recs, which must have
+// the same schema. A single record is returned as is, retained.
+func concatRecords(mem memory.Allocator, recs []array.Record) (array.Record, error) {
+	if len(recs) == 1 {
+		recs[0].Retain()
+		return recs[0], nil
+	}
+
+	var (
+		schema = recs[0].Schema()
+		cols   = make([]array.Interface, 0, len(schema.Fields()))
+		rows   int64
+	)
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	for _, rec := range recs {
+		rows += rec.NumRows()
+	}
+	for i := range schema.Fields() {
+		arrs := make([]array.Interface, len(recs))
+		for j, rec := range recs {
+			arrs[j] = rec.Column(i)
+		}
+		col, err := array.Concatenate(mem, arrs)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: could not merge field %q: %w", schema.Field(i).Name, err)
+		}
+		cols = append(cols, col)
+	}
+	return array.NewRecord(schema, cols, rows), nil
+}
+
+// checkStarted writes the header of the file, if not done yet.
+// rec is the first record written, if any.
+func (f *FileWriter) checkStarted(rec array.Record) error {
 	if !f.header.started {
-		return f.start()
+		return f.start(rec)
 	}
 	return nil
 }
 
-func (f *FileWriter) start() error {
+func (f *FileWriter) start(rec array.Record) error {
+	if err := checkNesting(f.schema, f.maxDepth); err != nil {
+		return xerrors.Errorf("arrow/ipc: invalid schema: %w", err)
+	}
+
+	enf, err := newEnforcer(f.mem, f.schema, f.policy)
+	if err != nil {
+		return err
+	}
+	f.enf = enf
+
+	schema := f.schema
+	if enf != nil {
+		schema = enf.schema
+	}
+	if f.arrMeta {
+		schema = withArrayMetadata(schema, rec)
+	}
+	if schema != f.schema {
+		f.pw.(*pwriter).schema = schema
+	}
+
 	f.header.started = true
-	err := f.pw.start()
+	err = f.pw.start()
 	if err != nil {
 		return err
 	}
 
 	// write out schema payloads
-	ps := payloadsFromSchema(f.schema, f.mem, nil)
+	ps := schemaPayloads(schema, f.mem, f.prepared)
 	defer ps.Release()
 
 	for _, data := range ps {
@@ -17,7 +17,6 @@
 package ipc
 
 import (
-	"bytes"
 	"io"
 	"sync/atomic"
 
@@ -40,6 +39,7 @@ type FlightDataStreamReader interface {
 type FlightDataReader struct {
 	r      FlightDataStreamReader
 	schema *arrow.Schema
+	layout *sourceLayout // layout of the fields, if some are unsupported
 
 	refCount int64
 	rec      array.Record
@@ -50,6 +50,11 @@ type FlightDataReader struct {
 
 	mem memory.Allocator
 
+	maxDepth int  // maximum nesting depth of the schema
+	validate bool // whether to check the layout of record batch buffers
+
+	metrics MetricsHandler
+
 	done bool
 }
 
@@ -61,10 +66,14 @@ func NewFlightDataReader(r FlightDataStreamReader, opts ...Option) (*FlightDataR
 	cfg := newConfig(opts...)
 
 	rr := &FlightDataReader{
-		r:   r,
-		mem: cfg.alloc,
+		r:        r,
+		mem:      cfg.alloc,
+		maxDepth: cfg.maxDepth,
+		validate: cfg.validate,
+		metrics:  cfg.metrics,
 	}
 
+	start := startTimer(rr.metrics)
 	msg, err := rr.nextMessage()
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not read message schema: %w", err)
@@ -78,6 +87,10 @@ func NewFlightDataReader(r FlightDataStreamReader, opts ...Option) (*FlightDataR
 	var schemaFB flatbuf.Schema
 	initFB(&schemaFB, msg.msg.Header)
 
+	if err := checkNestingFB(&schemaFB, rr.maxDepth); err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: invalid message schema: %w", err)
+	}
+
 	rr.types, err = dictTypesFromFB(&schemaFB)
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not read dictionary types from message schema: %w", err)
@@ -88,7 +101,7 @@ func NewFlightDataReader(r FlightDataStreamReader, opts ...Option) (*FlightDataR
 		panic("not implemented") // ReadNextDictionary
 	}
 
-	rr.schema, err = schemaFromFB(&schemaFB, &rr.memo)
+	rr.schema, rr.layout, err = schemaFromFBWith(&schemaFB, &rr.memo, cfg.unsupported)
 	if err != nil {
 		return nil, xerrors.Errorf("arrow/ipc: could not decode schema from message schema: %w", err)
 	}
@@ -96,7 +109,11 @@ func NewFlightDataReader(r FlightDataStreamReader, opts ...Option) (*FlightDataR
 	if cfg.schema != nil && !cfg.schema.Equal(rr.schema) {
 		return nil, errInconsistentSchema
 	}
+	if err := cfg.ensureSchema(rr.schema); err != nil {
+		return nil, err
+	}
 
+	messageRead(rr.metrics, msg, start)
 	return rr, nil
 }
 
@@ -110,7 +127,10 @@ func (f *FlightDataReader) nextMessage() (*Message, error) {
 }
 
 func (f *FlightDataReader) next() bool {
-	var msg *Message
+	var (
+		msg   *Message
+		start = startTimer(f.metrics)
+	)
 	msg, f.err = f.nextMessage()
 	if f.err != nil {
 		f.done = true
@@ -125,7 +145,11 @@ func (f *FlightDataReader) next() bool {
 		return false
 	}
 
-	f.rec = newRecord(f.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
+	f.rec, f.err = newRecord(f.schema, f.layout, msg.meta, msg.body, f.maxDepth, f.validate)
+	if f.err != nil {
+		return false
+	}
+	messageRead(f.metrics, msg, start)
 	return true
 }
 
@@ -200,6 +224,9 @@ func (f *FlightDataReader) Schema() *arrow.Schema { return f.schema }
 
 func SchemaFromFlightInfo(b []byte) (*arrow.Schema, error) {
 	fb := flatbuf.GetRootAsSchema(b, 0)
+	if err := checkNestingFB(fb, kMaxNestingDepth); err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: invalid schema: %w", err)
+	}
 	dict := newMemo()
 	return schemaFromFB(fb, &dict)
 }
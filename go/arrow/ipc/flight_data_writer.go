@@ -40,25 +40,29 @@ type FlightDataWriter struct {
 	fd  flight.FlightData
 	buf bytes.Buffer
 
-	mem     memory.Allocator
-	started bool
-	schema  *arrow.Schema
+	mem      memory.Allocator
+	metrics  MetricsHandler
+	started  bool
+	schema   *arrow.Schema
+	prepared *PreparedSchema
 }
 
 // NewFlightDataWriter returns a writer for writing array Records to a flight data stream.
 func NewFlightDataWriter(w FlightDataStreamWriter, opts ...Option) *FlightDataWriter {
 	cfg := newConfig(opts...)
 	return &FlightDataWriter{
-		w:      w,
-		mem:    cfg.alloc,
-		schema: cfg.schema,
+		w:        w,
+		mem:      cfg.alloc,
+		metrics:  cfg.metrics,
+		schema:   cfg.schema,
+		prepared: cfg.prepared,
 	}
 }
 
 func (w *FlightDataWriter) start() error {
 	w.started = true
 
-	ps := payloadsFromSchema(w.schema, w.mem, nil)
+	ps := schemaPayloads(w.schema, w.mem, w.prepared)
 	defer ps.Release()
 
 	for i := range ps {
@@ -80,6 +84,10 @@ func (w *FlightDataWriter) Close() (err error) {
 
 // Write the provided record to the underlying stream
 func (w *FlightDataWriter) Write(rec array.Record) error {
+	if err := checkRetained(rec); err != nil {
+		return err
+	}
+	start := startTimer(w.metrics)
 	if !w.started {
 		err := w.start()
 		if err != nil {
@@ -103,7 +111,11 @@ func (w *FlightDataWriter) Write(rec array.Record) error {
 		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
 	}
 
-	return w.writePayload(&data)
+	if err := w.writePayload(&data); err != nil {
+		return err
+	}
+	recordWritten(w.metrics, rec.NumRows(), data.size, start)
+	return nil
 }
 
 func (w *FlightDataWriter) writePayload(data *payload) (err error) {
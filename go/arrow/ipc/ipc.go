@@ -22,6 +22,7 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/arrio"
 	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
 )
 
 const (
@@ -30,6 +31,8 @@ const (
 	errInconsistentSchema       = errString("arrow/ipc: tried to write record batch with different schema")
 	errMaxRecursion             = errString("arrow/ipc: max recursion depth reached")
 	errBigArray                 = errString("arrow/ipc: array larger than 2^31-1 in length")
+	errNoSchema                 = errString("arrow/ipc: no schema bound to writer (use WithSchema or write a record first)")
+	errClosedFile               = errString("arrow/ipc: file reader is closed")
 
 	kArrowAlignment    = 64 // buffers are padded to 64b boundaries (for SIMD)
 	kTensorAlignment   = 64 // tensors are padded to 64b boundaries
@@ -65,12 +68,47 @@ type config struct {
 	footer struct {
 		offset int64
 	}
+	prefetch int
+	reverse  bool
+	subst    TypeSubstitution
+	custom   []arrow.StorageProvider
+	stats    bool
+	filter   BatchFilter
+	skip     func(md arrow.Metadata) bool
+	checksum bool
+	verify   bool
+	bufSize  int
+	encoding EncodingPolicy
+	maxDepth int
+	validate bool
+	metrics  MetricsHandler
+	fallback *arrow.Schema
+	prepared *PreparedSchema
+	ensure   struct {
+		schema *arrow.Schema
+		opts   arrow.CompatibilityOptions
+	}
+	dedup struct {
+		threshold float64
+		sample    int
+	}
+	unsupported UnsupportedFieldHandling
+	arrMeta     bool
+	queue       struct {
+		bytes int
+		msgs  int
+		abort bool
+	}
 }
 
 func newConfig(opts ...Option) *config {
 	cfg := &config{
-		alloc: memory.NewGoAllocator(),
+		alloc:    memory.NewGoAllocator(),
+		prefetch: 1,
+		maxDepth: kMaxNestingDepth,
 	}
+	cfg.queue.bytes = kDefaultQueueBytes
+	cfg.queue.msgs = kDefaultQueueMessages
 
 	for _, opt := range opts {
 		opt(cfg)
@@ -104,11 +142,153 @@ func WithSchema(schema *arrow.Schema) Option {
 	}
 }
 
+// WithFallbackSchema specifies the schema used by a stream Reader to decode
+// the record batches of a stream starting without a schema message, e.g.
+// because it was lost upstream.
+// The fallback schema is ignored when the stream starts with a schema.
+// Record batches whose field nodes and buffers do not match the fallback
+// schema are rejected with an error.
+func WithFallbackSchema(schema *arrow.Schema) Option {
+	return func(cfg *config) {
+		cfg.fallback = schema
+	}
+}
+
+// WithEnsureSchema specifies the schema expected by the consumer of a
+// Reader, FileReader or FlightDataReader. Opening an IPC source whose schema
+// is not compatible with expected, per arrow.SchemaCompatibility with opts,
+// fails with an error describing the incompatible fields.
+// Unlike WithSchema, the schema of the source is kept as is.
+func WithEnsureSchema(expected *arrow.Schema, opts arrow.CompatibilityOptions) Option {
+	return func(cfg *config) {
+		cfg.ensure.schema = expected
+		cfg.ensure.opts = opts
+	}
+}
+
+// ensureSchema checks the schema of an IPC source against the schema
+// specified with WithEnsureSchema, if any.
+func (cfg *config) ensureSchema(schema *arrow.Schema) error {
+	if cfg.ensure.schema == nil {
+		return nil
+	}
+	if _, err := arrow.SchemaCompatibility(cfg.ensure.schema, schema, cfg.ensure.opts); err != nil {
+		return xerrors.Errorf("arrow/ipc: unexpected schema: %w", err)
+	}
+	return nil
+}
+
+// WithMaxNestingDepth specifies the maximum nesting depth of the data types
+// read from or written to Arrow files and streams. Schemas nested deeper are
+// rejected with an *arrow.NestingError, matching arrow.ErrNestingTooDeep.
+// The default is 64.
+func WithMaxNestingDepth(n int) Option {
+	return func(cfg *config) {
+		cfg.maxDepth = n
+	}
+}
+
+// WithValidation specifies whether readers check the layout of the buffers
+// of each record batch beyond what is needed to load it safely: buffers
+// sharing bytes of the message body are then rejected.
+// Buffers may be laid out in any order in the body, and the range of each
+// buffer is always checked to lie within the body.
+func WithValidation(v bool) Option {
+	return func(cfg *config) {
+		cfg.validate = v
+	}
+}
+
+// WithPrefetch specifies the number of record batches a Scanner reads ahead
+// of the one being consumed. The default is 1.
+func WithPrefetch(n int) Option {
+	return func(cfg *config) {
+		cfg.prefetch = n
+	}
+}
+
+// WithReverse specifies whether a Scanner yields record batches from last to
+// first. The default is false.
+func WithReverse(v bool) Option {
+	return func(cfg *config) {
+		cfg.reverse = v
+	}
+}
+
+// WithTypeSubstitution specifies the function deciding which fields of the
+// schema read from an IPC source are exposed under another data type.
+// Records returned by readers hold the converted arrays and the
+// substituted schema.
+// Reinterpret and Convert provide converters for common substitutions.
+func WithTypeSubstitution(fn TypeSubstitution) Option {
+	return func(cfg *config) {
+		cfg.subst = fn
+	}
+}
+
+// WithCustomTypes specifies the custom data types readers restore from the
+// extension name written in the metadata of the fields of an IPC source.
+// A field is exposed under the custom type whose name and storage type match
+// its metadata and data type; the extension name is dropped from the field
+// metadata.
+// Only the top-level fields of the schema are restored: nested fields are
+// read as their storage type.
+// Custom types take precedence over WithTypeSubstitution.
+func WithCustomTypes(types ...arrow.StorageProvider) Option {
+	return func(cfg *config) {
+		cfg.custom = types
+	}
+}
+
+// WithArrayMetadata specifies whether a Writer or FileWriter folds the
+// metadata attached to the columns of the first record written (see
+// array.Data.Metadata) into the metadata of the matching top-level fields of
+// the schema it writes, where readers find it.
+// Keys of the array metadata replace the field keys with the same name.
+//
+// By default, array metadata is not serialized.
+func WithArrayMetadata(v bool) Option {
+	return func(cfg *config) {
+		cfg.arrMeta = v
+	}
+}
+
+// WithWriterBufferSize specifies the initial capacity, in bytes, of the
+// flatbuffer builder and staging buffer a Writer or FileWriter reuses to
+// encode the metadata of each record batch.
+// Writers grow these buffers as needed and keep them across calls to Write:
+// pre-sizing them only avoids the reallocations of the first writes.
+func WithWriterBufferSize(n int) Option {
+	return func(cfg *config) {
+		cfg.bufSize = n
+	}
+}
+
+// WithQueueLimits bounds the queue of serialized messages of a
+// BufferedWriter to n bytes and msgs messages.
+// A limit lower than or equal to zero disables the corresponding bound.
+// A message larger than n is still written, once the queue is empty.
+func WithQueueLimits(n, msgs int) Option {
+	return func(cfg *config) {
+		cfg.queue.bytes = n
+		cfg.queue.msgs = msgs
+	}
+}
+
+// WithAbortOnClose specifies whether closing a BufferedWriter discards the
+// messages still queued, instead of writing them out.
+func WithAbortOnClose(v bool) Option {
+	return func(cfg *config) {
+		cfg.queue.abort = v
+	}
+}
+
 var (
 	_ arrio.Reader = (*Reader)(nil)
 	_ arrio.Writer = (*Writer)(nil)
 	_ arrio.Reader = (*FileReader)(nil)
 	_ arrio.Writer = (*FileWriter)(nil)
+	_ arrio.Writer = (*BufferedWriter)(nil)
 
 	_ arrio.ReaderAt = (*FileReader)(nil)
 )
@@ -0,0 +1,275 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// rewriteRecordBatches calls fn, in place, on the metadata and body of each
+// record batch message of raw, starting at offset pos.
+func rewriteRecordBatches(t *testing.T, raw []byte, pos int, fn func(md *flatbuf.RecordBatch, body []byte)) {
+	t.Helper()
+	for pos+8 <= len(raw) && binary.LittleEndian.Uint32(raw[pos:]) == 0xFFFFFFFF {
+		n := int(binary.LittleEndian.Uint32(raw[pos+4:]))
+		if n == 0 {
+			return // end of stream.
+		}
+		meta := raw[pos+8 : pos+8+n]
+		msg := flatbuf.GetRootAsMessage(meta, 0)
+		body := raw[pos+8+n : pos+8+n+int(msg.BodyLength())]
+
+		if msg.HeaderType() == flatbuf.MessageHeaderRecordBatch {
+			var (
+				tbl flatbuffers.Table
+				md  flatbuf.RecordBatch
+			)
+			if !msg.Header(&tbl) {
+				t.Fatalf("could not read record batch header")
+			}
+			md.Init(tbl.Bytes, tbl.Pos)
+			fn(&md, body)
+		}
+		pos += 8 + n + len(body)
+	}
+}
+
+// reverseBuffers lays out the buffers of a record batch in the reverse order
+// of their metadata entries.
+func reverseBuffers(md *flatbuf.RecordBatch, body []byte) {
+	var (
+		buf  flatbuf.Buffer
+		out  = make([]byte, len(body))
+		next int64
+	)
+	for i := md.BuffersLength() - 1; i >= 0; i-- {
+		md.Buffers(&buf, i)
+		n := buf.Length()
+		if n == 0 {
+			buf.MutateOffset(0)
+			continue
+		}
+		copy(out[next:], body[buf.Offset():buf.Offset()+n])
+		buf.MutateOffset(next)
+		next += (n + 7) &^ 7
+	}
+	copy(body, out)
+}
+
+func TestReadPermutedBuffers(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			t.Run("stream", func(t *testing.T) {
+				var buf bytes.Buffer
+				writeStream(t, &buf, mem, recs)
+				raw := buf.Bytes()
+				rewriteRecordBatches(t, raw, 0, reverseBuffers)
+
+				r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(true))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Release()
+
+				n := 0
+				for r.Next() {
+					if !array.RecordEqual(r.Record(), recs[n]) {
+						t.Fatalf("records %d differ", n)
+					}
+					n++
+				}
+				if err := r.Err(); err != nil {
+					t.Fatal(err)
+				}
+				if n != len(recs) {
+					t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+				}
+			})
+
+			t.Run("file", func(t *testing.T) {
+				raw := writeFileBytes(t, mem, recs)
+				rewriteRecordBatches(t, raw, 8, reverseBuffers)
+
+				r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(true))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Close()
+
+				for i, want := range recs {
+					got, err := r.Record(i)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !array.RecordEqual(got, want) {
+						t.Fatalf("records %d differ", i)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestReadInvalidBuffers(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "b", Type: arrow.PrimitiveTypes.Int32},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3, 4}, nil)
+	b.Field(1).(*array.Int32Builder).AppendValues([]int32{5, 6, 7, 8}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	writeStream(t, &buf, mem, []array.Record{rec})
+
+	read := func(raw []byte, validate bool) (array.Record, error) {
+		r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(validate))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Release()
+		if !r.Next() {
+			return nil, r.Err()
+		}
+		rec := r.Record()
+		rec.Retain()
+		return rec, nil
+	}
+
+	t.Run("overlap", func(t *testing.T) {
+		raw := append([]byte(nil), buf.Bytes()...)
+		// values of b alias the ones of a.
+		rewriteRecordBatches(t, raw, 0, func(md *flatbuf.RecordBatch, body []byte) {
+			var a, b flatbuf.Buffer
+			md.Buffers(&a, 1)
+			md.Buffers(&b, 3)
+			b.MutateOffset(a.Offset())
+		})
+
+		got, err := read(raw, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer got.Release()
+		if want := []int32{1, 2, 3, 4}; !equalInt32s(got.Column(1).(*array.Int32).Int32Values(), want) {
+			t.Fatalf("invalid values: got=%v, want=%v", got.Column(1), want)
+		}
+
+		if _, err := read(raw, true); err == nil {
+			t.Fatalf("expected an error reading overlapping buffers")
+		}
+	})
+
+	t.Run("out-of-bounds", func(t *testing.T) {
+		raw := append([]byte(nil), buf.Bytes()...)
+		rewriteRecordBatches(t, raw, 0, func(md *flatbuf.RecordBatch, body []byte) {
+			var b flatbuf.Buffer
+			md.Buffers(&b, 3)
+			b.MutateOffset(int64(len(body)) - 8)
+		})
+
+		for _, validate := range []bool{false, true} {
+			if _, err := read(raw, validate); err == nil {
+				t.Fatalf("expected an error reading out of bounds buffers (validate=%v)", validate)
+			}
+		}
+	})
+}
+
+func equalInt32s(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReadCorruptedBufferLength(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_us},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int16Builder).AppendValues([]int16{1, 2, 3}, nil)
+	b.Field(1).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	b.Field(2).(*array.Float64Builder).AppendValues([]float64{1, 2, 3}, nil)
+	b.Field(3).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{1, 2, 3}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	writeStream(t, &buf, mem, []array.Record{rec})
+
+	for i, field := range schema.Fields() {
+		for _, validate := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s-validate=%v", field.Name, validate), func(t *testing.T) {
+				raw := append([]byte(nil), buf.Bytes()...)
+				// values of the i-th field do not hold a whole number of values.
+				rewriteRecordBatches(t, raw, 0, func(md *flatbuf.RecordBatch, body []byte) {
+					var values flatbuf.Buffer
+					md.Buffers(&values, 2*i+1)
+					values.MutateLength(values.Length() - 1)
+				})
+
+				r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(validate))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Release()
+
+				if r.Next() {
+					t.Fatalf("expected an error")
+				}
+				if err := r.Err(); err == nil || !strings.Contains(err.Error(), "values buffer") {
+					t.Fatalf("invalid error: %v", err)
+				}
+			})
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow/internal/debug"
@@ -137,16 +138,38 @@ func (msg *Message) Type() MessageType {
 	return MessageType(msg.msg.HeaderType())
 }
 
+// HeaderType returns the type of the message header.
+// It is equivalent to Type.
+func (msg *Message) HeaderType() MessageType {
+	return msg.Type()
+}
+
+// BodyLen returns the length of the message body, in bytes, as declared
+// by the message metadata.
 func (msg *Message) BodyLen() int64 {
 	return msg.msg.BodyLength()
 }
 
+// RawMetadata returns the flatbuffer metadata of the message, as written
+// by the producer of the message, without the continuation marker and
+// length prefix. The returned slice aliases the message metadata and is
+// valid until the message is released.
+//
+// Use MetadataToJSON to render the metadata in a human readable form.
+func (msg *Message) RawMetadata() []byte {
+	return msg.meta.Bytes()
+}
+
 // MessageReader reads messages from an io.Reader.
 type MessageReader struct {
 	r io.Reader
 
 	refCount int64
 	msg      *Message
+
+	// skip reports whether the body of a record batch message should be
+	// discarded, and the message skipped.
+	skip func(meta *flatbuf.Message) (bool, error)
 }
 
 // NewMessageReader returns a reader that reads messages from an input stream.
@@ -178,6 +201,17 @@ func (r *MessageReader) Release() {
 // underlying stream.
 // It is valid until the next call to Message.
 func (r *MessageReader) Message() (*Message, error) {
+	for {
+		msg, err := r.next()
+		if msg != nil || err != nil {
+			return msg, err
+		}
+	}
+}
+
+// next reads the next message from the underlying stream.
+// It returns a nil message, and no error, when the message is skipped.
+func (r *MessageReader) next() (*Message, error) {
 	var buf = make([]byte, 4)
 	_, err := io.ReadFull(r.r, buf)
 	if err != nil {
@@ -217,6 +251,16 @@ func (r *MessageReader) Message() (*Message, error) {
 	meta := flatbuf.GetRootAsMessage(buf, 0)
 	bodyLen := meta.BodyLength()
 
+	if r.skip != nil && MessageType(meta.HeaderType()) == MessageRecordBatch {
+		skip, err := r.skip(meta)
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			return nil, r.discard(bodyLen)
+		}
+	}
+
 	buf = make([]byte, bodyLen)
 	_, err = io.ReadFull(r.r, buf)
 	if err != nil {
@@ -232,3 +276,17 @@ func (r *MessageReader) Message() (*Message, error) {
 
 	return r.msg, nil
 }
+
+// discard skips the next n bytes of the underlying stream.
+func (r *MessageReader) discard(n int64) error {
+	var err error
+	if seeker, ok := r.r.(io.Seeker); ok {
+		_, err = seeker.Seek(n, io.SeekCurrent)
+	} else {
+		_, err = io.CopyN(ioutil.Discard, r.r, n)
+	}
+	if err != nil {
+		return xerrors.Errorf("arrow/ipc: could not skip message body: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestMessageMetadataToJSON(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["structs"]
+	var buf bytes.Buffer
+	writeStream(t, &buf, mem, recs)
+
+	r := ipc.NewMessageReader(&buf)
+	defer r.Release()
+
+	var types []ipc.MessageType
+	for {
+		msg, err := r.Message()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, msg.HeaderType())
+
+		raw, err := ipc.MetadataToJSON(msg.RawMetadata())
+		if err != nil {
+			t.Fatalf("could not render metadata of message %d: %v", len(types)-1, err)
+		}
+
+		var doc struct {
+			HeaderType string `json:"headerType"`
+			BodyLength int64  `json:"bodyLength"`
+			Header     struct {
+				Fields []struct {
+					Name     string `json:"name"`
+					Children []struct {
+						Name string `json:"name"`
+					} `json:"children"`
+				} `json:"fields"`
+				Length int64 `json:"length"`
+				Nodes  []struct {
+					Length int64 `json:"length"`
+				} `json:"nodes"`
+			} `json:"header"`
+		}
+		if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+			t.Fatalf("invalid JSON document: %v\n%s", err, raw)
+		}
+
+		if got, want := doc.HeaderType, msg.HeaderType().String(); got != want {
+			t.Fatalf("invalid header type: got=%q, want=%q", got, want)
+		}
+		if got, want := doc.BodyLength, msg.BodyLen(); got != want {
+			t.Fatalf("invalid body length: got=%d, want=%d", got, want)
+		}
+
+		switch msg.HeaderType() {
+		case ipc.MessageSchema:
+			schema := recs[0].Schema()
+			if got, want := len(doc.Header.Fields), len(schema.Fields()); got != want {
+				t.Fatalf("invalid number of fields: got=%d, want=%d\n%s", got, want, raw)
+			}
+			if got, want := doc.Header.Fields[0].Name, schema.Field(0).Name; got != want {
+				t.Fatalf("invalid field name: got=%q, want=%q", got, want)
+			}
+			if len(doc.Header.Fields[0].Children) == 0 {
+				t.Fatalf("missing children of nested field:\n%s", raw)
+			}
+		case ipc.MessageRecordBatch:
+			rec := recs[len(types)-2]
+			if got, want := doc.Header.Length, rec.NumRows(); got != want {
+				t.Fatalf("invalid record batch length: got=%d, want=%d", got, want)
+			}
+			if len(doc.Header.Nodes) == 0 {
+				t.Fatalf("missing field nodes:\n%s", raw)
+			}
+		}
+	}
+
+	if got, want := len(types), len(recs)+1; got != want {
+		t.Fatalf("invalid number of messages: got=%d, want=%d", got, want)
+	}
+}
+
+func TestMetadataToJSONInvalid(t *testing.T) {
+	for _, meta := range [][]byte{
+		nil,
+		{1, 2},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0xff, 0xff, 0xff, 0x7f},
+	} {
+		if _, err := ipc.MetadataToJSON(meta); err == nil {
+			t.Fatalf("expected an error for metadata %v", meta)
+		}
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"encoding/binary"
 	"io"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/flatbuf"
@@ -88,18 +90,7 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 		return nil, xerrors.Errorf("arrow/ipc: could not read message metadata: %w", err)
 	}
 
-	prefix := 0
-	switch binary.LittleEndian.Uint32(buf) {
-	case 0:
-	case kIPCContToken:
-		prefix = 8
-	default:
-		// ARROW-6314: backwards compatibility for reading old IPC
-		// messages produced prior to version 0.15.0
-		prefix = 4
-	}
-
-	meta := memory.NewBufferBytes(buf[prefix:]) // drop buf-size already known from blk.Meta
+	meta := memory.NewBufferBytes(buf[metaPrefix(buf):]) // drop buf-size already known from blk.Meta
 
 	buf = make([]byte, blk.Body)
 	_, err = io.ReadFull(r, buf)
@@ -111,6 +102,31 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 	return NewMessage(meta, body), nil
 }
 
+// messageFromBytes returns the message held by blk, given the bytes of its
+// metadata and body.
+func (blk fileBlock) messageFromBytes(buf []byte) *Message {
+	meta := buf[:blk.Meta]
+	return NewMessage(
+		memory.NewBufferBytes(meta[metaPrefix(meta):]),
+		memory.NewBufferBytes(buf[blk.Meta:]),
+	)
+}
+
+// metaPrefix returns the size of the prefix preceding the flatbuffer
+// metadata of a message.
+func metaPrefix(buf []byte) int {
+	switch binary.LittleEndian.Uint32(buf) {
+	case 0:
+		return 0
+	case kIPCContToken:
+		return 8
+	default:
+		// ARROW-6314: backwards compatibility for reading old IPC
+		// messages produced prior to version 0.15.0
+		return 4
+	}
+}
+
 func (blk fileBlock) section() io.Reader {
 	return io.NewSectionReader(blk.r, blk.Offset, int64(blk.Meta)+blk.Body)
 }
@@ -213,6 +229,15 @@ type fieldVisitor struct {
 }
 
 func (fv *fieldVisitor) visit(field arrow.Field) {
+	if stash, ok := opaqueType(field); ok {
+		var err error
+		fv.dtype, fv.offset, err = opaqueTypeToFB(fv.b, stash)
+		if err != nil {
+			panic(xerrors.Errorf("arrow/ipc: could not write opaque field %q: %w", field.Name, err))
+		}
+		return
+	}
+
 	dt := field.Type
 	switch dt := dt.(type) {
 	case *arrow.NullType:
@@ -274,6 +299,15 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 		flatbuf.DecimalStart(fv.b)
 		flatbuf.DecimalAddPrecision(fv.b, dt.Precision)
 		flatbuf.DecimalAddScale(fv.b, dt.Scale)
+		flatbuf.DecimalAddBitWidth(fv.b, int32(dt.BitWidth()))
+		fv.offset = flatbuf.DecimalEnd(fv.b)
+
+	case *arrow.Decimal256Type:
+		fv.dtype = flatbuf.TypeDecimal
+		flatbuf.DecimalStart(fv.b)
+		flatbuf.DecimalAddPrecision(fv.b, dt.Precision)
+		flatbuf.DecimalAddScale(fv.b, dt.Scale)
+		flatbuf.DecimalAddBitWidth(fv.b, int32(dt.BitWidth()))
 		fv.offset = flatbuf.DecimalEnd(fv.b)
 
 	case *arrow.FixedSizeBinaryType:
@@ -345,10 +379,23 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 
 	case *arrow.ListType:
 		fv.dtype = flatbuf.TypeList
-		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "item", Type: dt.Elem(), Nullable: field.Nullable}, fv.memo))
+		fv.kids = append(fv.kids, fieldToFB(fv.b, dt.ElemField(), fv.memo))
 		flatbuf.ListStart(fv.b)
 		fv.offset = flatbuf.ListEnd(fv.b)
 
+	case *arrow.LargeListType:
+		fv.dtype = flatbuf.TypeLargeList
+		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "item", Type: dt.Elem(), Nullable: field.Nullable}, fv.memo))
+		flatbuf.LargeListStart(fv.b)
+		fv.offset = flatbuf.LargeListEnd(fv.b)
+
+	case *arrow.MapType:
+		fv.dtype = flatbuf.TypeMap
+		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "entries", Type: dt.Elem()}, fv.memo))
+		flatbuf.MapStart(fv.b)
+		flatbuf.MapAddKeysSorted(fv.b, dt.KeysSorted)
+		fv.offset = flatbuf.MapEnd(fv.b)
+
 	case *arrow.FixedSizeListType:
 		fv.dtype = flatbuf.TypeFixedSizeList
 		fv.kids = append(fv.kids, fieldToFB(fv.b, arrow.Field{Name: "item", Type: dt.Elem(), Nullable: field.Nullable}, fv.memo))
@@ -375,6 +422,13 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 		flatbuf.DurationAddUnit(fv.b, unit)
 		fv.offset = flatbuf.DurationEnd(fv.b)
 
+	case arrow.StorageProvider:
+		if field.Metadata.FindKey(kExtensionTypeKeyName) < 0 {
+			fv.meta[kExtensionTypeKeyName] = dt.Name()
+		}
+		field.Type = dt.StorageType()
+		fv.visit(field)
+
 	default:
 		err := xerrors.Errorf("arrow/ipc: invalid data type %v", dt)
 		panic(err) // FIXME(sbinet): implement all data-types.
@@ -397,6 +451,10 @@ func (fv *fieldVisitor) result(field arrow.Field) flatbuffers.UOffsetT {
 		panic("not implemented") // FIXME(sbinet)
 	}
 
+	if _, ok := opaqueType(field); ok {
+		field.Metadata = withoutKey(field.Metadata, OpaqueTypeKey)
+	}
+
 	var (
 		metaFB flatbuffers.UOffsetT
 		kvs    []flatbuffers.UOffsetT
@@ -496,25 +554,12 @@ func typeFromFB(field *flatbuf.Field, children []arrow.Field, md arrow.Metadata)
 		return dt, err
 	}
 
-	// look for extension metadata in custom metadata field.
-	if md.Len() > 0 {
-		i := md.FindKey(kExtensionTypeKeyName)
-		if i < 0 {
-			return dt, err
-		}
-
-		panic("not implemented") // FIXME(sbinet)
-	}
-
+	// fields of extension types are read as their storage type: custom
+	// types are restored from the extension metadata by the substitutor.
 	return dt, err
 }
 
 func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arrow.Field) (arrow.DataType, error) {
-	var (
-		dt  arrow.DataType
-		err error
-	)
-
 	switch typ {
 	case flatbuf.TypeNONE:
 		return nil, xerrors.Errorf("arrow/ipc: Type metadata cannot be none")
@@ -555,7 +600,13 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 		if len(children) != 1 {
 			return nil, xerrors.Errorf("arrow/ipc: List must have exactly 1 child field (got=%d)", len(children))
 		}
-		return arrow.ListOf(children[0].Type), nil
+		return arrow.ListOfField(children[0]), nil
+
+	case flatbuf.TypeLargeList:
+		if len(children) != 1 {
+			return nil, xerrors.Errorf("arrow/ipc: LargeList must have exactly 1 child field (got=%d)", len(children))
+		}
+		return arrow.LargeListOf(children[0].Type), nil
 
 	case flatbuf.TypeFixedSizeList:
 		var dt flatbuf.FixedSizeList
@@ -565,6 +616,20 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 		}
 		return arrow.FixedSizeListOf(dt.ListSize(), children[0].Type), nil
 
+	case flatbuf.TypeMap:
+		var dt flatbuf.Map
+		dt.Init(data.Bytes, data.Pos)
+		if len(children) != 1 {
+			return nil, xerrors.Errorf("arrow/ipc: Map must have exactly 1 child field (got=%d)", len(children))
+		}
+		entries, ok := children[0].Type.(*arrow.StructType)
+		if !ok || len(entries.Fields()) != 2 {
+			return nil, xerrors.Errorf("arrow/ipc: Map child must be a struct of a key and an item (got=%v)", children[0].Type)
+		}
+		mt := arrow.MapOf(entries.Field(0).Type, entries.Field(1).Type)
+		mt.KeysSorted = dt.KeysSorted()
+		return mt, nil
+
 	case flatbuf.TypeStruct_:
 		return arrow.StructOf(children...), nil
 
@@ -595,10 +660,12 @@ func concreteTypeFromFB(typ flatbuf.Type, data flatbuffers.Table, children []arr
 
 	default:
 		// FIXME(sbinet): implement all the other types.
-		panic(xerrors.Errorf("arrow/ipc: type %v not implemented", flatbuf.EnumNamesType[typ]))
+		name, ok := flatbuf.EnumNamesType[typ]
+		if !ok {
+			name = strconv.Itoa(int(typ))
+		}
+		return nil, xerrors.Errorf("arrow/ipc: type %v not implemented: %w", name, ErrUnsupportedType)
 	}
-
-	return dt, err
 }
 
 func intFromFB(data flatbuf.Int) (arrow.DataType, error) {
@@ -679,7 +746,14 @@ func floatToFB(b *flatbuffers.Builder, bw int32) flatbuffers.UOffsetT {
 }
 
 func decimalFromFB(data flatbuf.Decimal) (arrow.DataType, error) {
-	return &arrow.Decimal128Type{Precision: data.Precision(), Scale: data.Scale()}, nil
+	switch bw := data.BitWidth(); bw {
+	case 128:
+		return &arrow.Decimal128Type{Precision: data.Precision(), Scale: data.Scale()}, nil
+	case 256:
+		return &arrow.Decimal256Type{Precision: data.Precision(), Scale: data.Scale()}, nil
+	default:
+		return nil, xerrors.Errorf("arrow/ipc: invalid decimal bit width %d", bw)
+	}
 }
 
 func timeFromFB(data flatbuf.Time) (arrow.DataType, error) {
@@ -796,6 +870,63 @@ func metadataToFB(b *flatbuffers.Builder, meta arrow.Metadata, start startVecFun
 	return b.EndVector(n)
 }
 
+// checkNestingFB returns an *arrow.NestingError if a field of schema is
+// nested deeper than max levels.
+// Fields are traversed iteratively, so that the recursive conversion of the
+// schema may only happen once its depth is known to be bounded.
+func checkNestingFB(schema *flatbuf.Schema, max int) error {
+	type node struct {
+		field  flatbuf.Field
+		depth  int
+		parent *node
+	}
+
+	stack := make([]*node, 0, schema.FieldsLength())
+	for i := schema.FieldsLength() - 1; i >= 0; i-- {
+		n := new(node)
+		if !schema.Fields(&n.field, i) {
+			return xerrors.Errorf("arrow/ipc: could not read field %d from schema", i)
+		}
+		stack = append(stack, n)
+	}
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if n.depth > max {
+			var names []string
+			for p := n; p != nil; p = p.parent {
+				names = append(names, string(p.field.Name()))
+			}
+			for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+				names[i], names[j] = names[j], names[i]
+			}
+			return &arrow.NestingError{Path: strings.Join(names, "."), Depth: n.depth, Max: max}
+		}
+
+		for i := n.field.ChildrenLength() - 1; i >= 0; i-- {
+			child := &node{depth: n.depth + 1, parent: n}
+			if !n.field.Children(&child.field, i) {
+				return xerrors.Errorf("arrow/ipc: could not load field child %d", i)
+			}
+			stack = append(stack, child)
+		}
+	}
+	return nil
+}
+
+// checkNesting returns an *arrow.NestingError if a field of schema is nested
+// deeper than max levels.
+func checkNesting(schema *arrow.Schema, max int) error {
+	for _, f := range schema.Fields() {
+		if err := arrow.ValidateNestingDepth(f, max); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func schemaFromFB(schema *flatbuf.Schema, memo *dictMemo) (*arrow.Schema, error) {
 	var (
 		err    error
@@ -924,23 +1055,28 @@ func writeFBBuilder(b *flatbuffers.Builder, mem memory.Allocator) *memory.Buffer
 	return buf
 }
 
-func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64) *memory.Buffer {
+func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64, custom arrow.Metadata) *memory.Buffer {
+	finishMessageFB(b, hdrType, hdr, bodyLen, custom)
+	return writeFBBuilder(b, mem)
+}
+
+func finishMessageFB(b *flatbuffers.Builder, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64, custom arrow.Metadata) {
+	customFB := metadataToFB(b, custom, flatbuf.MessageStartCustomMetadataVector)
 
 	flatbuf.MessageStart(b)
 	flatbuf.MessageAddVersion(b, int16(currentMetadataVersion))
 	flatbuf.MessageAddHeaderType(b, hdrType)
 	flatbuf.MessageAddHeader(b, hdr)
 	flatbuf.MessageAddBodyLength(b, bodyLen)
+	flatbuf.MessageAddCustomMetadata(b, customFB)
 	msg := flatbuf.MessageEnd(b)
 	b.Finish(msg)
-
-	return writeFBBuilder(b, mem)
 }
 
 func writeSchemaMessage(schema *arrow.Schema, mem memory.Allocator, dict *dictMemo) *memory.Buffer {
 	b := flatbuffers.NewBuilder(1024)
 	schemaFB := schemaToFB(b, schema, dict)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0, arrow.Metadata{})
 }
 
 func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer) error {
@@ -966,10 +1102,10 @@ func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer)
 	return err
 }
 
-func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) *memory.Buffer {
+func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata, custom arrow.Metadata) *memory.Buffer {
 	b := flatbuffers.NewBuilder(0)
 	recFB := recordToFB(b, size, bodyLength, fields, meta)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength, custom)
 }
 
 func recordToFB(b *flatbuffers.Builder, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) flatbuffers.UOffsetT {
@@ -1006,7 +1142,10 @@ func writeBuffers(b *flatbuffers.Builder, buffers []bufferMetadata, start startV
 	return b.EndVector(len(buffers))
 }
 
-func writeMessage(msg *memory.Buffer, alignment int32, w io.Writer) (int, error) {
+// writeMessage writes the encapsulated message msg to w.
+// scratch must be at least 4 bytes long: it is used to stage the message
+// prefix.
+func writeMessage(msg *memory.Buffer, alignment int32, w io.Writer, scratch []byte) (int, error) {
 	var (
 		n   int
 		err error
@@ -1019,7 +1158,7 @@ func writeMessage(msg *memory.Buffer, alignment int32, w io.Writer) (int, error)
 		paddedMsgLen += alignment - remainder
 	}
 
-	tmp := make([]byte, 4)
+	tmp := scratch[:4]
 
 	// write continuation indicator, to address 8-byte alignment requirement from FlatBuffers.
 	binary.LittleEndian.PutUint32(tmp, kIPCContToken)
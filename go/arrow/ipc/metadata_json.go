@@ -0,0 +1,300 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"golang.org/x/xerrors"
+)
+
+// MetadataToJSON renders the flatbuffer metadata of an IPC message, as
+// returned by Message.RawMetadata, as indented JSON.
+// Schema, record batch and dictionary batch headers are rendered in full;
+// other headers are only identified by their type.
+//
+// MetadataToJSON is meant for human inspection of the metadata written by
+// Arrow implementations. The layout of the JSON document is not stable.
+func MetadataToJSON(meta []byte) (out string, err error) {
+	if len(meta) >= 8 && binary.LittleEndian.Uint32(meta) == kIPCContToken {
+		meta = meta[8:]
+	}
+	if len(meta) < 4 {
+		return "", xerrors.Errorf("arrow/ipc: metadata too short (%d bytes)", len(meta))
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			err = xerrors.Errorf("arrow/ipc: invalid flatbuffer metadata: %v", e)
+		}
+	}()
+
+	var (
+		msg = flatbuf.GetRootAsMessage(meta, 0)
+		doc = jsonMessage{
+			Version:        MetadataVersion(msg.Version()).String(),
+			HeaderType:     MessageType(msg.HeaderType()).String(),
+			BodyLength:     msg.BodyLength(),
+			CustomMetadata: kvToJSON(msg.CustomMetadataLength(), msg.CustomMetadata),
+		}
+	)
+
+	switch MessageType(msg.HeaderType()) {
+	case MessageSchema:
+		var hdr flatbuf.Schema
+		initFB(&hdr, msg.Header)
+		doc.Header = schemaToJSON(&hdr)
+	case MessageRecordBatch:
+		var hdr flatbuf.RecordBatch
+		initFB(&hdr, msg.Header)
+		doc.Header = recordBatchToJSON(&hdr)
+	case MessageDictionaryBatch:
+		var hdr flatbuf.DictionaryBatch
+		initFB(&hdr, msg.Header)
+		doc.Header = jsonDictionaryBatch{
+			ID:      hdr.Id(),
+			IsDelta: hdr.IsDelta(),
+			Data:    recordBatchToJSON(hdr.Data(nil)),
+		}
+	}
+
+	raw, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", xerrors.Errorf("arrow/ipc: could not encode metadata to JSON: %w", err)
+	}
+	return string(raw), nil
+}
+
+type jsonMessage struct {
+	Version        string      `json:"version"`
+	HeaderType     string      `json:"headerType"`
+	BodyLength     int64       `json:"bodyLength"`
+	Header         interface{} `json:"header,omitempty"`
+	CustomMetadata []jsonKV    `json:"customMetadata,omitempty"`
+}
+
+type jsonKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type jsonSchema struct {
+	Endianness     string      `json:"endianness"`
+	Fields         []jsonField `json:"fields"`
+	CustomMetadata []jsonKV    `json:"customMetadata,omitempty"`
+}
+
+type jsonField struct {
+	Name           string                 `json:"name"`
+	Nullable       bool                   `json:"nullable"`
+	Type           map[string]interface{} `json:"type"`
+	Dictionary     *jsonDictEncoding      `json:"dictionary,omitempty"`
+	Children       []jsonField            `json:"children,omitempty"`
+	CustomMetadata []jsonKV               `json:"customMetadata,omitempty"`
+}
+
+type jsonDictEncoding struct {
+	ID        int64                  `json:"id"`
+	IndexType map[string]interface{} `json:"indexType,omitempty"`
+	IsOrdered bool                   `json:"isOrdered"`
+}
+
+type jsonRecordBatch struct {
+	Length  int64        `json:"length"`
+	Nodes   []jsonNode   `json:"nodes"`
+	Buffers []jsonBuffer `json:"buffers"`
+}
+
+type jsonNode struct {
+	Length    int64 `json:"length"`
+	NullCount int64 `json:"nullCount"`
+}
+
+type jsonBuffer struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+type jsonDictionaryBatch struct {
+	ID      int64            `json:"id"`
+	IsDelta bool             `json:"isDelta"`
+	Data    *jsonRecordBatch `json:"data,omitempty"`
+}
+
+func kvToJSON(n int, get func(*flatbuf.KeyValue, int) bool) []jsonKV {
+	if n == 0 {
+		return nil
+	}
+	kvs := make([]jsonKV, n)
+	for i := range kvs {
+		var kv flatbuf.KeyValue
+		if !get(&kv, i) {
+			panic(fmt.Errorf("could not read key-value %d", i))
+		}
+		kvs[i] = jsonKV{Key: string(kv.Key()), Value: string(kv.Value())}
+	}
+	return kvs
+}
+
+func schemaToJSON(schema *flatbuf.Schema) jsonSchema {
+	out := jsonSchema{
+		Endianness:     flatbuf.EnumNamesEndianness[schema.Endianness()],
+		Fields:         make([]jsonField, schema.FieldsLength()),
+		CustomMetadata: kvToJSON(schema.CustomMetadataLength(), schema.CustomMetadata),
+	}
+	for i := range out.Fields {
+		var field flatbuf.Field
+		if !schema.Fields(&field, i) {
+			panic(fmt.Errorf("could not read field %d", i))
+		}
+		out.Fields[i] = fieldToJSON(&field)
+	}
+	return out
+}
+
+func fieldToJSON(field *flatbuf.Field) jsonField {
+	out := jsonField{
+		Name:           string(field.Name()),
+		Nullable:       field.Nullable(),
+		Type:           typeToJSON(field),
+		CustomMetadata: kvToJSON(field.CustomMetadataLength(), field.CustomMetadata),
+	}
+
+	if dict := field.Dictionary(nil); dict != nil {
+		out.Dictionary = &jsonDictEncoding{ID: dict.Id(), IsOrdered: dict.IsOrdered()}
+		if idx := dict.IndexType(nil); idx != nil {
+			out.Dictionary.IndexType = map[string]interface{}{
+				"name":     flatbuf.EnumNamesType[flatbuf.TypeInt],
+				"bitWidth": idx.BitWidth(),
+				"isSigned": idx.IsSigned(),
+			}
+		}
+	}
+
+	for i := 0; i < field.ChildrenLength(); i++ {
+		var child flatbuf.Field
+		if !field.Children(&child, i) {
+			panic(fmt.Errorf("could not read child %d of field %q", i, field.Name()))
+		}
+		out.Children = append(out.Children, fieldToJSON(&child))
+	}
+	return out
+}
+
+func typeToJSON(field *flatbuf.Field) map[string]interface{} {
+	var (
+		typ = flatbuf.Type(field.TypeType())
+		out = map[string]interface{}{"name": flatbuf.EnumNamesType[typ]}
+		tbl flatbuffers.Table
+	)
+	if !field.Type(&tbl) {
+		return out
+	}
+
+	switch typ {
+	case flatbuf.TypeInt:
+		var dt flatbuf.Int
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["bitWidth"] = dt.BitWidth()
+		out["isSigned"] = dt.IsSigned()
+	case flatbuf.TypeFloatingPoint:
+		var dt flatbuf.FloatingPoint
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["precision"] = flatbuf.EnumNamesPrecision[dt.Precision()]
+	case flatbuf.TypeDecimal:
+		var dt flatbuf.Decimal
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["precision"] = dt.Precision()
+		out["scale"] = dt.Scale()
+	case flatbuf.TypeDate:
+		var dt flatbuf.Date
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["unit"] = flatbuf.EnumNamesDateUnit[dt.Unit()]
+	case flatbuf.TypeTime:
+		var dt flatbuf.Time
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["unit"] = flatbuf.EnumNamesTimeUnit[dt.Unit()]
+		out["bitWidth"] = dt.BitWidth()
+	case flatbuf.TypeTimestamp:
+		var dt flatbuf.Timestamp
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["unit"] = flatbuf.EnumNamesTimeUnit[dt.Unit()]
+		if tz := dt.Timezone(); len(tz) > 0 {
+			out["timezone"] = string(tz)
+		}
+	case flatbuf.TypeInterval:
+		var dt flatbuf.Interval
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["unit"] = flatbuf.EnumNamesIntervalUnit[dt.Unit()]
+	case flatbuf.TypeDuration:
+		var dt flatbuf.Duration
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["unit"] = flatbuf.EnumNamesTimeUnit[dt.Unit()]
+	case flatbuf.TypeFixedSizeBinary:
+		var dt flatbuf.FixedSizeBinary
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["byteWidth"] = dt.ByteWidth()
+	case flatbuf.TypeFixedSizeList:
+		var dt flatbuf.FixedSizeList
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["listSize"] = dt.ListSize()
+	case flatbuf.TypeUnion:
+		var dt flatbuf.Union
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["mode"] = flatbuf.EnumNamesUnionMode[dt.Mode()]
+		ids := make([]int32, dt.TypeIdsLength())
+		for i := range ids {
+			ids[i] = dt.TypeIds(i)
+		}
+		out["typeIds"] = ids
+	case flatbuf.TypeMap:
+		var dt flatbuf.Map
+		dt.Init(tbl.Bytes, tbl.Pos)
+		out["keysSorted"] = dt.KeysSorted()
+	}
+	return out
+}
+
+func recordBatchToJSON(rec *flatbuf.RecordBatch) *jsonRecordBatch {
+	if rec == nil {
+		return nil
+	}
+	out := &jsonRecordBatch{
+		Length:  rec.Length(),
+		Nodes:   make([]jsonNode, rec.NodesLength()),
+		Buffers: make([]jsonBuffer, rec.BuffersLength()),
+	}
+	for i := range out.Nodes {
+		var node flatbuf.FieldNode
+		if !rec.Nodes(&node, i) {
+			panic(fmt.Errorf("could not read field node %d", i))
+		}
+		out.Nodes[i] = jsonNode{Length: node.Length(), NullCount: node.NullCount()}
+	}
+	for i := range out.Buffers {
+		var buf flatbuf.Buffer
+		if !rec.Buffers(&buf, i) {
+			panic(fmt.Errorf("could not read buffer %d", i))
+		}
+		out.Buffers[i] = jsonBuffer{Offset: buf.Offset(), Length: buf.Length()}
+	}
+	return out
+}
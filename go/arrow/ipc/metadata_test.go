@@ -40,6 +40,38 @@ func TestRWSchema(t *testing.T) {
 			}, &meta),
 			memo: newMemo(),
 		},
+		{
+			schema: arrow.NewSchema([]arrow.Field{
+				{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "America/New_York"}},
+				{Name: "ts-offset", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "+05:30"}},
+				{Name: "ts-naive", Type: &arrow.TimestampType{Unit: arrow.Nanosecond}},
+			}, nil),
+			memo: newMemo(),
+		},
+		{
+			schema: arrow.NewSchema([]arrow.Field{
+				{Name: "map", Type: arrow.MapOf(arrow.BinaryTypes.String, arrow.ListOf(arrow.PrimitiveTypes.Int32)), Nullable: true},
+				{Name: "sorted", Type: func() arrow.DataType {
+					dt := arrow.MapOf(arrow.PrimitiveTypes.Int64, arrow.BinaryTypes.Binary)
+					dt.KeysSorted = true
+					return dt
+				}()},
+			}, nil),
+			memo: newMemo(),
+		},
+		{
+			schema: arrow.NewSchema([]arrow.Field{
+				{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+				{Name: "not-null", Type: arrow.ListOfField(arrow.Field{Name: "item", Type: arrow.PrimitiveTypes.Int32}), Nullable: true},
+				{Name: "named", Type: arrow.ListOfField(arrow.Field{
+					Name:     "element",
+					Type:     arrow.ListOfField(arrow.Field{Name: "inner", Type: arrow.BinaryTypes.String}),
+					Nullable: true,
+					Metadata: arrow.NewMetadata([]string{"k"}, []string{"v"}),
+				})},
+			}, nil),
+			memo: newMemo(),
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			b := flatbuffers.NewBuilder(0)
@@ -76,6 +108,51 @@ func TestRWSchema(t *testing.T) {
 	}
 }
 
+func TestMapToFB(t *testing.T) {
+	dt := arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int32)
+	dt.KeysSorted = true
+	schema := arrow.NewSchema([]arrow.Field{{Name: "m", Type: dt}}, nil)
+
+	b := flatbuffers.NewBuilder(0)
+	memo := newMemo()
+	b.Finish(schemaToFB(b, schema, &memo))
+
+	var (
+		fb    = flatbuf.GetRootAsSchema(b.FinishedBytes(), 0)
+		field flatbuf.Field
+		kid   flatbuf.Field
+		tbl   flatbuffers.Table
+		mt    flatbuf.Map
+	)
+	if !fb.Fields(&field, 0) {
+		t.Fatal("could not load field")
+	}
+	if got, want := field.TypeType(), flatbuf.TypeMap; got != want {
+		t.Fatalf("invalid flatbuffer type: got=%v, want=%v", got, want)
+	}
+	if !field.Type(&tbl) {
+		t.Fatal("could not load field type")
+	}
+	mt.Init(tbl.Bytes, tbl.Pos)
+	if !mt.KeysSorted() {
+		t.Fatal("keys should be sorted")
+	}
+
+	if got, want := field.ChildrenLength(), 1; got != want {
+		t.Fatalf("invalid number of children: got=%d, want=%d", got, want)
+	}
+	field.Children(&kid, 0)
+	if got, want := string(kid.Name()), "entries"; got != want {
+		t.Fatalf("invalid child name: got=%q, want=%q", got, want)
+	}
+	if kid.Nullable() {
+		t.Fatal("map entries should not be nullable")
+	}
+	if got, want := kid.TypeType(), flatbuf.TypeStruct_; got != want {
+		t.Fatalf("invalid child type: got=%v, want=%v", got, want)
+	}
+}
+
 func TestRWFooter(t *testing.T) {
 	for _, tc := range []struct {
 		schema *arrow.Schema
@@ -157,3 +234,36 @@ func TestRWFooter(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimalFromFB(t *testing.T) {
+	for _, tc := range []struct {
+		bw   int32 // 0: bit width left to its default value.
+		want arrow.DataType
+	}{
+		{bw: 0, want: &arrow.Decimal128Type{Precision: 20, Scale: 3}},
+		{bw: 128, want: &arrow.Decimal128Type{Precision: 20, Scale: 3}},
+		{bw: 256, want: &arrow.Decimal256Type{Precision: 20, Scale: 3}},
+		{bw: 64},
+	} {
+		b := flatbuffers.NewBuilder(0)
+		flatbuf.DecimalStart(b)
+		flatbuf.DecimalAddPrecision(b, 20)
+		flatbuf.DecimalAddScale(b, 3)
+		if tc.bw != 0 {
+			flatbuf.DecimalAddBitWidth(b, tc.bw)
+		}
+		b.Finish(flatbuf.DecimalEnd(b))
+
+		got, err := decimalFromFB(*flatbuf.GetRootAsDecimal(b.FinishedBytes(), 0))
+		switch {
+		case tc.want == nil:
+			if err == nil {
+				t.Fatalf("bitwidth=%d: expected an error", tc.bw)
+			}
+		case err != nil:
+			t.Fatalf("bitwidth=%d: %v", tc.bw, err)
+		case !reflect.DeepEqual(got, tc.want):
+			t.Fatalf("bitwidth=%d: got=%v, want=%v", tc.bw, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// MetricsHandler receives measurements from the readers and writers of IPC
+// streams and files, e.g. to export them to a monitoring system.
+//
+// A handler may be shared by several readers and writers, and its methods
+// may be called concurrently: by readers and writers used from different
+// goroutines, or by Scanners of the same file, which read record batches
+// ahead from background goroutines. Implementations must be safe for
+// concurrent use, and should return quickly as they are called while
+// reading and writing.
+type MetricsHandler interface {
+	// OnMessageRead is called for each message read by a reader, with the
+	// length of its metadata and body in bytes.
+	// dur is the time spent reading and decoding the message. For record
+	// batches read ahead by a Scanner, it is the time spent decoding it.
+	OnMessageRead(typ MessageType, metaLen, bodyLen int64, dur time.Duration)
+
+	// OnRecordWritten is called for each record batch written by a writer,
+	// with its number of rows and the length of its body in bytes.
+	// dur is the time spent encoding and writing the record batch.
+	OnRecordWritten(rows, bodyBytes int64, dur time.Duration)
+
+	// OnCompression is called for each buffer compressed by a writer, with
+	// its original and compressed lengths in bytes.
+	// Buffers are not compressed by the writers of this package yet.
+	OnCompression(orig, compressed int64)
+}
+
+// WithMetricsHandler specifies the handler receiving the measurements of a
+// reader or writer. Scanners use the handler of their FileReader, unless
+// one is given to Scan or RecordRange.
+// No measurement is taken when no handler is given.
+func WithMetricsHandler(h MetricsHandler) Option {
+	return func(cfg *config) {
+		cfg.metrics = h
+	}
+}
+
+// MetricsCounter is a MetricsHandler accumulating the measurements it
+// receives. It is safe for concurrent use: while it is in use, its fields
+// must be read with the functions of the sync/atomic package.
+type MetricsCounter struct {
+	MessagesRead  int64         // number of messages read
+	MetaBytesRead int64         // number of metadata bytes read
+	BodyBytesRead int64         // number of body bytes read
+	ReadTime      time.Duration // time spent reading and decoding messages
+
+	RecordsWritten   int64         // number of record batches written
+	RowsWritten      int64         // number of rows written
+	BodyBytesWritten int64         // number of body bytes written
+	WriteTime        time.Duration // time spent encoding and writing record batches
+
+	BytesCompressed int64 // number of bytes before compression
+	CompressedBytes int64 // number of bytes after compression
+}
+
+func (c *MetricsCounter) OnMessageRead(typ MessageType, metaLen, bodyLen int64, dur time.Duration) {
+	atomic.AddInt64(&c.MessagesRead, 1)
+	atomic.AddInt64(&c.MetaBytesRead, metaLen)
+	atomic.AddInt64(&c.BodyBytesRead, bodyLen)
+	atomic.AddInt64((*int64)(&c.ReadTime), int64(dur))
+}
+
+func (c *MetricsCounter) OnRecordWritten(rows, bodyBytes int64, dur time.Duration) {
+	atomic.AddInt64(&c.RecordsWritten, 1)
+	atomic.AddInt64(&c.RowsWritten, rows)
+	atomic.AddInt64(&c.BodyBytesWritten, bodyBytes)
+	atomic.AddInt64((*int64)(&c.WriteTime), int64(dur))
+}
+
+func (c *MetricsCounter) OnCompression(orig, compressed int64) {
+	atomic.AddInt64(&c.BytesCompressed, orig)
+	atomic.AddInt64(&c.CompressedBytes, compressed)
+}
+
+// startTimer returns the current time, or the zero time when no handler is
+// set, so that readers and writers do not pay for unused measurements.
+func startTimer(h MetricsHandler) time.Time {
+	if h == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// messageRead reports msg, read and decoded since start, to h.
+func messageRead(h MetricsHandler, msg *Message, start time.Time) {
+	if h == nil {
+		return
+	}
+	var metaLen, bodyLen int64
+	if msg.meta != nil {
+		metaLen = int64(msg.meta.Len())
+	}
+	if msg.body != nil {
+		bodyLen = int64(msg.body.Len())
+	}
+	h.OnMessageRead(msg.Type(), metaLen, bodyLen, time.Since(start))
+}
+
+// recordWritten reports a record batch of rows rows and body bytes,
+// encoded and written since start, to h.
+func recordWritten(h MetricsHandler, rows, body int64, start time.Time) {
+	if h == nil {
+		return
+	}
+	h.OnRecordWritten(rows, body, time.Since(start))
+}
+
+var (
+	_ MetricsHandler = (*MetricsCounter)(nil)
+)
@@ -0,0 +1,160 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func numRows(recs []array.Record) int64 {
+	var n int64
+	for _, rec := range recs {
+		n += rec.NumRows()
+	}
+	return n
+}
+
+func TestMetricsHandler(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		recs = arrdata.Records["primitives"]
+		wc   = new(ipc.MetricsCounter)
+		rc   = new(ipc.MetricsCounter)
+		buf  = new(bytes.Buffer)
+	)
+
+	w := ipc.NewWriter(buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem), ipc.WithMetricsHandler(wc))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := wc.RecordsWritten, int64(len(recs)); got != want {
+		t.Fatalf("invalid number of records written: got=%d, want=%d", got, want)
+	}
+	if got, want := wc.RowsWritten, numRows(recs); got != want {
+		t.Fatalf("invalid number of rows written: got=%d, want=%d", got, want)
+	}
+	if wc.BodyBytesWritten <= 0 {
+		t.Fatalf("invalid number of body bytes written: %d", wc.BodyBytesWritten)
+	}
+	if wc.MessagesRead != 0 || wc.BytesCompressed != 0 {
+		t.Fatalf("unexpected measurements: %+v", *wc)
+	}
+
+	r, err := ipc.NewReader(buf, ipc.WithAllocator(mem), ipc.WithMetricsHandler(rc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+	for r.Next() {
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the schema message has no body.
+	if got, want := rc.MessagesRead, int64(len(recs)+1); got != want {
+		t.Fatalf("invalid number of messages read: got=%d, want=%d", got, want)
+	}
+	if got, want := rc.BodyBytesRead, wc.BodyBytesWritten; got != want {
+		t.Fatalf("invalid number of body bytes read: got=%d, want=%d", got, want)
+	}
+	if rc.MetaBytesRead <= 0 {
+		t.Fatalf("invalid number of metadata bytes read: %d", rc.MetaBytesRead)
+	}
+	if rc.RecordsWritten != 0 {
+		t.Fatalf("unexpected measurements: %+v", *rc)
+	}
+}
+
+// TestMetricsHandlerConcurrent shares a handler between writers and
+// scanners running concurrently. It is meant to be run with -race.
+func TestMetricsHandlerConcurrent(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const n = 4
+	var (
+		recs = arrdata.Records["primitives"]
+		raw  = writeFileBytes(t, mem, recs)
+		mc   = new(ipc.MetricsCounter)
+		wg   sync.WaitGroup
+		errc = make(chan error, 2*n)
+	)
+
+	f, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithMetricsHandler(mc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s := f.Scan(context.Background(), ipc.WithPrefetch(2))
+			defer s.Release()
+			for s.Next() {
+			}
+			errc <- s.Err()
+		}()
+		go func() {
+			defer wg.Done()
+			w := ipc.NewWriter(new(bytes.Buffer), ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem), ipc.WithMetricsHandler(mc))
+			for _, rec := range recs {
+				if err := w.Write(rec); err != nil {
+					errc <- err
+					return
+				}
+			}
+			errc <- w.Close()
+		}()
+	}
+	wg.Wait()
+	close(errc)
+	for err := range errc {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := atomic.LoadInt64(&mc.MessagesRead), int64(n*len(recs)); got != want {
+		t.Fatalf("invalid number of messages read: got=%d, want=%d", got, want)
+	}
+	if got, want := atomic.LoadInt64(&mc.RowsWritten), n*numRows(recs); got != want {
+		t.Fatalf("invalid number of rows written: got=%d, want=%d", got, want)
+	}
+	if got, want := atomic.LoadInt64(&mc.BodyBytesRead), atomic.LoadInt64(&mc.BodyBytesWritten); got != want {
+		t.Fatalf("invalid number of body bytes read: got=%d, want=%d", got, want)
+	}
+}
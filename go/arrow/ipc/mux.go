@@ -0,0 +1,330 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"golang.org/x/xerrors"
+)
+
+// Multiplexed streams
+//
+// A multiplexed stream carries several Arrow streams, possibly with
+// different schemas, over a single byte stream. This framing is specific to
+// this package: it is not part of the Arrow format.
+//
+// A multiplexed stream starts with an 8-byte header: the magic bytes "AMUX"
+// followed by the version of the framing as a little-endian uint32,
+// currently 1 (kMuxVersion). Readers reject other versions.
+//
+// The header is followed by frames, each made of:
+//   - the channel id, as a little-endian uint32;
+//   - the length of the payload in bytes, as a little-endian uint32;
+//   - the payload: a chunk of the Arrow stream of the channel, made of whole
+//     encapsulated IPC messages.
+//
+// A frame with an empty payload ends its channel: no frame of that channel
+// follows it.
+//
+// Channels are numbered from 0, in the order they are created by the writer.
+// Frames of different channels may be interleaved in any order; the
+// multiplexed stream ends with the underlying byte stream.
+const kMuxVersion = 1
+
+var kMuxMagic = [4]byte{'A', 'M', 'U', 'X'}
+
+// MuxWriter writes several Arrow streams, each on its own channel, to a
+// single io.Writer.
+//
+// The channels of a MuxWriter may be written to from different goroutines.
+type MuxWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	started bool
+	next    uint32
+	chans   []*MuxChannelWriter
+	scratch [8]byte
+}
+
+// NewMuxWriter returns a writer multiplexing Arrow streams over w.
+// The header of the multiplexed stream is written with the first frame.
+func NewMuxWriter(w io.Writer) *MuxWriter {
+	return &MuxWriter{w: w}
+}
+
+// Register creates a new channel, writing records of the provided schema.
+// The options are those of NewWriter; WithSchema is implied.
+func (mw *MuxWriter) Register(schema *arrow.Schema, opts ...Option) *MuxChannelWriter {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	ch := &MuxChannelWriter{mw: mw, id: mw.next}
+	ch.w = NewWriter(&ch.buf, append(opts[:len(opts):len(opts)], WithSchema(schema))...)
+	mw.next++
+	mw.chans = append(mw.chans, ch)
+	return ch
+}
+
+// Close closes all the channels not closed yet, in the order they were
+// registered. Close does not close the underlying writer.
+func (mw *MuxWriter) Close() error {
+	mw.mu.Lock()
+	chans := mw.chans
+	mw.chans = nil
+	mw.mu.Unlock()
+
+	for _, ch := range chans {
+		if err := ch.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// frame writes payload as a frame of channel id.
+func (mw *MuxWriter) frame(id uint32, payload []byte) error {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	if !mw.started {
+		copy(mw.scratch[:4], kMuxMagic[:])
+		binary.LittleEndian.PutUint32(mw.scratch[4:], kMuxVersion)
+		if _, err := mw.w.Write(mw.scratch[:]); err != nil {
+			return xerrors.Errorf("arrow/ipc: could not write multiplexed stream header: %w", err)
+		}
+		mw.started = true
+	}
+
+	binary.LittleEndian.PutUint32(mw.scratch[:4], id)
+	binary.LittleEndian.PutUint32(mw.scratch[4:], uint32(len(payload)))
+	if _, err := mw.w.Write(mw.scratch[:]); err != nil {
+		return xerrors.Errorf("arrow/ipc: could not write frame header of channel %d: %w", id, err)
+	}
+	if _, err := mw.w.Write(payload); err != nil {
+		return xerrors.Errorf("arrow/ipc: could not write frame of channel %d: %w", id, err)
+	}
+	return nil
+}
+
+// MuxChannelWriter writes an Arrow stream on a channel of a MuxWriter.
+type MuxChannelWriter struct {
+	mw     *MuxWriter
+	id     uint32
+	w      *Writer
+	buf    bytes.Buffer // messages of the stream not written to a frame yet
+	closed bool
+}
+
+// ID returns the id of the channel.
+func (ch *MuxChannelWriter) ID() uint32 { return ch.id }
+
+// Schema returns the schema of the records written to the channel.
+func (ch *MuxChannelWriter) Schema() *arrow.Schema { return ch.w.Schema() }
+
+// Write writes rec to the channel, as a single frame.
+func (ch *MuxChannelWriter) Write(rec array.Record) error {
+	if ch.closed {
+		return xerrors.Errorf("arrow/ipc: write to closed channel %d", ch.id)
+	}
+	if err := ch.w.Write(rec); err != nil {
+		return err
+	}
+	return ch.flush()
+}
+
+// Close ends the Arrow stream of the channel, and the channel itself.
+// Close may be called multiple times.
+func (ch *MuxChannelWriter) Close() error {
+	if ch.closed {
+		return nil
+	}
+	ch.closed = true
+	if err := ch.w.Close(); err != nil {
+		return err
+	}
+	if err := ch.flush(); err != nil {
+		return err
+	}
+	return ch.mw.frame(ch.id, nil)
+}
+
+func (ch *MuxChannelWriter) flush() error {
+	if ch.buf.Len() == 0 {
+		return nil
+	}
+	err := ch.mw.frame(ch.id, ch.buf.Bytes())
+	ch.buf.Reset()
+	return err
+}
+
+// MuxReader demultiplexes the Arrow streams of a multiplexed stream written
+// by a MuxWriter.
+//
+// Frames are read from the underlying reader on demand, when reading from
+// a channel whose frames are exhausted. The frames of the other channels
+// read in the meantime are buffered until read: flow control is left to
+// the caller. A MuxReader, and the Readers of its channels, must not be
+// used concurrently.
+type MuxReader struct {
+	r    io.Reader
+	opts []Option
+
+	chans   map[uint32]*muxChannel
+	pending []uint32 // channels seen, not returned by NextChannel yet
+	err     error    // error of the underlying reader, io.EOF at its end
+	scratch [8]byte
+}
+
+// NewMuxReader reads the header of the multiplexed stream r and returns a
+// reader of its channels. The options are passed to the Reader of each
+// channel.
+func NewMuxReader(r io.Reader, opts ...Option) (*MuxReader, error) {
+	mr := &MuxReader{r: r, opts: opts, chans: make(map[uint32]*muxChannel)}
+
+	if _, err := io.ReadFull(r, mr.scratch[:]); err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not read multiplexed stream header: %w", err)
+	}
+	if !bytes.Equal(mr.scratch[:4], kMuxMagic[:]) {
+		return nil, xerrors.Errorf("arrow/ipc: not a multiplexed stream (magic=%q)", mr.scratch[:4])
+	}
+	if v := binary.LittleEndian.Uint32(mr.scratch[4:]); v != kMuxVersion {
+		return nil, xerrors.Errorf("arrow/ipc: unsupported multiplexed stream version %d (want=%d)", v, kMuxVersion)
+	}
+	return mr, nil
+}
+
+// Channel returns a reader of the Arrow stream of channel id, reading frames
+// until the channel appears in the multiplexed stream.
+// Each channel may be opened once, either with Channel or NextChannel.
+// The returned reader must be released after use.
+func (mr *MuxReader) Channel(id uint32) (*Reader, error) {
+	for mr.chans[id] == nil {
+		if err := mr.frame(); err != nil {
+			if err == io.EOF {
+				return nil, xerrors.Errorf("arrow/ipc: no channel %d in multiplexed stream", id)
+			}
+			return nil, err
+		}
+	}
+	return mr.open(mr.chans[id])
+}
+
+// NextChannel returns the id and a reader of the next channel, in the order
+// of their first frame, not opened yet.
+// NextChannel returns io.EOF when the multiplexed stream has no channel left.
+// The returned reader must be released after use.
+func (mr *MuxReader) NextChannel() (uint32, *Reader, error) {
+	for {
+		for len(mr.pending) > 0 {
+			ch := mr.chans[mr.pending[0]]
+			mr.pending = mr.pending[1:]
+			if ch.opened {
+				continue
+			}
+			r, err := mr.open(ch)
+			return ch.id, r, err
+		}
+		if err := mr.frame(); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+func (mr *MuxReader) open(ch *muxChannel) (*Reader, error) {
+	if ch.opened {
+		return nil, xerrors.Errorf("arrow/ipc: channel %d already opened", ch.id)
+	}
+	ch.opened = true
+	r, err := NewReader(ch, mr.opts...)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not open channel %d: %w", ch.id, err)
+	}
+	return r, nil
+}
+
+// frame reads the next frame of the multiplexed stream into the buffer of
+// its channel.
+func (mr *MuxReader) frame() error {
+	if mr.err != nil {
+		return mr.err
+	}
+
+	_, err := io.ReadFull(mr.r, mr.scratch[:])
+	switch err {
+	case nil:
+	case io.EOF:
+		mr.err = io.EOF
+		return mr.err
+	default:
+		mr.err = xerrors.Errorf("arrow/ipc: could not read frame header: %w", err)
+		return mr.err
+	}
+
+	var (
+		id = binary.LittleEndian.Uint32(mr.scratch[:4])
+		n  = int64(binary.LittleEndian.Uint32(mr.scratch[4:]))
+		ch = mr.chans[id]
+	)
+	if ch == nil {
+		ch = &muxChannel{mr: mr, id: id}
+		mr.chans[id] = ch
+		mr.pending = append(mr.pending, id)
+	}
+	if ch.closed {
+		mr.err = xerrors.Errorf("arrow/ipc: frame of closed channel %d", id)
+		return mr.err
+	}
+	if n == 0 {
+		ch.closed = true
+		return nil
+	}
+	if _, err := io.CopyN(&ch.buf, mr.r, n); err != nil {
+		mr.err = xerrors.Errorf("arrow/ipc: could not read frame of channel %d: %w", id, err)
+		return mr.err
+	}
+	return nil
+}
+
+// muxChannel is the io.Reader of the Arrow stream of a channel.
+type muxChannel struct {
+	mr     *MuxReader
+	id     uint32
+	buf    bytes.Buffer // frames read, not consumed yet
+	opened bool
+	closed bool // whether the frame ending the channel was read
+}
+
+func (ch *muxChannel) Read(p []byte) (int, error) {
+	for ch.buf.Len() == 0 {
+		if ch.closed {
+			return 0, io.EOF
+		}
+		if err := ch.mr.frame(); err != nil {
+			if err == io.EOF {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+	}
+	return ch.buf.Read(p)
+}
@@ -0,0 +1,214 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestMuxLoopback(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	names := []string{"primitives", "strings", "structs"}
+
+	pr, pw := io.Pipe()
+	errc := make(chan error, 1)
+	go func() {
+		mw := ipc.NewMuxWriter(pw)
+		chans := make([]*ipc.MuxChannelWriter, len(names))
+		for i, name := range names {
+			chans[i] = mw.Register(arrdata.Records[name][0].Schema(), ipc.WithAllocator(mem))
+		}
+		// interleave the records of the channels.
+		for i := 0; ; i++ {
+			n := 0
+			for j, name := range names {
+				recs := arrdata.Records[name]
+				if i >= len(recs) {
+					continue
+				}
+				n++
+				if err := chans[j].Write(recs[i]); err != nil {
+					errc <- err
+					pw.CloseWithError(err)
+					return
+				}
+			}
+			if n == 0 {
+				break
+			}
+		}
+		err := mw.Close()
+		errc <- err
+		pw.CloseWithError(err)
+	}()
+
+	mr, err := ipc.NewMuxReader(pr, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// read the channels one after the other: frames of the other channels
+	// are buffered meanwhile.
+	for i := range names {
+		id, r, err := mr.NextChannel()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != uint32(i) {
+			t.Fatalf("invalid channel id: got=%d, want=%d", id, i)
+		}
+
+		want := arrdata.Records[names[i]]
+		if !r.Schema().Equal(want[0].Schema()) {
+			t.Fatalf("channel %d: invalid schema:\ngot:\n%v\nwant:\n%v", id, r.Schema(), want[0].Schema())
+		}
+		n := 0
+		for r.Next() {
+			if n >= len(want) {
+				t.Fatalf("channel %d: too many records", id)
+			}
+			if !array.RecordEqual(r.Record(), want[n]) {
+				t.Fatalf("channel %d: records %d differ", id, n)
+			}
+			n++
+		}
+		if err := r.Err(); err != nil {
+			t.Fatalf("channel %d: %+v", id, err)
+		}
+		if n != len(want) {
+			t.Fatalf("channel %d: invalid number of records: got=%d, want=%d", id, n, len(want))
+		}
+		r.Release()
+	}
+
+	if _, _, err := mr.NextChannel(); err != io.EOF {
+		t.Fatalf("invalid error: got=%v, want=%v", err, io.EOF)
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMuxChannel(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		buf  = new(bytes.Buffer)
+		mw   = ipc.NewMuxWriter(buf)
+		recs = arrdata.Records["primitives"]
+		a    = mw.Register(recs[0].Schema(), ipc.WithAllocator(mem))
+		b    = mw.Register(recs[0].Schema(), ipc.WithAllocator(mem))
+	)
+	for _, rec := range recs {
+		if err := a.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Write(recs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Write(recs[0]); err == nil {
+		t.Fatalf("expected an error writing to a closed channel")
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	raw := buf.Bytes()
+
+	mr, err := ipc.NewMuxReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := mr.Channel(b.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Next() || !array.RecordEqual(r.Record(), recs[0]) {
+		t.Fatalf("could not read record of channel %d: %v", b.ID(), r.Err())
+	}
+	if r.Next() {
+		t.Fatalf("unexpected record in channel %d", b.ID())
+	}
+	r.Release()
+
+	if _, err := mr.Channel(b.ID()); err == nil || !strings.Contains(err.Error(), "already opened") {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if _, err := mr.Channel(42); err == nil || !strings.Contains(err.Error(), "no channel 42") {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	id, r, err := mr.NextChannel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != a.ID() {
+		t.Fatalf("invalid channel id: got=%d, want=%d", id, a.ID())
+	}
+	n := 0
+	for r.Next() {
+		n++
+	}
+	r.Release()
+	if n != len(recs) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+	}
+	if _, _, err := mr.NextChannel(); err != io.EOF {
+		t.Fatalf("invalid error: got=%v, want=%v", err, io.EOF)
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		mr, err := ipc.NewMuxReader(bytes.NewReader(raw[:len(raw)/2]), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, r, err := mr.NextChannel()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+		for r.Next() {
+		}
+		if r.Err() == nil {
+			t.Fatalf("expected an error reading a truncated channel")
+		}
+	})
+
+	t.Run("version", func(t *testing.T) {
+		bad := append([]byte{}, raw...)
+		bad[4] = 2
+		_, err := ipc.NewMuxReader(bytes.NewReader(bad))
+		if err == nil || !strings.Contains(err.Error(), "unsupported multiplexed stream version 2") {
+			t.Fatalf("invalid error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,188 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+func nestedListSchema(n int) *arrow.Schema {
+	var dt arrow.DataType = arrow.PrimitiveTypes.Int32
+	for i := 0; i < n; i++ {
+		dt = arrow.ListOf(dt)
+	}
+	return arrow.NewSchema([]arrow.Field{{Name: "deep", Type: dt, Nullable: true}}, nil)
+}
+
+// TestReaderDeepNesting reads a stream whose schema holds 10000 levels of
+// nested lists, as produced by a fuzzer.
+func TestReaderDeepNesting(t *testing.T) {
+	f, err := os.Open("testdata/deep-nesting.arrows.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := ioutil.ReadAll(z)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ipc.NewReader(bytes.NewReader(raw))
+	if !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	var nerr *arrow.NestingError
+	if !xerrors.As(err, &nerr) {
+		t.Fatalf("invalid error type: %v", err)
+	}
+	if got, want := nerr.Depth, 65; got != want {
+		t.Fatalf("invalid depth: got=%d, want=%d", got, want)
+	}
+	if want := "deep" + strings.Repeat(".item", 65); nerr.Path != want {
+		t.Fatalf("invalid path: got=%q, want=%q", nerr.Path, want)
+	}
+}
+
+func TestMaxNestingDepth(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := nestedListSchema(3)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).AppendNull()
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	t.Run("writer", func(t *testing.T) {
+		w := ipc.NewWriter(new(bytes.Buffer), ipc.WithSchema(schema), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(2))
+		if err := w.Write(rec); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+			t.Fatalf("invalid error: %v", err)
+		}
+		if err := w.Close(); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+			t.Fatalf("invalid error: %v", err)
+		}
+
+		f, err := ioutil.TempFile("", "go-arrow-nesting-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		fw, err := ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := fw.Write(rec); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+			t.Fatalf("invalid error: %v", err)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := ipc.NewReader(bytes.NewReader(buf.Bytes()), ipc.WithMaxNestingDepth(2)); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+			t.Fatalf("invalid error: %v", err)
+		}
+
+		r, err := ipc.NewReader(bytes.NewReader(buf.Bytes()), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+		if !r.Next() {
+			t.Fatalf("could not read record: %v", r.Err())
+		}
+		if !array.RecordEqual(r.Record(), rec) {
+			t.Fatalf("records differ")
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		raw := writeFileBytes(t, mem, []array.Record{rec})
+
+		if _, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithMaxNestingDepth(2)); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+			t.Fatalf("invalid error: %v", err)
+		}
+
+		r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		got, err := r.Record(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !array.RecordEqual(got, rec) {
+			t.Fatalf("records differ")
+		}
+	})
+}
+
+func TestDeepNestingRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const depth = 100
+	schema := nestedListSchema(depth)
+
+	buf := new(bytes.Buffer)
+	w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := w.Close(); !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	buf.Reset()
+	w = ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(depth))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(buf.Bytes()), ipc.WithAllocator(mem), ipc.WithMaxNestingDepth(depth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+	if !r.Schema().Equal(schema) {
+		t.Fatalf("schemas differ")
+	}
+}
@@ -0,0 +1,185 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"golang.org/x/xerrors"
+)
+
+// This file holds one-shot helpers writing and reading whole Arrow files and
+// streams. They are implemented on the exported API of the package only.
+
+// WriteFile writes recs to a new Arrow file at path, truncating any existing
+// file. The schema of the file is the one of the first record, unless set
+// with WithSchema: it must be set if recs is empty.
+// The file may be left partially written if an error occurs.
+func WriteFile(path string, recs []array.Record, opts ...Option) error {
+	return WriteFileContext(context.Background(), path, recs, opts...)
+}
+
+// WriteFileContext is like WriteFile, but stops writing records when ctx is
+// done.
+func WriteFileContext(ctx context.Context, path string, recs []array.Record, opts ...Option) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return xerrors.Errorf("arrow/ipc: could not create file: %w", err)
+	}
+	defer f.Close()
+
+	w, err := NewFileWriter(f, withRecordsSchema(recs, opts)...)
+	if err != nil {
+		return err
+	}
+	if err := writeRecords(ctx, w, recs); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// ReadFile reads all the records of the Arrow file at path, and returns them
+// along with the schema of the file.
+// The returned records must be Release()'d after use.
+func ReadFile(path string, opts ...Option) ([]array.Record, *arrow.Schema, error) {
+	return ReadFileContext(context.Background(), path, opts...)
+}
+
+// ReadFileContext is like ReadFile, but stops reading records when ctx is
+// done.
+func ReadFileContext(ctx context.Context, path string, opts ...Option) ([]array.Record, *arrow.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("arrow/ipc: could not open file: %w", err)
+	}
+	defer f.Close()
+
+	r, err := NewFileReader(f, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer r.Close()
+
+	recs, err := readRecords(ctx, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return recs, r.Schema(), nil
+}
+
+// WriteStreamBytes returns recs encoded in the Arrow stream format.
+// The schema of the stream is the one of the first record, unless set with
+// WithSchema: it must be set if recs is empty.
+func WriteStreamBytes(recs []array.Record, opts ...Option) ([]byte, error) {
+	return WriteStreamBytesContext(context.Background(), recs, opts...)
+}
+
+// WriteStreamBytesContext is like WriteStreamBytes, but stops encoding
+// records when ctx is done.
+func WriteStreamBytesContext(ctx context.Context, recs []array.Record, opts ...Option) ([]byte, error) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, withRecordsSchema(recs, opts)...)
+	if err := writeRecords(ctx, w, recs); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadStreamBytes decodes all the records of the Arrow stream held by b, and
+// returns them along with the schema of the stream.
+// The returned records must be Release()'d after use.
+func ReadStreamBytes(b []byte, opts ...Option) ([]array.Record, *arrow.Schema, error) {
+	return ReadStreamBytesContext(context.Background(), b, opts...)
+}
+
+// ReadStreamBytesContext is like ReadStreamBytes, but stops decoding records
+// when ctx is done.
+func ReadStreamBytesContext(ctx context.Context, b []byte, opts ...Option) ([]array.Record, *arrow.Schema, error) {
+	r, err := NewReader(bytes.NewReader(b), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer r.Release()
+
+	recs, err := readRecords(ctx, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return recs, r.Schema(), nil
+}
+
+// withRecordsSchema prepends the schema of the first record to opts, so that
+// a schema given with WithSchema takes precedence.
+func withRecordsSchema(recs []array.Record, opts []Option) []Option {
+	if len(recs) == 0 {
+		return opts
+	}
+	return append([]Option{WithSchema(recs[0].Schema())}, opts...)
+}
+
+func writeRecords(ctx context.Context, w interface{ Write(array.Record) error }, recs []array.Record) error {
+	for _, rec := range recs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecords reads all the records of r. The records returned by r are
+// only valid until the next call to Read, hence they are retained.
+func readRecords(ctx context.Context, r interface{ Read() (array.Record, error) }) ([]array.Record, error) {
+	var recs []array.Record
+	for {
+		if err := ctx.Err(); err != nil {
+			releaseRecords(recs)
+			return nil, err
+		}
+		rec, err := r.Read()
+		if err == io.EOF {
+			return recs, nil
+		}
+		if err != nil {
+			releaseRecords(recs)
+			return nil, err
+		}
+		rec.Retain()
+		recs = append(recs, rec)
+	}
+}
+
+func releaseRecords(recs []array.Record) {
+	for _, rec := range recs {
+		rec.Release()
+	}
+}
@@ -0,0 +1,169 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+func checkRecords(t *testing.T, got, want []array.Record) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", len(got), len(want))
+	}
+	for i := range got {
+		if !array.RecordEqual(got[i], want[i]) {
+			t.Fatalf("records %d differ", i)
+		}
+	}
+}
+
+func TestWriteReadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-arrow-oneshot-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			fname := filepath.Join(dir, name+".arrow")
+			if err := ipc.WriteFile(fname, recs, ipc.WithAllocator(mem)); err != nil {
+				t.Fatal(err)
+			}
+
+			got, schema, err := ipc.ReadFile(fname, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer releaseAll(got)
+
+			if !schema.Equal(recs[0].Schema()) {
+				t.Fatalf("invalid schema:\ngot=%v\nwant=%v", schema, recs[0].Schema())
+			}
+			checkRecords(t, got, recs)
+		})
+	}
+}
+
+func TestWriteReadStreamBytes(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			raw, err := ipc.WriteStreamBytes(recs, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, schema, err := ipc.ReadStreamBytes(raw, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer releaseAll(got)
+
+			if !schema.Equal(recs[0].Schema()) {
+				t.Fatalf("invalid schema:\ngot=%v\nwant=%v", schema, recs[0].Schema())
+			}
+			checkRecords(t, got, recs)
+		})
+	}
+}
+
+func TestWriteReadEmpty(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i", Type: arrow.PrimitiveTypes.Int32}}, nil)
+
+	if _, err := ipc.WriteStreamBytes(nil); err == nil {
+		t.Fatalf("expected an error writing a stream without schema")
+	}
+
+	raw, err := ipc.WriteStreamBytes(nil, ipc.WithSchema(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs, got, err := ipc.ReadStreamBytes(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 0 {
+		t.Fatalf("invalid number of records: got=%d, want=0", len(recs))
+	}
+	if !got.Equal(schema) {
+		t.Fatalf("invalid schema:\ngot=%v\nwant=%v", got, schema)
+	}
+}
+
+func TestWriteReadContext(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := ipc.WriteStreamBytesContext(ctx, recs, ipc.WithAllocator(mem)); !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	raw, err := ipc.WriteStreamBytes(recs, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ipc.ReadStreamBytesContext(ctx, raw, ipc.WithAllocator(mem)); !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "go-arrow-oneshot-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fname := filepath.Join(dir, "recs.arrow")
+	if err := ipc.WriteFileContext(ctx, fname, recs, ipc.WithAllocator(mem)); !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+	if err := ipc.WriteFile(fname, recs, ipc.WithAllocator(mem)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ipc.ReadFileContext(ctx, fname, ipc.WithAllocator(mem)); !xerrors.Is(err, context.Canceled) {
+		t.Fatalf("invalid error: %v", err)
+	}
+}
+
+func releaseAll(recs []array.Record) {
+	for _, rec := range recs {
+		rec.Release()
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// PreparedSchema is a schema serialized once to its IPC message, to be
+// shared by many writers of streams or files with that schema.
+//
+// A PreparedSchema is immutable: it may be used simultaneously by writers
+// running on different goroutines.
+type PreparedSchema struct {
+	schema *arrow.Schema
+	meta   []byte   // flatbuffer of the schema message.
+	memo   dictMemo // dictionary IDs of the fields of the schema.
+}
+
+// PrepareSchema serializes schema, using mem for temporary buffers.
+// The returned PreparedSchema is passed to writers with WithPreparedSchema.
+func PrepareSchema(schema *arrow.Schema, mem memory.Allocator) *PreparedSchema {
+	ps := &PreparedSchema{schema: schema, memo: newMemo()}
+
+	buf := writeSchemaMessage(schema, mem, &ps.memo)
+	defer buf.Release()
+	ps.meta = append([]byte(nil), buf.Bytes()...)
+
+	return ps
+}
+
+// Schema returns the schema that was prepared.
+func (ps *PreparedSchema) Schema() *arrow.Schema { return ps.schema }
+
+// WithPreparedSchema specifies the schema of the records written by a
+// Writer, FileWriter or FlightDataWriter, as WithSchema does, and lets it
+// write the schema message serialized by PrepareSchema instead of
+// serializing the schema again.
+// The written bytes are the same either way.
+//
+// Writers whose EncodingPolicy rewrites the schema serialize the rewritten
+// schema themselves.
+func WithPreparedSchema(ps *PreparedSchema) Option {
+	return func(cfg *config) {
+		cfg.schema = ps.schema
+		cfg.prepared = ps
+	}
+}
+
+// schemaPayloads returns the payloads of the schema messages of schema, taken
+// from prepared when it was prepared from that schema.
+// Callers need to call Release after use.
+func schemaPayloads(schema *arrow.Schema, mem memory.Allocator, prepared *PreparedSchema) payloads {
+	if prepared == nil || prepared.schema != schema {
+		return payloadsFromSchema(schema, mem, nil)
+	}
+	return payloads{{msg: MessageSchema, meta: memory.NewBufferBytes(prepared.meta)}}
+}
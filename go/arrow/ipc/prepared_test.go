@@ -0,0 +1,131 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestPreparedSchema(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			ps := ipc.PrepareSchema(recs[0].Schema(), mem)
+			if ps.Schema() != recs[0].Schema() {
+				t.Fatalf("invalid prepared schema")
+			}
+
+			want := writeStreamBytes(t, mem, recs, ipc.WithSchema(recs[0].Schema()))
+			got := writeStreamBytes(t, mem, recs, ipc.WithPreparedSchema(ps))
+			if !bytes.Equal(got, want) {
+				t.Fatalf("prepared stream differs from the unprepared one")
+			}
+
+			want = writeFileBytes(t, mem, recs, ipc.WithSchema(recs[0].Schema()))
+			got = writeFileBytes(t, mem, recs, ipc.WithPreparedSchema(ps))
+			if !bytes.Equal(got, want) {
+				t.Fatalf("prepared file differs from the unprepared one")
+			}
+		})
+	}
+}
+
+func TestPreparedSchemaConcurrent(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	ps := ipc.PrepareSchema(recs[0].Schema(), mem)
+	want := writeStreamBytes(t, mem, recs, ipc.WithSchema(recs[0].Schema()))
+
+	const n = 8
+	var (
+		wg   sync.WaitGroup
+		outs = make([][]byte, n)
+	)
+	for i := range outs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			buf := new(bytes.Buffer)
+			w := ipc.NewWriter(buf, ipc.WithPreparedSchema(ps), ipc.WithAllocator(mem))
+			for _, rec := range recs {
+				if err := w.Write(rec); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Error(err)
+				return
+			}
+			outs[i] = buf.Bytes()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, got := range outs {
+		if !bytes.Equal(got, want) {
+			t.Fatalf("stream %d differs from the unprepared one", i)
+		}
+	}
+}
+
+func writeStreamBytes(t testing.TB, mem memory.Allocator, recs []array.Record, opts ...ipc.Option) []byte {
+	buf := new(bytes.Buffer)
+	w := ipc.NewWriter(buf, append([]ipc.Option{ipc.WithAllocator(mem)}, opts...)...)
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkStartWriter(b *testing.B) {
+	schema := arrdata.Records["fixed_width_types"][0].Schema()
+	start := func(b *testing.B, opts ...ipc.Option) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			w := ipc.NewWriter(ioutil.Discard, opts...)
+			if err := w.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("schema", func(b *testing.B) {
+		start(b, ipc.WithSchema(schema))
+	})
+	b.Run("prepared", func(b *testing.B) {
+		start(b, ipc.WithPreparedSchema(ipc.PrepareSchema(schema, memory.NewGoAllocator())))
+	})
+}
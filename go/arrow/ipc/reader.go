@@ -17,7 +17,6 @@
 package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
-	"bytes"
 	"io"
 	"sync/atomic"
 
@@ -35,6 +34,7 @@ import (
 type Reader struct {
 	r      *MessageReader
 	schema *arrow.Schema
+	layout *sourceLayout // layout of the fields, if some are unsupported
 
 	refCount int64
 	rec      array.Record
@@ -43,8 +43,20 @@ type Reader struct {
 	types dictTypeMap
 	memo  dictMemo
 
-	mem memory.Allocator
+	mem   memory.Allocator
+	subst *substitutor
+	dedup *deduplicator
 
+	maxDepth int  // maximum nesting depth of the schema
+	validate bool // whether to check the layout of record batch buffers
+
+	metrics MetricsHandler
+
+	pending *Message                     // record batch read in place of the schema message
+	skip    func(md arrow.Metadata) bool // predicate selecting the record batches to read
+	verify  bool                         // whether to verify the checksums of the record batches
+
+	irec int // index of the next record batch
 	done bool
 }
 
@@ -56,16 +68,33 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 	}
 
 	rr := &Reader{
-		r:     NewMessageReader(r),
-		types: make(dictTypeMap),
-		memo:  newMemo(),
-		mem:   cfg.alloc,
+		r:        NewMessageReader(r),
+		refCount: 1,
+		types:    make(dictTypeMap),
+		memo:     newMemo(),
+		mem:      cfg.alloc,
+		maxDepth: cfg.maxDepth,
+		validate: cfg.validate,
+		metrics:  cfg.metrics,
+		verify:   cfg.verify,
 	}
 
-	err := rr.readSchema(cfg.schema)
+	err := rr.readSchema(cfg.schema, cfg.fallback, cfg.unsupported)
 	if err != nil {
+		rr.Release()
 		return nil, xerrors.Errorf("arrow/ipc: could not read schema from stream: %w", err)
 	}
+	rr.subst = newSubstitutor(rr.mem, rr.schema, cfg.subst, cfg.custom)
+	rr.dedup = newDeduplicator(cfg)
+	rr.skip = cfg.skip
+	if rr.skip != nil {
+		rr.r.skip = rr.skipBatch
+	}
+
+	if err := cfg.ensureSchema(rr.Schema()); err != nil {
+		rr.Release()
+		return nil, err
+	}
 
 	return rr, nil
 }
@@ -74,14 +103,34 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 // underlying stream.
 func (r *Reader) Err() error { return r.err }
 
-func (r *Reader) Schema() *arrow.Schema { return r.schema }
+func (r *Reader) Schema() *arrow.Schema {
+	if r.subst != nil {
+		return r.subst.schema
+	}
+	return r.schema
+}
 
-func (r *Reader) readSchema(schema *arrow.Schema) error {
+func (r *Reader) readSchema(schema, fallback *arrow.Schema, mode UnsupportedFieldHandling) error {
+	start := startTimer(r.metrics)
 	msg, err := r.r.Message()
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not read message schema: %w", err)
 	}
 
+	if msg.Type() == MessageRecordBatch && fallback != nil {
+		// headless stream: the record batch is decoded by the first call
+		// to next, with the fallback schema.
+		if schema != nil && !schema.Equal(fallback) {
+			return errInconsistentSchema
+		}
+		if err := checkNesting(fallback, r.maxDepth); err != nil {
+			return xerrors.Errorf("arrow/ipc: invalid fallback schema: %w", err)
+		}
+		r.schema = fallback
+		r.pending = msg
+		return nil
+	}
+
 	if msg.Type() != MessageSchema {
 		return xerrors.Errorf("arrow/ipc: invalid message type (got=%v, want=%v)", msg.Type(), MessageSchema)
 	}
@@ -90,6 +139,10 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 	var schemaFB flatbuf.Schema
 	initFB(&schemaFB, msg.msg.Header)
 
+	if err := checkNestingFB(&schemaFB, r.maxDepth); err != nil {
+		return xerrors.Errorf("arrow/ipc: invalid message schema: %w", err)
+	}
+
 	r.types, err = dictTypesFromFB(&schemaFB)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could read dictionary types from message schema: %w", err)
@@ -101,7 +154,7 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 		panic("not implemented") // FIXME(sbinet): ReadNextDictionary
 	}
 
-	r.schema, err = schemaFromFB(&schemaFB, &r.memo)
+	r.schema, r.layout, err = schemaFromFBWith(&schemaFB, &r.memo, mode)
 	if err != nil {
 		return xerrors.Errorf("arrow/ipc: could not decode schema from message schema: %w", err)
 	}
@@ -111,6 +164,7 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 		return errInconsistentSchema
 	}
 
+	messageRead(r.metrics, msg, start)
 	return nil
 }
 
@@ -135,6 +189,7 @@ func (r *Reader) Release() {
 			r.r.Release()
 			r.r = nil
 		}
+		r.memo.delete()
 	}
 }
 
@@ -153,8 +208,26 @@ func (r *Reader) Next() bool {
 }
 
 func (r *Reader) next() bool {
-	var msg *Message
-	msg, r.err = r.r.Message()
+	if r.r == nil {
+		// the reader has been released.
+		r.done = true
+		return false
+	}
+
+	var (
+		msg   *Message
+		start = startTimer(r.metrics)
+	)
+	if r.pending != nil {
+		var skip bool
+		msg, r.pending = r.pending, nil
+		skip, r.err = r.skipBatch(msg.msg)
+		if skip {
+			msg, r.err = r.r.Message()
+		}
+	} else {
+		msg, r.err = r.r.Message()
+	}
 	if r.err != nil {
 		r.done = true
 		if r.err == io.EOF {
@@ -167,11 +240,62 @@ func (r *Reader) next() bool {
 		r.err = xerrors.Errorf("arrow/ipc: invalid message type (got=%v, want=%v", got, want)
 		return false
 	}
+	if r.verify {
+		r.err = r.verifyBatch(msg)
+		if r.err != nil {
+			return false
+		}
+	}
 
-	r.rec = newRecord(r.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
+	r.rec, r.err = newRecord(r.schema, r.layout, msg.meta, msg.body, r.maxDepth, r.validate)
+	if r.err != nil {
+		return false
+	}
+	if r.subst != nil {
+		r.rec, r.err = r.subst.apply(r.rec, r.irec)
+		if r.err != nil {
+			return false
+		}
+	}
+	if r.dedup != nil {
+		r.rec = r.dedup.apply(r.rec)
+	}
+	messageRead(r.metrics, msg, start)
+	r.irec++
 	return true
 }
 
+// skipBatch reports whether the record batch message described by meta is
+// rejected by the skip predicate of the reader.
+func (r *Reader) skipBatch(meta *flatbuf.Message) (bool, error) {
+	if r.skip == nil {
+		return false, nil
+	}
+	md, err := metadataFromFB(meta)
+	if err != nil {
+		return false, xerrors.Errorf("arrow/ipc: could not read record batch metadata: %w", err)
+	}
+	if r.skip(md) {
+		return false, nil
+	}
+	r.irec++
+	return true, nil
+}
+
+// verifyBatch checks the body of the current record batch message against
+// the checksum held by its custom metadata.
+func (r *Reader) verifyBatch(msg *Message) error {
+	md, err := metadataFromFB(msg.msg)
+	if err != nil {
+		return xerrors.Errorf("arrow/ipc: could not read record batch metadata: %w", err)
+	}
+	crc, err := batchChecksum(r.irec, md)
+	if err != nil {
+		return err
+	}
+	return verifyChecksum(r.irec, msg.body, crc)
+}
+
 // Record returns the current record that has been extracted from the
 // underlying stream.
 // It is valid until the next call to Next.
@@ -0,0 +1,423 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"golang.org/x/xerrors"
+)
+
+// kBatchStatsKey is the key of the metadata holding statistics about record
+// batches: the footer schema metadata of a file holds the statistics of all
+// its batches, the custom metadata of a record batch message in a stream
+// holds the statistics of that batch only.
+const kBatchStatsKey = "arrow-go:batch_stats"
+
+// ColumnStats holds statistics about the values of a column of a record batch.
+type ColumnStats struct {
+	NullN int64 // number of null values
+
+	// Min and Max are the smallest and greatest valid values of the column.
+	// They are nil when the column has no valid value, or when statistics
+	// are not collected for its data type.
+	// Otherwise, they hold an int64 for signed integer and temporal types,
+	// a uint64 for unsigned integers, a float64 for floating point numbers
+	// (NaN values are ignored) and a string for binary and string types.
+	Min, Max interface{}
+}
+
+// BatchStats holds the statistics of the columns of a record batch,
+// indexed like the fields of the schema.
+type BatchStats []ColumnStats
+
+// BatchFilter reports whether the record batch i, described by its
+// statistics, may hold records of interest.
+type BatchFilter func(i int, stats BatchStats) bool
+
+// WithBatchStats specifies whether a FileWriter collects statistics about
+// the columns of each record batch and stores them in the file footer.
+//
+// A stream Writer stores the statistics of each record batch in the custom
+// metadata of its message, where StatsPredicate can consult them.
+func WithBatchStats(v bool) Option {
+	return func(cfg *config) {
+		cfg.stats = v
+	}
+}
+
+// WithBatchSkipPredicate specifies a predicate consulted by a stream Reader
+// with the custom metadata of each record batch message, before reading its
+// body: batches for which the predicate returns false are skipped without
+// being decoded. Their body is discarded with Seek when the underlying
+// stream implements io.Seeker.
+func WithBatchSkipPredicate(fn func(md arrow.Metadata) bool) Option {
+	return func(cfg *config) {
+		cfg.skip = fn
+	}
+}
+
+// StatsPredicate returns a predicate, suitable for WithBatchSkipPredicate,
+// consulting filter with the statistics that a stream Writer created with
+// WithBatchStats stored in the metadata of a record batch.
+// filter is called with a batch index of -1, and batches without statistics,
+// or with invalid ones, are kept.
+func StatsPredicate(filter BatchFilter) func(md arrow.Metadata) bool {
+	return func(md arrow.Metadata) bool {
+		idx := md.FindKey(kBatchStatsKey)
+		if idx < 0 {
+			return true
+		}
+		stats, err := decodeBatchStats(md.Values()[idx])
+		if err != nil || len(stats) != 1 {
+			return true
+		}
+		return filter(-1, stats[0])
+	}
+}
+
+// WithBatchFilter specifies a filter consulted by the sequential readers of
+// a file (FileReader.Read and Scanner) before loading each record batch:
+// batches for which the filter returns false are skipped.
+//
+// The filter is not consulted, and all batches are loaded, when the file
+// holds no statistics. Random access through FileReader.Record is not
+// filtered.
+func WithBatchFilter(fn BatchFilter) Option {
+	return func(cfg *config) {
+		cfg.filter = fn
+	}
+}
+
+// Int64Range returns a filter selecting the record batches where column col
+// may hold values in [lo, hi].
+// Column col must have a signed integer or temporal data type.
+func Int64Range(col int, lo, hi int64) BatchFilter {
+	return func(_ int, stats BatchStats) bool {
+		min, okmin := colMin(stats, col).(int64)
+		max, okmax := colMax(stats, col).(int64)
+		return !okmin || !okmax || (max >= lo && min <= hi)
+	}
+}
+
+// Uint64Range returns a filter selecting the record batches where column col
+// may hold values in [lo, hi].
+// Column col must have an unsigned integer data type.
+func Uint64Range(col int, lo, hi uint64) BatchFilter {
+	return func(_ int, stats BatchStats) bool {
+		min, okmin := colMin(stats, col).(uint64)
+		max, okmax := colMax(stats, col).(uint64)
+		return !okmin || !okmax || (max >= lo && min <= hi)
+	}
+}
+
+// Float64Range returns a filter selecting the record batches where column col
+// may hold values in [lo, hi].
+// Column col must have a floating point data type.
+func Float64Range(col int, lo, hi float64) BatchFilter {
+	return func(_ int, stats BatchStats) bool {
+		min, okmin := colMin(stats, col).(float64)
+		max, okmax := colMax(stats, col).(float64)
+		return !okmin || !okmax || (max >= lo && min <= hi)
+	}
+}
+
+// PrefixRange returns a filter selecting the record batches where column col
+// may hold values starting with prefix.
+// Column col must have a binary or string data type.
+func PrefixRange(col int, prefix string) BatchFilter {
+	// values starting with prefix are in [prefix, end).
+	end := []byte(prefix)
+	for len(end) > 0 && end[len(end)-1] == 0xff {
+		end = end[:len(end)-1]
+	}
+	if len(end) > 0 {
+		end[len(end)-1]++
+	}
+
+	return func(_ int, stats BatchStats) bool {
+		min, okmin := colMin(stats, col).(string)
+		max, okmax := colMax(stats, col).(string)
+		if !okmin || !okmax {
+			return true
+		}
+		return max >= prefix && (len(end) == 0 || min < string(end))
+	}
+}
+
+func colMin(stats BatchStats, col int) interface{} {
+	if col < 0 || col >= len(stats) {
+		return nil
+	}
+	return stats[col].Min
+}
+
+func colMax(stats BatchStats, col int) interface{} {
+	if col < 0 || col >= len(stats) {
+		return nil
+	}
+	return stats[col].Max
+}
+
+// newBatchStats computes the statistics of the columns of rec.
+func newBatchStats(rec array.Record) BatchStats {
+	stats := make(BatchStats, rec.NumCols())
+	for i, col := range rec.Columns() {
+		stats[i] = newColumnStats(col)
+	}
+	return stats
+}
+
+func newColumnStats(arr array.Interface) ColumnStats {
+	var (
+		stats = ColumnStats{NullN: int64(arr.NullN())}
+		ival  func(i int) int64
+		uval  func(i int) uint64
+		fval  func(i int) float64
+		sval  func(i int) string
+	)
+
+	switch arr := arr.(type) {
+	case *array.Int8:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int16:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int32:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int64:
+		ival = func(i int) int64 { return arr.Value(i) }
+	case *array.Date32:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Date64:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Timestamp:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Time32:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Time64:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Duration:
+		ival = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Uint8:
+		uval = func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint16:
+		uval = func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint32:
+		uval = func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint64:
+		uval = func(i int) uint64 { return arr.Value(i) }
+	case *array.Float16:
+		fval = func(i int) float64 { return float64(arr.Value(i).Float32()) }
+	case *array.Float32:
+		fval = func(i int) float64 { return float64(arr.Value(i)) }
+	case *array.Float64:
+		fval = func(i int) float64 { return arr.Value(i) }
+	case *array.String:
+		sval = arr.Value
+	case *array.Binary:
+		sval = arr.ValueString
+	default:
+		return stats
+	}
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			continue
+		}
+		switch {
+		case ival != nil:
+			v := ival(i)
+			if stats.Min == nil || v < stats.Min.(int64) {
+				stats.Min = v
+			}
+			if stats.Max == nil || v > stats.Max.(int64) {
+				stats.Max = v
+			}
+		case uval != nil:
+			v := uval(i)
+			if stats.Min == nil || v < stats.Min.(uint64) {
+				stats.Min = v
+			}
+			if stats.Max == nil || v > stats.Max.(uint64) {
+				stats.Max = v
+			}
+		case fval != nil:
+			v := fval(i)
+			if math.IsNaN(v) {
+				continue
+			}
+			if stats.Min == nil || v < stats.Min.(float64) {
+				stats.Min = v
+			}
+			if stats.Max == nil || v > stats.Max.(float64) {
+				stats.Max = v
+			}
+		default:
+			v := sval(i)
+			if stats.Min == nil || v < stats.Min.(string) {
+				stats.Min = v
+			}
+			if stats.Max == nil || v > stats.Max.(string) {
+				stats.Max = v
+			}
+		}
+	}
+
+	return stats
+}
+
+// jsonColumnStats is the serialized form of ColumnStats.
+type jsonColumnStats struct {
+	Nulls int64  `json:"nulls"`
+	Kind  string `json:"kind,omitempty"` // "int", "uint", "float" or "bytes". empty when there is no min/max.
+	Min   string `json:"min,omitempty"`
+	Max   string `json:"max,omitempty"`
+}
+
+func encodeStatsValue(v interface{}) (kind, str string) {
+	switch v := v.(type) {
+	case int64:
+		return "int", strconv.FormatInt(v, 10)
+	case uint64:
+		return "uint", strconv.FormatUint(v, 10)
+	case float64:
+		return "float", strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return "bytes", base64.StdEncoding.EncodeToString([]byte(v))
+	default:
+		panic(xerrors.Errorf("arrow/ipc: invalid statistics value type %T", v))
+	}
+}
+
+func decodeStatsValue(kind, str string) (interface{}, error) {
+	switch kind {
+	case "int":
+		return strconv.ParseInt(str, 10, 64)
+	case "uint":
+		return strconv.ParseUint(str, 10, 64)
+	case "float":
+		return strconv.ParseFloat(str, 64)
+	case "bytes":
+		v, err := base64.StdEncoding.DecodeString(str)
+		return string(v), err
+	default:
+		return nil, xerrors.Errorf("invalid statistics kind %q", kind)
+	}
+}
+
+func encodeBatchStats(stats []BatchStats) (string, error) {
+	out := make([][]jsonColumnStats, len(stats))
+	for i, batch := range stats {
+		out[i] = make([]jsonColumnStats, len(batch))
+		for j, col := range batch {
+			out[i][j].Nulls = col.NullN
+			if col.Min == nil {
+				continue
+			}
+			out[i][j].Kind, out[i][j].Min = encodeStatsValue(col.Min)
+			_, out[i][j].Max = encodeStatsValue(col.Max)
+		}
+	}
+
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return "", xerrors.Errorf("arrow/ipc: could not encode batch statistics: %w", err)
+	}
+	return string(raw), nil
+}
+
+func decodeBatchStats(raw string) ([]BatchStats, error) {
+	var in [][]jsonColumnStats
+	err := json.Unmarshal([]byte(raw), &in)
+	if err != nil {
+		return nil, xerrors.Errorf("arrow/ipc: could not decode batch statistics: %w", err)
+	}
+
+	stats := make([]BatchStats, len(in))
+	for i, batch := range in {
+		stats[i] = make(BatchStats, len(batch))
+		for j, col := range batch {
+			stats[i][j].NullN = col.Nulls
+			if col.Kind == "" {
+				continue
+			}
+			stats[i][j].Min, err = decodeStatsValue(col.Kind, col.Min)
+			if err != nil {
+				return nil, xerrors.Errorf("arrow/ipc: could not decode min of column %d of batch %d: %w", j, i, err)
+			}
+			stats[i][j].Max, err = decodeStatsValue(col.Kind, col.Max)
+			if err != nil {
+				return nil, xerrors.Errorf("arrow/ipc: could not decode max of column %d of batch %d: %w", j, i, err)
+			}
+		}
+	}
+	return stats, nil
+}
+
+// batchStatsMetadata returns the custom metadata of a record batch message
+// holding the statistics of rec.
+func batchStatsMetadata(rec array.Record) (arrow.Metadata, error) {
+	raw, err := encodeBatchStats([]BatchStats{newBatchStats(rec)})
+	if err != nil {
+		return arrow.Metadata{}, err
+	}
+	return arrow.NewMetadata([]string{kBatchStatsKey}, []string{raw}), nil
+}
+
+// withBatchStats returns a copy of schema, whose metadata holds the
+// provided statistics.
+func withBatchStats(schema *arrow.Schema, stats []BatchStats) (*arrow.Schema, error) {
+	raw, err := encodeBatchStats(stats)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		md   = schema.Metadata()
+		keys = append(append([]string{}, md.Keys()...), kBatchStatsKey)
+		vals = append(append([]string{}, md.Values()...), raw)
+		meta = arrow.NewMetadata(keys, vals)
+	)
+	return arrow.NewSchema(schema.Fields(), &meta), nil
+}
+
+// stripBatchStats extracts the batch statistics held in the metadata of
+// schema, returning schema without them.
+func stripBatchStats(schema *arrow.Schema) (*arrow.Schema, []BatchStats, error) {
+	md := schema.Metadata()
+	idx := md.FindKey(kBatchStatsKey)
+	if idx < 0 {
+		return schema, nil, nil
+	}
+
+	stats, err := decodeBatchStats(md.Values()[idx])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		keys = append(append([]string{}, md.Keys()[:idx]...), md.Keys()[idx+1:]...)
+		vals = append(append([]string{}, md.Values()[:idx]...), md.Values()[idx+1:]...)
+		meta = arrow.NewMetadata(keys, vals)
+	)
+	return arrow.NewSchema(schema.Fields(), &meta), stats, nil
+}
@@ -0,0 +1,405 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// countingReader counts the calls to ReadAt.
+type countingReader struct {
+	*bytes.Reader
+	n int
+}
+
+func (r *countingReader) ReadAt(p []byte, off int64) (int, error) {
+	r.n++
+	return r.Reader.ReadAt(p, off)
+}
+
+func TestFileBatchFilter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const (
+		nbatches = 100
+		nrows    = 10
+	)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	recs := make([]array.Record, nbatches)
+	for i := range recs {
+		for j := 0; j < nrows; j++ {
+			bldr.Field(0).(*array.TimestampBuilder).Append(arrow.Timestamp(i*nrows + j))
+			bldr.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("batch-%03d", i))
+		}
+		recs[i] = bldr.NewRecord()
+		defer recs[i].Release()
+	}
+
+	raw := writeFileBytes(t, mem, recs, ipc.WithBatchStats(true))
+
+	// loaded returns the number of record batches loaded by fn, and the
+	// first timestamp of each record returned.
+	loaded := func(t *testing.T, fn func(r *ipc.FileReader) []int64, opts ...ipc.Option) (int, []int64) {
+		full := &countingReader{Reader: bytes.NewReader(raw)}
+		r, err := ipc.NewFileReader(full, append(opts[:len(opts):len(opts)], ipc.WithAllocator(mem))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		full.n = 0
+		got := fn(r)
+		return full.n, got
+	}
+
+	read := func(r *ipc.FileReader) []int64 {
+		var firsts []int64
+		for {
+			rec, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			firsts = append(firsts, int64(rec.Column(0).(*array.Timestamp).Value(0)))
+		}
+		return firsts
+	}
+
+	scan := func(r *ipc.FileReader) []int64 {
+		s := r.Scan(context.Background())
+		defer s.Release()
+		var firsts []int64
+		for s.Next() {
+			firsts = append(firsts, int64(s.Record().Column(0).(*array.Timestamp).Value(0)))
+		}
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return firsts
+	}
+
+	for _, tc := range []struct {
+		name string
+		fn   func(r *ipc.FileReader) []int64
+	}{
+		{"read", read},
+		{"scan", scan},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			nfull, all := loaded(t, tc.fn)
+			if got, want := len(all), nbatches; got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+			perBatch := nfull / nbatches
+
+			// timestamps in [245, 312] live in batches 24 to 31.
+			n, got := loaded(t, tc.fn, ipc.WithBatchFilter(ipc.Int64Range(0, 245, 312)))
+			want := []int64{240, 250, 260, 270, 280, 290, 300, 310}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("invalid records: got=%v, want=%v", got, want)
+			}
+			if got, want := n, len(want)*perBatch; got != want {
+				t.Fatalf("invalid number of reads: got=%d, want=%d", got, want)
+			}
+
+			n, got = loaded(t, tc.fn, ipc.WithBatchFilter(ipc.PrefixRange(1, "batch-05")))
+			if got, want := len(got), 10; got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+			if got, want := n, 10*perBatch; got != want {
+				t.Fatalf("invalid number of reads: got=%d, want=%d", got, want)
+			}
+		})
+	}
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithSchema(schema))
+	if err != nil {
+		t.Fatalf("statistics should not leak into the schema: %+v", err)
+	}
+	defer r.Close()
+
+	stats := r.BatchStats(42)
+	if got, want := fmt.Sprint(stats), "[{0 420 429} {0 batch-042 batch-042}]"; got != want {
+		t.Fatalf("invalid stats: got=%s, want=%s", got, want)
+	}
+}
+
+// TestFileScanMatchesRead checks that Scan and Read return the same records
+// when the reader filters record batches and substitutes types.
+func TestFileScanMatchesRead(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "n", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	recs := make([]array.Record, 10)
+	for i := range recs {
+		bldr.Field(0).(*array.Int64Builder).Append(int64(i))
+		bldr.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("batch-%d", i))
+		recs[i] = bldr.NewRecord()
+		defer recs[i].Release()
+	}
+
+	raw := writeFileBytes(t, mem, recs, ipc.WithBatchStats(true))
+	r, err := ipc.NewFileReader(
+		bytes.NewReader(raw),
+		ipc.WithAllocator(mem),
+		ipc.WithBatchFilter(ipc.Int64Range(0, 3, 6)),
+		ipc.WithTypeSubstitution(func(field arrow.Field) (arrow.DataType, ipc.ConvertFunc, bool) {
+			if field.Type.ID() != arrow.STRING {
+				return nil, nil, false
+			}
+			return arrow.BinaryTypes.Binary, ipc.Reinterpret(arrow.BinaryTypes.Binary), true
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var scanned []array.Record
+	defer func() {
+		for _, rec := range scanned {
+			rec.Release()
+		}
+	}()
+	s := r.Scan(context.Background())
+	defer s.Release()
+	for s.Next() {
+		s.Record().Retain()
+		scanned = append(scanned, s.Record())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for ; ; n++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n >= len(scanned) || !array.RecordEqual(rec, scanned[n]) {
+			t.Fatalf("records[%d] differ", n)
+		}
+	}
+	if got, want := n, 4; got != want || len(scanned) != want {
+		t.Fatalf("invalid number of records: read=%d, scanned=%d, want=%d", got, len(scanned), want)
+	}
+}
+
+func TestFileBatchFilterNoStats(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	raw := writeFileBytes(t, mem, recs)
+
+	calls := 0
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithBatchFilter(func(int, ipc.BatchStats) bool {
+		calls++
+		return false
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if r.BatchStats(0) != nil {
+		t.Fatalf("unexpected statistics")
+	}
+
+	n := 0
+	for {
+		_, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != len(recs) || calls != 0 {
+		t.Fatalf("all records should be read without consulting the filter: got=%d records, %d calls", n, calls)
+	}
+}
+
+// seekCounter counts the bytes read from a seekable stream.
+type seekCounter struct {
+	*bytes.Reader
+	n int
+}
+
+func (r *seekCounter) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += n
+	return n, err
+}
+
+// allocCounter counts the calls to Allocate.
+type allocCounter struct {
+	memory.Allocator
+	n int
+}
+
+func (a *allocCounter) Allocate(size int) []byte {
+	a.n++
+	return a.Allocator.Allocate(size)
+}
+
+func TestStreamBatchSkipPredicate(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const (
+		nbatches = 20
+		nrows    = 10
+	)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	recs := make([]array.Record, nbatches)
+	for i := range recs {
+		for j := 0; j < nrows; j++ {
+			bldr.Field(0).(*array.TimestampBuilder).Append(arrow.Timestamp(i*nrows + j))
+			bldr.Field(1).(*array.StringBuilder).Append(fmt.Sprintf("batch-%03d", i))
+		}
+		recs[i] = bldr.NewRecord()
+		defer recs[i].Release()
+	}
+
+	raw := writeStreamBytes(t, mem, recs, ipc.WithBatchStats(true))
+
+	// read returns the first timestamp of each record read from src, the
+	// number of bytes read and the number of allocations.
+	read := func(t *testing.T, src []byte, seek bool, opts ...ipc.Option) ([]int64, int, int) {
+		var (
+			alloc = &allocCounter{Allocator: mem}
+			sc    = &seekCounter{Reader: bytes.NewReader(src)}
+			rr    io.Reader
+		)
+		rr = sc
+		if !seek {
+			rr = struct{ io.Reader }{sc}
+		}
+
+		r, err := ipc.NewReader(rr, append(opts[:len(opts):len(opts)], ipc.WithAllocator(alloc))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+
+		var firsts []int64
+		for r.Next() {
+			firsts = append(firsts, int64(r.Record().Column(0).(*array.Timestamp).Value(0)))
+		}
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return firsts, sc.n, alloc.n
+	}
+
+	for _, seek := range []bool{true, false} {
+		t.Run(fmt.Sprintf("seek=%v", seek), func(t *testing.T) {
+			all, nall, _ := read(t, raw, seek)
+			if got, want := len(all), nbatches; got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+
+			none, nnone, allocs := read(t, raw, seek, ipc.WithBatchSkipPredicate(func(arrow.Metadata) bool { return false }))
+			if len(none) != 0 {
+				t.Fatalf("unexpected records: %v", none)
+			}
+			if allocs != 0 {
+				t.Fatalf("skipped record batches should not allocate: got %d allocations", allocs)
+			}
+
+			// timestamps in [45, 72] live in batches 4 to 7.
+			got, n, _ := read(t, raw, seek, ipc.WithBatchSkipPredicate(ipc.StatsPredicate(ipc.Int64Range(0, 45, 72))))
+			want := []int64{40, 50, 60, 70}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("invalid records: got=%v, want=%v", got, want)
+			}
+
+			if !seek {
+				return
+			}
+			// all batches have bodies of the same size.
+			body := (nall - nnone) / nbatches
+			if got, want := n-nnone, len(want)*body; got != want {
+				t.Fatalf("invalid number of bytes read: got=%d, want=%d", got, want)
+			}
+		})
+	}
+
+	t.Run("headless", func(t *testing.T) {
+		headless := dropSchemaMessage(t, raw)
+		got, _, _ := read(t, headless, true,
+			ipc.WithFallbackSchema(schema),
+			ipc.WithBatchSkipPredicate(ipc.StatsPredicate(ipc.PrefixRange(1, "batch-01"))),
+		)
+		want := []int64{100, 110, 120, 130, 140, 150, 160, 170, 180, 190}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("invalid records: got=%v, want=%v", got, want)
+		}
+	})
+
+	t.Run("no stats", func(t *testing.T) {
+		plain := writeStreamBytes(t, mem, recs)
+		got, _, _ := read(t, plain, true, ipc.WithBatchSkipPredicate(ipc.StatsPredicate(ipc.Int64Range(0, 45, 72))))
+		if got, want := len(got), nbatches; got != want {
+			t.Fatalf("batches without statistics should be kept: got=%d, want=%d records", got, want)
+		}
+	})
+}
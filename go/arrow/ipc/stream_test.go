@@ -17,12 +17,20 @@
 package ipc_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -60,3 +68,401 @@ func TestStream(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamLateSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+
+	buf := new(bytes.Buffer)
+	w := ipc.NewWriter(buf, ipc.WithAllocator(mem))
+	if w.Schema() != nil {
+		t.Fatalf("unexpected schema before first write: %v", w.Schema())
+	}
+
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %v", err)
+		}
+	}
+	if !w.Schema().Equal(recs[0].Schema()) {
+		t.Fatalf("invalid bound schema:\ngot= %v\nwant=%v", w.Schema(), recs[0].Schema())
+	}
+
+	err := w.Write(arrdata.Records["strings"][0])
+	if err == nil {
+		t.Fatalf("expected an error writing a record with a different schema")
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(buf, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		if !array.RecordEqual(r.Record(), recs[n]) {
+			t.Fatalf("records[%d] differ", n)
+		}
+		n++
+	}
+	if n != len(recs) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+	}
+}
+
+func TestStreamCloseWithoutSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	w := ipc.NewWriter(new(bytes.Buffer), ipc.WithAllocator(mem))
+	if err := w.Close(); err == nil {
+		t.Fatalf("expected an error closing a writer without a schema")
+	}
+}
+
+// Benchmarks in this package write and read every arrdata set, reporting the
+// encoded size via b.SetBytes so that results are comparable across sets.
+// Memory obtained from the arrow allocator is accounted for with a
+// CheckedAllocator: each iteration must release everything it allocated.
+// In -short mode, the benchmarks of steady-state paths, reusing the scratch
+// space of a writer, also check that their iterations make no allocation
+// with the arrow allocator.
+//
+// To compare two revisions, run the same invocation on both and feed the
+// outputs to benchstat:
+//
+//  $> go test -run=NONE -bench=. -count=10 ./ipc > old.txt
+//  $> go test -run=NONE -bench=. -count=10 ./ipc > new.txt
+//  $> benchstat old.txt new.txt
+
+func BenchmarkWriteStream(b *testing.B) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		b.Run(name, func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			buf := new(bytes.Buffer)
+			writeStream(b, buf, mem, recs)
+			b.SetBytes(int64(buf.Len()))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				buf.Reset()
+				writeStream(b, buf, mem, recs)
+			}
+		})
+	}
+}
+
+// BenchmarkWriteRecord measures steady-state writes of same-shaped records
+// to an open stream: only the first write of each writer sizes its scratch
+// buffers.
+func BenchmarkWriteRecord(b *testing.B) {
+	benchWriteRecord(b)
+}
+
+// BenchmarkWriteRecordMetrics measures the overhead of a MetricsHandler
+// on BenchmarkWriteRecord.
+func BenchmarkWriteRecordMetrics(b *testing.B) {
+	benchWriteRecord(b, ipc.WithMetricsHandler(new(ipc.MetricsCounter)))
+}
+
+// BenchmarkWriteRecordChecksums measures the overhead of computing the
+// checksum of each record batch body on BenchmarkWriteRecord.
+func BenchmarkWriteRecordChecksums(b *testing.B) {
+	benchWriteRecord(b, ipc.WithChecksums())
+}
+
+func benchWriteRecord(b *testing.B, opts ...ipc.Option) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		b.Run(name, func(b *testing.B) {
+			mem := &countingAllocator{CheckedAllocator: memory.NewCheckedAllocator(memory.NewGoAllocator())}
+			defer mem.AssertSize(b, 0)
+
+			opts := append([]ipc.Option{ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem)}, opts...)
+			w := ipc.NewWriter(ioutil.Discard, opts...)
+			for _, rec := range recs {
+				if err := w.Write(rec); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			allocs := mem.Allocs()
+			for i := 0; i < b.N; i++ {
+				if err := w.Write(recs[i%len(recs)]); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+
+			if n := mem.Allocs() - allocs; testing.Short() && n != 0 {
+				b.Errorf("%d allocations in %d steady-state writes, want 0", n, b.N)
+			}
+
+			if err := w.Close(); err != nil {
+				b.Fatal(err)
+			}
+		})
+	}
+}
+
+func BenchmarkReadStream(b *testing.B) {
+	benchReadStream(b)
+}
+
+// BenchmarkReadStreamMetrics measures the overhead of a MetricsHandler
+// on BenchmarkReadStream.
+func BenchmarkReadStreamMetrics(b *testing.B) {
+	benchReadStream(b, ipc.WithMetricsHandler(new(ipc.MetricsCounter)))
+}
+
+func benchReadStream(b *testing.B, opts ...ipc.Option) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		b.Run(name, func(b *testing.B) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(b, 0)
+
+			buf := new(bytes.Buffer)
+			writeStream(b, buf, mem, recs)
+			raw := buf.Bytes()
+
+			b.SetBytes(int64(len(raw)))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				r, err := ipc.NewReader(bytes.NewReader(raw), append([]ipc.Option{ipc.WithAllocator(mem)}, opts...)...)
+				if err != nil {
+					b.Fatal(err)
+				}
+				n := 0
+				for r.Next() {
+					n++
+				}
+				r.Release()
+				if n != len(recs) {
+					b.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+				}
+				if testing.Short() {
+					mem.AssertSize(b, 0)
+				}
+			}
+		})
+	}
+}
+
+func writeStream(t testing.TB, w io.Writer, mem memory.Allocator, recs []array.Record) {
+	ww := ipc.NewWriter(w, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+	for _, rec := range recs {
+		if err := ww.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ww.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// dropSchemaMessage returns the stream raw without its leading schema
+// message, which has no body.
+func dropSchemaMessage(t *testing.T, raw []byte) []byte {
+	if cont := binary.LittleEndian.Uint32(raw); cont != 0xFFFFFFFF {
+		t.Fatalf("invalid continuation indicator %x", cont)
+	}
+	n := binary.LittleEndian.Uint32(raw[4:])
+	return raw[8+n:]
+}
+
+func TestReaderFallbackSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	buf := new(bytes.Buffer)
+	writeStream(t, buf, mem, recs)
+	headless := dropSchemaMessage(t, buf.Bytes())
+
+	if _, err := ipc.NewReader(bytes.NewReader(headless), ipc.WithAllocator(mem)); err == nil {
+		t.Fatalf("expected an error reading a stream without schema")
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(headless), ipc.WithAllocator(mem), ipc.WithFallbackSchema(recs[0].Schema()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		if !array.RecordEqual(r.Record(), recs[n]) {
+			t.Fatalf("records[%d] differ", n)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := n, len(recs); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	// the fallback schema is ignored when the stream has a schema.
+	other := arrdata.Records["strings"][0].Schema()
+	r2, err := ipc.NewReader(bytes.NewReader(buf.Bytes()), ipc.WithAllocator(mem), ipc.WithFallbackSchema(other))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r2.Release()
+	if !r2.Schema().Equal(recs[0].Schema()) {
+		t.Fatalf("invalid schema: %v", r2.Schema())
+	}
+}
+
+func TestReaderFallbackSchemaMismatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	buf := new(bytes.Buffer)
+	writeStream(t, buf, mem, recs)
+	headless := dropSchemaMessage(t, buf.Bytes())
+
+	fields := recs[0].Schema().Fields()
+	for _, tc := range []struct {
+		name   string
+		schema *arrow.Schema
+		err    string
+	}{
+		{"fewer fields", arrow.NewSchema(fields[:3], nil), "record batch has 11 field nodes, schema requires 3"},
+		{"more fields", arrow.NewSchema(append(fields, fields[0]), nil), "schema requires more"},
+		{"strings", arrdata.Records["strings"][0].Schema(), "offsets buffer too small"},
+		{"lists", arrdata.Records["lists"][0].Schema(), "values buffer too small"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := ipc.NewReader(bytes.NewReader(headless), ipc.WithAllocator(mem), ipc.WithFallbackSchema(tc.schema))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			if r.Next() {
+				t.Fatalf("expected an error decoding a record batch with the fallback schema")
+			}
+			if err := r.Err(); err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+}
+
+// countingAllocator counts the allocations made with a CheckedAllocator.
+type countingAllocator struct {
+	*memory.CheckedAllocator
+	n int64
+}
+
+func (a *countingAllocator) Allocate(size int) []byte {
+	atomic.AddInt64(&a.n, 1)
+	return a.CheckedAllocator.Allocate(size)
+}
+
+func (a *countingAllocator) Reallocate(size int, b []byte) []byte {
+	atomic.AddInt64(&a.n, 1)
+	return a.CheckedAllocator.Reallocate(size, b)
+}
+
+// Allocs returns the number of calls to Allocate and Reallocate so far.
+func (a *countingAllocator) Allocs() int64 { return atomic.LoadInt64(&a.n) }
+
+// arrdataNames returns the names of the arrdata sets, in a stable order.
+func arrdataNames() []string {
+	names := make([]string, 0, len(arrdata.Records))
+	for name := range arrdata.Records {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestReaderEnsureSchema(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	var (
+		stream = writeStreamBytes(t, mem, recs)
+		file   = writeFileBytes(t, mem, recs)
+	)
+
+	open := func(b []byte, isFile bool, opts ...ipc.Option) error {
+		opts = append(opts, ipc.WithAllocator(mem))
+		if isFile {
+			r, err := ipc.NewFileReader(bytes.NewReader(b), opts...)
+			if err == nil {
+				r.Close()
+			}
+			return err
+		}
+		r, err := ipc.NewReader(bytes.NewReader(b), opts...)
+		if err == nil {
+			r.Release()
+		}
+		return err
+	}
+
+	// consumers expecting a subset of the fields, some of them wider.
+	expected := arrow.NewSchema([]arrow.Field{
+		{Name: "int64s", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "int32s", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "float32s", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	}, nil)
+
+	for _, tc := range []struct {
+		name string
+		opts arrow.CompatibilityOptions
+		err  string
+	}{
+		{
+			name: "strict",
+			err:  `arrow/ipc: unexpected schema: arrow: incompatible schema: "int32s": widened (got=int32, want=int64)`,
+		},
+		{
+			name: "widening",
+			opts: arrow.CompatibilityOptions{AllowWidening: true},
+			err:  `"bools": unexpected field`,
+		},
+		{
+			name: "widening-extra",
+			opts: arrow.CompatibilityOptions{AllowWidening: true, AllowExtraFields: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, isFile := range []bool{false, true} {
+				b := stream
+				if isFile {
+					b = file
+				}
+				err := open(b, isFile, ipc.WithEnsureSchema(expected, tc.opts))
+				switch {
+				case tc.err == "" && err != nil:
+					t.Fatalf("file=%v: unexpected error: %+v", isFile, err)
+				case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+					t.Fatalf("file=%v: invalid error:\ngot= %v\nwant=%s", isFile, err, tc.err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+	"golang.org/x/xerrors"
+)
+
+// ConvertFunc converts an array loaded from an IPC source into an array of
+// the substituted data type.
+// The input array is released by the caller.
+type ConvertFunc func(mem memory.Allocator, arr array.Interface) (array.Interface, error)
+
+// TypeSubstitution decides, for a field of the schema read from an IPC
+// source, the data type exposed to the application and the function
+// converting the loaded arrays to that type.
+// TypeSubstitution returns false if the field is to be read as is.
+type TypeSubstitution func(field arrow.Field) (arrow.DataType, ConvertFunc, bool)
+
+// Reinterpret returns a ConvertFunc exposing the buffers of an array under
+// the data type dt, without copying them.
+// The physical layouts of both types must match: binary and string arrays
+// may be reinterpreted one as the other, and fixed-width arrays as arrays
+// of any fixed-width type of the same bit width.
+func Reinterpret(dt arrow.DataType) ConvertFunc {
+	return func(mem memory.Allocator, arr array.Interface) (array.Interface, error) {
+		src := arr.DataType()
+		switch {
+		case arrow.IsBinaryLike(src.ID()) && arrow.IsBinaryLike(dt.ID()):
+		case bitWidth(src) > 0 && bitWidth(src) == bitWidth(dt):
+		default:
+			return nil, xerrors.Errorf("arrow/ipc: cannot reinterpret %v as %v", src, dt)
+		}
+
+		data := array.NewData(dt, arr.Len(), arr.Data().Buffers(), nil, arr.NullN(), arr.Data().Offset())
+		defer data.Release()
+		return array.MakeFromData(data), nil
+	}
+}
+
+// Convert returns a ConvertFunc converting arrays to the data type dt with
+// compute.Cast, e.g. to read lists as large lists and conversely, or to
+// decode dictionary-encoded arrays into arrays of the type of their values.
+func Convert(dt arrow.DataType) ConvertFunc {
+	return func(mem memory.Allocator, arr array.Interface) (array.Interface, error) {
+		return compute.Cast(mem, arr, dt)
+	}
+}
+
+func bitWidth(dt arrow.DataType) int {
+	if dt, ok := dt.(arrow.FixedWidthDataType); ok {
+		return dt.BitWidth()
+	}
+	return 0
+}
+
+// substitutor applies type substitutions to the records loaded from an IPC
+// source.
+type substitutor struct {
+	mem    memory.Allocator
+	schema *arrow.Schema // substituted schema
+	types  []arrow.DataType
+	convs  []ConvertFunc // nil for fields read as is
+}
+
+// newSubstitutor returns the substitutor restoring the custom types of
+// schema and applying fn to its other fields, or nil if no field is
+// substituted.
+func newSubstitutor(mem memory.Allocator, schema *arrow.Schema, fn TypeSubstitution, custom []arrow.StorageProvider) *substitutor {
+	if fn == nil && len(custom) == 0 {
+		return nil
+	}
+
+	var (
+		fields = make([]arrow.Field, len(schema.Fields()))
+		s      = &substitutor{
+			mem:   mem,
+			types: make([]arrow.DataType, len(fields)),
+			convs: make([]ConvertFunc, len(fields)),
+		}
+		n = 0
+	)
+
+	for i, field := range schema.Fields() {
+		fields[i] = field
+		dt, conv, ok := customType(field, custom)
+		switch {
+		case ok:
+			fields[i].Metadata = dropKey(field.Metadata, kExtensionTypeKeyName)
+		case fn != nil:
+			dt, conv, ok = fn(field)
+		}
+		if !ok {
+			continue
+		}
+		fields[i].Type = dt
+		s.types[i] = dt
+		s.convs[i] = conv
+		n++
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	meta := schema.Metadata()
+	s.schema = arrow.NewSchema(fields, &meta)
+	return s
+}
+
+// customType returns the custom type of field, among types, and the function
+// wrapping its storage arrays.
+func customType(field arrow.Field, types []arrow.StorageProvider) (arrow.DataType, ConvertFunc, bool) {
+	i := field.Metadata.FindKey(kExtensionTypeKeyName)
+	if i < 0 {
+		return nil, nil, false
+	}
+	name := field.Metadata.Values()[i]
+	for _, dt := range types {
+		if dt.Name() != name || !arrow.TypeEqual(dt.StorageType(), field.Type) {
+			continue
+		}
+		dt := dt
+		conv := func(mem memory.Allocator, arr array.Interface) (array.Interface, error) {
+			return array.NewCustom(dt, arr), nil
+		}
+		return dt, conv, true
+	}
+	return nil, nil, false
+}
+
+// dropKey returns a copy of md without the key k.
+func dropKey(md arrow.Metadata, k string) arrow.Metadata {
+	var keys, vals []string
+	for i, key := range md.Keys() {
+		if key == k {
+			continue
+		}
+		keys = append(keys, key)
+		vals = append(vals, md.Values()[i])
+	}
+	return arrow.NewMetadata(keys, vals)
+}
+
+// apply converts the columns of the i-th record batch, rec.
+// apply releases rec.
+func (s *substitutor) apply(rec array.Record, i int) (array.Record, error) {
+	defer rec.Release()
+
+	cols := make([]array.Interface, 0, len(s.convs))
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+
+	for j, conv := range s.convs {
+		col := rec.Column(j)
+		if conv == nil {
+			col.Retain()
+			cols = append(cols, col)
+			continue
+		}
+
+		name := s.schema.Field(j).Name
+		out, err := conv(s.mem, col)
+		if err != nil {
+			return nil, xerrors.Errorf("arrow/ipc: could not convert field %q (index %d) of record batch %d: %w", name, j, i, err)
+		}
+		cols = append(cols, out)
+
+		switch {
+		case !arrow.TypeEqual(out.DataType(), s.types[j]):
+			return nil, xerrors.Errorf(
+				"arrow/ipc: invalid data type for converted field %q (index %d) of record batch %d (got=%v, want=%v)",
+				name, j, i, out.DataType(), s.types[j],
+			)
+		case out.Len() != col.Len():
+			return nil, xerrors.Errorf(
+				"arrow/ipc: invalid length for converted field %q (index %d) of record batch %d (got=%d, want=%d)",
+				name, j, i, out.Len(), col.Len(),
+			)
+		}
+	}
+
+	return array.NewRecord(s.schema, cols, rec.NumRows()), nil
+}
@@ -0,0 +1,377 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func makeSubstRecords(mem memory.Allocator) []array.Record {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ts", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+
+	var recs []array.Record
+	for i := 0; i < 2; i++ {
+		bld.Field(0).(*array.Int64Builder).AppendValues([]int64{int64(i), 10, 20}, nil)
+		bld.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "", "c"}, []bool{true, false, true})
+		bld.Field(2).(*array.Float64Builder).AppendValues([]float64{1, 2, 3}, nil)
+		recs = append(recs, bld.NewRecord())
+	}
+	return recs
+}
+
+func substitute(field arrow.Field) (arrow.DataType, ipc.ConvertFunc, bool) {
+	switch field.Name {
+	case "ts":
+		return arrow.FixedWidthTypes.Timestamp_ns, ipc.Reinterpret(arrow.FixedWidthTypes.Timestamp_ns), true
+	case "str":
+		return arrow.BinaryTypes.Binary, ipc.Reinterpret(arrow.BinaryTypes.Binary), true
+	}
+	return nil, nil, false
+}
+
+func TestTypeSubstitution(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeSubstRecords(mem)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ns},
+		{Name: "str", Type: arrow.BinaryTypes.Binary, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	check := func(t *testing.T, i int, rec array.Record) {
+		t.Helper()
+		if !rec.Schema().Equal(want) {
+			t.Fatalf("invalid record schema:\ngot:  %v\nwant: %v", rec.Schema(), want)
+		}
+		ts := rec.Column(0).(*array.Timestamp)
+		if got, want := int64(ts.Value(0)), int64(i); got != want {
+			t.Fatalf("invalid timestamp: got=%d, want=%d", got, want)
+		}
+		bin := rec.Column(1).(*array.Binary)
+		if got, want := string(bin.Value(2)), "c"; got != want || !bin.IsNull(1) {
+			t.Fatalf("invalid binary array: %v", bin)
+		}
+		if !array.ArrayEqual(rec.Column(2), recs[i].Column(2)) {
+			t.Fatalf("invalid float64 array: %v", rec.Column(2))
+		}
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeStream(t, &buf, mem, recs)
+
+		r, err := ipc.NewReader(&buf, ipc.WithAllocator(mem), ipc.WithTypeSubstitution(substitute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Release()
+
+		if !r.Schema().Equal(want) {
+			t.Fatalf("invalid schema:\ngot:  %v\nwant: %v", r.Schema(), want)
+		}
+
+		n := 0
+		for r.Next() {
+			check(t, n, r.Record())
+			n++
+		}
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if n != len(recs) {
+			t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		raw := writeFileBytes(t, mem, recs)
+		r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithTypeSubstitution(substitute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		if !r.Schema().Equal(want) {
+			t.Fatalf("invalid schema:\ngot:  %v\nwant: %v", r.Schema(), want)
+		}
+
+		for i := 0; i < r.NumRecords(); i++ {
+			rec, err := r.Record(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, i, rec)
+		}
+	})
+}
+
+func TestTypeSubstitutionError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeSubstRecords(mem)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	errBoom := errors.New("boom")
+	calls := 0
+	subst := func(field arrow.Field) (arrow.DataType, ipc.ConvertFunc, bool) {
+		if field.Name != "f64" {
+			return nil, nil, false
+		}
+		return field.Type, func(mem memory.Allocator, arr array.Interface) (array.Interface, error) {
+			calls++
+			if calls > 1 {
+				return nil, errBoom
+			}
+			arr.Retain()
+			return arr, nil
+		}, true
+	}
+
+	raw := writeFileBytes(t, mem, recs)
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithTypeSubstitution(subst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if _, err := r.Record(0); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = r.Record(1)
+	switch {
+	case err == nil:
+		t.Fatalf("expected an error")
+	case !strings.Contains(err.Error(), `field "f64" (index 2) of record batch 1`) || !errors.Is(err, errBoom):
+		t.Fatalf("invalid error: %v", err)
+	}
+}
+
+func TestReinterpretInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt32Builder(mem)
+	defer bld.Release()
+	bld.AppendValues([]int32{1, 2}, nil)
+	arr := bld.NewArray()
+	defer arr.Release()
+
+	out, err := ipc.Reinterpret(arrow.PrimitiveTypes.Int64)(mem, arr)
+	if err == nil {
+		out.Release()
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestConvertLists(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		{Name: "large", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	lb := bld.Field(0).(*array.ListBuilder)
+	llb := bld.Field(1).(*array.LargeListBuilder)
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+	llb.AppendNull()
+	llb.Append(true)
+	llb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{3, 4, 5}, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	subst := func(field arrow.Field) (arrow.DataType, ipc.ConvertFunc, bool) {
+		var dt arrow.DataType
+		switch t := field.Type.(type) {
+		case *arrow.ListType:
+			dt = arrow.LargeListOf(t.Elem())
+		case *arrow.LargeListType:
+			dt = arrow.ListOf(t.Elem())
+		default:
+			return nil, nil, false
+		}
+		return dt, ipc.Convert(dt), true
+	}
+
+	raw := writeFileBytes(t, mem, []array.Record{rec})
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithTypeSubstitution(subst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := r.Record(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := arrow.NewSchema([]arrow.Field{
+		{Name: "list", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		{Name: "large", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+	}, nil)
+	if !got.Schema().Equal(want) {
+		t.Fatalf("invalid record schema:\ngot:  %v\nwant: %v", got.Schema(), want)
+	}
+	for i, want := range []string{"[[1 2] (null)]", "[(null) [3 4 5]]"} {
+		if got := fmt.Sprint(got.Column(i)); got != want {
+			t.Fatalf("invalid column %d: got=%s, want=%s", i, got, want)
+		}
+	}
+}
+
+func TestConvertDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewDictionaryBuilder(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.BinaryTypes.String})
+	defer bld.Release()
+	for _, v := range []string{"x", "y", "x"} {
+		if err := bld.AppendString(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bld.AppendNull()
+	arr := bld.NewArray()
+	defer arr.Release()
+
+	out, err := ipc.Convert(arrow.BinaryTypes.String)(mem, arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Release()
+
+	if got, want := fmt.Sprint(out), `["x" "y" "x" (null)]`; got != want {
+		t.Fatalf("invalid array: got=%s, want=%s", got, want)
+	}
+
+	out, err = ipc.Convert(arrow.PrimitiveTypes.Int64)(mem, arr)
+	if err == nil {
+		out.Release()
+		t.Fatalf("expected an error")
+	}
+}
+
+// celsius is a custom data type holding temperatures as float64 values.
+type celsius struct{}
+
+func (celsius) ID() arrow.Type              { return arrow.EXTENSION }
+func (celsius) Name() string                { return "example.celsius" }
+func (celsius) String() string              { return "celsius" }
+func (celsius) StorageType() arrow.DataType { return arrow.PrimitiveTypes.Float64 }
+
+func TestCustomTypes(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "temp", Type: celsius{}, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	bld := array.NewRecordBuilder(mem, schema)
+	defer bld.Release()
+	bld.Field(0).(*array.CustomBuilder).StorageBuilder().(*array.Float64Builder).AppendValues([]float64{21.5, 0, -3}, []bool{true, false, true})
+	bld.Field(1).(*array.Float64Builder).AppendValues([]float64{1, 2, 3}, nil)
+	rec := bld.NewRecord()
+	defer rec.Release()
+
+	raw, err := ipc.WriteStreamBytes([]array.Record{rec}, ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("storage", func(t *testing.T) {
+		recs, got, err := ipc.ReadStreamBytes(raw, ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			for _, rec := range recs {
+				rec.Release()
+			}
+		}()
+
+		want := arrow.NewSchema([]arrow.Field{
+			{
+				Name: "temp", Type: arrow.PrimitiveTypes.Float64, Nullable: true,
+				Metadata: arrow.NewMetadata([]string{"arrow_extension_name"}, []string{"example.celsius"}),
+			},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+		}, nil)
+		if !got.Equal(want) {
+			t.Fatalf("invalid schema:\ngot:  %v\nwant: %v", got, want)
+		}
+		storage := rec.Column(0).(*array.Custom).Storage()
+		if !array.ArrayEqual(recs[0].Column(0), storage) {
+			t.Fatalf("invalid storage array:\ngot= %v\nwant=%v", recs[0].Column(0), storage)
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		recs, got, err := ipc.ReadStreamBytes(raw, ipc.WithAllocator(mem), ipc.WithCustomTypes(celsius{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			for _, rec := range recs {
+				rec.Release()
+			}
+		}()
+
+		if !got.Equal(schema) {
+			t.Fatalf("invalid schema:\ngot:  %v\nwant: %v", got, schema)
+		}
+		if !array.RecordEqual(recs[0], rec) {
+			t.Fatalf("invalid record:\ngot:\n%v\nwant:\n%v", recs[0], rec)
+		}
+	})
+}
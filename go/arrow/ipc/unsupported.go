@@ -0,0 +1,287 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"golang.org/x/xerrors"
+)
+
+// ErrUnsupportedType is matched by the errors of readers opening an IPC
+// source whose schema holds a data type this package does not implement.
+var ErrUnsupportedType = xerrors.New("arrow/ipc: unsupported data type")
+
+// OpaqueTypeKey is the key of the field metadata holding the original data
+// type of a field read with UnsupportedOpaque.
+// Writers write the Binary columns of such fields with their original data
+// type, so that sources holding unsupported data types may be passed
+// through.
+const OpaqueTypeKey = "ARROW:go:opaque_type"
+
+// UnsupportedFieldHandling specifies how readers handle the fields of an
+// IPC source whose data type is not implemented by this package.
+//
+// With UnsupportedSkip and UnsupportedOpaque, the buffers of unsupported
+// fields are located from the number of buffers of each record batch: at
+// most one field of a schema may be unsupported.
+type UnsupportedFieldHandling int
+
+const (
+	// UnsupportedError rejects sources holding unsupported fields, with an
+	// error matching ErrUnsupportedType. This is the default.
+	UnsupportedError UnsupportedFieldHandling = iota
+
+	// UnsupportedSkip drops unsupported fields from the schema and the
+	// records read from the source.
+	UnsupportedSkip
+
+	// UnsupportedOpaque exposes unsupported fields as Binary columns, holding
+	// the raw bytes of each element, and stashes their original data type
+	// under OpaqueTypeKey in the field metadata.
+	// Only top-level fields without children and with the layout of
+	// variable-size binary data (validity bitmap, 32-bit offsets and data)
+	// may be read as opaque. Reading the record batches of other unsupported
+	// fields fails with an error.
+	UnsupportedOpaque
+)
+
+// WithUnsupportedFieldHandling specifies how Readers, FileReaders and
+// FlightDataReaders handle the fields whose data type is not implemented by
+// this package. The default is UnsupportedError.
+func WithUnsupportedFieldHandling(mode UnsupportedFieldHandling) Option {
+	return func(cfg *config) {
+		cfg.unsupported = mode
+	}
+}
+
+// sourceLayout maps the fields of the schema of an IPC source holding
+// unsupported fields to the field nodes and buffers of its record batches.
+type sourceLayout struct {
+	fields []sourceField // fields of the source schema
+	known  int           // buffers of the supported fields
+}
+
+type sourceField struct {
+	dt     arrow.DataType // data type of a supported field, nil otherwise
+	name   string         // name of an unsupported field
+	nodes  int            // field nodes of an unsupported field
+	opaque bool           // whether an unsupported field is read as opaque
+}
+
+// schemaFromFBWith converts schema, handling its unsupported fields with
+// mode. The returned layout is nil if schema holds no unsupported field.
+func schemaFromFBWith(schema *flatbuf.Schema, memo *dictMemo, mode UnsupportedFieldHandling) (*arrow.Schema, *sourceLayout, error) {
+	if mode == UnsupportedError {
+		s, err := schemaFromFB(schema, memo)
+		return s, nil, err
+	}
+
+	var (
+		fields  = make([]arrow.Field, 0, schema.FieldsLength())
+		layout  = &sourceLayout{fields: make([]sourceField, schema.FieldsLength())}
+		unknown = 0
+	)
+
+	for i := range layout.fields {
+		var field flatbuf.Field
+		if !schema.Fields(&field, i) {
+			return nil, nil, xerrors.Errorf("arrow/ipc: could not read field %d from schema", i)
+		}
+
+		f, err := fieldFromFB(&field, memo)
+		switch {
+		case err == nil:
+			fields = append(fields, f)
+			layout.fields[i].dt = f.Type
+			layout.known += loadedBuffers(f.Type)
+			continue
+		case !xerrors.Is(err, ErrUnsupportedType):
+			return nil, nil, xerrors.Errorf("arrow/ipc: could not convert field %d from flatbuf: %w", i, err)
+		}
+
+		unknown++
+		layout.fields[i] = sourceField{name: string(field.Name()), nodes: countNodes(&field)}
+		if mode != UnsupportedOpaque {
+			continue
+		}
+
+		if field.ChildrenLength() != 0 || field.Dictionary(nil) != nil {
+			return nil, nil, xerrors.Errorf("arrow/ipc: could not read field %q as opaque: only fields without children are supported: %w", field.Name(), err)
+		}
+		f, err = opaqueFieldFromFB(&field)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("arrow/ipc: could not read field %q as opaque: %w", field.Name(), err)
+		}
+		fields = append(fields, f)
+		layout.fields[i].opaque = true
+	}
+
+	md, err := metadataFromFB(schema)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("arrow/ipc: could not convert schema metadata from flatbuf: %w", err)
+	}
+
+	if unknown == 0 {
+		layout = nil
+	}
+	return arrow.NewSchema(fields, &md), layout, nil
+}
+
+// countNodes returns the number of field nodes of the arrays of field.
+func countNodes(field *flatbuf.Field) int {
+	n := 1
+	for i := 0; i < field.ChildrenLength(); i++ {
+		var child flatbuf.Field
+		if field.Children(&child, i) {
+			n += countNodes(&child)
+		}
+	}
+	return n
+}
+
+// loadedBuffers returns the number of buffers consumed by the array loader
+// for an array of type dt.
+func loadedBuffers(dt arrow.DataType) int {
+	switch dt := dt.(type) {
+	case *arrow.NullType:
+		return 0
+	case *arrow.ListType:
+		return 2 + loadedBuffers(dt.Elem())
+	case *arrow.MapType:
+		return 2 + loadedBuffers(dt.Elem())
+	case *arrow.LargeListType:
+		return 2 + loadedBuffers(dt.Elem())
+	case *arrow.FixedSizeListType:
+		return 1 + loadedBuffers(dt.Elem())
+	case *arrow.StructType:
+		n := 1
+		for _, f := range dt.Fields() {
+			n += loadedBuffers(f.Type)
+		}
+		return n
+	case *arrow.FixedSizeBinaryType:
+		return 2
+	}
+	if arrow.IsBinaryLike(dt.ID()) {
+		return 3
+	}
+	return 2
+}
+
+// opaqueFieldFromFB returns the Binary field exposing the unsupported field,
+// with its original data type stashed in its metadata.
+//
+// The data type is stashed as the tail of the flatbuffer holding field,
+// starting at its type table: a table only references data written before
+// it, i.e. located after it in the flatbuffer. The table is copied as is
+// into the flatbuffers of writers, see opaqueTypeToFB.
+func opaqueFieldFromFB(field *flatbuf.Field) (arrow.Field, error) {
+	md, err := metadataFromFB(field)
+	if err != nil {
+		return arrow.Field{}, err
+	}
+
+	var tbl flatbuffers.Table
+	if !field.Type(&tbl) {
+		return arrow.Field{}, xerrors.Errorf("arrow/ipc: could not load field type data")
+	}
+	var (
+		pos   = int(tbl.Pos)
+		vtbl  = pos - int(tbl.GetSOffsetT(tbl.Pos))
+		start = pos
+	)
+	if vtbl < start {
+		start = vtbl
+	}
+	if start < 0 || vtbl+flatbuffers.SizeVOffsetT > len(tbl.Bytes) {
+		return arrow.Field{}, xerrors.Errorf("arrow/ipc: invalid type table")
+	}
+
+	stash := fmt.Sprintf("%d:%d:%s", field.TypeType(), pos-start, base64.StdEncoding.EncodeToString(tbl.Bytes[start:]))
+	return arrow.Field{
+		Name:     string(field.Name()),
+		Type:     arrow.BinaryTypes.Binary,
+		Nullable: field.Nullable(),
+		Metadata: appendMetadata(md, OpaqueTypeKey, stash),
+	}, nil
+}
+
+// opaqueTypeToFB writes the original data type stashed in the metadata of
+// an opaque field to b, and returns its type and the offset of its table.
+func opaqueTypeToFB(b *flatbuffers.Builder, stash string) (flatbuf.Type, flatbuffers.UOffsetT, error) {
+	toks := strings.SplitN(stash, ":", 3)
+	if len(toks) != 3 {
+		return 0, 0, xerrors.Errorf("arrow/ipc: invalid opaque type %q", stash)
+	}
+	typ, err := strconv.ParseUint(toks[0], 10, 8)
+	if err != nil {
+		return 0, 0, xerrors.Errorf("arrow/ipc: invalid opaque type id: %w", err)
+	}
+	pos, err := strconv.Atoi(toks[1])
+	if err != nil {
+		return 0, 0, xerrors.Errorf("arrow/ipc: invalid opaque type offset: %w", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(toks[2])
+	if err != nil {
+		return 0, 0, xerrors.Errorf("arrow/ipc: invalid opaque type data: %w", err)
+	}
+	if pos < 0 || pos >= len(raw) {
+		return 0, 0, xerrors.Errorf("arrow/ipc: invalid opaque type offset %d", pos)
+	}
+
+	// the copied bytes keep their alignment relative to the end of the
+	// flatbuffer, which was 0 for the original tail.
+	b.Prep(8, 0)
+	b.Prep(1, len(raw))
+	for i := len(raw) - 1; i >= 0; i-- {
+		b.PlaceByte(raw[i])
+	}
+	return flatbuf.Type(typ), b.Offset() - flatbuffers.UOffsetT(pos), nil
+}
+
+// opaqueType returns the stashed data type of field, if it was read with
+// UnsupportedOpaque.
+func opaqueType(field arrow.Field) (string, bool) {
+	if field.Type.ID() != arrow.BINARY {
+		return "", false
+	}
+	i := field.Metadata.FindKey(OpaqueTypeKey)
+	if i < 0 {
+		return "", false
+	}
+	return field.Metadata.Values()[i], true
+}
+
+// withoutKey returns a copy of md without key.
+func withoutKey(md arrow.Metadata, key string) arrow.Metadata {
+	var keys, vals []string
+	for i, k := range md.Keys() {
+		if k == key {
+			continue
+		}
+		keys = append(keys, k)
+		vals = append(vals, md.Values()[i])
+	}
+	return arrow.NewMetadata(keys, vals)
+}
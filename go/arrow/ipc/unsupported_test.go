@@ -0,0 +1,419 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"golang.org/x/xerrors"
+)
+
+// unknownType is a type id no version of the Arrow format defines.
+const unknownType = flatbuf.Type(99)
+
+// retypeFields changes the type id of the named fields of schema to typ.
+func retypeFields(t *testing.T, schema *flatbuf.Schema, typ flatbuf.Type, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		found := false
+		for i := 0; i < schema.FieldsLength(); i++ {
+			var field flatbuf.Field
+			if !schema.Fields(&field, i) {
+				t.Fatalf("could not load field %d", i)
+			}
+			if string(field.Name()) != name {
+				continue
+			}
+			if !field.MutateTypeType(byte(typ)) {
+				t.Fatalf("could not retype field %q", name)
+			}
+			found = true
+		}
+		if !found {
+			t.Fatalf("no field %q", name)
+		}
+	}
+}
+
+// retypeStream returns a copy of the stream raw, whose schema message
+// declares the named fields with the type id typ.
+func retypeStream(t *testing.T, raw []byte, typ flatbuf.Type, names ...string) []byte {
+	t.Helper()
+	raw = append([]byte(nil), raw...)
+	n := binary.LittleEndian.Uint32(raw[4:])
+	msg := flatbuf.GetRootAsMessage(raw[8:8+n], 0)
+	if msg.HeaderType() != flatbuf.MessageHeaderSchema {
+		t.Fatalf("invalid first message %v", msg.HeaderType())
+	}
+	var schema flatbuf.Schema
+	initTable(t, &schema, msg.Header)
+	retypeFields(t, &schema, typ, names...)
+	return raw
+}
+
+// retypeFile returns a copy of the file raw, whose footer declares the
+// named fields with the type id typ.
+func retypeFile(t *testing.T, raw []byte, typ flatbuf.Type, names ...string) []byte {
+	t.Helper()
+	raw = append([]byte(nil), raw...)
+	end := len(raw) - len(ipc.Magic) - 4
+	n := int(binary.LittleEndian.Uint32(raw[end:]))
+	footer := flatbuf.GetRootAsFooter(raw[end-n:end], 0)
+	retypeFields(t, footer.Schema(nil), typ, names...)
+	return raw
+}
+
+func initTable(t *testing.T, v interface {
+	Init([]byte, flatbuffers.UOffsetT)
+}, f func(*flatbuffers.Table) bool) {
+	t.Helper()
+	var tbl flatbuffers.Table
+	if !f(&tbl) {
+		t.Fatalf("could not load table")
+	}
+	v.Init(tbl.Bytes, tbl.Pos)
+}
+
+// makeUnsupportedRecords returns records with an int32 field "a", a binary
+// field "u" and a string field "b".
+func makeUnsupportedRecords(mem memory.Allocator) []array.Record {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "u", Type: arrow.BinaryTypes.Binary, Nullable: true},
+		{Name: "b", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	var recs []array.Record
+	for _, chunk := range []struct {
+		a []int32
+		u [][]byte
+		b []string
+	}{
+		{[]int32{1, 2, 3}, [][]byte{[]byte("x"), nil, []byte("yz")}, []string{"a", "bc", "def"}},
+		{[]int32{4, 5}, [][]byte{nil, []byte("tuvw")}, []string{"", "g"}},
+	} {
+		b.Field(0).(*array.Int32Builder).AppendValues(chunk.a, []bool{true, false, true}[:len(chunk.a)])
+		for _, v := range chunk.u {
+			if v == nil {
+				b.Field(1).AppendNull()
+				continue
+			}
+			b.Field(1).(*array.BinaryBuilder).Append(v)
+		}
+		b.Field(2).(*array.StringBuilder).AppendValues(chunk.b, nil)
+		recs = append(recs, b.NewRecord())
+	}
+	return recs
+}
+
+func releaseRecords(recs []array.Record) {
+	for _, rec := range recs {
+		rec.Release()
+	}
+}
+
+// unsupportedSources returns the records of makeUnsupportedRecords written
+// as a stream and as a file, whose schema declares the field "u" with an
+// unknown type.
+func unsupportedSources(t *testing.T, mem memory.Allocator, recs []array.Record) map[string]func(...ipc.Option) (recordReader, error) {
+	var (
+		stream = retypeStream(t, writeStreamBytes(t, mem, recs), unknownType, "u")
+		file   = retypeFile(t, writeFileBytes(t, mem, recs), unknownType, "u")
+	)
+	return map[string]func(...ipc.Option) (recordReader, error){
+		"stream": func(opts ...ipc.Option) (recordReader, error) {
+			return ipc.NewReader(bytes.NewReader(stream), append(opts, ipc.WithAllocator(mem))...)
+		},
+		"file": func(opts ...ipc.Option) (recordReader, error) {
+			r, err := ipc.NewFileReader(bytes.NewReader(file), append(opts, ipc.WithAllocator(mem))...)
+			if err != nil {
+				return nil, err
+			}
+			return &fileRecordReader{FileReader: r}, nil
+		},
+	}
+}
+
+type recordReader interface {
+	array.RecordReader
+	Err() error
+}
+
+// fileRecordReader iterates over the records of a FileReader.
+type fileRecordReader struct {
+	*ipc.FileReader
+	rec array.Record
+	err error
+}
+
+func (r *fileRecordReader) Retain() {}
+
+func (r *fileRecordReader) Release() { r.Close() }
+
+func (r *fileRecordReader) Next() bool {
+	r.rec, r.err = r.FileReader.Read()
+	return r.err == nil
+}
+
+func (r *fileRecordReader) Record() array.Record { return r.rec }
+
+func (r *fileRecordReader) Err() error {
+	if r.err == io.EOF {
+		return nil
+	}
+	return r.err
+}
+
+func TestUnsupportedFieldError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeUnsupportedRecords(mem)
+	defer releaseRecords(recs)
+
+	for name, open := range unsupportedSources(t, mem, recs) {
+		t.Run(name, func(t *testing.T) {
+			_, err := open()
+			if !xerrors.Is(err, ipc.ErrUnsupportedType) {
+				t.Fatalf("invalid error: got=%v, want=%v", err, ipc.ErrUnsupportedType)
+			}
+		})
+	}
+}
+
+func TestUnsupportedFieldSkip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeUnsupportedRecords(mem)
+	defer releaseRecords(recs)
+
+	want := arrow.NewSchema([]arrow.Field{recs[0].Schema().Field(0), recs[0].Schema().Field(2)}, nil)
+	for name, open := range unsupportedSources(t, mem, recs) {
+		t.Run(name, func(t *testing.T) {
+			r, err := open(ipc.WithUnsupportedFieldHandling(ipc.UnsupportedSkip))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			if !r.Schema().Equal(want) {
+				t.Fatalf("invalid schema:\ngot= %v\nwant=%v", r.Schema(), want)
+			}
+
+			n := 0
+			for r.Next() {
+				rec := r.Record()
+				for i, j := range []int{0, 2} {
+					if !array.ArrayEqual(rec.Column(i), recs[n].Column(j)) {
+						t.Fatalf("record %d: invalid column %q:\ngot= %v\nwant=%v", n, rec.ColumnName(i), rec.Column(i), recs[n].Column(j))
+					}
+				}
+				n++
+			}
+			if err := r.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if n != len(recs) {
+				t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+			}
+		})
+	}
+}
+
+func TestUnsupportedFieldOpaque(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeUnsupportedRecords(mem)
+	defer releaseRecords(recs)
+
+	for name, open := range unsupportedSources(t, mem, recs) {
+		t.Run(name, func(t *testing.T) {
+			r, err := open(ipc.WithUnsupportedFieldHandling(ipc.UnsupportedOpaque))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			field := r.Schema().Field(1)
+			if field.Name != "u" || !arrow.TypeEqual(field.Type, arrow.BinaryTypes.Binary) || field.Metadata.FindKey(ipc.OpaqueTypeKey) < 0 {
+				t.Fatalf("invalid opaque field %v", field)
+			}
+
+			// pass the records through a writer.
+			out := new(bytes.Buffer)
+			w := ipc.NewWriter(out, ipc.WithSchema(r.Schema()), ipc.WithAllocator(mem))
+			n := 0
+			for r.Next() {
+				rec := r.Record()
+				for i := range recs[n].Columns() {
+					if !array.ArrayEqual(rec.Column(i), recs[n].Column(i)) {
+						t.Fatalf("record %d: invalid column %q:\ngot= %v\nwant=%v", n, rec.ColumnName(i), rec.Column(i), recs[n].Column(i))
+					}
+				}
+				if err := w.Write(rec); err != nil {
+					t.Fatal(err)
+				}
+				n++
+			}
+			if err := r.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if n != len(recs) {
+				t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+			}
+
+			// the written stream declares the unknown type.
+			_, err = ipc.NewReader(bytes.NewReader(out.Bytes()), ipc.WithAllocator(mem))
+			if !xerrors.Is(err, ipc.ErrUnsupportedType) {
+				t.Fatalf("invalid error: got=%v, want=%v", err, ipc.ErrUnsupportedType)
+			}
+
+			// once the type is known, the original records are read back.
+			rr, err := ipc.NewReader(
+				bytes.NewReader(retypeStream(t, out.Bytes(), flatbuf.TypeBinary, "u")),
+				ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rr.Release()
+
+			n = 0
+			for rr.Next() {
+				if !array.RecordEqual(rr.Record(), recs[n]) {
+					t.Fatalf("record %d: invalid round trip", n)
+				}
+				n++
+			}
+			if err := rr.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if n != len(recs) {
+				t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+			}
+		})
+	}
+}
+
+func TestUnsupportedFieldOpaqueTypeTable(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "u", Type: &arrow.FixedSizeBinaryType{ByteWidth: 7}, Nullable: true},
+	}, nil)
+	raw := new(bytes.Buffer)
+	w := ipc.NewWriter(raw, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(
+		bytes.NewReader(retypeStream(t, raw.Bytes(), unknownType, "u")),
+		ipc.WithUnsupportedFieldHandling(ipc.UnsupportedOpaque), ipc.WithAllocator(mem),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	out := new(bytes.Buffer)
+	w = ipc.NewWriter(out, ipc.WithSchema(r.Schema()), ipc.WithAllocator(mem))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the type table of the field, holding its byte width, is written back.
+	rr, err := ipc.NewReader(
+		bytes.NewReader(retypeStream(t, out.Bytes(), flatbuf.TypeFixedSizeBinary, "u")),
+		ipc.WithAllocator(mem),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rr.Release()
+
+	if !rr.Schema().Equal(schema) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", rr.Schema(), schema)
+	}
+}
+
+func TestUnsupportedFieldOpaqueLayout(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeUnsupportedRecords(mem)
+	defer releaseRecords(recs)
+
+	// fixed-size layouts cannot be read as opaque.
+	raw := retypeStream(t, writeStreamBytes(t, mem, recs), unknownType, "a")
+	r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithUnsupportedFieldHandling(ipc.UnsupportedOpaque), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if r.Next() {
+		t.Fatalf("record read with an invalid opaque field")
+	}
+	if err := r.Err(); !xerrors.Is(err, ipc.ErrUnsupportedType) {
+		t.Fatalf("invalid error: got=%v, want=%v", err, ipc.ErrUnsupportedType)
+	}
+}
+
+func TestUnsupportedFieldSkipMany(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := makeUnsupportedRecords(mem)
+	defer releaseRecords(recs)
+
+	raw := retypeStream(t, writeStreamBytes(t, mem, recs), unknownType, "a", "u")
+	r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithUnsupportedFieldHandling(ipc.UnsupportedSkip), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if got, want := len(r.Schema().Fields()), 1; got != want {
+		t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+	}
+	if r.Next() {
+		t.Fatalf("record read with several unsupported fields")
+	}
+	if r.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+}
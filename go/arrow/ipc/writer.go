@@ -23,13 +23,17 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
 	"github.com/apache/arrow/go/arrow/memory"
+	flatbuffers "github.com/google/flatbuffers/go"
 	"golang.org/x/xerrors"
 )
 
 type swriter struct {
 	w   io.Writer
 	pos int64
+
+	scratch [4]byte // staging space for message prefixes
 }
 
 func (w *swriter) start() error { return nil }
@@ -39,7 +43,7 @@ func (w *swriter) Close() error {
 }
 
 func (w *swriter) write(p payload) error {
-	_, err := writeIPCPayload(w, p)
+	_, err := writeIPCPayload(w, p, w.scratch[:])
 	if err != nil {
 		return err
 	}
@@ -58,25 +62,63 @@ type Writer struct {
 
 	mem memory.Allocator
 	pw  payloadWriter
+	enc *recordEncoder // reused across calls to Write
+
+	policy EncodingPolicy
+	enf    *enforcer
 
-	started bool
-	schema  *arrow.Schema
+	maxDepth int // maximum nesting depth of the schema
+	metrics  MetricsHandler
+	stats    bool // whether record batch messages hold statistics
+	arrMeta  bool // whether the schema holds the metadata of the first record
+
+	started  bool
+	schema   *arrow.Schema
+	prepared *PreparedSchema
 }
 
 // NewWriter returns a writer that writes records to the provided output stream.
+//
+// If no schema is provided via WithSchema, the writer binds to the schema of
+// the first record written to it.
 func NewWriter(w io.Writer, opts ...Option) *Writer {
 	cfg := newConfig(opts...)
+	enc := newWriterEncoder(cfg)
+	if cfg.checksum {
+		enc.checksum = messageChecksum
+	}
 	return &Writer{
 		w:      w,
 		mem:    cfg.alloc,
 		pw:     &swriter{w: w},
+		enc:    enc,
+		policy: cfg.encoding,
 		schema: cfg.schema,
+
+		maxDepth: cfg.maxDepth,
+		metrics:  cfg.metrics,
+		stats:    cfg.stats,
+		arrMeta:  cfg.arrMeta,
+		prepared: cfg.prepared,
 	}
 }
 
+// Schema returns the schema of the records written to the stream.
+// Schema returns nil if the writer was created without a schema and no
+// record has been written yet.
+func (w *Writer) Schema() *arrow.Schema { return w.schema }
+
 func (w *Writer) Close() error {
+	// no record is encoded once the writer is closed: the scratch space of
+	// the encoder is released first, whether or not the stream can be
+	// completed.
+	w.enc.Release()
+
 	if !w.started {
-		err := w.start()
+		if w.schema == nil {
+			return errNoSchema
+		}
+		err := w.start(nil)
 		if err != nil {
 			return err
 		}
@@ -96,41 +138,105 @@ func (w *Writer) Close() error {
 }
 
 func (w *Writer) Write(rec array.Record) error {
+	if err := checkRetained(rec); err != nil {
+		return err
+	}
+	start := startTimer(w.metrics)
+	schema := rec.Schema()
+	if w.schema == nil {
+		w.schema = schema
+	}
+
+	if !conforms(schema, w.schema, w.policy) {
+		return errInconsistentSchema
+	}
+
 	if !w.started {
-		err := w.start()
+		err := w.start(rec)
 		if err != nil {
 			return err
 		}
 	}
 
-	schema := rec.Schema()
-	if schema == nil || !schema.Equal(w.schema) {
-		return errInconsistentSchema
+	if w.enf != nil {
+		var err error
+		rec, err = w.enf.apply(rec)
+		if err != nil {
+			return err
+		}
+		defer rec.Release()
 	}
 
-	const allow64b = true
-	var (
-		data = payload{msg: MessageRecordBatch}
-		enc  = newRecordEncoder(w.mem, 0, kMaxNestingDepth, allow64b)
-	)
-	defer data.Release()
+	w.enc.custom = arrow.Metadata{}
+	if w.stats {
+		md, err := batchStatsMetadata(rec)
+		if err != nil {
+			return err
+		}
+		w.enc.custom = md
+	}
 
-	if err := enc.Encode(&data, rec); err != nil {
+	data := w.enc.payload()
+	defer w.enc.release(&data)
+
+	if err := w.enc.Encode(&data, rec); err != nil {
 		return xerrors.Errorf("arrow/ipc: could not encode record to payload: %w", err)
 	}
 
-	return w.pw.write(data)
+	if err := w.pw.write(data); err != nil {
+		return err
+	}
+	recordWritten(w.metrics, rec.NumRows(), data.size, start)
+	return nil
+}
+
+// checkRetained returns an error if some columns of rec have been released
+// with ReleaseColumn.
+func checkRetained(rec array.Record) error {
+	idx := rec.RetainedColumns()
+	if int64(len(idx)) == rec.NumCols() {
+		return nil
+	}
+
+	var released []int
+	for i, j := 0, 0; i < int(rec.NumCols()); i++ {
+		if j < len(idx) && idx[j] == i {
+			j++
+			continue
+		}
+		released = append(released, i)
+	}
+	return xerrors.Errorf("arrow/ipc: cannot write record with released columns %v", released)
 }
 
-func (w *Writer) start() error {
+// start writes the schema.
+// rec is the first record written, if any.
+func (w *Writer) start(rec array.Record) error {
+	if err := checkNesting(w.schema, w.maxDepth); err != nil {
+		return xerrors.Errorf("arrow/ipc: invalid schema: %w", err)
+	}
+
+	enf, err := newEnforcer(w.mem, w.schema, w.policy)
+	if err != nil {
+		return err
+	}
+	w.enf = enf
 	w.started = true
 
+	schema := w.schema
+	if enf != nil {
+		schema = enf.schema
+	}
+	if w.arrMeta {
+		schema = withArrayMetadata(schema, rec)
+	}
+
 	// write out schema payloads
-	ps := payloadsFromSchema(w.schema, w.mem, nil)
+	ps := schemaPayloads(schema, w.mem, w.prepared)
 	defer ps.Release()
 
 	for _, data := range ps {
-		err := w.pw.write(data)
+		err = w.pw.write(data)
 		if err != nil {
 			return err
 		}
@@ -148,6 +254,17 @@ type recordEncoder struct {
 	depth    int64
 	start    int64
 	allow64b bool
+
+	custom arrow.Metadata // custom metadata of the record batch message
+
+	checksum checksumMode // whether, and where, to store the checksum of the body
+	crc      uint32       // checksum of the last encoded body
+
+	// scratch space of encoders reused across record batches.
+	// b is nil for single-use encoders.
+	b    *flatbuffers.Builder
+	buf  *memory.Buffer   // staging buffer for the encoded metadata
+	body []*memory.Buffer // backing array of the payload bodies
 }
 
 func newRecordEncoder(mem memory.Allocator, startOffset, maxDepth int64, allow64b bool) *recordEncoder {
@@ -159,7 +276,43 @@ func newRecordEncoder(mem memory.Allocator, startOffset, maxDepth int64, allow64
 	}
 }
 
+// newWriterEncoder returns an encoder meant to be reused across the record
+// batches of a Writer or FileWriter.
+// The encoder must be released once the writer is closed.
+func newWriterEncoder(cfg *config) *recordEncoder {
+	const allow64b = true
+	enc := newRecordEncoder(cfg.alloc, 0, int64(cfg.maxDepth), allow64b)
+	enc.b = flatbuffers.NewBuilder(cfg.bufSize)
+	enc.buf = memory.NewResizableBuffer(cfg.alloc)
+	enc.buf.Reserve(cfg.bufSize)
+	return enc
+}
+
+// payload returns an empty record batch payload, backed by the scratch
+// space of the encoder.
+func (w *recordEncoder) payload() payload {
+	return payload{msg: MessageRecordBatch, body: w.body[:0]}
+}
+
+// release releases the buffers of a payload created with w.payload and
+// recycles its body.
+func (w *recordEncoder) release(p *payload) {
+	p.Release()
+	w.body = p.body[:0]
+}
+
+// Release releases the scratch space of the encoder.
+// The encoder may still be used afterwards, as a single-use one.
+func (w *recordEncoder) Release() {
+	if w.buf != nil {
+		w.buf.Release()
+		w.buf = nil
+	}
+	w.b = nil
+}
+
 func (w *recordEncoder) Encode(p *payload, rec array.Record) error {
+	w.fields = w.fields[:0]
 
 	// perform depth-first traversal of the row-batch
 	for i, col := range rec.Columns() {
@@ -172,7 +325,10 @@ func (w *recordEncoder) Encode(p *payload, rec array.Record) error {
 	// position for the start of a buffer relative to the passed frame of reference.
 	// may be 0 or some other position in an address space.
 	offset := w.start
-	w.meta = make([]bufferMetadata, len(p.body))
+	if cap(w.meta) < len(p.body) {
+		w.meta = make([]bufferMetadata, len(p.body))
+	}
+	w.meta = w.meta[:len(p.body)]
 
 	// construct the metadata for the record batch header
 	for i, buf := range p.body {
@@ -197,6 +353,13 @@ func (w *recordEncoder) Encode(p *payload, rec array.Record) error {
 		panic("not aligned")
 	}
 
+	if w.checksum != noChecksum {
+		w.crc = bodyChecksum(p.body)
+		if w.checksum == messageChecksum {
+			w.custom = appendMetadata(w.custom, kBatchChecksumKey, formatChecksum(w.crc))
+		}
+	}
+
 	return w.encodeMetadata(p, rec.NumRows())
 }
 
@@ -205,10 +368,18 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 		return errMaxRecursion
 	}
 
+	if arr, ok := arr.(*array.Custom); ok {
+		return w.visit(p, arr.Storage())
+	}
+
 	if !w.allow64b && arr.Len() > math.MaxInt32 {
 		return errBigArray
 	}
 
+	if err := validateBuffers(arr); err != nil {
+		return err
+	}
+
 	// add all common elements
 	w.fields = append(w.fields, fieldMetadata{
 		Len:    int64(arr.Len()),
@@ -257,76 +428,32 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 			// non-zero offset: slice the buffer
 			offset := int64(data.Offset()) * typeWidth
 			// send padding if available
-			len := minI64(bitutil.CeilByte64(arrLen*typeWidth), int64(data.Len())-offset)
-			data = array.NewSliceData(data, offset, offset+len)
-			defer data.Release()
-			values = data.Buffers()[1]
-		}
-		if values != nil {
+			len := minI64(bitutil.CeilByte64(arrLen*typeWidth), int64(values.Len())-offset)
+			values = memory.NewBufferBytes(values.Bytes()[offset : offset+len])
+		case values != nil:
 			values.Retain()
 		}
 		p.body = append(p.body, values)
 
-	case *arrow.BinaryType:
-		arr := arr.(*array.Binary)
-		voffsets, err := w.getZeroBasedValueOffsets(arr)
-		if err != nil {
-			return xerrors.Errorf("could not retrieve zero-based value offsets from %T: %w", arr, err)
-		}
-		data := arr.Data()
-		values := data.Buffers()[2]
-
-		var totalDataBytes int64
-		if voffsets != nil {
-			totalDataBytes = int64(len(arr.ValueBytes()))
-		}
-
-		switch {
-		case needTruncate(int64(data.Offset()), values, totalDataBytes):
-			// slice data buffer to include the range we need now.
-			var (
-				beg = int64(arr.ValueOffset(0))
-				len = minI64(paddedLength(totalDataBytes, kArrowAlignment), int64(data.Len())-beg)
-			)
-			data = array.NewSliceData(data, beg, beg+len)
-			defer data.Release()
-			values = data.Buffers()[2]
-		default:
-			if values != nil {
-				values.Retain()
-			}
-		}
-		p.body = append(p.body, voffsets)
-		p.body = append(p.body, values)
-
-	case *arrow.StringType:
-		arr := arr.(*array.String)
+	case arrow.BinaryDataType:
 		voffsets, err := w.getZeroBasedValueOffsets(arr)
 		if err != nil {
 			return xerrors.Errorf("could not retrieve zero-based value offsets from %T: %w", arr, err)
 		}
-		data := arr.Data()
-		values := data.Buffers()[2]
-
-		var totalDataBytes int64
-		if voffsets != nil {
-			totalDataBytes = int64(arr.ValueOffset(arr.Len()) - arr.ValueOffset(0))
-		}
+		var (
+			data    = arr.Data()
+			values  = data.Buffers()[2]
+			offsets = arrow.Int32Traits.CastFromBytes(data.Buffers()[1].Bytes())
+			beg     = int64(offsets[data.Offset()])
+			end     = int64(offsets[data.Offset()+data.Len()])
+		)
 
 		switch {
-		case needTruncate(int64(data.Offset()), values, totalDataBytes):
+		case needTruncate(beg, values, end-beg):
 			// slice data buffer to include the range we need now.
-			var (
-				beg = int64(arr.ValueOffset(0))
-				len = minI64(paddedLength(totalDataBytes, kArrowAlignment), int64(data.Len())-beg)
-			)
-			data = array.NewSliceData(data, beg, beg+len)
-			defer data.Release()
-			values = data.Buffers()[2]
-		default:
-			if values != nil {
-				values.Retain()
-			}
+			values = memory.NewBufferBytes(values.Bytes()[beg:end])
+		case values != nil:
+			values.Retain()
 		}
 		p.body = append(p.body, voffsets)
 		p.body = append(p.body, values)
@@ -342,8 +469,8 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 		}
 		w.depth++
 
-	case *arrow.ListType:
-		arr := arr.(*array.List)
+	case *arrow.ListType, *arrow.MapType:
+		arr := listArray(arr)
 		voffsets, err := w.getZeroBasedValueOffsets(arr)
 		if err != nil {
 			return xerrors.Errorf("could not retrieve zero-based value offsets for array %T: %w", arr, err)
@@ -352,26 +479,44 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 
 		w.depth--
 		var (
-			values        = arr.ListValues()
-			mustRelease   = false
-			values_offset int64
-			values_length int64
+			values  = arr.ListValues()
+			offsets = arr.Offsets()
+			beg     = int64(offsets[arr.Offset()])
+			end     = int64(offsets[arr.Offset()+arr.Len()])
 		)
-		defer func() {
-			if mustRelease {
-				values.Release()
-			}
-		}()
 
-		if voffsets != nil {
-			values_offset = int64(arr.Offsets()[0])
-			values_length = int64(arr.Offsets()[arr.Len()]) - values_offset
+		if beg != 0 || end < int64(values.Len()) {
+			// must also slice the values
+			values = array.NewSlice(values, beg, end)
+			defer values.Release()
+		}
+		err = w.visit(p, values)
+
+		if err != nil {
+			return xerrors.Errorf("could not visit list element for array %T: %w", arr, err)
+		}
+		w.depth++
+
+	case *arrow.LargeListType:
+		arr := arr.(*array.LargeList)
+		voffsets, err := w.getZeroBasedValueOffsets64(arr)
+		if err != nil {
+			return xerrors.Errorf("could not retrieve zero-based value offsets for array %T: %w", arr, err)
 		}
+		p.body = append(p.body, voffsets)
+
+		w.depth--
+		var (
+			values  = arr.ListValues()
+			offsets = arr.Offsets()
+			beg     = offsets[arr.Offset()]
+			end     = offsets[arr.Offset()+arr.Len()]
+		)
 
-		if len(arr.Offsets()) != 0 || values_length < int64(values.Len()) {
+		if beg != 0 || end < int64(values.Len()) {
 			// must also slice the values
-			values = array.NewSlice(values, values_offset, values_length)
-			mustRelease = true
+			values = array.NewSlice(values, beg, end)
+			defer values.Release()
 		}
 		err = w.visit(p, values)
 
@@ -406,40 +551,228 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 	return nil
 }
 
+// validateBuffers checks that the buffers of arr are large enough for its
+// length, offset and data type.
+// Only O(1) size comparisons are performed: buffer contents, beyond the
+// last value offset, are not inspected.
+func validateBuffers(arr array.Interface) error {
+	var (
+		data = arr.Data()
+		bufs = data.Buffers()
+		n    = int64(data.Offset() + data.Len())
+	)
+
+	if data.Len() == 0 {
+		return nil
+	}
+
+	if arr.NullN() > 0 && arr.DataType().ID() != arrow.NULL {
+		if err := checkBufferLen("validity bitmap", bufs, 0, bitutil.BytesForBits(n)); err != nil {
+			return err
+		}
+	}
+
+	// checkOffsets checks the offsets buffer and returns the last offset.
+	checkOffsets := func() (int64, error) {
+		if err := checkBufferLen("offsets", bufs, 1, (n+1)*int64(arrow.Int32SizeBytes)); err != nil {
+			return 0, err
+		}
+		offsets := arrow.Int32Traits.CastFromBytes(bufs[1].Bytes())
+		return int64(offsets[n]), nil
+	}
+
+	switch dt := arr.DataType().(type) {
+	case *arrow.NullType:
+		// no buffers.
+
+	case arrow.FixedWidthDataType:
+		return checkBufferLen("values", bufs, 1, bitutil.BytesForBits(n*int64(dt.BitWidth())))
+
+	case arrow.BinaryDataType:
+		last, err := checkOffsets()
+		if err != nil {
+			return err
+		}
+		return checkBufferLen("data", bufs, 2, last)
+
+	case *arrow.ListType, *arrow.MapType:
+		last, err := checkOffsets()
+		if err != nil {
+			return err
+		}
+		if got := int64(listArray(arr).ListValues().Len()); got < last {
+			return xerrors.Errorf("arrow/ipc: list values too short (got=%d, want>=%d)", got, last)
+		}
+
+	case *arrow.LargeListType:
+		if err := checkBufferLen("offsets", bufs, 1, (n+1)*int64(arrow.Int64SizeBytes)); err != nil {
+			return err
+		}
+		last := arrow.Int64Traits.CastFromBytes(bufs[1].Bytes())[n]
+		if got := int64(arr.(*array.LargeList).ListValues().Len()); got < last {
+			return xerrors.Errorf("arrow/ipc: list values too short (got=%d, want>=%d)", got, last)
+		}
+
+	case *arrow.FixedSizeListType:
+		want := n * int64(dt.Len())
+		if got := int64(arr.(*array.FixedSizeList).ListValues().Len()); got < want {
+			return xerrors.Errorf("arrow/ipc: fixed-size list values too short (got=%d, want>=%d)", got, want)
+		}
+
+	case *arrow.StructType:
+		arr := arr.(*array.Struct)
+		for i := 0; i < arr.NumField(); i++ {
+			if got, want := arr.Field(i).Len(), arr.Len(); got < want {
+				return xerrors.Errorf("arrow/ipc: struct field %q too short (got=%d, want>=%d)", dt.Field(i).Name, got, want)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkBufferLen(name string, bufs []*memory.Buffer, i int, want int64) error {
+	var got int64
+	if i < len(bufs) && bufs[i] != nil {
+		got = int64(bufs[i].Len())
+	}
+	if got < want {
+		return xerrors.Errorf("arrow/ipc: %s buffer too short (got=%d bytes, want>=%d)", name, got, want)
+	}
+	return nil
+}
+
+// getZeroBasedValueOffsets returns the Len()+1 offsets of the variable-width
+// array arr, shifted so that the first one is zero.
+// Empty arrays have a single zero offset.
+// listArray returns the List array a List or Map array is laid out as.
+func listArray(arr array.Interface) *array.List {
+	if arr, ok := arr.(*array.Map); ok {
+		return arr.List
+	}
+	return arr.(*array.List)
+}
+
 func (w *recordEncoder) getZeroBasedValueOffsets(arr array.Interface) (*memory.Buffer, error) {
-	data := arr.Data()
-	voffsets := data.Buffers()[1]
-	if data.Offset() != 0 {
-		// FIXME(sbinet): writer.cc:231
-		panic(xerrors.Errorf("not implemented offset=%d", data.Offset()))
+	var (
+		data     = arr.Data()
+		voffsets = data.Buffers()[1]
+		beg      = data.Offset() * arrow.Int32SizeBytes
+		end      = beg + (data.Len()+1)*arrow.Int32SizeBytes
+	)
+	if voffsets == nil || voffsets.Len() < end {
+		return nil, xerrors.Errorf("arrow/ipc: offsets buffer too short (got=%d bytes, want>=%d)", bufferLen(voffsets), end)
+	}
+
+	raw := voffsets.Bytes()[beg:end]
+	offsets := arrow.Int32Traits.CastFromBytes(raw)
+	if offsets[0] == 0 {
+		if beg == 0 && end == voffsets.Len() {
+			voffsets.Retain()
+			return voffsets, nil
+		}
+		return memory.NewBufferBytes(raw), nil
+	}
+
+	buf := memory.NewResizableBuffer(w.mem)
+	buf.Resize(len(raw))
+	out := arrow.Int32Traits.CastFromBytes(buf.Bytes())
+	for i, v := range offsets {
+		out[i] = v - offsets[0]
+	}
+	return buf, nil
+}
+
+// getZeroBasedValueOffsets64 is like getZeroBasedValueOffsets, for the
+// 64-bit offsets of LargeList arrays.
+func (w *recordEncoder) getZeroBasedValueOffsets64(arr array.Interface) (*memory.Buffer, error) {
+	var (
+		data     = arr.Data()
+		voffsets = data.Buffers()[1]
+		beg      = data.Offset() * arrow.Int64SizeBytes
+		end      = beg + (data.Len()+1)*arrow.Int64SizeBytes
+	)
+	if voffsets == nil || voffsets.Len() < end {
+		return nil, xerrors.Errorf("arrow/ipc: offsets buffer too short (got=%d bytes, want>=%d)", bufferLen(voffsets), end)
 	}
-	if voffsets == nil || voffsets.Len() == 0 {
-		return nil, nil
+
+	raw := voffsets.Bytes()[beg:end]
+	offsets := arrow.Int64Traits.CastFromBytes(raw)
+	if offsets[0] == 0 {
+		if beg == 0 && end == voffsets.Len() {
+			voffsets.Retain()
+			return voffsets, nil
+		}
+		return memory.NewBufferBytes(raw), nil
 	}
 
-	voffsets.Retain()
-	return voffsets, nil
+	buf := memory.NewResizableBuffer(w.mem)
+	buf.Resize(len(raw))
+	out := arrow.Int64Traits.CastFromBytes(buf.Bytes())
+	for i, v := range offsets {
+		out[i] = v - offsets[0]
+	}
+	return buf, nil
+}
+
+func bufferLen(buf *memory.Buffer) int {
+	if buf == nil {
+		return 0
+	}
+	return buf.Len()
 }
 
 func (w *recordEncoder) encodeMetadata(p *payload, nrows int64) error {
-	p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta)
+	if w.b == nil {
+		p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta, w.custom)
+		return nil
+	}
+
+	w.b.Reset()
+	recFB := recordToFB(w.b, nrows, p.size, w.fields, w.meta)
+	finishMessageFB(w.b, flatbuf.MessageHeaderRecordBatch, recFB, p.size, w.custom)
+
+	raw := w.b.FinishedBytes()
+	w.buf.ResizeNoShrink(len(raw))
+	copy(w.buf.Bytes(), raw)
+
+	// the payload releases its metadata once written: keep ours.
+	w.buf.Retain()
+	p.meta = w.buf
 	return nil
 }
 
+// newTruncatedBitmap returns the length bits of the input bitmap, starting
+// at bit offset, in a bitmap starting at bit zero.
 func newTruncatedBitmap(mem memory.Allocator, offset, length int64, input *memory.Buffer) *memory.Buffer {
-	if input != nil {
-		input.Retain()
-		return input
+	if input == nil {
+		return nil
 	}
 
-	minLength := paddedLength(bitutil.BytesForBits(length), kArrowAlignment)
+	nbytes := bitutil.BytesForBits(length)
 	switch {
-	case offset != 0 || minLength < int64(input.Len()):
-		// with a sliced array / non-zero offset, we must copy the bitmap
-		panic("not implemented") // FIXME(sbinet): writer.cc:75
-	default:
+	case offset == 0 && int64(input.Len()) <= paddedLength(nbytes, kArrowAlignment):
 		input.Retain()
 		return input
+	case offset%8 == 0:
+		// byte-aligned slice: share the bytes of the input bitmap.
+		beg := offset / 8
+		return memory.NewBufferBytes(input.Bytes()[beg : beg+nbytes])
+	default:
+		// with a sliced array / non-zero offset, we must copy the bitmap
+		buf := memory.NewResizableBuffer(mem)
+		buf.Resize(int(nbytes))
+		var (
+			src = input.Bytes()
+			dst = buf.Bytes()
+		)
+		memory.Set(dst, 0)
+		for i := int64(0); i < length; i++ {
+			if bitutil.BitIsSet(src, int(offset+i)) {
+				bitutil.SetBit(dst, int(i))
+			}
+		}
+		return buf
 	}
 }
 
@@ -456,3 +789,44 @@ func minI64(a, b int64) int64 {
 	}
 	return b
 }
+
+// withArrayMetadata returns schema, where the metadata of the columns of rec
+// is merged into the metadata of the matching fields.
+// schema is returned as is when no column of rec has metadata.
+func withArrayMetadata(schema *arrow.Schema, rec array.Record) *arrow.Schema {
+	if rec == nil {
+		return schema
+	}
+	var fields []arrow.Field
+	for i, col := range rec.Columns() {
+		md := col.Data().Metadata()
+		if md == nil || md.Len() == 0 {
+			continue
+		}
+		if fields == nil {
+			fields = append([]arrow.Field(nil), schema.Fields()...)
+		}
+		fields[i].Metadata = mergeMetadata(fields[i].Metadata, *md)
+	}
+	if fields == nil {
+		return schema
+	}
+	meta := schema.Metadata()
+	return arrow.NewSchema(fields, &meta)
+}
+
+// mergeMetadata returns the keys and values of md and of extra, where the
+// values of extra take precedence.
+func mergeMetadata(md, extra arrow.Metadata) arrow.Metadata {
+	var keys, vals []string
+	for i, k := range md.Keys() {
+		if extra.FindKey(k) >= 0 {
+			continue
+		}
+		keys = append(keys, k)
+		vals = append(vals, md.Values()[i])
+	}
+	keys = append(keys, extra.Keys()...)
+	vals = append(vals, extra.Values()...)
+	return arrow.NewMetadata(keys, vals)
+}
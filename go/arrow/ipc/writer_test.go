@@ -0,0 +1,394 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestWriterReleasedColumns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	rec := recs[0].NewSlice(0, recs[0].NumRows())
+	defer rec.Release()
+	rec.ReleaseColumn(1)
+	rec.ReleaseColumn(3)
+
+	const want = "arrow/ipc: cannot write record with released columns [1 3]"
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	if err := w.Write(rec); err == nil || err.Error() != want {
+		t.Fatalf("invalid error: got=%v, want=%q", err, want)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := ioutil.TempFile("", "arrow-ipc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	fw, err := ipc.NewFileWriter(f, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fw.Write(rec); err == nil || err.Error() != want {
+		t.Fatalf("invalid error: got=%v, want=%q", err, want)
+	}
+	if err := fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriterInvalidBuffers(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	offsets := memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 3, 6, 9}))
+
+	for _, tc := range []struct {
+		name string
+		data *array.Data
+		err  string
+	}{
+		{
+			name: "int64-bitmap",
+			data: array.NewData(
+				arrow.PrimitiveTypes.Int64, 3,
+				[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Int64Traits.CastToBytes([]int64{1, 2, 3}))},
+				nil, 1, 0,
+			),
+			err: "validity bitmap buffer too short (got=0 bytes, want>=1)",
+		},
+		{
+			name: "string-data",
+			data: array.NewData(
+				arrow.BinaryTypes.String, 3,
+				[]*memory.Buffer{nil, offsets, memory.NewBufferBytes([]byte("abcdef"))},
+				nil, 0, 0,
+			),
+			err: "data buffer too short (got=6 bytes, want>=9)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.data.Release()
+
+			arr := array.MakeFromData(tc.data)
+			defer arr.Release()
+
+			schema := arrow.NewSchema([]arrow.Field{{Name: "col", Type: arr.DataType(), Nullable: true}}, nil)
+			rec := array.NewRecord(schema, []array.Interface{arr}, -1)
+			defer rec.Release()
+
+			buf := new(bytes.Buffer)
+			w := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+			defer w.Close()
+
+			err := w.Write(rec)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.err) || !strings.Contains(err.Error(), `"col"`) {
+				t.Fatalf("invalid error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWriterBufferSize(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			write := func(opts ...ipc.Option) []byte {
+				buf := new(bytes.Buffer)
+				opts = append(opts, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+				w := ipc.NewWriter(buf, opts...)
+				// write the records twice, so that the second pass reuses
+				// the scratch buffers sized by the first one.
+				for i := 0; i < 2; i++ {
+					for _, rec := range recs {
+						if err := w.Write(rec); err != nil {
+							t.Fatal(err)
+						}
+					}
+				}
+				if err := w.Close(); err != nil {
+					t.Fatal(err)
+				}
+				return buf.Bytes()
+			}
+
+			want := write()
+			for _, n := range []int{1, 1 << 16} {
+				got := write(ipc.WithWriterBufferSize(n))
+				if !bytes.Equal(got, want) {
+					t.Fatalf("buffer-size=%d: stream differs from default", n)
+				}
+			}
+		})
+	}
+}
+
+func TestWriterSlicedRecords(t *testing.T) {
+	for _, name := range arrdataNames() {
+		recs := arrdata.Records[name]
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			var slices []array.Record
+			for _, rec := range recs {
+				n := rec.NumRows()
+				if n > 1 {
+					slices = append(slices, rec.NewSlice(1, n), rec.NewSlice(1, n-1))
+				}
+				slices = append(slices, rec.NewSlice(n, n))
+			}
+			defer func() {
+				for _, rec := range slices {
+					rec.Release()
+				}
+			}()
+
+			buf := new(bytes.Buffer)
+			w := ipc.NewWriter(buf, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+			for _, rec := range slices {
+				if err := w.Write(rec); err != nil {
+					t.Fatalf("could not write record: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := ipc.NewReader(buf, ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Release()
+
+			for i, want := range slices {
+				if !r.Next() {
+					t.Fatalf("could not read record %d: %v", i, r.Err())
+				}
+				if got := r.Record(); !array.RecordEqual(got, want) {
+					t.Fatalf("records %d differ:\ngot:  %v\nwant: %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriterNullColumns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ints", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "nulls", Type: arrow.Null, Nullable: true},
+		{Name: "struct", Type: arrow.StructOf(
+			arrow.Field{Name: "nulls", Type: arrow.Null, Nullable: true},
+			arrow.Field{Name: "int8s", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+		), Nullable: true},
+		{Name: "list", Type: arrow.ListOf(arrow.Null), Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	var (
+		ints = b.Field(0).(*array.Int32Builder)
+		nb   = b.Field(1).(*array.NullBuilder)
+		sb   = b.Field(2).(*array.StructBuilder)
+		lb   = b.Field(3).(*array.ListBuilder)
+	)
+	for i := 0; i < 10; i++ {
+		ints.Append(int32(i))
+		nb.AppendNull()
+		sb.Append(i%3 != 0)
+		sb.FieldBuilder(0).AppendNull()
+		sb.FieldBuilder(1).(*array.Int8Builder).Append(int8(i))
+		lb.Append(i%2 == 0)
+		for j := 0; j < i%4; j++ {
+			lb.ValueBuilder().AppendNull()
+		}
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	recs := []array.Record{rec, rec.NewSlice(3, 7), rec.NewSlice(5, 5)}
+	defer recs[1].Release()
+	defer recs[2].Release()
+
+	for _, isFile := range []bool{false, true} {
+		var got []array.Record
+		if isFile {
+			r, err := ipc.NewFileReader(bytes.NewReader(writeFileBytes(t, mem, recs, ipc.WithSchema(schema))), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < r.NumRecords(); i++ {
+				rec, err := r.Record(i)
+				if err != nil {
+					t.Fatal(err)
+				}
+				rec.Retain()
+				got = append(got, rec)
+			}
+			r.Close()
+		} else {
+			r, err := ipc.NewReader(bytes.NewReader(writeStreamBytes(t, mem, recs, ipc.WithSchema(schema))), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for r.Next() {
+				rec := r.Record()
+				rec.Retain()
+				got = append(got, rec)
+			}
+			if err := r.Err(); err != nil {
+				t.Fatal(err)
+			}
+			r.Release()
+		}
+
+		if len(got) != len(recs) {
+			t.Fatalf("file=%v: invalid number of records: got=%d, want=%d", isFile, len(got), len(recs))
+		}
+		for i, rec := range got {
+			if !array.RecordEqual(rec, recs[i]) {
+				t.Errorf("file=%v: invalid record %d:\ngot= %v\nwant=%v", isFile, i, rec, recs[i])
+			}
+			if n, nulls := rec.Column(1).Len(), rec.Column(1).NullN(); n != nulls {
+				t.Errorf("file=%v: invalid null column %d: len=%d, nulls=%d", isFile, i, n, nulls)
+			}
+			rec.Release()
+		}
+	}
+}
+
+func TestWriterArrayMetadata(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "a", Type: arrow.PrimitiveTypes.Int64, Metadata: arrow.NewMetadata([]string{"k", "source"}, []string{"v", "old"})},
+		{Name: "b", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+	b.AppendValues([]int64{1, 2}, nil)
+	plain := b.NewArray()
+	defer plain.Release()
+
+	md := arrow.NewMetadata([]string{"source"}, []string{"part-0.csv:0-42"})
+	tagged := array.MakeFromDataWithMetadata(plain.Data(), &md)
+	defer tagged.Release()
+
+	rec := array.NewRecord(schema, []array.Interface{tagged, plain}, 2)
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		name string
+		fold bool
+		want arrow.Metadata
+	}{
+		{"ignored", false, schema.Field(0).Metadata},
+		{"folded", true, arrow.NewMetadata([]string{"k", "source"}, []string{"v", "part-0.csv:0-42"})},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("stream", func(t *testing.T) {
+				buf := new(bytes.Buffer)
+				w := ipc.NewWriter(buf, ipc.WithAllocator(mem), ipc.WithArrayMetadata(tc.fold))
+				if err := w.Write(rec); err != nil {
+					t.Fatal(err)
+				}
+				if err := w.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if !w.Schema().Equal(schema) {
+					t.Fatalf("writer schema modified: %v", w.Schema())
+				}
+
+				r, err := ipc.NewReader(buf, ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Release()
+				checkArrayMetadata(t, r.Schema(), tc.want)
+			})
+
+			t.Run("file", func(t *testing.T) {
+				f, err := ioutil.TempFile("", "go-arrow-file-")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer os.Remove(f.Name())
+				defer f.Close()
+
+				w, err := ipc.NewFileWriter(f, ipc.WithAllocator(mem), ipc.WithArrayMetadata(tc.fold))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := w.Write(rec); err != nil {
+					t.Fatal(err)
+				}
+				if err := w.Close(); err != nil {
+					t.Fatal(err)
+				}
+
+				r, err := ipc.NewFileReader(f, ipc.WithAllocator(mem))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Close()
+				checkArrayMetadata(t, r.Schema(), tc.want)
+			})
+		})
+	}
+}
+
+func checkArrayMetadata(t *testing.T, schema *arrow.Schema, want arrow.Metadata) {
+	t.Helper()
+	if got := schema.Field(0).Metadata; !reflect.DeepEqual(got.Keys(), want.Keys()) || !reflect.DeepEqual(got.Values(), want.Values()) {
+		t.Fatalf("invalid metadata for field 0:\ngot= %v\nwant=%v", got, want)
+	}
+	if got := schema.Field(1).Metadata; got.Len() != 0 {
+		t.Fatalf("invalid metadata for field 1: %v", got)
+	}
+}
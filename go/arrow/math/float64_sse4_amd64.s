@@ -1,4 +1,4 @@
-//+build !noasm !appengine
+//+build !noasm
 // AUTO-GENERATED BY C2GOASM -- DO NOT EDIT
 
 TEXT ·_sum_float64_sse4(SB), $0-24
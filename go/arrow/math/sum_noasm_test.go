@@ -0,0 +1,41 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build noasm !amd64
+
+package math
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSumNoasm checks that the Go implementations are used when assembly is
+// disabled, or not available for this platform.
+func TestSumNoasm(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"float64", Float64.sum, sum_float64_go},
+		{"int64", Int64.sum, sum_int64_go},
+		{"uint64", Uint64.sum, sum_uint64_go},
+	} {
+		if got, want := reflect.ValueOf(tc.got).Pointer(), reflect.ValueOf(tc.want).Pointer(); got != want {
+			t.Errorf("%s: the Go implementation of sum is not used", tc.name)
+		}
+	}
+}
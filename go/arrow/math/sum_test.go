@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// The sum implementations usable on this platform, by name. The assembly
+// ones are added by the sum_<arch>_test.go files.
+var (
+	float64SumImpls = map[string]func(*array.Float64) float64{"go": sum_float64_go}
+	int64SumImpls   = map[string]func(*array.Int64) int64{"go": sum_int64_go}
+	uint64SumImpls  = map[string]func(*array.Uint64) uint64{"go": sum_uint64_go}
+)
+
+func sumTestSizes(rng *rand.Rand) []int {
+	sizes := []int{1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 31, 32, 33, 1000}
+	for i := 0; i < 50; i++ {
+		sizes = append(sizes, 1+rng.Intn(5000))
+	}
+	return sizes
+}
+
+// TestSumImpls checks that every sum implementation, and the one selected for
+// this platform, agree with the Go one over random arrays.
+// Floating-point values are integers, so that their sums do not depend on the
+// order of the additions.
+func TestSumImpls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rng := rand.New(rand.NewSource(1))
+	sizes := sumTestSizes(rng)
+
+	t.Run("float64", func(t *testing.T) {
+		impls := map[string]func(*array.Float64) float64{"selected": Float64.sum}
+		for name, fn := range float64SumImpls {
+			impls[name] = fn
+		}
+		for _, n := range sizes {
+			bldr := array.NewFloat64Builder(mem)
+			for i := 0; i < n; i++ {
+				bldr.Append(float64(rng.Intn(2000001) - 1000000))
+			}
+			arr := bldr.NewFloat64Array()
+			bldr.Release()
+
+			want := sum_float64_go(arr)
+			for name, fn := range impls {
+				if got := fn(arr); got != want {
+					t.Errorf("%s: invalid sum of %d values: got=%v, want=%v", name, n, got, want)
+				}
+			}
+			arr.Release()
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		impls := map[string]func(*array.Int64) int64{"selected": Int64.sum}
+		for name, fn := range int64SumImpls {
+			impls[name] = fn
+		}
+		for _, n := range sizes {
+			bldr := array.NewInt64Builder(mem)
+			for i := 0; i < n; i++ {
+				bldr.Append(int64(rng.Uint64()))
+			}
+			arr := bldr.NewInt64Array()
+			bldr.Release()
+
+			want := sum_int64_go(arr)
+			for name, fn := range impls {
+				if got := fn(arr); got != want {
+					t.Errorf("%s: invalid sum of %d values: got=%v, want=%v", name, n, got, want)
+				}
+			}
+			arr.Release()
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		impls := map[string]func(*array.Uint64) uint64{"selected": Uint64.sum}
+		for name, fn := range uint64SumImpls {
+			impls[name] = fn
+		}
+		for _, n := range sizes {
+			bldr := array.NewUint64Builder(mem)
+			for i := 0; i < n; i++ {
+				bldr.Append(rng.Uint64())
+			}
+			arr := bldr.NewUint64Array()
+			bldr.Release()
+
+			want := sum_uint64_go(arr)
+			for name, fn := range impls {
+				if got := fn(arr); got != want {
+					t.Errorf("%s: invalid sum of %d values: got=%v, want=%v", name, n, got, want)
+				}
+			}
+			arr.Release()
+		}
+	})
+}
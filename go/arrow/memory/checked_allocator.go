@@ -16,10 +16,13 @@
 
 package memory
 
+import "sync/atomic"
+
+// CheckedAllocator is an Allocator that keeps track of the number of bytes
+// currently allocated. It may be used simultaneously from multiple goroutines.
 type CheckedAllocator struct {
-	mem  Allocator
-	base int
-	sz   int
+	mem Allocator
+	sz  int64
 }
 
 func NewCheckedAllocator(mem Allocator) *CheckedAllocator {
@@ -27,17 +30,17 @@ func NewCheckedAllocator(mem Allocator) *CheckedAllocator {
 }
 
 func (a *CheckedAllocator) Allocate(size int) []byte {
-	a.sz += size
+	atomic.AddInt64(&a.sz, int64(size))
 	return a.mem.Allocate(size)
 }
 
 func (a *CheckedAllocator) Reallocate(size int, b []byte) []byte {
-	a.sz += size - len(b)
+	atomic.AddInt64(&a.sz, int64(size-len(b)))
 	return a.mem.Reallocate(size, b)
 }
 
 func (a *CheckedAllocator) Free(b []byte) {
-	a.sz -= len(b)
+	atomic.AddInt64(&a.sz, -int64(len(b)))
 	a.mem.Free(b)
 }
 
@@ -47,25 +50,25 @@ type TestingT interface {
 }
 
 func (a *CheckedAllocator) AssertSize(t TestingT, sz int) {
-	if a.sz != sz {
+	if got := atomic.LoadInt64(&a.sz); got != int64(sz) {
 		t.Helper()
-		t.Errorf("invalid memory size exp=%d, got=%d", sz, a.sz)
+		t.Errorf("invalid memory size exp=%d, got=%d", sz, got)
 	}
 }
 
 type CheckedAllocatorScope struct {
 	alloc *CheckedAllocator
-	sz    int
+	sz    int64
 }
 
 func NewCheckedAllocatorScope(alloc *CheckedAllocator) *CheckedAllocatorScope {
-	return &CheckedAllocatorScope{alloc: alloc, sz: alloc.sz}
+	return &CheckedAllocatorScope{alloc: alloc, sz: atomic.LoadInt64(&alloc.sz)}
 }
 
 func (c *CheckedAllocatorScope) CheckSize(t TestingT) {
-	if c.sz != c.alloc.sz {
+	if got := atomic.LoadInt64(&c.alloc.sz); c.sz != got {
 		t.Helper()
-		t.Errorf("invalid memory size exp=%d, got=%d", c.sz, c.alloc.sz)
+		t.Errorf("invalid memory size exp=%d, got=%d", c.sz, got)
 	}
 }
 
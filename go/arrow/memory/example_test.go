@@ -0,0 +1,51 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// reporter prints the failures reported by a CheckedAllocator.
+// Tests pass their *testing.T instead.
+type reporter struct{}
+
+func (reporter) Errorf(format string, args ...interface{}) { fmt.Printf(format+"\n", args...) }
+func (reporter) Helper()                                   {}
+
+func ExampleCheckedAllocator() {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	b := array.NewInt64Builder(mem)
+	b.AppendValues([]int64{1, 2, 3}, nil)
+	arr := b.NewArray()
+	b.Release()
+
+	// arr has not been released yet: its buffers are still allocated.
+	mem.AssertSize(reporter{}, 0)
+
+	arr.Release()
+	mem.AssertSize(reporter{}, 0)
+	fmt.Println("no leak")
+
+	// Output:
+	// invalid memory size exp=0, got=320
+	// no leak
+}
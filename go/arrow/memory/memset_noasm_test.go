@@ -0,0 +1,32 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build noasm !amd64
+
+package memory
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestMemsetNoasm checks that the Go implementation is used when assembly is
+// disabled, or not available for this platform.
+func TestMemsetNoasm(t *testing.T) {
+	if got, want := reflect.ValueOf(memset).Pointer(), reflect.ValueOf(memory_memset_go).Pointer(); got != want {
+		t.Fatalf("the Go implementation of memset is not used")
+	}
+}
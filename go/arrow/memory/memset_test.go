@@ -0,0 +1,81 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// memsetImpls holds the memset implementations usable on this platform, by
+// name. The assembly ones are added by the memset_<arch>_test.go files.
+var memsetImpls = map[string]func([]byte, byte){
+	"go": memory_memset_go,
+}
+
+// TestMemsetImpls checks that every memset implementation, and the one
+// selected for this platform, fill exactly the given slice, over random sizes,
+// offsets and values.
+func TestMemsetImpls(t *testing.T) {
+	impls := map[string]func([]byte, byte){"selected": memset}
+	for name, fn := range memsetImpls {
+		impls[name] = fn
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	sizes := []int{0, 1, 7, 15, 16, 17, 31, 32, 33, 63, 64, 65, 255, 256, 257, 1999, 2000, 2001, 4096}
+	for i := 0; i < 100; i++ {
+		sizes = append(sizes, rng.Intn(10000))
+	}
+
+	for name, fn := range impls {
+		t.Run(name, func(t *testing.T) {
+			for _, n := range sizes {
+				var (
+					off  = rng.Intn(16)
+					c    = byte(rng.Intn(256))
+					want = make([]byte, n+32)
+				)
+				rng.Read(want)
+				got := append([]byte(nil), want...)
+
+				memory_memset_go(want[off:off+n], c)
+				fn(got[off:off+n], c)
+				if !bytes.Equal(got, want) {
+					t.Fatalf("invalid memset of %d bytes at offset %d with %#x", n, off, c)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMemsetImpls(b *testing.B) {
+	for name, fn := range memsetImpls {
+		for _, n := range []int{64, 1024, 8192} {
+			b.Run(fmt.Sprintf("%s/%d", name, n), func(b *testing.B) {
+				buf := make([]byte, n)
+				b.SetBytes(int64(n))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					fn(buf, 0x1f)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultMaxNestingDepth is the default maximum nesting depth of data types,
+// ie: the number of list, fixed-size list or struct levels enclosing the most
+// deeply nested field of a data type.
+const DefaultMaxNestingDepth = 64
+
+// ErrNestingTooDeep is matched, with xerrors.Is, by the errors reporting a data
+// type nested deeper than allowed.
+var ErrNestingTooDeep = errors.New("arrow: nesting too deep")
+
+// NestingError describes a data type nested deeper than allowed.
+type NestingError struct {
+	Path  string // dot-separated names of the fields leading to the offending field
+	Depth int    // nesting depth of the offending field
+	Max   int    // maximum nesting depth allowed
+}
+
+func (e *NestingError) Error() string {
+	return fmt.Sprintf("arrow: field %q has nesting depth %d, exceeding the maximum of %d", e.Path, e.Depth, e.Max)
+}
+
+// Is reports whether target is ErrNestingTooDeep.
+func (e *NestingError) Is(target error) bool { return target == ErrNestingTooDeep }
+
+// ValidateNestingDepth returns a *NestingError if the data type of f is nested
+// deeper than max levels. The elements of lists are named after their
+// element field, and the ones of large and fixed-size lists "item".
+//
+// Data types are traversed iteratively, so that validating arbitrarily deep
+// data types does not exhaust the stack.
+func ValidateNestingDepth(f Field, max int) error {
+	type node struct {
+		name   string
+		dtype  DataType
+		depth  int
+		parent *node
+	}
+
+	stack := []*node{{name: f.Name, dtype: f.Type}}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if n.depth > max {
+			var names []string
+			for p := n; p != nil; p = p.parent {
+				if p.parent == nil && p.name == "" {
+					break // anonymous top-level data type.
+				}
+				names = append(names, p.name)
+			}
+			for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+				names[i], names[j] = names[j], names[i]
+			}
+			return &NestingError{Path: strings.Join(names, "."), Depth: n.depth, Max: max}
+		}
+
+		switch dt := n.dtype.(type) {
+		case *ListType:
+			stack = append(stack, &node{name: dt.elem.Name, dtype: dt.Elem(), depth: n.depth + 1, parent: n})
+		case *LargeListType:
+			stack = append(stack, &node{name: "item", dtype: dt.Elem(), depth: n.depth + 1, parent: n})
+		case *FixedSizeListType:
+			stack = append(stack, &node{name: "item", dtype: dt.Elem(), depth: n.depth + 1, parent: n})
+		case *MapType:
+			stack = append(stack, &node{name: "entries", dtype: dt.Elem(), depth: n.depth + 1, parent: n})
+		case *StructType:
+			for i := len(dt.fields) - 1; i >= 0; i-- {
+				stack = append(stack, &node{name: dt.fields[i].Name, dtype: dt.fields[i].Type, depth: n.depth + 1, parent: n})
+			}
+		case StorageProvider:
+			n.dtype = dt.StorageType()
+			stack = append(stack, n)
+		case *DictionaryType:
+			n.dtype = dt.ValueType
+			stack = append(stack, n)
+		}
+	}
+	return nil
+}
+
+// writeType writes the string representation of dt, nested at the given
+// depth, to o. Types nested deeper than DefaultMaxNestingDepth are elided as
+// "...", so that printing arbitrarily deep data types does not exhaust the
+// stack.
+func writeType(o *strings.Builder, dt DataType, depth int) {
+	if depth > DefaultMaxNestingDepth {
+		o.WriteString("...")
+		return
+	}
+
+	switch dt := dt.(type) {
+	case *ListType:
+		o.WriteString("list<")
+		o.WriteString(dt.elem.Name)
+		o.WriteString(": ")
+		writeType(o, dt.elem.Type, depth+1)
+		o.WriteString(">")
+	case *LargeListType:
+		o.WriteString("large_list<item: ")
+		writeType(o, dt.elem, depth+1)
+		o.WriteString(">")
+	case *FixedSizeListType:
+		o.WriteString("fixed_size_list<item: ")
+		writeType(o, dt.elem, depth+1)
+		fmt.Fprintf(o, ">[%d]", dt.n)
+	case *MapType:
+		o.WriteString("map<")
+		writeType(o, dt.KeyType(), depth+1)
+		o.WriteString(", ")
+		writeType(o, dt.ItemType(), depth+1)
+		if dt.KeysSorted {
+			o.WriteString(", keys_sorted")
+		}
+		o.WriteString(">")
+	case *StructType:
+		o.WriteString("struct<")
+		for i, f := range dt.fields {
+			if i > 0 {
+				o.WriteString(", ")
+			}
+			o.WriteString(f.Name)
+			o.WriteString(": ")
+			writeType(o, f.Type, depth+1)
+		}
+		o.WriteString(">")
+	case *DictionaryType:
+		o.WriteString("dictionary<values=")
+		writeType(o, dt.ValueType, depth+1)
+		fmt.Fprintf(o, ", indices=%v, ordered=%v>", dt.IndexType, dt.Ordered)
+	default:
+		fmt.Fprintf(o, "%v", dt)
+	}
+}
+
+// typeString returns the string representation of the nested type dt.
+func typeString(dt DataType) string {
+	o := new(strings.Builder)
+	writeType(o, dt, 0)
+	return o.String()
+}
@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"golang.org/x/xerrors"
+)
+
+// nestedList returns a data type with n levels of lists around elem.
+func nestedList(n int, elem arrow.DataType) arrow.DataType {
+	dt := elem
+	for i := 0; i < n; i++ {
+		dt = arrow.ListOf(dt)
+	}
+	return dt
+}
+
+func TestValidateNestingDepth(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		field arrow.Field
+		max   int
+		path  string // path of the offending field, or empty if valid.
+	}{
+		{"primitive", arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int32}, 0, ""},
+		{"list-ok", arrow.Field{Name: "f", Type: nestedList(3, arrow.PrimitiveTypes.Int32)}, 3, ""},
+		{"list-deep", arrow.Field{Name: "f", Type: nestedList(3, arrow.PrimitiveTypes.Int32)}, 2, "f.item.item.item"},
+		{"fixed-size-list", arrow.Field{Name: "f", Type: arrow.FixedSizeListOf(2, nestedList(1, arrow.PrimitiveTypes.Int32))}, 1, "f.item.item"},
+		{"struct", arrow.Field{Name: "s", Type: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "b", Type: arrow.StructOf(arrow.Field{Name: "c", Type: nestedList(1, arrow.BinaryTypes.String)})},
+		)}, 2, "s.b.c.item"},
+		{"anonymous", arrow.Field{Type: nestedList(2, arrow.PrimitiveTypes.Int32)}, 1, "item.item"},
+		{"default", arrow.Field{Name: "f", Type: nestedList(arrow.DefaultMaxNestingDepth, arrow.PrimitiveTypes.Int32)}, arrow.DefaultMaxNestingDepth, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := arrow.ValidateNestingDepth(tc.field, tc.max)
+			if tc.path == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+				t.Fatalf("invalid error: %v", err)
+			}
+			var nerr *arrow.NestingError
+			if !xerrors.As(err, &nerr) {
+				t.Fatalf("invalid error type %T", err)
+			}
+			if got, want := *nerr, (arrow.NestingError{Path: tc.path, Depth: tc.max + 1, Max: tc.max}); got != want {
+				t.Fatalf("invalid error: got=%+v, want=%+v", got, want)
+			}
+		})
+	}
+}
+
+func TestNestingDeepTypes(t *testing.T) {
+	dt := nestedList(100000, arrow.PrimitiveTypes.Int32)
+
+	err := arrow.ValidateNestingDepth(arrow.Field{Name: "deep", Type: dt}, arrow.DefaultMaxNestingDepth)
+	if !xerrors.Is(err, arrow.ErrNestingTooDeep) {
+		t.Fatalf("invalid error: %v", err)
+	}
+
+	str := dt.(*arrow.ListType).String()
+	if got, want := strings.Count(str, "list<"), arrow.DefaultMaxNestingDepth+1; got != want {
+		t.Fatalf("invalid number of printed levels: got=%d, want=%d", got, want)
+	}
+	if !strings.Contains(str, "<item: ...>") {
+		t.Fatalf("deeply nested levels not elided: %s", str[:100])
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "deep", Type: dt}}, nil)
+	if got := schema.String(); !strings.Contains(got, "deep: type=list<item: list<item: ") {
+		t.Fatalf("invalid schema representation: %s", got[:100])
+	}
+}
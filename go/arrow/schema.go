@@ -137,6 +137,10 @@ func (sc *Schema) Metadata() Metadata { return sc.meta }
 func (sc *Schema) Fields() []Field    { return sc.fields }
 func (sc *Schema) Field(i int) Field  { return sc.fields[i] }
 
+// FieldsByName returns the fields with the given name, in the order of the
+// schema, and whether there is at least one. Schemas may hold several fields
+// with the same name, e.g. after a join: use Field to access a field by its
+// index.
 func (sc *Schema) FieldsByName(n string) ([]Field, bool) {
 	indices, ok := sc.index[n]
 	if !ok {
@@ -149,7 +153,8 @@ func (sc *Schema) FieldsByName(n string) ([]Field, bool) {
 	return fields, ok
 }
 
-// FieldIndices returns the indices of the named field or nil.
+// FieldIndices returns the indices of the fields with the given name, in
+// increasing order, or nil.
 func (sc *Schema) FieldIndices(n string) []int {
 	return sc.index[n]
 }
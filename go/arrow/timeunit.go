@@ -0,0 +1,113 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"math"
+
+	"golang.org/x/xerrors"
+)
+
+// convertUnit converts v from the time unit from to the time unit to.
+// Conversions to a coarser unit truncate towards zero and never overflow.
+// Conversions to a finer unit report whether they overflowed, in which case
+// the returned value is saturated to the int64 range.
+func convertUnit(v int64, from, to TimeUnit) (int64, bool) {
+	var (
+		fnanos = from.Multiplier()
+		tnanos = to.Multiplier()
+	)
+	switch {
+	case fnanos == tnanos:
+		return v, true
+	case fnanos < tnanos:
+		return v / (tnanos / fnanos), true
+	}
+
+	factor := fnanos / tnanos
+	switch {
+	case v > math.MaxInt64/factor:
+		return math.MaxInt64, false
+	case v < math.MinInt64/factor:
+		return math.MinInt64, false
+	default:
+		return v * factor, true
+	}
+}
+
+func overflowError(v int64, from, to TimeUnit) error {
+	return xerrors.Errorf("arrow: overflow converting %d from %v to %v", v, from, to)
+}
+
+// ConvertTimestampValue converts the timestamp v from the time unit from to
+// the time unit to.
+//
+// Conversions to a coarser unit truncate towards zero. Conversions to a finer
+// unit return an error if the result does not fit in an int64.
+func ConvertTimestampValue(v Timestamp, from, to TimeUnit) (Timestamp, error) {
+	o, ok := convertUnit(int64(v), from, to)
+	if !ok {
+		return 0, overflowError(int64(v), from, to)
+	}
+	return Timestamp(o), nil
+}
+
+// ConvertDurationValue converts the duration v from the time unit from to the
+// time unit to, as ConvertTimestampValue does.
+func ConvertDurationValue(v Duration, from, to TimeUnit) (Duration, error) {
+	o, ok := convertUnit(int64(v), from, to)
+	if !ok {
+		return 0, overflowError(int64(v), from, to)
+	}
+	return Duration(o), nil
+}
+
+// ConvertTimestampValues converts the timestamps of src from the time unit
+// from to the time unit to, and stores them in dst.
+// dst must be at least as long as src, and may be src itself.
+//
+// Values are converted as by ConvertTimestampValue. When a value overflows:
+//  - if saturate is false, ConvertTimestampValues stops and returns the
+//    index of the overflowing value, and an error;
+//  - if saturate is true, the value is clamped to math.MinInt64 or
+//    math.MaxInt64 and the conversion continues.
+// Otherwise, ConvertTimestampValues returns len(src) and a nil error.
+func ConvertTimestampValues(dst, src []Timestamp, from, to TimeUnit, saturate bool) (int, error) {
+	dst = dst[:len(src)]
+	for i, v := range src {
+		o, ok := convertUnit(int64(v), from, to)
+		if !ok && !saturate {
+			return i, overflowError(int64(v), from, to)
+		}
+		dst[i] = Timestamp(o)
+	}
+	return len(src), nil
+}
+
+// ConvertDurationValues converts the durations of src from the time unit from
+// to the time unit to, and stores them in dst, as ConvertTimestampValues does.
+func ConvertDurationValues(dst, src []Duration, from, to TimeUnit, saturate bool) (int, error) {
+	dst = dst[:len(src)]
+	for i, v := range src {
+		o, ok := convertUnit(int64(v), from, to)
+		if !ok && !saturate {
+			return i, overflowError(int64(v), from, to)
+		}
+		dst[i] = Duration(o)
+	}
+	return len(src), nil
+}
@@ -0,0 +1,116 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+var units = []arrow.TimeUnit{arrow.Second, arrow.Millisecond, arrow.Microsecond, arrow.Nanosecond}
+
+// factor returns the number of to units in one from unit, or 0 if to is
+// coarser than from.
+func factor(from, to arrow.TimeUnit) int64 {
+	if to > from {
+		return 0
+	}
+	f := int64(1)
+	for u := from; u > to; u-- {
+		f *= 1000
+	}
+	return f
+}
+
+func TestConvertTimestampValueBoundaries(t *testing.T) {
+	for _, from := range units {
+		for _, to := range units {
+			t.Run(fmt.Sprintf("%v-%v", from, to), func(t *testing.T) {
+				f := factor(from, to)
+				if f == 0 {
+					// coarser units truncate towards zero and never overflow.
+					div := factor(to, from)
+					for _, v := range []int64{math.MaxInt64, math.MinInt64, -1, 1, div + 1, -div - 1} {
+						got, err := arrow.ConvertTimestampValue(arrow.Timestamp(v), from, to)
+						if err != nil {
+							t.Fatalf("unexpected error converting %d: %v", v, err)
+						}
+						if want := arrow.Timestamp(v / div); got != want {
+							t.Fatalf("invalid conversion of %d: got=%d, want=%d", v, got, want)
+						}
+					}
+					return
+				}
+
+				var (
+					max = int64(math.MaxInt64) / f
+					min = int64(math.MinInt64) / f
+				)
+				for _, v := range []int64{max, min, 0, -1} {
+					got, err := arrow.ConvertTimestampValue(arrow.Timestamp(v), from, to)
+					if err != nil {
+						t.Fatalf("unexpected error converting %d: %v", v, err)
+					}
+					if want := arrow.Timestamp(v * f); got != want {
+						t.Fatalf("invalid conversion of %d: got=%d, want=%d", v, got, want)
+					}
+					d, err := arrow.ConvertDurationValue(arrow.Duration(v), from, to)
+					if err != nil || d != arrow.Duration(v*f) {
+						t.Fatalf("invalid duration conversion of %d: got=%d, err=%v", v, d, err)
+					}
+				}
+				if f == 1 {
+					return
+				}
+				for _, v := range []int64{max + 1, min - 1, math.MaxInt64, math.MinInt64} {
+					if _, err := arrow.ConvertTimestampValue(arrow.Timestamp(v), from, to); err == nil {
+						t.Fatalf("expected an overflow error converting %d", v)
+					}
+					if _, err := arrow.ConvertDurationValue(arrow.Duration(v), from, to); err == nil {
+						t.Fatalf("expected an overflow error converting duration %d", v)
+					}
+				}
+
+				src := []arrow.Timestamp{1, arrow.Timestamp(max), arrow.Timestamp(min - 1), arrow.Timestamp(max + 1)}
+				dst := make([]arrow.Timestamp, len(src))
+				n, err := arrow.ConvertTimestampValues(dst, src, from, to, false)
+				if err == nil || n != 2 {
+					t.Fatalf("invalid result: n=%d, err=%v (want n=2 and an error)", n, err)
+				}
+				n, err = arrow.ConvertTimestampValues(dst, src, from, to, true)
+				if err != nil || n != len(src) {
+					t.Fatalf("invalid saturated result: n=%d, err=%v", n, err)
+				}
+				want := []arrow.Timestamp{arrow.Timestamp(f), arrow.Timestamp(max * f), math.MinInt64, math.MaxInt64}
+				for i := range want {
+					if dst[i] != want[i] {
+						t.Fatalf("invalid saturated value %d: got=%d, want=%d", i, dst[i], want[i])
+					}
+				}
+
+				ds := []arrow.Duration{arrow.Duration(min - 1), 1}
+				n, err = arrow.ConvertDurationValues(ds, ds, from, to, false)
+				if err == nil || n != 0 {
+					t.Fatalf("invalid duration result: n=%d, err=%v (want n=0 and an error)", n, err)
+				}
+			})
+		}
+	}
+}
@@ -23,58 +23,9 @@ import (
 )
 
 var (
-	MonthIntervalTraits   monthTraits
 	DayTimeIntervalTraits daytimeTraits
 )
 
-// MonthInterval traits
-
-const (
-	// MonthIntervalSizeBytes specifies the number of bytes required to store a single MonthInterval in memory
-	MonthIntervalSizeBytes = int(unsafe.Sizeof(MonthInterval(0)))
-)
-
-type monthTraits struct{}
-
-// BytesRequired returns the number of bytes required to store n elements in memory.
-func (monthTraits) BytesRequired(n int) int { return MonthIntervalSizeBytes * n }
-
-// PutValue
-func (monthTraits) PutValue(b []byte, v MonthInterval) {
-	binary.LittleEndian.PutUint32(b, uint32(v))
-}
-
-// CastFromBytes reinterprets the slice b to a slice of type MonthInterval.
-//
-// NOTE: len(b) must be a multiple of MonthIntervalSizeBytes.
-func (monthTraits) CastFromBytes(b []byte) []MonthInterval {
-	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
-
-	var res []MonthInterval
-	s := (*reflect.SliceHeader)(unsafe.Pointer(&res))
-	s.Data = h.Data
-	s.Len = h.Len / MonthIntervalSizeBytes
-	s.Cap = h.Cap / MonthIntervalSizeBytes
-
-	return res
-}
-
-// CastToBytes reinterprets the slice b to a slice of bytes.
-func (monthTraits) CastToBytes(b []MonthInterval) []byte {
-	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
-
-	var res []byte
-	s := (*reflect.SliceHeader)(unsafe.Pointer(&res))
-	s.Data = h.Data
-	s.Len = h.Len * MonthIntervalSizeBytes
-	s.Cap = h.Cap * MonthIntervalSizeBytes
-
-	return res
-}
-
-// Copy copies src to dst.
-func (monthTraits) Copy(dst, src []MonthInterval) { copy(dst, src) }
-
 // DayTimeInterval traits
 
 const (
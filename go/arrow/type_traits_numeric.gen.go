@@ -26,22 +26,23 @@ import (
 )
 
 var (
-	Int64Traits     int64Traits
-	Uint64Traits    uint64Traits
-	Float64Traits   float64Traits
-	Int32Traits     int32Traits
-	Uint32Traits    uint32Traits
-	Float32Traits   float32Traits
-	Int16Traits     int16Traits
-	Uint16Traits    uint16Traits
-	Int8Traits      int8Traits
-	Uint8Traits     uint8Traits
-	TimestampTraits timestampTraits
-	Time32Traits    time32Traits
-	Time64Traits    time64Traits
-	Date32Traits    date32Traits
-	Date64Traits    date64Traits
-	DurationTraits  durationTraits
+	Int64Traits         int64Traits
+	Uint64Traits        uint64Traits
+	Float64Traits       float64Traits
+	Int32Traits         int32Traits
+	Uint32Traits        uint32Traits
+	Float32Traits       float32Traits
+	Int16Traits         int16Traits
+	Uint16Traits        uint16Traits
+	Int8Traits          int8Traits
+	Uint8Traits         uint8Traits
+	TimestampTraits     timestampTraits
+	Time32Traits        time32Traits
+	Time64Traits        time64Traits
+	Date32Traits        date32Traits
+	Date64Traits        date64Traits
+	DurationTraits      durationTraits
+	MonthIntervalTraits monthIntervalTraits
 )
 
 // Int64 traits
@@ -811,3 +812,51 @@ func (durationTraits) CastToBytes(b []Duration) []byte {
 
 // Copy copies src to dst.
 func (durationTraits) Copy(dst, src []Duration) { copy(dst, src) }
+
+// MonthInterval traits
+
+const (
+	// MonthIntervalSizeBytes specifies the number of bytes required to store a single MonthInterval in memory
+	MonthIntervalSizeBytes = int(unsafe.Sizeof(MonthInterval(0)))
+)
+
+type monthIntervalTraits struct{}
+
+// BytesRequired returns the number of bytes required to store n elements in memory.
+func (monthIntervalTraits) BytesRequired(n int) int { return MonthIntervalSizeBytes * n }
+
+// PutValue
+func (monthIntervalTraits) PutValue(b []byte, v MonthInterval) {
+	binary.LittleEndian.PutUint32(b, uint32(v))
+}
+
+// CastFromBytes reinterprets the slice b to a slice of type MonthInterval.
+//
+// NOTE: len(b) must be a multiple of MonthIntervalSizeBytes.
+func (monthIntervalTraits) CastFromBytes(b []byte) []MonthInterval {
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+
+	var res []MonthInterval
+	s := (*reflect.SliceHeader)(unsafe.Pointer(&res))
+	s.Data = h.Data
+	s.Len = h.Len / MonthIntervalSizeBytes
+	s.Cap = h.Cap / MonthIntervalSizeBytes
+
+	return res
+}
+
+// CastToBytes reinterprets the slice b to a slice of bytes.
+func (monthIntervalTraits) CastToBytes(b []MonthInterval) []byte {
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+
+	var res []byte
+	s := (*reflect.SliceHeader)(unsafe.Pointer(&res))
+	s.Data = h.Data
+	s.Len = h.Len * MonthIntervalSizeBytes
+	s.Cap = h.Cap * MonthIntervalSizeBytes
+
+	return res
+}
+
+// Copy copies src to dst.
+func (monthIntervalTraits) Copy(dst, src []MonthInterval) { copy(dst, src) }
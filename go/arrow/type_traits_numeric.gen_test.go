@@ -568,3 +568,37 @@ func TestDurationTraits(t *testing.T) {
 		t.Fatalf("invalid values:\nv1=%v\nv2=%v\n", v1, v2)
 	}
 }
+
+func TestMonthIntervalTraits(t *testing.T) {
+	const N = 10
+	b1 := arrow.MonthIntervalTraits.CastToBytes([]arrow.MonthInterval{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+	})
+
+	b2 := make([]byte, arrow.MonthIntervalTraits.BytesRequired(N))
+	for i := 0; i < N; i++ {
+		beg := i * arrow.MonthIntervalSizeBytes
+		end := (i + 1) * arrow.MonthIntervalSizeBytes
+		arrow.MonthIntervalTraits.PutValue(b2[beg:end], arrow.MonthInterval(i))
+	}
+
+	if !reflect.DeepEqual(b1, b2) {
+		v1 := arrow.MonthIntervalTraits.CastFromBytes(b1)
+		v2 := arrow.MonthIntervalTraits.CastFromBytes(b2)
+		t.Fatalf("invalid values:\nb1=%v\nb2=%v\nv1=%v\nv2=%v\n", b1, b2, v1, v2)
+	}
+
+	v1 := arrow.MonthIntervalTraits.CastFromBytes(b1)
+	for i, v := range v1 {
+		if got, want := v, arrow.MonthInterval(i); got != want {
+			t.Fatalf("invalid value[%d]. got=%v, want=%v", i, got, want)
+		}
+	}
+
+	v2 := make([]arrow.MonthInterval, N)
+	arrow.MonthIntervalTraits.Copy(v2, v1)
+
+	if !reflect.DeepEqual(v1, v2) {
+		t.Fatalf("invalid values:\nv1=%v\nv2=%v\n", v1, v2)
+	}
+}
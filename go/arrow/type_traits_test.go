@@ -23,6 +23,7 @@ import (
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
 	"github.com/apache/arrow/go/arrow/float16"
 )
 
@@ -132,34 +133,33 @@ func TestDecimal128Traits(t *testing.T) {
 	}
 }
 
-func TestMonthIntervalTraits(t *testing.T) {
+func TestDecimal256Traits(t *testing.T) {
 	const N = 10
-	b1 := arrow.MonthIntervalTraits.CastToBytes([]arrow.MonthInterval{
-		0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-	})
-
-	b2 := make([]byte, arrow.MonthIntervalTraits.BytesRequired(N))
+	nbytes := arrow.Decimal256Traits.BytesRequired(N)
+	b1 := make([]byte, nbytes)
 	for i := 0; i < N; i++ {
-		beg := i * arrow.MonthIntervalSizeBytes
-		end := (i + 1) * arrow.MonthIntervalSizeBytes
-		arrow.MonthIntervalTraits.PutValue(b2[beg:end], arrow.MonthInterval(i))
+		beg := i * arrow.Decimal256SizeBytes
+		end := (i + 1) * arrow.Decimal256SizeBytes
+		arrow.Decimal256Traits.PutValue(b1[beg:end], decimal256.New(uint64(i), 3, 2, 1))
 	}
 
-	if !reflect.DeepEqual(b1, b2) {
-		v1 := arrow.MonthIntervalTraits.CastFromBytes(b1)
-		v2 := arrow.MonthIntervalTraits.CastFromBytes(b2)
-		t.Fatalf("invalid values:\nb1=%v\nb2=%v\nv1=%v\nv2=%v\n", b1, b2, v1, v2)
+	if got, want := b1[:8], []byte{1, 0, 0, 0, 0, 0, 0, 0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid little-endian layout: got=%v, want=%v", got, want)
 	}
 
-	v1 := arrow.MonthIntervalTraits.CastFromBytes(b1)
+	v1 := arrow.Decimal256Traits.CastFromBytes(b1)
 	for i, v := range v1 {
-		if got, want := v, arrow.MonthInterval(i); got != want {
+		if got, want := v, decimal256.New(uint64(i), 3, 2, 1); got != want {
 			t.Fatalf("invalid value[%d]. got=%v, want=%v", i, got, want)
 		}
 	}
 
-	v2 := make([]arrow.MonthInterval, N)
-	arrow.MonthIntervalTraits.Copy(v2, v1)
+	if b2 := arrow.Decimal256Traits.CastToBytes(v1); !reflect.DeepEqual(b1, b2) {
+		t.Fatalf("invalid bytes:\nb1=%v\nb2=%v\n", b1, b2)
+	}
+
+	v2 := make([]decimal256.Num, N)
+	arrow.Decimal256Traits.Copy(v2, v1)
 
 	if !reflect.DeepEqual(v1, v2) {
 		t.Fatalf("invalid values:\nv1=%v\nv2=%v\n", v1, v2)